@@ -45,6 +45,15 @@ type binary struct {
 	// env contains the Mumble binary required environment variables
 	env []string
 
+	// packaging, launchArgs and configBase describe the clients installed
+	// with Flatpak, Snap or as an AppImage, which are started with
+	// launchArgs before the Mumble arguments and keep their configuration
+	// in configDir, a temporary directory created inside configBase
+	packaging  packaging
+	launchArgs []string
+	configBase string
+	configDir  string
+
 	// The last occurred error during Mumble binary detection
 	lastError error
 }
@@ -104,6 +113,13 @@ func (b *binary) remove() {
 			log.Errorf("An error occurred while removing Mumble temp directory: %s", err.Error())
 		}
 	}
+
+	if b.configDir != "" {
+		err := os.RemoveAll(b.configDir)
+		if err != nil {
+			log.Errorf("An error occurred while removing Mumble configuration directory: %s", err.Error())
+		}
+	}
 }
 
 func closeAndIgnore(c io.Closer) {
@@ -145,10 +161,12 @@ func realBinaryPath(path string) string {
 func searchBinary(conf *config.ApplicationConfig) (*binary, error) {
 	callbacks := []func() (*binary, error){
 		searchBinaryInConf(conf),
+		searchManagedBinary(conf),
 		searchBinaryInLocalDir,
 		searchBinaryInCurrentWorkingDir,
 		searchBinaryInDataDir,
 		searchBinaryInSystem,
+		searchPackagedBinary,
 	}
 
 	for _, c := range callbacks {
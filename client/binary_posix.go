@@ -21,7 +21,7 @@ var (
 
 func searchBinaryInSystem() (*binary, error) {
 	path, err := execLookPath("mumble")
-	if err != nil {
+	if err != nil || isSnapPath(path) {
 		return nil, nil
 	}
 
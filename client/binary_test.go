@@ -1041,6 +1041,7 @@ func (s *clientSuite) Test_envIfBundle_returnsNilWhenBinaryIsBundledButDoesNotHa
 
 func (s *clientSuite) Test_searchBinary_returnsNilWhenNoBinaryIsFound(c *C) {
 	conf := &config.ApplicationConfig{}
+	defer gostub.New().Stub(&searchPackagedBinary, func() (*binary, error) { return nil, nil }).Reset()
 
 	ml := &mockLookPath{}
 	defer gostub.New().Stub(&execLookPath, ml.LookPath).Reset()
@@ -13,6 +13,14 @@ const (
 	wahayMumbleBundlePath = "wahay/Mumble/client/mumble.exe"
 )
 
+// mumbleInstallPaths are where the installers of Mumble put the client
+// inside the programs directory. Mumble 1.3 and older didn't have the
+// client directory, since the server was installed next to it
+var mumbleInstallPaths = []string{
+	mumbleBundlePath,
+	"Mumble/mumble.exe",
+}
+
 var (
 	execLookPath = exec.LookPath
 	osGetenv     = os.Getenv
@@ -21,16 +29,15 @@ var (
 func searchBinaryInSystem() (*binary, error) {
 	//Here we ignore the error because we handle the empty string returned.
 	path, _ := execLookPath("mumble.exe")
-	programFilesDir := osGetenv("PROGRAMFILES")
-	programFilesX86Dir := osGetenv("PROGRAMFILES(X86)")
-	dirs := []string{
-		path,
-		programFilesX86Dir,
-		programFilesDir,
+	if path != "" {
+		b := isThereAnAvailableBinary(path)
+		if b != nil && b.isValid {
+			return b, nil
+		}
 	}
 
-	for _, d := range dirs {
-		b := isThereAnAvailableBinary(d)
+	for _, p := range mumbleWindowsCandidates() {
+		b := isThereAnAvailableBinary(p)
 		if b != nil && b.isValid {
 			return b, nil
 		}
@@ -39,17 +46,51 @@ func searchBinaryInSystem() (*binary, error) {
 	return nil, nil
 }
 
+// mumbleWindowsCandidates returns the places Mumble is installed in,
+// for everybody or only for the current user, which is what the
+// installer does when it's run without administrator rights
+func mumbleWindowsCandidates() []string {
+	programDirs := []string{
+		osGetenv("PROGRAMFILES"),
+		osGetenv("PROGRAMFILES(X86)"),
+	}
+	if local := osGetenv("LOCALAPPDATA"); local != "" {
+		programDirs = append(programDirs, filepath.Join(local, "Programs"))
+	}
+
+	var candidates []string
+	for _, d := range programDirs {
+		if d == "" {
+			continue
+		}
+		for _, p := range mumbleInstallPaths {
+			candidates = append(candidates, filepath.Join(d, p))
+		}
+	}
+
+	return candidates
+}
+
+// copyBinaryFilesToDir copies the directory of the client, since
+// mumble.exe needs the libraries and plugins installed next to it
 func (b *binary) copyBinaryFilesToDir(destination string) error {
-	err := filepath.Walk(filepath.Dir(b.path), func(path string, info os.FileInfo, err error) error {
+	source := filepath.Dir(b.path)
+	target := filepath.Dir(destination)
+
+	err := filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
-		relPath, err := filepath.Rel(b.path, path)
+		relPath, err := filepath.Rel(source, path)
 		if err != nil {
 			return err
 		}
-		targetPath := filepath.Join(destination, relPath)
+
+		targetPath := filepath.Join(target, relPath)
+		if path == b.path {
+			targetPath = destination
+		}
 
 		if info.IsDir() {
 			return os.MkdirAll(targetPath, info.Mode())
@@ -30,6 +30,10 @@ import (
 const certServerPort = 8181
 
 func (c *client) requestCertificate() error {
+	c.Lock()
+	c.serverCertificate = nil
+	c.Unlock()
+
 	u := &url.URL{
 		Scheme: "http",
 		Host:   net.JoinHostPort(c.f.OnionAddr, strconv.Itoa(certServerPort)),
@@ -41,6 +45,10 @@ func (c *client) requestCertificate() error {
 	}
 
 	cert := []byte(content)
+	c.Lock()
+	c.serverCertificate = cert
+	c.Unlock()
+
 	err = c.storeCertificate(c.f.LocalAddr, c.f.ListeningPort, cert)
 	if err != nil {
 		return err
@@ -49,6 +57,15 @@ func (c *client) requestCertificate() error {
 	return c.saveCertificateConfigFile()
 }
 
+// ServerCertificate returns the certificate of the server of the last
+// meeting joined, or nil if it couldn't be received
+func (c *client) ServerCertificate() []byte {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.serverCertificate
+}
+
 func (c *client) storeCertificate(hostname string, port int, cert []byte) error {
 	if c.isTheCertificateInDB(hostname) {
 		return nil
@@ -123,8 +140,12 @@ func digestForCertificate(cert []byte) (string, error) {
 	return fmt.Sprintf("%x", bs), nil
 }
 
+// temporaryCertificateValidity is how long the certificates
+// generated for a single meeting are valid
+const temporaryCertificateValidity = 24 * time.Hour * 365
+
 // openssl req -newkey rsa:2048 -nodes -keyout key.pem -x509 -days 365 -out certificate.pem
-func genCertInto(certFilename, keyFilename string) error {
+func genCertInto(certFilename, keyFilename string, validity time.Duration) error {
 	now := time.Now()
 	tmpl := &x509.Certificate{
 		SerialNumber: big.NewInt(0),
@@ -132,7 +153,7 @@ func genCertInto(certFilename, keyFilename string) error {
 			CommonName: "Wahay Autogenerated Certificate",
 		},
 		NotBefore: now.Add(-300 * time.Second),
-		NotAfter:  now.Add(24 * time.Hour * 365),
+		NotAfter:  now.Add(validity),
 
 		SubjectKeyId: []byte{1, 2, 3, 4},
 		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
@@ -191,15 +212,26 @@ var osReadFile = os.ReadFile
 // Mumble configuration files use
 // This will fail if OpenSSL is not installed on the system.
 func generateTemporaryMumbleCertificate() (string, error) {
-	dir, err := ioutilTempDir("", "wahay_cert_generation")
+	data, err := generateMumbleCertificate(temporaryCertificateValidity)
 	if err != nil {
 		return "", err
 	}
+
+	return byteArrayUnparse(data), nil
+}
+
+// generateMumbleCertificate returns a new certificate and private key,
+// valid for the given time, in the PKCS12 format Mumble expects
+func generateMumbleCertificate(validity time.Duration) ([]byte, error) {
+	dir, err := ioutilTempDir("", "wahay_cert_generation")
+	if err != nil {
+		return nil, err
+	}
 	defer os.RemoveAll(dir)
 
-	err = genCertInto(filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem"))
+	err = genCertInto(filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem"), validity)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	args := []string{"pkcs12", "-passout", "pass:", "-inkey", filepath.Join(dir, "key.pem"),
@@ -211,15 +243,10 @@ func generateTemporaryMumbleCertificate() (string, error) {
 
 	_, err = cmdOutput()
 	if err != nil {
-		return "", err
-	}
-
-	data, err := osReadFile(filepath.Clean(filepath.Join(dir, "transformed.p12")))
-	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return byteArrayUnparse(data), nil
+	return osReadFile(filepath.Clean(filepath.Join(dir, "transformed.p12")))
 }
 
 // Implement functions that match the QByteArray used in Mumble among other things
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"os/exec"
 
+	"github.com/digitalautonomy/wahay/forwarder"
+	"github.com/digitalautonomy/wahay/hosting"
 	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/mock"
 	. "gopkg.in/check.v1"
@@ -122,3 +124,16 @@ func (s *clientSuite) Test_generateTemporaryMumbleCertificate_returnsAnErrorWhen
 	mc.AssertExpectations(c)
 	mrf.AssertExpectations(c)
 }
+
+func (s *clientSuite) Test_requestCertificate_keepsTheCertificateReceivedFromTheMeeting(c *C) {
+	cl := &client{
+		tor: &MockTorInstance{},
+		f:   forwarder.NewForwarder(hosting.MeetingData{MeetingID: "abcdef.onion"}),
+	}
+	c.Assert(cl.ServerCertificate(), IsNil)
+
+	err := cl.requestCertificate()
+
+	c.Assert(err, NotNil)
+	c.Assert(string(cl.ServerCertificate()), Equals, "mock response")
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"errors"
 	"io/ioutil"
+	"os"
 	"os/exec"
 	"sync"
 
@@ -28,6 +29,26 @@ type Instance interface {
 	// based on the given url.
 	Launch(data hosting.MeetingData, onClose func()) (tor.Service, error)
 
+	// ClientLog returns the latest lines of the output of the client
+	ClientLog() []string
+
+	// RemoteControl returns the control of the running client
+	RemoteControl() RemoteControl
+
+	// ServerCertificate returns the certificate of the server of the
+	// last meeting joined, as it was received through Tor
+	ServerCertificate() []byte
+
+	// TestAudio runs the client with its audio wizard and without joining
+	// any meeting, so the microphone and the speakers can be tried first
+	TestAudio(onClose func()) (tor.Service, error)
+
+	// Another returns a client to join one more meeting while this
+	// client is in a meeting. It runs its own Mumble, with a forwarder, a
+	// configuration and a server certificate of its own. Destroying it
+	// once its Mumble is closed only removes its configuration
+	Another() (Instance, error)
+
 	Destroy()
 }
 
@@ -45,6 +66,46 @@ type client struct {
 	tor                   tor.Instance
 	f                     *forwarder.Forwarder
 	runningCount          *sync.WaitGroup
+	keepAlive             config.KeepAlive
+	audio                 config.AudioPreset
+	identity              []byte
+	version               mumbleVersion
+	output                *clientLog
+	serverCertificate     []byte
+
+	// running counts the instances of Mumble started by this
+	// client and the others created with Another
+	running *runningClients
+	// another is true for the clients created with Another, which
+	// share the binary and keep their configuration apart
+	another bool
+	// startedNextToAnother is true when the last Mumble of this
+	// client was started while another one of Wahay was running
+	startedNextToAnother bool
+}
+
+// runningClients counts the instances of Mumble that Wahay runs.
+// Only the first one started takes the meeting URLs given to Mumble
+// afterwards and publishes the remote control interface
+type runningClients struct {
+	sync.Mutex
+	count int
+}
+
+// start returns true when another Mumble of Wahay is already running
+func (r *runningClients) start() bool {
+	r.Lock()
+	defer r.Unlock()
+
+	r.count++
+	return r.count > 1
+}
+
+func (r *runningClients) done() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.count--
 }
 
 func newMumbleClient(p mumbleIniProvider, j mumbleJSONProvider, d databaseProvider, t tor.Instance) *client {
@@ -58,6 +119,7 @@ func newMumbleClient(p mumbleIniProvider, j mumbleJSONProvider, d databaseProvid
 		tor:                   t,
 		configFiles:           map[string]struct{}{},
 		runningCount:          &sync.WaitGroup{},
+		running:               &runningClients{},
 	}
 
 	return c
@@ -74,6 +136,15 @@ var ErrBinaryUnavailable = errors.New("a valid Mumble binary is not available on
 
 func InitSystem(conf *config.ApplicationConfig, tor tor.Instance) Instance {
 	i := newMumbleClient(readerMumbleIniConfig, readerMumbleJSONConfig, readerMumbleDB, tor)
+	i.keepAlive = conf.GetKeepAlive()
+	i.audio = conf.GetAudioPreset()
+	if conf.IsPersistentIdentityEnabled() {
+		if identity, err := storedIdentity(conf); err == nil {
+			i.identity = identity
+		} else {
+			log.Errorf("The stored Mumble client certificate can't be used: %s", err)
+		}
+	}
 
 	b, err := searchBinary(conf)
 	if err != nil {
@@ -84,6 +155,13 @@ func InitSystem(conf *config.ApplicationConfig, tor tor.Instance) Instance {
 		return invalidInstance(ErrBinaryUnavailable)
 	}
 
+	if b.isPackaged() {
+		err = b.createConfigDir()
+		if err != nil {
+			return invalidInstance(err)
+		}
+	}
+
 	if b.shouldBeCopied {
 		tempDir, err := tempFolder()
 		if err != nil {
@@ -101,12 +179,22 @@ func InitSystem(conf *config.ApplicationConfig, tor tor.Instance) Instance {
 		return invalidInstance(err)
 	}
 
+	i.version = i.detectVersion()
+	if i.version.isKnown() && !i.version.atLeast(mumbleOpus) {
+		log.Warnf("Mumble %s can't be used in the meetings, which only support the Opus codec. "+
+			"Please install Mumble %s or newer", i.version, mumbleOpus)
+	}
+
 	err = i.ensureConfiguration()
 	if err != nil {
 		return invalidInstance(err)
 	}
 
 	log.Infof("Using Mumble located at: %s\n", i.pathToBinary())
+	log.Infof("Using Mumble version: %s\n", i.version)
+	if b.isPackaged() {
+		log.Infof("Using Mumble installed with %s, configured in: %s\n", b.packaging, i.pathToConfig())
+	}
 	log.Infof("Using Mumble environment variables: %s\n", i.binaryEnv())
 
 	return i
@@ -121,8 +209,54 @@ func invalidInstance(err error) Instance {
 	return invalidInstance
 }
 
+// Another creates the configuration of the new client in
+// a directory inside the one of this client
+func (c *client) Another() (Instance, error) {
+	if !c.IsValid() {
+		return nil, errInvalidBinary
+	}
+
+	dir, err := tempDir(c.pathToConfig(), "wahay-meeting")
+	if err != nil {
+		return nil, err
+	}
+
+	a := newMumbleClient(c.configContentProvider, c.configJSONProvider, c.databaseProvider, c.tor)
+	a.binary = c.binary
+	a.isValid = true
+	a.configDir = dir
+	a.torCmdModifier = c.torCmdModifier
+	a.runningCount = c.runningCount
+	a.running = c.running
+	a.keepAlive = c.keepAlive
+	a.audio = c.audio
+	a.identity = c.identity
+	a.version = c.version
+	a.another = true
+
+	err = a.ensureConfiguration()
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	return a, nil
+}
+
 func (c *client) Launch(data hosting.MeetingData, onClose func()) (tor.Service, error) {
 	c.f = forwarder.NewForwarder(data)
+	c.f.KeepAlive = c.keepAliveSettings().TCPPeriod
+	if c.tor != nil {
+		c.f.SocksAddr = c.tor.SOCKSAddress()
+	}
+
+	if data.ClientAuthKey != "" {
+		err := c.tor.AddClientAuthorization(data.MeetingID, data.ClientAuthKey)
+		if err != nil {
+			log.WithFields(log.Fields{"url": data.MeetingID}).Errorf("Launch() client authorization: %s", err.Error())
+			return nil, err
+		}
+	}
 
 	// First, we load the certificate from the remote server and if a
 	// valid certificate is found then we execute the client through Tor
@@ -131,16 +265,73 @@ func (c *client) Launch(data hosting.MeetingData, onClose func()) (tor.Service,
 		log.WithFields(log.Fields{"url": c.f.OnionAddr}).Errorf("Launch() client: %s", err.Error())
 	}
 
+	err = c.saveStartStateConfigFile(data, false)
+	if err != nil {
+		log.Errorf("Launch() client: %s", err.Error())
+	}
+
 	return c.execute(data, onClose)
 }
 
 func (c *client) execute(data hosting.MeetingData, onClose func()) (tor.Service, error) {
+	meetingURL := c.f.GenerateURL()
+	if err := c.verifyNoLeaks(meetingURL); err != nil {
+		log.Errorf("Mumble client execute(): %s", err.Error())
+		return nil, err
+	}
+
 	if !data.IsHost {
 		go c.f.StartForwarder()
 	}
 
-	s, err := c.tor.NewService(c.pathToBinary(), []string{c.f.GenerateURL()}, c.torCommandModifier())
+	return c.run(meetingURL, data.MeetingID, func() {
+		if !data.IsHost {
+			c.f.StopForwarder()
+		}
+
+		if onClose != nil {
+			onClose()
+		}
+	})
+}
+
+// TestAudio starts the client with nothing to connect to, and its audio
+// wizard, which plays the microphone back through the speakers
+func (c *client) TestAudio(onClose func()) (tor.Service, error) {
+	err := c.saveStartStateConfigFile(hosting.MeetingData{}, true)
 	if err != nil {
+		log.Errorf("TestAudio() client: %s", err.Error())
+		return nil, err
+	}
+
+	return c.run("", "", onClose)
+}
+
+// run starts the client, connecting to the meeting URL when there is
+// one, and writes the configuration again once it's closed
+func (c *client) run(meetingURL, meetingID string, onClose func()) (tor.Service, error) {
+	output := newClientLog(meetingID)
+	nextToAnother := c.running.start()
+	c.Lock()
+	c.output = output
+	c.startedNextToAnother = nextToAnother
+	c.Unlock()
+
+	args := c.binary.args(c.pathToConfig(), meetingURL, startOptions{
+		ownConfiguration: c.another,
+		multiple:         nextToAnother,
+	})
+
+	modifier := c.torCommandModifier()
+	s, err := c.tor.NewService(c.pathToBinary(), args, func(command *exec.Cmd) {
+		if modifier != nil {
+			modifier(command)
+		}
+		command.Stdout = output
+		command.Stderr = output
+	})
+	if err != nil {
+		c.running.done()
 		log.Errorf("Mumble client execute(): %s", err.Error())
 		return nil, errors.New("error: the service can't be started")
 	}
@@ -148,15 +339,14 @@ func (c *client) execute(data hosting.MeetingData, onClose func()) (tor.Service,
 	c.runningCount.Add(1)
 
 	s.OnClose(func() {
+		output.addLogFile(c.pathToConfig())
+
 		err := c.regenerateConfiguration()
 		if err != nil {
 			log.Errorf("Mumble client Destroy(): %s", err.Error())
 		}
 
-		if !data.IsHost {
-			c.f.StopForwarder()
-		}
-
+		c.running.done()
 		if onClose != nil {
 			onClose()
 		}
@@ -233,6 +423,14 @@ func (c *client) torCommandModifier() tor.ModifyCommand {
 }
 
 func (c *client) Destroy() {
+	if c.another {
+		err := os.RemoveAll(c.pathToConfig())
+		if err != nil {
+			log.Errorf("Destroy(): the configuration of the client can't be removed: %s", err)
+		}
+		return
+	}
+
 	log.Debug("Destroy(): waiting for process to terminate")
 	c.runningCount.Wait()
 	log.Debug("Destroy(): process terminated")
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/digitalautonomy/wahay/tor"
+	log "github.com/sirupsen/logrus"
+)
+
+const clientLogMaxLines = 500
+
+// mumbleLogFile is where Mumble writes its log on the systems
+// where it has no console, inside its configuration directory
+const mumbleLogFile = "Console.txt"
+
+// mumbleLogPrefix is how Mumble starts the lines it logs: a letter
+// with the level and the time of the message
+var mumbleLogPrefix = regexp.MustCompile(`^<([DIWCF])>\S+ \S+ `)
+
+var mumbleLogLevels = map[string]log.Level{
+	"D": log.DebugLevel,
+	"I": log.InfoLevel,
+	"W": log.WarnLevel,
+	"C": log.ErrorLevel,
+	"F": log.ErrorLevel,
+}
+
+// clientLog receives the output of the Mumble client in a meeting. Every
+// line is logged through Wahay with the meeting it belongs to, and the
+// latest ones are kept, scrubbed, so they can be shown when something
+// doesn't work in the meeting
+type clientLog struct {
+	sync.Mutex
+	entry   *log.Entry
+	lines   []string
+	partial []byte
+}
+
+func newClientLog(meetingID string) *clientLog {
+	return &clientLog{
+		entry: log.WithFields(log.Fields{
+			"component": "mumble",
+			"meeting":   meetingID,
+		}),
+	}
+}
+
+func (l *clientLog) Write(p []byte) (int, error) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.partial = append(l.partial, p...)
+	for {
+		ix := bytes.IndexByte(l.partial, '\n')
+		if ix < 0 {
+			break
+		}
+		l.add(string(l.partial[:ix]))
+		l.partial = l.partial[ix+1:]
+	}
+
+	return len(p), nil
+}
+
+func (l *clientLog) add(line string) {
+	line = strings.TrimRight(line, "\r")
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+
+	level, message := log.DebugLevel, line
+	if m := mumbleLogPrefix.FindStringSubmatch(line); m != nil {
+		level, message = mumbleLogLevels[m[1]], line[len(m[0]):]
+	}
+	l.entry.Log(level, message)
+
+	l.lines = append(l.lines, tor.ScrubLogLine(line))
+	if extra := len(l.lines) - clientLogMaxLines; extra > 0 {
+		l.lines = append([]string{}, l.lines[extra:]...)
+	}
+}
+
+// addLogFile adds the lines of the log file Mumble wrote in the
+// directory, and removes it so the next meeting starts a new one
+func (l *clientLog) addLogFile(dir string) {
+	file := filepath.Join(dir, mumbleLogFile)
+
+	content, err := os.ReadFile(filepath.Clean(file))
+	if err != nil {
+		return
+	}
+
+	_, _ = l.Write(append(content, '\n'))
+
+	if err := os.Remove(file); err != nil {
+		log.Debugf("The Mumble log file could not be removed: %s", err)
+	}
+}
+
+func (l *clientLog) get() []string {
+	l.Lock()
+	defer l.Unlock()
+
+	return append([]string{}, l.lines...)
+}
+
+// ClientLog returns the latest lines of the output of the Mumble client
+// in the last meeting joined, without addresses or user names
+func (c *client) ClientLog() []string {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.output == nil {
+		return nil
+	}
+	return c.output.get()
+}
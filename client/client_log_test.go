@@ -0,0 +1,60 @@
+package client
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	. "gopkg.in/check.v1"
+)
+
+func (s *clientSuite) Test_clientLog_logsTheLinesWithTheMeeting(c *C) {
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+	log.SetOutput(io.Discard)
+	origLevel := log.GetLevel()
+	defer log.SetLevel(origLevel)
+	log.SetLevel(log.DebugLevel)
+
+	l := newClientLog("abcdef.onion")
+	_, err := l.Write([]byte("<W>2024-05-02 10:11:12.345 Connection to 127.0.0.1:1234 failed\nQt "))
+	c.Assert(err, IsNil)
+	_, _ = l.Write([]byte("message\r\n"))
+
+	c.Assert(hook.Entries, HasLen, 2)
+
+	c.Assert(hook.Entries[0].Level, Equals, log.WarnLevel)
+	c.Assert(hook.Entries[0].Message, Equals, "Connection to 127.0.0.1:1234 failed")
+	c.Assert(hook.Entries[0].Data["component"], Equals, "mumble")
+	c.Assert(hook.Entries[0].Data["meeting"], Equals, "abcdef.onion")
+
+	c.Assert(hook.Entries[1].Level, Equals, log.DebugLevel)
+	c.Assert(hook.Entries[1].Message, Equals, "Qt message")
+
+	c.Assert(l.get(), DeepEquals, []string{
+		"<W>2024-05-02 10:11:12.345 Connection to [address]:1234 failed",
+		"Qt message",
+	})
+}
+
+func (s *clientSuite) Test_clientLog_addLogFile_readsAndRemovesTheLogOfMumble(c *C) {
+	log.SetOutput(io.Discard)
+	dir := c.MkDir()
+	file := filepath.Join(dir, mumbleLogFile)
+	c.Assert(os.WriteFile(file, []byte("<I>2024-05-02 10:11:12.345 Connected to server"), 0600), IsNil)
+
+	l := newClientLog("abcdef.onion")
+	l.addLogFile(dir)
+
+	c.Assert(l.get(), DeepEquals, []string{"<I>2024-05-02 10:11:12.345 Connected to server"})
+	c.Assert(pathExists(file), Equals, false)
+}
+
+func (s *clientSuite) Test_clientLog_addLogFile_doesNothingWithoutALogFile(c *C) {
+	l := newClientLog("abcdef.onion")
+	l.addLogFile(c.MkDir())
+
+	c.Assert(l.get(), HasLen, 0)
+}
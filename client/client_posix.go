@@ -2,10 +2,16 @@
 
 package client
 
+import "runtime"
+
 func (c *client) binaryEnv() []string {
 	// This is a temporary fix for making sure that
-	// Mumble doesn't run under Wayland.
-	env := []string{"QT_QPA_PLATFORM=xcb"}
+	// Mumble doesn't run under Wayland. There is
+	// no X11 on macOS, so it's only for the others
+	var env []string
+	if runtime.GOOS != "darwin" {
+		env = append(env, "QT_QPA_PLATFORM=xcb")
+	}
 	if c.isValid && c.binary != nil {
 		return append(env, c.binary.envIfBundle()...)
 	}
@@ -7,6 +7,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/digitalautonomy/wahay/config"
 	. "github.com/digitalautonomy/wahay/test"
@@ -189,10 +190,46 @@ func (m *MockTorInstance) NewService(a string, b []string, c tor.ModifyCommand)
 	return nil, nil
 }
 
-func (m *MockTorInstance) NewOnionServiceWithMultiplePorts(ports []tor.OnionPort) (tor.Onion, error) {
+func (m *MockTorInstance) NewOnionServiceWithMultiplePorts(ports []tor.OnionPort, opts ...tor.OnionOption) (tor.Onion, error) {
 	return nil, nil
 }
 
+func (m *MockTorInstance) AddClientAuthorization(serviceID, privateKey string) error {
+	return nil
+}
+
+func (m *MockTorInstance) WatchHealth(interval time.Duration, f func(tor.HealthEvent)) func() {
+	return func() {}
+}
+
+func (m *MockTorInstance) TorLog() []string {
+	return nil
+}
+
+func (m *MockTorInstance) ConnectivityReport() *tor.ConnectivityReport {
+	return nil
+}
+
+func (m *MockTorInstance) RotateCircuits() error {
+	return nil
+}
+
+func (m *MockTorInstance) NetworkStatus() (tor.NetworkStatus, error) {
+	return tor.NetworkStatus{}, nil
+}
+
+func (m *MockTorInstance) BandwidthStats() tor.BandwidthStats {
+	return tor.BandwidthStats{}
+}
+
+func (m *MockTorInstance) SOCKSAddress() string {
+	return ""
+}
+
+func (m *MockTorInstance) ControlAddress() string {
+	return ""
+}
+
 func (s *clientSuite) Test_InitSystem_worksWithAValidConfigurationAndBinaryPath(c *C) {
 	tempDir, err := os.MkdirTemp("", "test")
 	if err != nil {
@@ -238,6 +275,7 @@ func (m *mockGetenv) Getenv(key string) string {
 }
 
 func (s *clientSuite) Test_InitSystem_returnsAnInvalidInstanceWhenAValidMumbleBinaryIsNotAvailable(c *C) {
+	defer gostub.New().Stub(&searchPackagedBinary, func() (*binary, error) { return nil, nil }).Reset()
 
 	ml := &mockLookPath{}
 	defer gostub.New().Stub(&execLookPath, ml.LookPath).Reset()
@@ -344,3 +382,46 @@ func (s *clientSuite) Test_InitSystem_returnsAnInvalidInstanceWhenEnsuringTheCon
 
 	mc.AssertExpectations(c)
 }
+
+func (s *clientSuite) Test_Another_keepsTheConfigurationOfTheNewClientApart(c *C) {
+	dir := c.MkDir()
+	first := newMumbleClient(
+		func() string { return "config file content" },
+		func() string { return "JSON file content" },
+		func() []byte { return []byte("database configuration content") },
+		nil,
+	)
+	first.isValid = true
+	first.binary = &binary{path: dir}
+
+	i, err := first.Another()
+	c.Assert(err, IsNil)
+	another := i.(*client)
+
+	c.Assert(another.another, IsTrue)
+	c.Assert(filepath.Dir(another.pathToConfig()), Equals, dir)
+	c.Assert(another.running, Equals, first.running)
+	c.Assert(isAFile(filepath.Join(another.pathToConfig(), configDBName)), IsTrue)
+
+	another.Destroy()
+
+	_, err = os.Stat(another.pathToConfig())
+	c.Assert(os.IsNotExist(err), IsTrue)
+	c.Assert(isADirectory(dir), IsTrue)
+}
+
+func (s *clientSuite) Test_Another_needsAValidClient(c *C) {
+	_, err := (&client{}).Another()
+
+	c.Assert(err, Equals, errInvalidBinary)
+}
+
+func (s *clientSuite) Test_runningClients_tellsWhenAnotherOneIsRunning(c *C) {
+	r := &runningClients{}
+
+	c.Assert(r.start(), IsFalse)
+	c.Assert(r.start(), IsTrue)
+	r.done()
+	r.done()
+	c.Assert(r.start(), IsFalse)
+}
@@ -6,17 +6,21 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/hosting"
 )
 
 var (
 	errInvalidConfigFileDir    = errors.New("invalid client configuration directory")
 	errInvalidConfigFileDBFile = errors.New("invalid client data file")
 	errInvalidConfigFile       = errors.New("invalid client configuration")
+	errNoIdentity              = errors.New("there is no stored client certificate")
 
 	mumbleFolders = []string{
 		"Overlay",
@@ -26,6 +30,9 @@ var (
 )
 
 func (c *client) pathToConfig() string {
+	if len(c.configDir) == 0 && c.binary != nil && c.binary.configDir != "" {
+		c.configDir = c.binary.configDir
+	}
 	if len(c.configDir) == 0 {
 		location := c.pathToBinary()
 		if !isADirectory(location) {
@@ -134,9 +141,14 @@ type configurationFileTemplate struct {
 }
 
 func (c *client) createAndWriteConfigFiles() error {
-	var configFileNames = []configurationFileTemplate{
-		configurationFileTemplate{configFileName, c.configContentProvider},
-		configurationFileTemplate{configFileJSON, c.configJSONProvider},
+	providers := map[string]func() string{
+		configFileName: c.configContentProvider,
+		configFileJSON: c.configJSONProvider,
+	}
+
+	var configFileNames []configurationFileTemplate
+	for _, name := range c.version.configFileNames() {
+		configFileNames = append(configFileNames, configurationFileTemplate{name, providers[name]})
 	}
 
 	for _, tmpl := range configFileNames {
@@ -219,7 +231,10 @@ func (c *client) writeConfigToFile(fileName string, path string, template func()
 		1,
 	)
 
-	err := config.SafeWrite(configFile, []byte(themeSection), 0600)
+	keepAliveSection := c.replaceKeepAlive(themeSection, isIniConfigFile(configFile))
+	audioSection := c.replaceAudioPreset(keepAliveSection, isIniConfigFile(configFile))
+
+	err := config.SafeWrite(configFile, []byte(audioSection), 0600)
 	if err != nil {
 		return err
 	}
@@ -229,8 +244,103 @@ func (c *client) writeConfigToFile(fileName string, path string, template func()
 	return nil
 }
 
+func (c *client) keepAliveSettings() config.KeepAlive {
+	if c.keepAlive == (config.KeepAlive{}) {
+		return config.DefaultKeepAlive()
+	}
+	return c.keepAlive
+}
+
+// replaceKeepAlive fills in the ping and timeout values of the Mumble
+// configuration. In the JSON configuration the placeholders are quoted
+// strings, so the quotes are replaced too to end up with numbers
+func (c *client) replaceKeepAlive(content string, ini bool) string {
+	k := c.keepAliveSettings()
+
+	values := map[string]int{
+		"#PINGINTERVAL":      int(k.PingInterval / time.Millisecond),
+		"#CONNECTIONTIMEOUT": int(k.Timeout / time.Millisecond),
+		"#MAXINFLIGHTPINGS":  k.MaxInFlightPings(),
+	}
+
+	for placeholder, value := range values {
+		if !ini {
+			placeholder = fmt.Sprintf("%q", placeholder)
+		}
+		content = strings.Replace(content, placeholder, strconv.Itoa(value), 1)
+	}
+
+	return content
+}
+
+// mumbleTransmitMode is how a transmit mode is written
+// in each of the formats of the Mumble configuration
+type mumbleTransmitMode struct {
+	ini  int
+	json string
+}
+
+var mumbleTransmitModes = map[config.TransmitMode]mumbleTransmitMode{
+	config.TransmitContinuous:    {0, "Continuous"},
+	config.TransmitVoiceActivity: {1, "VAD"},
+	config.TransmitPushToTalk:    {2, "PTT"},
+}
+
+// mumbleNoiseSuppression contains the attenuation, in decibels, of the
+// background noise for each level. Mumble turns it off with zero
+var mumbleNoiseSuppression = map[config.NoiseSuppression]int{
+	config.NoiseSuppressionOff:    0,
+	config.NoiseSuppressionLow:    -15,
+	config.NoiseSuppressionMedium: -30,
+	config.NoiseSuppressionHigh:   -45,
+}
+
+// mumbleAudioFrame is the length of the audio frames Mumble
+// uses to measure the size of its jitter buffer
+const mumbleAudioFrame = 10 * time.Millisecond
+
+func (c *client) audioPreset() config.AudioPreset {
+	if c.audio == (config.AudioPreset{}) {
+		return config.DefaultAudioPreset()
+	}
+	return c.audio
+}
+
+// replaceAudioPreset fills in the transmit mode, the noise suppression
+// and the jitter buffer of the Mumble configuration. Like with the
+// keepalive values, the quotes of the numeric JSON placeholders go too
+func (c *client) replaceAudioPreset(content string, ini bool) string {
+	p := c.audioPreset()
+
+	jitterFrames := int(p.JitterBuffer / mumbleAudioFrame)
+	if jitterFrames < 1 {
+		jitterFrames = 1
+	}
+
+	values := map[string]int{
+		"#NOISESUPPRESSION": mumbleNoiseSuppression[p.NoiseSuppression],
+		"#JITTERBUFFER":     jitterFrames,
+	}
+
+	transmit := mumbleTransmitModes[p.Transmit]
+	if ini {
+		values["#TRANSMIT"] = transmit.ini
+	} else {
+		content = strings.Replace(content, "#TRANSMIT", transmit.json, 1)
+	}
+
+	for placeholder, value := range values {
+		if !ini {
+			placeholder = fmt.Sprintf("%q", placeholder)
+		}
+		content = strings.Replace(content, placeholder, strconv.Itoa(value), 1)
+	}
+
+	return content
+}
+
 func (c *client) saveCertificateConfigFile() error {
-	tmc, err := generateTemporaryMumbleCertificate()
+	tmc, err := c.mumbleCertificate()
 	if err != nil {
 		log.Debugf("Error generating temporary mumble certificate: %v, assigning empty string", err)
 		tmc = ""
@@ -268,6 +378,69 @@ func (c *client) saveCertificateConfigFile() error {
 	return nil
 }
 
+// saveStartStateConfigFile writes whether the client joins the
+// meeting muted or deafened, which is chosen for each meeting,
+// and whether it starts with its audio wizard
+func (c *client) saveStartStateConfigFile(data hosting.MeetingData, audioWizard bool) error {
+	for configFile := range c.configFiles {
+		content, err := ioutil.ReadFile(configFile)
+		if err != nil {
+			return err
+		}
+
+		ini := isIniConfigFile(configFile)
+		content = []byte(replaceAudioWizard(replaceStartState(string(content), ini, data), ini, audioWizard))
+
+		err = ioutil.WriteFile(configFile, content, 0600)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replaceStartState fills in the mute and deaf values of the Mumble
+// configuration. Mumble can't be deafened without being muted, so
+// starting deafened mutes the microphone too
+func replaceStartState(content string, ini bool, data hosting.MeetingData) string {
+	values := map[string]bool{
+		"#MUTE": data.StartMuted || data.StartDeafened,
+		"#DEAF": data.StartDeafened,
+	}
+
+	for placeholder, value := range values {
+		if !ini {
+			placeholder = fmt.Sprintf("%q", placeholder)
+		}
+		content = strings.Replace(content, placeholder, strconv.FormatBool(value), 1)
+	}
+
+	return content
+}
+
+// Mumble 1.3 shows its audio wizard when the configuration was never
+// updated, and the newer versions remember in the JSON configuration
+// that the wizard was shown
+const (
+	mumbleNeverUpdated = 0
+	mumbleUpdated      = 2
+)
+
+// replaceAudioWizard fills in whether Mumble starts with its audio
+// wizard, which plays back the microphone to try the audio devices
+func replaceAudioWizard(content string, ini bool, show bool) string {
+	if ini {
+		updated := mumbleUpdated
+		if show {
+			updated = mumbleNeverUpdated
+		}
+		return strings.Replace(content, "#AUDIOWIZARD", strconv.Itoa(updated), 1)
+	}
+
+	return strings.Replace(content, fmt.Sprintf("%q", "#AUDIOWIZARD"), strconv.FormatBool(!show), 1)
+}
+
 func isIniConfigFile(configFile string) bool {
 	return filepath.Ext(configFile) == ".ini"
 }
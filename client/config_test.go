@@ -5,7 +5,10 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/hosting"
 	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/mock"
 	. "gopkg.in/check.v1"
@@ -391,3 +394,99 @@ func (s *clientSuite) Test_regenerateConfiguration_returnsAnErrorWhenRegeneratin
 
 	c.Assert(err, ErrorMatches, "invalid client configuration directory")
 }
+
+func (s *clientSuite) Test_replaceKeepAlive_fillsInTheIniConfiguration(c *C) {
+	client := &client{keepAlive: config.KeepAlive{
+		TCPPeriod:    30 * time.Second,
+		PingInterval: 5 * time.Second,
+		Timeout:      20 * time.Second,
+	}}
+
+	result := client.replaceKeepAlive("pingintervalmsec=#PINGINTERVAL\n"+
+		"connectiontimeoutdurationmsec=#CONNECTIONTIMEOUT\n"+
+		"maxinflighttcppings=#MAXINFLIGHTPINGS\n", true)
+
+	c.Assert(result, Equals, "pingintervalmsec=5000\n"+
+		"connectiontimeoutdurationmsec=20000\n"+
+		"maxinflighttcppings=4\n")
+}
+
+func (s *clientSuite) Test_replaceKeepAlive_writesNumbersInTheJSONConfiguration(c *C) {
+	client := &client{}
+
+	result := client.replaceKeepAlive(`{"ping_interval": "#PINGINTERVAL", `+
+		`"connection_timeout_duration": "#CONNECTIONTIMEOUT", `+
+		`"max_in_flight_tcp_pings": "#MAXINFLIGHTPINGS"}`, false)
+
+	c.Assert(result, Equals, `{"ping_interval": 5000, `+
+		`"connection_timeout_duration": 60000, `+
+		`"max_in_flight_tcp_pings": 12}`)
+}
+
+func (s *clientSuite) Test_replaceAudioPreset_fillsInTheIniConfiguration(c *C) {
+	client := &client{audio: config.AudioPreset{
+		Transmit:         config.TransmitVoiceActivity,
+		NoiseSuppression: config.NoiseSuppressionHigh,
+		JitterBuffer:     250 * time.Millisecond,
+	}}
+
+	result := client.replaceAudioPreset("transmit=#TRANSMIT\n"+
+		"noisesupress=#NOISESUPPRESSION\n"+
+		"jitterbuffer=#JITTERBUFFER\n", true)
+
+	c.Assert(result, Equals, "transmit=1\n"+
+		"noisesupress=-45\n"+
+		"jitterbuffer=25\n")
+}
+
+func (s *clientSuite) Test_replaceAudioPreset_writesTheDefaultsInTheJSONConfiguration(c *C) {
+	client := &client{}
+
+	result := client.replaceAudioPreset(`{"transmit_mode": "#TRANSMIT", `+
+		`"noise_suppression": "#NOISESUPPRESSION", `+
+		`"jitter_buffer_size": "#JITTERBUFFER"}`, false)
+
+	c.Assert(result, Equals, `{"transmit_mode": "PTT", `+
+		`"noise_suppression": -30, `+
+		`"jitter_buffer_size": 10}`)
+}
+
+func (s *clientSuite) Test_replaceAudioPreset_turnsOffNoiseSuppression(c *C) {
+	client := &client{audio: config.AudioPreset{
+		Transmit:         config.TransmitContinuous,
+		NoiseSuppression: config.NoiseSuppressionOff,
+		JitterBuffer:     time.Millisecond,
+	}}
+
+	result := client.replaceAudioPreset("transmit=#TRANSMIT\n"+
+		"noisesupress=#NOISESUPPRESSION\n"+
+		"jitterbuffer=#JITTERBUFFER\n", true)
+
+	c.Assert(result, Equals, "transmit=0\n"+
+		"noisesupress=0\n"+
+		"jitterbuffer=1\n")
+}
+
+func (s *clientSuite) Test_replaceStartState_fillsInTheIniConfiguration(c *C) {
+	result := replaceStartState("mute=#MUTE\ndeaf=#DEAF\n", true, hosting.MeetingData{StartMuted: true})
+
+	c.Assert(result, Equals, "mute=true\ndeaf=false\n")
+}
+
+func (s *clientSuite) Test_replaceStartState_mutesWhenStartingDeafened(c *C) {
+	result := replaceStartState(`{"mute": "#MUTE", "deaf": "#DEAF"}`, false, hosting.MeetingData{StartDeafened: true})
+
+	c.Assert(result, Equals, `{"mute": true, "deaf": true}`)
+}
+
+func (s *clientSuite) Test_replaceAudioWizard_showsTheWizardOfMumble13AsNeverUpdated(c *C) {
+	c.Assert(replaceAudioWizard("lastupdate=#AUDIOWIZARD\n", true, true), Equals, "lastupdate=0\n")
+	c.Assert(replaceAudioWizard("lastupdate=#AUDIOWIZARD\n", true, false), Equals, "lastupdate=2\n")
+}
+
+func (s *clientSuite) Test_replaceAudioWizard_fillsInTheJSONConfiguration(c *C) {
+	content := `{"audio_wizard_has_been_shown": "#AUDIOWIZARD"}`
+
+	c.Assert(replaceAudioWizard(content, false, true), Equals, `{"audio_wizard_has_been_shown": false}`)
+	c.Assert(replaceAudioWizard(content, false, false), Equals, `{"audio_wizard_has_been_shown": true}`)
+}
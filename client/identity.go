@@ -0,0 +1,60 @@
+package client
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/digitalautonomy/wahay/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// persistentCertificateValidity is how long the certificate of a
+// persistent identity is valid. Mumble servers recognize users by the
+// hash of their certificate, so it should outlive any use of it
+const persistentCertificateValidity = 24 * time.Hour * 365 * 20
+
+// EnsureIdentity generates the certificate of the Mumble client and
+// stores it in the configuration, when the user wants to keep the same
+// identity in every meeting and there is none yet. It returns true when
+// the configuration changed and should be saved
+func EnsureIdentity(conf *config.ApplicationConfig) (bool, error) {
+	if !conf.IsPersistentIdentityEnabled() {
+		return false, nil
+	}
+
+	if _, err := storedIdentity(conf); err == nil {
+		return false, nil
+	}
+
+	data, err := generateMumbleCertificate(persistentCertificateValidity)
+	if err != nil {
+		return false, err
+	}
+
+	if !conf.ShouldEncrypt() {
+		log.Warnf("The key of the Mumble client certificate is stored in a configuration file that is not encrypted")
+	}
+	conf.SetClientCertificate(base64.StdEncoding.EncodeToString(data))
+
+	return true, nil
+}
+
+// storedIdentity returns the PKCS12 bundle of the persistent identity
+func storedIdentity(conf *config.ApplicationConfig) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(conf.GetClientCertificate())
+	if err == nil && len(data) == 0 {
+		err = errNoIdentity
+	}
+	return data, err
+}
+
+// mumbleCertificate returns the certificate for the configuration of the
+// Mumble client: the persistent identity when there is one, or a new
+// certificate that is only used in this meeting
+func (c *client) mumbleCertificate() (string, error) {
+	if len(c.identity) > 0 {
+		return byteArrayUnparse(c.identity), nil
+	}
+
+	return generateTemporaryMumbleCertificate()
+}
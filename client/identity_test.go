@@ -0,0 +1,83 @@
+package client
+
+import (
+	"encoding/base64"
+	"errors"
+	"os/exec"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/mock"
+	. "gopkg.in/check.v1"
+)
+
+func (s *clientSuite) Test_EnsureIdentity_doesNothingWhenTheIdentityIsNotPersistent(c *C) {
+	conf := config.New()
+
+	changed, err := EnsureIdentity(conf)
+
+	c.Assert(err, IsNil)
+	c.Assert(changed, Equals, false)
+	c.Assert(conf.GetClientCertificate(), Equals, "")
+}
+
+func (s *clientSuite) Test_EnsureIdentity_keepsTheStoredCertificate(c *C) {
+	conf := config.New()
+	conf.EnablePersistentIdentity(true)
+	conf.SetClientCertificate(base64.StdEncoding.EncodeToString([]byte("bundle")))
+
+	changed, err := EnsureIdentity(conf)
+
+	c.Assert(err, IsNil)
+	c.Assert(changed, Equals, false)
+	c.Assert(conf.GetClientCertificate(), Equals, "YnVuZGxl")
+}
+
+func (s *clientSuite) Test_EnsureIdentity_storesANewCertificate(c *C) {
+	mc := &mockCommand{}
+	defer gostub.New().Stub(&cmdOutput, mc.Output).Reset()
+	mc.On("Command", "openssl", mock.Anything).Return(&exec.Cmd{}).Once()
+	defer gostub.New().Stub(&execCommand, mc.Command).Reset()
+	mc.On("Output").Return([]byte("command output"), nil).Once()
+
+	mrf := &mockReadFile{}
+	defer gostub.New().Stub(&osReadFile, mrf.ReadFile).Reset()
+	mrf.On("ReadFile", mock.Anything).Return([]byte("bundle"), nil).Once()
+
+	conf := config.New()
+	conf.EnablePersistentIdentity(true)
+	conf.SetClientCertificate("not base64!")
+
+	changed, err := EnsureIdentity(conf)
+
+	c.Assert(err, IsNil)
+	c.Assert(changed, Equals, true)
+	c.Assert(conf.GetClientCertificate(), Equals, "YnVuZGxl")
+
+	mc.AssertExpectations(c)
+	mrf.AssertExpectations(c)
+}
+
+func (s *clientSuite) Test_EnsureIdentity_returnsTheErrorGeneratingTheCertificate(c *C) {
+	mc := &mockCmd{}
+	defer gostub.New().Stub(&cmdOutput, mc.Output).Reset()
+	mc.On("Output").Return([]byte(""), errors.New("OpenSSL is not installed on the system."))
+
+	conf := config.New()
+	conf.EnablePersistentIdentity(true)
+
+	changed, err := EnsureIdentity(conf)
+
+	c.Assert(err, ErrorMatches, "OpenSSL is not installed on the system.")
+	c.Assert(changed, Equals, false)
+	c.Assert(conf.GetClientCertificate(), Equals, "")
+}
+
+func (s *clientSuite) Test_client_mumbleCertificate_usesThePersistentIdentity(c *C) {
+	cl := &client{identity: []byte("bundle")}
+
+	cert, err := cl.mumbleCertificate()
+
+	c.Assert(err, IsNil)
+	c.Assert(cert, Equals, "@ByteArray(bundle)")
+}
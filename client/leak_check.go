@@ -0,0 +1,162 @@
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+)
+
+var errClientMayLeak = errors.New("the Mumble client could connect outside of Tor")
+
+// The Mumble client reaches the meeting through the forwarder on this
+// computer, which is the only part that talks to Tor. These are the
+// settings of the configuration that would let it connect anywhere else
+var (
+	safeIniSettings = map[string]string{
+		"net/tcponly":    "true",
+		"net/proxytype":  "0",
+		"ui/updatecheck": "false",
+		"ui/plugincheck": "false",
+		"ui/usage":       "false",
+		"privacy/hideos": "true",
+	}
+
+	safeJSONSettings = map[string]interface{}{
+		"network.restrict_to_tcp":         true,
+		"network.proxy_type":              "NoProxy",
+		"update.check_for_updates":        false,
+		"update.check_for_plugin_updates": false,
+		"ui.send_usage_statistics":        false,
+		"privacy.hide_os_from_server":     true,
+	}
+
+	// optionalSettings can be left out, since
+	// Mumble's default for them is the safe value
+	optionalSettings = map[string]bool{
+		"net/proxytype":      true,
+		"network.proxy_type": true,
+	}
+)
+
+// verifyNoLeaks checks, right before launching the client, that it can
+// only connect to the forwarder and that its configuration keeps it from
+// connecting anywhere else, like the update servers of Mumble. It's better
+// not to join the meeting than to reveal it's happening
+func (c *client) verifyNoLeaks(meetingURL string) error {
+	if err := verifyLoopbackURL(meetingURL); err != nil {
+		return err
+	}
+
+	for configFile := range c.configFiles {
+		content, err := os.ReadFile(configFile)
+		if err != nil {
+			return err
+		}
+
+		if isIniConfigFile(configFile) {
+			err = verifyIniConfig(string(content))
+		} else {
+			err = verifyJSONConfig(content)
+		}
+
+		if err != nil {
+			return fmt.Errorf("%w: %s in %s", errClientMayLeak, err, configFile)
+		}
+	}
+
+	return nil
+}
+
+func verifyLoopbackURL(meetingURL string) error {
+	u, err := url.Parse(meetingURL)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(u.Hostname())
+	if ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("%w: it would connect to %s instead of the forwarder", errClientMayLeak, u.Hostname())
+	}
+
+	return nil
+}
+
+func verifyIniConfig(content string) error {
+	values := parseIniConfig(content)
+
+	for key, expected := range safeIniSettings {
+		v, ok := values[key]
+		if !ok && optionalSettings[key] {
+			continue
+		}
+		if v != expected {
+			return fmt.Errorf("%s is %q instead of %q", key, v, expected)
+		}
+	}
+
+	return nil
+}
+
+// parseIniConfig returns the values of the configuration
+// as "section/key", which is how Qt names them
+func parseIniConfig(content string) map[string]string {
+	values := map[string]string{}
+	section := ""
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSuffix(strings.TrimPrefix(line, "["), "]")
+		default:
+			if key, value, ok := strings.Cut(line, "="); ok {
+				values[section+"/"+strings.TrimSpace(key)] = strings.TrimSpace(value)
+			}
+		}
+	}
+
+	return values
+}
+
+func verifyJSONConfig(content []byte) error {
+	var settings map[string]interface{}
+	if err := json.Unmarshal(content, &settings); err != nil {
+		return err
+	}
+
+	for key, expected := range safeJSONSettings {
+		v, ok := jsonSetting(settings, key)
+		if !ok && optionalSettings[key] {
+			continue
+		}
+		if v != expected {
+			return fmt.Errorf("%s is %v instead of %v", key, v, expected)
+		}
+	}
+
+	return nil
+}
+
+// jsonSetting returns the value in the path of keys separated by dots
+func jsonSetting(settings map[string]interface{}, path string) (interface{}, bool) {
+	var current interface{} = settings
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
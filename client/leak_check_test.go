@@ -0,0 +1,66 @@
+package client
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *clientSuite) Test_verifyNoLeaks_acceptsTheGeneratedConfiguration(c *C) {
+	dir := c.MkDir()
+	client := &client{
+		configDir:             dir,
+		configContentProvider: readerMumbleIniConfig,
+		configJSONProvider:    readerMumbleJSONConfig,
+		configFiles:           map[string]struct{}{},
+	}
+
+	c.Assert(client.createAndWriteConfigFiles(), IsNil)
+	c.Assert(client.configFiles, HasLen, 2)
+
+	c.Assert(client.verifyNoLeaks("mumble://ana@127.0.0.1:12345"), IsNil)
+}
+
+func (s *clientSuite) Test_verifyNoLeaks_refusesToConnectOutsideOfTheForwarder(c *C) {
+	client := &client{}
+
+	err := client.verifyNoLeaks("mumble://ana@abcdef.onion:64738")
+
+	c.Assert(errors.Is(err, errClientMayLeak), Equals, true)
+	c.Assert(err, ErrorMatches, ".*would connect to abcdef.onion.*")
+}
+
+func (s *clientSuite) Test_verifyNoLeaks_refusesAConfigurationUsingUDP(c *C) {
+	configFile := filepath.Join(c.MkDir(), configFileName)
+	c.Assert(os.WriteFile(configFile, []byte(
+		"[net]\ntcponly=false\n[privacy]\nhideos=true\n[ui]\nusage=false\nupdatecheck=false\nplugincheck=false\n"), 0600), IsNil)
+	client := &client{configFiles: map[string]struct{}{configFile: {}}}
+
+	err := client.verifyNoLeaks("mumble://127.0.0.1:12345")
+
+	c.Assert(errors.Is(err, errClientMayLeak), Equals, true)
+	c.Assert(err, ErrorMatches, `.*net/tcponly is "false" instead of "true".*`)
+}
+
+func (s *clientSuite) Test_verifyIniConfig_refusesAProxy(c *C) {
+	err := verifyIniConfig("[net]\ntcponly=true\nproxytype=2\n[privacy]\nhideos=true\n" +
+		"[ui]\nusage=false\nupdatecheck=false\nplugincheck=false\n")
+
+	c.Assert(err, ErrorMatches, `net/proxytype is "2" instead of "0"`)
+}
+
+func (s *clientSuite) Test_verifyJSONConfig_refusesUpdateChecks(c *C) {
+	err := verifyJSONConfig([]byte(`{"network": {"restrict_to_tcp": true},
+		"update": {"check_for_updates": true, "check_for_plugin_updates": false},
+		"ui": {"send_usage_statistics": false}, "privacy": {"hide_os_from_server": true}}`))
+
+	c.Assert(err, ErrorMatches, "update.check_for_updates is true instead of false")
+}
+
+func (s *clientSuite) Test_verifyJSONConfig_refusesMissingSettings(c *C) {
+	err := verifyJSONConfig([]byte(`{"network": {"restrict_to_tcp": true}}`))
+
+	c.Assert(err, NotNil)
+}
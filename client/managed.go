@@ -0,0 +1,267 @@
+package client
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/tor"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/proxy"
+)
+
+// The Mumble build managed by Wahay is pinned when Wahay is packaged,
+// with -ldflags "-X github.com/digitalautonomy/wahay/client.managedClientURL=...".
+// The build is an executable file, like an AppImage, and the signature
+// is an Ed25519 signature of it, encoded in base64, at the same URL
+// followed by .sig. Without a public key only the hash is verified
+var (
+	managedClientURL       string
+	managedClientSHA256    string
+	managedClientPublicKey string
+)
+
+var (
+	// ErrNoManagedClient is returned when the version of Wahay
+	// running has no Mumble build pinned
+	ErrNoManagedClient = errors.New("there is no Mumble build pinned in this version of Wahay")
+
+	errManagedClientHash      = errors.New("the downloaded Mumble doesn't have the expected hash")
+	errManagedClientSignature = errors.New("the signature of the downloaded Mumble is not valid")
+)
+
+const (
+	managedClientDirName  = "mumble"
+	managedClientFileName = "mumble"
+	managedClientMaxSize  = 512 * 1024 * 1024
+	managedClientTimeout  = 10 * time.Minute
+)
+
+// managedClient is a Mumble build pinned in Wahay
+type managedClient struct {
+	url       string
+	sha256    string
+	publicKey string
+}
+
+func pinnedManagedClient() (managedClient, error) {
+	m := managedClient{
+		url:       managedClientURL,
+		sha256:    strings.ToLower(managedClientSHA256),
+		publicKey: managedClientPublicKey,
+	}
+	if m.url == "" || m.sha256 == "" {
+		return m, ErrNoManagedClient
+	}
+	return m, nil
+}
+
+var managedClientBaseDir = func() string {
+	return filepath.Join(config.Dir(), managedClientDirName)
+}
+
+// path returns where the build is stored. Every build has a
+// directory of its own, named by its hash, so a new one pinned
+// in an update of Wahay is downloaded next to the old one
+func (m managedClient) path() string {
+	return filepath.Join(managedClientBaseDir(), m.sha256, m.fileName())
+}
+
+// fileName keeps the name of the downloaded file,
+// since its extension can matter to run it
+func (m managedClient) fileName() string {
+	u, err := url.Parse(m.url)
+	if err != nil {
+		return managedClientFileName
+	}
+
+	name := path.Base(u.Path)
+	if name == "/" || name == "." {
+		return managedClientFileName
+	}
+	return name
+}
+
+// httpGetThroughTor is how the managed client is downloaded,
+// through the SOCKS port of Tor so nobody learns who uses Wahay
+var httpGetThroughTor = func(socksAddress, address string) (*http.Response, error) {
+	dialer, err := proxy.SOCKS5("tcp", socksAddress, nil, proxy.Direct)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &http.Client{
+		Transport: &http.Transport{Dial: dialer.Dial},
+		Timeout:   managedClientTimeout,
+	}
+	return c.Get(address)
+}
+
+// EnsureManagedClient downloads the Mumble build pinned in Wahay when the
+// user wants to use it and it's not stored yet, and removes the builds
+// pinned by older versions of Wahay. It's verified before being stored
+func EnsureManagedClient(conf *config.ApplicationConfig, t tor.Instance) error {
+	if !conf.IsManagedClientEnabled() {
+		return nil
+	}
+
+	m, err := pinnedManagedClient()
+	if err != nil {
+		return err
+	}
+
+	if m.verifyStored() == nil {
+		return nil
+	}
+
+	log.WithFields(log.Fields{"url": m.url}).Info("Downloading the Mumble client managed by Wahay")
+
+	err = m.download(t.SOCKSAddress())
+	if err != nil {
+		return err
+	}
+
+	m.removeOtherBuilds()
+
+	return nil
+}
+
+// verifyStored checks the stored build, which could
+// have been modified since it was downloaded
+func (m managedClient) verifyStored() error {
+	f, err := os.Open(filepath.Clean(m.path()))
+	if err != nil {
+		return err
+	}
+	defer closeAndIgnore(f)
+
+	return m.verifyHash(f)
+}
+
+func (m managedClient) verifyHash(r io.Reader) error {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return err
+	}
+
+	if hex.EncodeToString(h.Sum(nil)) != m.sha256 {
+		return errManagedClientHash
+	}
+
+	return nil
+}
+
+func (m managedClient) verifySignature(content []byte, signature string) error {
+	if m.publicKey == "" {
+		return nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(m.publicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return errManagedClientSignature
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+	if err != nil || !ed25519.Verify(ed25519.PublicKey(key), content, sig) {
+		return errManagedClientSignature
+	}
+
+	return nil
+}
+
+func (m managedClient) download(socksAddress string) error {
+	content, err := getThroughTor(socksAddress, m.url, managedClientMaxSize)
+	if err != nil {
+		return err
+	}
+
+	if err := m.verifyHash(bytes.NewReader(content)); err != nil {
+		return err
+	}
+
+	if m.publicKey != "" {
+		signature, err := getThroughTor(socksAddress, m.url+".sig", 1024)
+		if err != nil {
+			return err
+		}
+		if err := m.verifySignature(content, string(signature)); err != nil {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(m.path()), 0700); err != nil {
+		return err
+	}
+
+	// The build has to be executable, like the Mumble installed in the system
+	/* #nosec G306 */
+	return config.SafeWrite(m.path(), content, 0700)
+}
+
+func getThroughTor(socksAddress, address string, maxSize int64) ([]byte, error) {
+	resp, err := httpGetThroughTor(socksAddress, address)
+	if err != nil {
+		return nil, err
+	}
+	defer closeAndIgnore(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading %s: %s", address, resp.Status)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("downloading %s: it's bigger than expected", address)
+	}
+
+	return content, nil
+}
+
+func (m managedClient) removeOtherBuilds() {
+	entries, err := os.ReadDir(managedClientBaseDir())
+	if err != nil {
+		return
+	}
+
+	for _, e := range entries {
+		if e.Name() == m.sha256 {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(managedClientBaseDir(), e.Name())); err != nil {
+			log.Debugf("The old Mumble build could not be removed: %s", err)
+		}
+	}
+}
+
+// searchManagedBinary uses the Mumble build managed by Wahay, when the
+// user wants to and it has been downloaded and verified
+func searchManagedBinary(conf *config.ApplicationConfig) func() (*binary, error) {
+	return func() (*binary, error) {
+		if !conf.IsManagedClientEnabled() {
+			return nil, nil
+		}
+
+		m, err := pinnedManagedClient()
+		if err != nil || m.verifyStored() != nil {
+			return nil, nil
+		}
+
+		return isThereAnAvailableBinary(m.path()), nil
+	}
+}
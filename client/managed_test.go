@@ -0,0 +1,118 @@
+package client
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/prashantv/gostub"
+	. "gopkg.in/check.v1"
+)
+
+var managedClientContent = []byte("#!/bin/sh\necho Mumble\n")
+
+func managedClientHash() string {
+	h := sha256.Sum256(managedClientContent)
+	return hex.EncodeToString(h[:])
+}
+
+// serveManagedClient serves the build and its signature, and
+// pins them with the given public key
+func serveManagedClient(c *C, publicKey ed25519.PublicKey, signature []byte) (*httptest.Server, *gostub.Stubs) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/Mumble.AppImage", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(managedClientContent)
+	})
+	mux.HandleFunc("/Mumble.AppImage.sig", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(base64.StdEncoding.EncodeToString(signature)))
+	})
+	server := httptest.NewServer(mux)
+
+	dir := c.MkDir()
+	stubs := gostub.Stub(&managedClientURL, server.URL+"/Mumble.AppImage").
+		Stub(&managedClientSHA256, managedClientHash()).
+		Stub(&managedClientPublicKey, base64.StdEncoding.EncodeToString(publicKey)).
+		Stub(&managedClientBaseDir, func() string { return dir }).
+		Stub(&httpGetThroughTor, func(_, address string) (*http.Response, error) {
+			return http.Get(address)
+		})
+
+	return server, stubs
+}
+
+func enabledManagedClient() *config.ApplicationConfig {
+	conf := config.New()
+	conf.EnableManagedClient(true)
+	return conf
+}
+
+func (s *clientSuite) Test_EnsureManagedClient_storesTheVerifiedBuild(c *C) {
+	pub, priv, _ := ed25519.GenerateKey(rand.Reader)
+	server, stubs := serveManagedClient(c, pub, ed25519.Sign(priv, managedClientContent))
+	defer server.Close()
+	defer stubs.Reset()
+
+	old := filepath.Join(managedClientBaseDir(), "oldhash")
+	c.Assert(os.MkdirAll(old, 0700), IsNil)
+
+	err := EnsureManagedClient(enabledManagedClient(), &MockTorInstance{})
+
+	c.Assert(err, IsNil)
+	stored, err := os.ReadFile(filepath.Join(managedClientBaseDir(), managedClientHash(), "Mumble.AppImage"))
+	c.Assert(err, IsNil)
+	c.Assert(stored, DeepEquals, managedClientContent)
+	c.Assert(pathExists(old), Equals, false)
+}
+
+func (s *clientSuite) Test_EnsureManagedClient_rejectsAWrongSignature(c *C) {
+	pub, _, _ := ed25519.GenerateKey(rand.Reader)
+	_, other, _ := ed25519.GenerateKey(rand.Reader)
+	server, stubs := serveManagedClient(c, pub, ed25519.Sign(other, managedClientContent))
+	defer server.Close()
+	defer stubs.Reset()
+
+	err := EnsureManagedClient(enabledManagedClient(), &MockTorInstance{})
+
+	c.Assert(err, Equals, errManagedClientSignature)
+	c.Assert(pathExists(filepath.Join(managedClientBaseDir(), managedClientHash())), Equals, false)
+}
+
+func (s *clientSuite) Test_EnsureManagedClient_rejectsAWrongHash(c *C) {
+	pub, priv, _ := ed25519.GenerateKey(rand.Reader)
+	server, stubs := serveManagedClient(c, pub, ed25519.Sign(priv, managedClientContent))
+	defer server.Close()
+	defer stubs.Reset()
+	stubs.Stub(&managedClientSHA256, hex.EncodeToString(make([]byte, sha256.Size)))
+
+	err := EnsureManagedClient(enabledManagedClient(), &MockTorInstance{})
+
+	c.Assert(err, Equals, errManagedClientHash)
+}
+
+func (s *clientSuite) Test_EnsureManagedClient_failsWithoutAPinnedBuild(c *C) {
+	defer gostub.Stub(&managedClientURL, "").Reset()
+
+	c.Assert(EnsureManagedClient(enabledManagedClient(), &MockTorInstance{}), Equals, ErrNoManagedClient)
+	c.Assert(EnsureManagedClient(config.New(), &MockTorInstance{}), IsNil)
+}
+
+func (s *clientSuite) Test_managedClient_verifyStored_noticesModifiedBuilds(c *C) {
+	pub, priv, _ := ed25519.GenerateKey(rand.Reader)
+	server, stubs := serveManagedClient(c, pub, ed25519.Sign(priv, managedClientContent))
+	defer server.Close()
+	defer stubs.Reset()
+	c.Assert(EnsureManagedClient(enabledManagedClient(), &MockTorInstance{}), IsNil)
+
+	m, _ := pinnedManagedClient()
+	c.Assert(m.verifyStored(), IsNil)
+
+	c.Assert(os.WriteFile(m.path(), []byte("modified"), 0700), IsNil)
+	c.Assert(m.verifyStored(), Equals, errManagedClientHash)
+}
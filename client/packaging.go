@@ -0,0 +1,91 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// packaging is the way a Mumble client that is not a plain binary was installed
+type packaging string
+
+const (
+	packagingFlatpak   packaging = "flatpak"
+	packagingSnap      packaging = "snap"
+	packagingAppImage  packaging = "appimage"
+	packagingAppBundle packaging = "appbundle"
+)
+
+// newPackagedBinary returns the client started with the command in path
+// and the given arguments. Its configuration goes in a new directory
+// inside configBase, or the temporary directory if it's empty
+func newPackagedBinary(path string, p packaging, configBase string, launchArgs ...string) *binary {
+	b := &binary{
+		path:       path,
+		isValid:    true,
+		env:        []string{},
+		packaging:  p,
+		configBase: configBase,
+		launchArgs: launchArgs,
+	}
+
+	if !pathExists(path) {
+		b.isValid = false
+		b.lastError = errInvalidBinaryFile
+	}
+
+	return b
+}
+
+func (b *binary) isPackaged() bool {
+	return b.packaging != ""
+}
+
+// createConfigDir creates the directory the configuration of a packaged
+// client goes in, which is removed when Wahay finishes using the client
+func (b *binary) createConfigDir() error {
+	if b.configBase != "" {
+		if err := os.MkdirAll(b.configBase, 0700); err != nil {
+			return err
+		}
+	}
+
+	dir, err := tempDir(b.configBase, "wahay-mumble")
+	if err != nil {
+		return err
+	}
+
+	b.configDir = dir
+	return nil
+}
+
+// startOptions say how a client is started next to the
+// other instances of Mumble that Wahay runs
+type startOptions struct {
+	// ownConfiguration is true when the configuration of the client is
+	// not the one Mumble finds by itself, next to the binary
+	ownConfiguration bool
+	// multiple is true when another Mumble of Wahay is running, which
+	// would be handed the meeting URL instead of starting a new client
+	multiple bool
+}
+
+// args returns the arguments to start the client and join the meeting
+// at the given URL. Packaged clients are told where their configuration is
+func (b *binary) args(configDir, meetingURL string, o startOptions) []string {
+	result := []string{}
+	if b != nil && b.isPackaged() {
+		result = append(result, b.launchArgs...)
+	}
+	if b != nil && (b.isPackaged() || o.ownConfiguration) {
+		result = append(result, "--config", filepath.Join(configDir, configFileName))
+	}
+	if o.multiple {
+		result = append(result, "--multiple")
+	}
+
+	// Without a meeting URL the client starts without connecting
+	if meetingURL != "" {
+		result = append(result, meetingURL)
+	}
+	return result
+}
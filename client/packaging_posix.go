@@ -0,0 +1,151 @@
+//go:build !windows
+
+package client
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	flatpakMumbleApp = "info.mumble.Mumble"
+	snapBinDir       = "/snap/bin"
+	snapMumble       = "/snap/bin/mumble"
+)
+
+var (
+	packagingLookPath = exec.LookPath
+	packagingHomeDir  = os.UserHomeDir
+	packagingGlob     = filepath.Glob
+	packagingRun      = func(name string, args ...string) error {
+		/* #nosec G204 */
+		return exec.Command(name, args...).Run()
+	}
+)
+
+// appImageDirs are the places, relative to the home directory of the
+// user unless they are absolute, where AppImages are usually kept
+var appImageDirs = []string{
+	"Applications",
+	".local/bin",
+	"bin",
+	"Downloads",
+	"/opt",
+	"/opt/mumble",
+}
+
+// appImagePatterns are the names the Mumble AppImages are published with
+var appImagePatterns = []string{
+	"[Mm]umble*.AppImage",
+	"[Mm]umble*.appimage",
+}
+
+// appBundleDirs are where macOS applications are installed, for
+// everybody or, relative to the home directory, only for the user
+var appBundleDirs = []string{
+	"/Applications",
+	"Applications",
+}
+
+// appBundleBinary is the executable inside of the Mumble application
+const appBundleBinary = "Mumble.app/Contents/MacOS/Mumble"
+
+// searchPackagedBinary looks for a Mumble client installed with Flatpak,
+// Snap, as an AppImage or as a macOS application, in that order. These clients can't be copied
+// or read a configuration next to their binary, so they get a
+// configuration directory of their own inside of what they can reach
+var searchPackagedBinary = func() (*binary, error) {
+	home, err := packagingHomeDir()
+	if err != nil {
+		return nil, nil
+	}
+
+	for _, search := range []func(string) *binary{
+		searchFlatpakBinary,
+		searchSnapBinary,
+		searchAppImageBinary,
+		searchAppBundleBinary,
+	} {
+		if b := search(home); b != nil {
+			return b, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func searchFlatpakBinary(home string) *binary {
+	path, err := packagingLookPath("flatpak")
+	if err != nil {
+		return nil
+	}
+
+	if packagingRun(path, "info", flatpakMumbleApp) != nil {
+		return nil
+	}
+
+	// The application has access to its own data directory in the
+	// same path, so there's no need to open the sandbox
+	return newPackagedBinary(path, packagingFlatpak,
+		filepath.Join(home, ".var", "app", flatpakMumbleApp),
+		"run", "--env=QT_QPA_PLATFORM=xcb", flatpakMumbleApp)
+}
+
+func searchSnapBinary(home string) *binary {
+	if !pathExists(snapMumble) {
+		return nil
+	}
+
+	// A strictly confined snap can only write in its own user data directory
+	return newPackagedBinary(snapMumble, packagingSnap, filepath.Join(home, "snap", "mumble", "current"))
+}
+
+func searchAppImageBinary(home string) *binary {
+	for _, dir := range appImageDirs {
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(home, dir)
+		}
+
+		for _, pattern := range appImagePatterns {
+			matches, _ := packagingGlob(filepath.Join(dir, pattern))
+			for _, m := range matches {
+				if isExecutable(m) {
+					return newPackagedBinary(m, packagingAppImage, "")
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// searchAppBundleBinary finds the Mumble application of macOS. Its
+// executable needs the rest of the bundle, and the bundle must not be
+// modified, so it can't be copied or get a configuration next to it
+func searchAppBundleBinary(home string) *binary {
+	for _, dir := range appBundleDirs {
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(home, dir)
+		}
+
+		path := filepath.Join(dir, appBundleBinary)
+		if isExecutable(path) {
+			return newPackagedBinary(path, packagingAppBundle, "")
+		}
+	}
+
+	return nil
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir() && info.Mode()&0111 != 0
+}
+
+// isSnapPath returns true for the binaries of snaps, which
+// are links to the snap command and can't be copied
+func isSnapPath(path string) bool {
+	return strings.HasPrefix(path, snapBinDir+"/")
+}
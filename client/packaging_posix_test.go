@@ -0,0 +1,90 @@
+//go:build !windows
+
+package client
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/prashantv/gostub"
+	. "gopkg.in/check.v1"
+)
+
+func (s *clientSuite) Test_searchAppImageBinary_findsExecutableAppImagesInTheHome(c *C) {
+	home := c.MkDir()
+	dir := filepath.Join(home, "Applications")
+	c.Assert(os.MkdirAll(dir, 0700), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, "Mumble-notes.txt"), nil, 0600), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, "mumble-1.5.AppImage"), nil, 0600), IsNil)
+
+	c.Assert(searchAppImageBinary(home), IsNil)
+
+	c.Assert(os.Chmod(filepath.Join(dir, "mumble-1.5.AppImage"), 0700), IsNil)
+
+	b := searchAppImageBinary(home)
+	c.Assert(b, NotNil)
+	c.Assert(b.path, Equals, filepath.Join(dir, "mumble-1.5.AppImage"))
+	c.Assert(b.packaging, Equals, packagingAppImage)
+	c.Assert(b.configBase, Equals, "")
+}
+
+func (s *clientSuite) Test_searchFlatpakBinary_runsTheMumbleApplication(c *C) {
+	flatpak := filepath.Join(c.MkDir(), "flatpak")
+	c.Assert(os.WriteFile(flatpak, nil, 0700), IsNil)
+
+	defer gostub.New().Stub(&packagingLookPath, func(string) (string, error) {
+		return flatpak, nil
+	}).Reset()
+	defer gostub.New().Stub(&packagingRun, func(name string, args ...string) error {
+		c.Assert(args, DeepEquals, []string{"info", flatpakMumbleApp})
+		return nil
+	}).Reset()
+
+	b := searchFlatpakBinary("/home/ana")
+
+	c.Assert(b, NotNil)
+	c.Assert(b.isValid, Equals, true)
+	c.Assert(b.packaging, Equals, packagingFlatpak)
+	c.Assert(b.configBase, Equals, "/home/ana/.var/app/info.mumble.Mumble")
+	c.Assert(b.launchArgs[len(b.launchArgs)-1], Equals, flatpakMumbleApp)
+}
+
+func (s *clientSuite) Test_searchFlatpakBinary_returnsNilWhenMumbleIsNotInstalled(c *C) {
+	defer gostub.New().Stub(&packagingLookPath, func(string) (string, error) {
+		return "/usr/bin/flatpak", nil
+	}).Reset()
+	defer gostub.New().Stub(&packagingRun, func(string, ...string) error {
+		return errors.New("not installed")
+	}).Reset()
+
+	c.Assert(searchFlatpakBinary("/home/ana"), IsNil)
+}
+
+func (s *clientSuite) Test_searchBinaryInSystem_leavesSnapsToThePackagedSearch(c *C) {
+	ml := &mockLookPath{}
+	defer gostub.New().Stub(&execLookPath, ml.LookPath).Reset()
+	ml.On("LookPath", "mumble").Return("/snap/bin/mumble", nil).Once()
+
+	b, err := searchBinaryInSystem()
+
+	c.Assert(b, IsNil)
+	c.Assert(err, IsNil)
+	ml.AssertExpectations(c)
+}
+
+func (s *clientSuite) Test_searchAppBundleBinary_findsTheMumbleApplication(c *C) {
+	home := c.MkDir()
+	c.Assert(searchAppBundleBinary(home), IsNil)
+
+	path := filepath.Join(home, "Applications", appBundleBinary)
+	c.Assert(os.MkdirAll(filepath.Dir(path), 0700), IsNil)
+	c.Assert(os.WriteFile(path, nil, 0700), IsNil)
+
+	b := searchAppBundleBinary(home)
+	c.Assert(b, NotNil)
+	c.Assert(b.path, Equals, path)
+	c.Assert(b.packaging, Equals, packagingAppBundle)
+	c.Assert(b.args("/tmp/wahay-mumble", "mumble://example.onion", startOptions{}), DeepEquals,
+		[]string{"--config", "/tmp/wahay-mumble/mumble.ini", "mumble://example.onion"})
+}
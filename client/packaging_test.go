@@ -0,0 +1,64 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *clientSuite) Test_binary_args_onlyJoinsTheMeetingWithAPlainBinary(c *C) {
+	b := &binary{path: "/usr/bin/mumble"}
+
+	c.Assert(b.args("/tmp/conf", "mumble://abc.onion", startOptions{}), DeepEquals, []string{"mumble://abc.onion"})
+}
+
+func (s *clientSuite) Test_binary_args_startsWithoutConnectingWithoutAMeetingURL(c *C) {
+	b := &binary{path: "/usr/bin/mumble"}
+
+	c.Assert(b.args("/tmp/conf", "", startOptions{}), DeepEquals, []string{})
+}
+
+func (s *clientSuite) Test_binary_args_pointsPackagedClientsToTheirConfiguration(c *C) {
+	b := &binary{packaging: packagingFlatpak, launchArgs: []string{"run", "info.mumble.Mumble"}}
+
+	c.Assert(b.args("/home/ana/conf", "mumble://abc.onion", startOptions{}), DeepEquals, []string{
+		"run", "info.mumble.Mumble",
+		"--config", filepath.Join("/home/ana/conf", configFileName),
+		"mumble://abc.onion",
+	})
+	c.Assert(b.launchArgs, HasLen, 2)
+}
+
+func (s *clientSuite) Test_binary_args_startsAnotherClientWithItsOwnConfiguration(c *C) {
+	b := &binary{path: "/usr/bin/mumble"}
+
+	c.Assert(b.args("/tmp/conf/meeting", "mumble://abc.onion", startOptions{ownConfiguration: true, multiple: true}), DeepEquals, []string{
+		"--config", filepath.Join("/tmp/conf/meeting", configFileName),
+		"--multiple",
+		"mumble://abc.onion",
+	})
+}
+
+func (s *clientSuite) Test_binary_createConfigDir_isRemovedWithTheBinary(c *C) {
+	base := filepath.Join(c.MkDir(), "snap", "mumble", "current")
+	b := &binary{packaging: packagingSnap, configBase: base}
+
+	c.Assert(b.createConfigDir(), IsNil)
+	c.Assert(filepath.Dir(b.configDir), Equals, base)
+	c.Assert(isADirectory(b.configDir), Equals, true)
+
+	b.destroy()
+
+	_, err := os.Stat(b.configDir)
+	c.Assert(os.IsNotExist(err), Equals, true)
+	c.Assert(isADirectory(base), Equals, true)
+}
+
+func (s *clientSuite) Test_newPackagedBinary_isInvalidWithoutTheCommand(c *C) {
+	b := newPackagedBinary(filepath.Join(c.MkDir(), "Mumble.AppImage"), packagingAppImage, "")
+
+	c.Assert(b.isValid, Equals, false)
+	c.Assert(b.isPackaged(), Equals, true)
+	c.Assert(b.shouldBeCopied, Equals, false)
+}
@@ -0,0 +1,7 @@
+package client
+
+// searchPackagedBinary finds nothing, since Flatpak,
+// Snap and AppImage are only available on Linux
+var searchPackagedBinary = func() (*binary, error) {
+	return nil, nil
+}
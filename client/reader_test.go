@@ -20,9 +20,13 @@ func (s *clientSuite) Test_readerMumbleDB_returnsTheByteRepresentationFromAStrin
 func (s *clientSuite) Test_readerMumbleIniConfig_returnsTheContentLikeAString(c *C) {
 	result := readerMumbleIniConfig()
 
-	c.Assert(result, HasLen, 498)
+	c.Assert(result, HasLen, 751)
 	c.Assert(result, Contains, "version=1.3.0")
 	c.Assert(result, Contains, "#CERTIFICATE")
+	c.Assert(result, Contains, "#PINGINTERVAL")
+	c.Assert(result, Contains, "#TRANSMIT")
+	c.Assert(result, Contains, "#MUTE")
+	c.Assert(result, Contains, "#AUDIOWIZARD")
 	c.Assert(result, Contains, "#LANGUAGE")
 	c.Assert(result, Contains, "#THEME")
 }
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrRemoteControlUnavailable is returned when the running Mumble
+// client can't be reached through its remote control interface
+var ErrRemoteControlUnavailable = errors.New("the Mumble client can't be controlled from Wahay")
+
+// RemoteControl controls the Mumble client running the meeting, so
+// the people in it don't have to switch to the window of Mumble.
+// Mumble has no way to be disconnected from outside, so leaving the
+// meeting still closes the client
+type RemoteControl interface {
+	// IsConnected returns true when the client is connected to a server
+	IsConnected() bool
+
+	IsSelfMuted() (bool, error)
+	SetSelfMuted(bool) error
+
+	IsSelfDeafened() (bool, error)
+	SetSelfDeafened(bool) error
+}
+
+// RemoteControl returns the control of the client. Mumble publishes
+// a single interface in the session, the one of the first client
+// started, so a client started next to another one can't be controlled
+func (c *client) RemoteControl() RemoteControl {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.startedNextToAnother {
+		return unavailableControl{}
+	}
+	return newRemoteControl()
+}
+
+// unavailableControl is the control of a client that can't be reached
+type unavailableControl struct{}
+
+func (unavailableControl) IsConnected() bool {
+	return false
+}
+
+func (unavailableControl) IsSelfMuted() (bool, error) {
+	return false, ErrRemoteControlUnavailable
+}
+
+func (unavailableControl) SetSelfMuted(bool) error {
+	return ErrRemoteControlUnavailable
+}
+
+func (unavailableControl) IsSelfDeafened() (bool, error) {
+	return false, ErrRemoteControlUnavailable
+}
+
+func (unavailableControl) SetSelfDeafened(bool) error {
+	return ErrRemoteControlUnavailable
+}
+
+// parseBooleanReply reads the value of a reply of Mumble
+// printed by dbus-send, like "   boolean true"
+func parseBooleanReply(reply string) (bool, error) {
+	for _, line := range strings.Split(reply, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "boolean" {
+			return fields[1] == "true", nil
+		}
+	}
+
+	return false, ErrRemoteControlUnavailable
+}
@@ -0,0 +1,97 @@
+//go:build !windows
+
+package client
+
+import (
+	"context"
+	"os/exec"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// The D-Bus interface Mumble publishes in the session bus
+const (
+	mumbleDBusName      = "net.sourceforge.mumble.mumble"
+	mumbleDBusPath      = "/"
+	mumbleDBusInterface = "net.sourceforge.mumble.Mumble"
+)
+
+const remoteControlTimeout = 2 * time.Second
+
+// dbusSend calls a method of Mumble with dbus-send, which is
+// installed with D-Bus itself, and returns what it prints
+var dbusSend = func(method string, args ...string) ([]byte, error) {
+	path, err := exec.LookPath("dbus-send")
+	if err != nil {
+		return nil, ErrRemoteControlUnavailable
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteControlTimeout)
+	defer cancel()
+
+	cmdArgs := append([]string{
+		"--session",
+		"--print-reply",
+		"--dest=" + mumbleDBusName,
+		mumbleDBusPath,
+		mumbleDBusInterface + "." + method,
+	}, args...)
+
+	/* #nosec G204 */
+	return exec.CommandContext(ctx, path, cmdArgs...).Output()
+}
+
+type dbusControl struct{}
+
+func newRemoteControl() RemoteControl {
+	return dbusControl{}
+}
+
+// IsConnected asks for the URL of the current server,
+// which Mumble answers with an error when it's not connected
+func (dbusControl) IsConnected() bool {
+	_, err := dbusSend("getCurrentUrl")
+	return err == nil
+}
+
+func (dbusControl) IsSelfMuted() (bool, error) {
+	return callBoolean("isSelfMuted")
+}
+
+func (dbusControl) SetSelfMuted(muted bool) error {
+	return callWithBoolean("setSelfMuted", muted)
+}
+
+func (dbusControl) IsSelfDeafened() (bool, error) {
+	return callBoolean("isSelfDeaf")
+}
+
+func (dbusControl) SetSelfDeafened(deafened bool) error {
+	return callWithBoolean("setSelfDeaf", deafened)
+}
+
+func callBoolean(method string) (bool, error) {
+	reply, err := dbusSend(method)
+	if err != nil {
+		log.WithError(err).Debugf("The Mumble client didn't answer %s", method)
+		return false, ErrRemoteControlUnavailable
+	}
+
+	return parseBooleanReply(string(reply))
+}
+
+func callWithBoolean(method string, value bool) error {
+	arg := "boolean:false"
+	if value {
+		arg = "boolean:true"
+	}
+
+	_, err := dbusSend(method, arg)
+	if err != nil {
+		log.WithError(err).Debugf("The Mumble client didn't answer %s", method)
+		return ErrRemoteControlUnavailable
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+//go:build !windows
+
+package client
+
+import (
+	"errors"
+
+	"github.com/prashantv/gostub"
+	. "gopkg.in/check.v1"
+)
+
+func (s *clientSuite) Test_dbusControl_setsTheStateOfTheClient(c *C) {
+	var calls [][]string
+	defer gostub.Stub(&dbusSend, func(method string, args ...string) ([]byte, error) {
+		calls = append(calls, append([]string{method}, args...))
+		return []byte("method return\n"), nil
+	}).Reset()
+
+	ctl := (&client{}).RemoteControl()
+
+	c.Assert(ctl.SetSelfMuted(true), IsNil)
+	c.Assert(ctl.SetSelfDeafened(false), IsNil)
+	c.Assert(calls, DeepEquals, [][]string{
+		{"setSelfMuted", "boolean:true"},
+		{"setSelfDeaf", "boolean:false"},
+	})
+}
+
+func (s *clientSuite) Test_dbusControl_readsTheStateOfTheClient(c *C) {
+	defer gostub.Stub(&dbusSend, func(method string, args ...string) ([]byte, error) {
+		switch method {
+		case "isSelfMuted":
+			return []byte("method return\n   boolean true\n"), nil
+		case "getCurrentUrl":
+			return []byte("method return\n   string \"mumble://example.onion\"\n"), nil
+		}
+		return []byte("method return\n   boolean false\n"), nil
+	}).Reset()
+
+	ctl := (&client{}).RemoteControl()
+
+	muted, err := ctl.IsSelfMuted()
+	c.Assert(err, IsNil)
+	c.Assert(muted, Equals, true)
+
+	deafened, err := ctl.IsSelfDeafened()
+	c.Assert(err, IsNil)
+	c.Assert(deafened, Equals, false)
+
+	c.Assert(ctl.IsConnected(), Equals, true)
+}
+
+func (s *clientSuite) Test_dbusControl_failsWhenMumbleDoesNotAnswer(c *C) {
+	defer gostub.Stub(&dbusSend, func(method string, args ...string) ([]byte, error) {
+		return nil, errors.New("org.freedesktop.DBus.Error.ServiceUnknown")
+	}).Reset()
+
+	ctl := (&client{}).RemoteControl()
+
+	_, err := ctl.IsSelfMuted()
+	c.Assert(err, Equals, ErrRemoteControlUnavailable)
+	c.Assert(ctl.SetSelfDeafened(true), Equals, ErrRemoteControlUnavailable)
+	c.Assert(ctl.IsConnected(), Equals, false)
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *clientSuite) Test_parseBooleanReply_readsTheValueOfTheReply(c *C) {
+	reply := "method return time=1700000000.1 sender=:1.42 -> destination=:1.43 serial=7 reply_serial=2\n" +
+		"   boolean true\n"
+
+	v, err := parseBooleanReply(reply)
+	c.Assert(err, IsNil)
+	c.Assert(v, Equals, true)
+
+	v, err = parseBooleanReply("method return\n   boolean false\n")
+	c.Assert(err, IsNil)
+	c.Assert(v, Equals, false)
+}
+
+func (s *clientSuite) Test_parseBooleanReply_failsWithoutABoolean(c *C) {
+	_, err := parseBooleanReply("method return\n   string \"mumble://example.onion\"\n")
+	c.Assert(err, Equals, ErrRemoteControlUnavailable)
+}
+
+func (s *clientSuite) Test_RemoteControl_isUnavailableForAClientStartedNextToAnother(c *C) {
+	ctl := (&client{startedNextToAnother: true}).RemoteControl()
+
+	c.Assert(ctl.IsConnected(), Equals, false)
+	c.Assert(ctl.SetSelfMuted(true), Equals, ErrRemoteControlUnavailable)
+}
@@ -0,0 +1,7 @@
+package client
+
+// Mumble only publishes its remote control interface through
+// D-Bus, so the client can't be controlled on Windows
+func newRemoteControl() RemoteControl {
+	return unavailableControl{}
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// mumbleVersion is the version of the Mumble client. The zero value
+// means the version couldn't be detected
+type mumbleVersion struct {
+	major, minor, patch int
+}
+
+var (
+	// mumble15 is the first version that keeps its settings in
+	// JSON, instead of the INI file the older versions use
+	mumble15 = mumbleVersion{1, 5, 0}
+	// mumbleOpus is the first version with the Opus codec, the
+	// only one servers like the ones Wahay hosts support
+	mumbleOpus = mumbleVersion{1, 2, 4}
+)
+
+var mumbleVersionPattern = regexp.MustCompile(`(\d+)\.(\d+)\.(\d+)`)
+
+// parseMumbleVersion finds the version in what "mumble --version" prints,
+// like "Mumble version 1.4.230" or "Mumble version 1.3.4"
+func parseMumbleVersion(output string) (mumbleVersion, bool) {
+	m := mumbleVersionPattern.FindStringSubmatch(output)
+	if m == nil {
+		return mumbleVersion{}, false
+	}
+
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+	patch, _ := strconv.Atoi(m[3])
+
+	return mumbleVersion{major, minor, patch}, true
+}
+
+func (v mumbleVersion) isKnown() bool {
+	return v != mumbleVersion{}
+}
+
+func (v mumbleVersion) atLeast(o mumbleVersion) bool {
+	if v.major != o.major {
+		return v.major > o.major
+	}
+	if v.minor != o.minor {
+		return v.minor > o.minor
+	}
+	return v.patch >= o.patch
+}
+
+func (v mumbleVersion) String() string {
+	if !v.isKnown() {
+		return "unknown"
+	}
+	return fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+}
+
+// configFileNames returns the configuration files the client reads.
+// When the version is unknown both are written, and the client picks
+// the one it understands
+func (v mumbleVersion) configFileNames() []string {
+	if v.isKnown() && !v.atLeast(mumble15) {
+		return []string{configFileName}
+	}
+	return []string{configFileName, configFileJSON}
+}
@@ -0,0 +1,37 @@
+//go:build !windows
+
+package client
+
+import (
+	"context"
+	"os/exec"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const versionDetectionTimeout = 10 * time.Second
+
+var versionCommandOutput = func(env []string, name string, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), versionDetectionTimeout)
+	defer cancel()
+
+	/* #nosec G204 */
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = append(cmd.Environ(), env...)
+	return cmd.CombinedOutput()
+}
+
+// detectVersion asks the client for its version. Packaged clients
+// are asked through the command that starts them
+func (c *client) detectVersion() mumbleVersion {
+	args := append(append([]string{}, c.binary.launchArgs...), "--version")
+
+	output, err := versionCommandOutput(c.binaryEnv(), c.pathToBinary(), args...)
+	v, ok := parseMumbleVersion(string(output))
+	if !ok {
+		log.WithError(err).Debug("The version of the Mumble client couldn't be detected")
+	}
+
+	return v
+}
@@ -0,0 +1,40 @@
+//go:build !windows
+
+package client
+
+import (
+	"errors"
+
+	"github.com/prashantv/gostub"
+	. "gopkg.in/check.v1"
+)
+
+func (s *clientSuite) Test_client_detectVersion_asksPackagedClientsThroughTheirCommand(c *C) {
+	var gotName string
+	var gotArgs []string
+	defer gostub.New().Stub(&versionCommandOutput, func(env []string, name string, args ...string) ([]byte, error) {
+		gotName, gotArgs = name, args
+		return []byte("Mumble version 1.5.517\n"), nil
+	}).Reset()
+
+	cl := &client{isValid: true, binary: &binary{
+		path:       "/usr/bin/flatpak",
+		isValid:    true,
+		packaging:  packagingFlatpak,
+		launchArgs: []string{"run", "info.mumble.Mumble"},
+	}}
+
+	c.Assert(cl.detectVersion(), Equals, mumbleVersion{1, 5, 517})
+	c.Assert(gotName, Equals, "/usr/bin/flatpak")
+	c.Assert(gotArgs, DeepEquals, []string{"run", "info.mumble.Mumble", "--version"})
+}
+
+func (s *clientSuite) Test_client_detectVersion_returnsAnUnknownVersionWhenTheClientFails(c *C) {
+	defer gostub.New().Stub(&versionCommandOutput, func([]string, string, ...string) ([]byte, error) {
+		return nil, errors.New("exit status 1")
+	}).Reset()
+
+	cl := &client{isValid: true, binary: &binary{path: "/usr/bin/mumble", isValid: true}}
+
+	c.Assert(cl.detectVersion().isKnown(), Equals, false)
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *clientSuite) Test_parseMumbleVersion_findsTheVersionInTheOutput(c *C) {
+	v, ok := parseMumbleVersion("Mumble version 1.4.230\n")
+
+	c.Assert(ok, Equals, true)
+	c.Assert(v, Equals, mumbleVersion{1, 4, 230})
+	c.Assert(v.String(), Equals, "1.4.230")
+}
+
+func (s *clientSuite) Test_parseMumbleVersion_failsWithoutAVersion(c *C) {
+	v, ok := parseMumbleVersion("qt.qpa.xcb: could not connect to display")
+
+	c.Assert(ok, Equals, false)
+	c.Assert(v.isKnown(), Equals, false)
+	c.Assert(v.String(), Equals, "unknown")
+}
+
+func (s *clientSuite) Test_mumbleVersion_atLeast_comparesEveryPart(c *C) {
+	c.Assert(mumbleVersion{1, 5, 517}.atLeast(mumble15), Equals, true)
+	c.Assert(mumbleVersion{1, 4, 230}.atLeast(mumble15), Equals, false)
+	c.Assert(mumbleVersion{1, 2, 4}.atLeast(mumbleOpus), Equals, true)
+	c.Assert(mumbleVersion{1, 2, 3}.atLeast(mumbleOpus), Equals, false)
+	c.Assert(mumbleVersion{2, 0, 0}.atLeast(mumble15), Equals, true)
+}
+
+func (s *clientSuite) Test_mumbleVersion_configFileNames_dependsOnTheVersion(c *C) {
+	c.Assert(mumbleVersion{1, 3, 4}.configFileNames(), DeepEquals, []string{configFileName})
+	c.Assert(mumbleVersion{1, 5, 517}.configFileNames(), DeepEquals, []string{configFileName, configFileJSON})
+	c.Assert(mumbleVersion{}.configFileNames(), DeepEquals, []string{configFileName, configFileJSON})
+}
+
+func (s *clientSuite) Test_createAndWriteConfigFiles_onlyWritesTheIniConfigurationForOldClients(c *C) {
+	dir := c.MkDir()
+	client := &client{
+		configDir:             dir,
+		configContentProvider: readerMumbleIniConfig,
+		configJSONProvider:    readerMumbleJSONConfig,
+		configFiles:           map[string]struct{}{},
+		version:               mumbleVersion{1, 4, 230},
+	}
+
+	c.Assert(client.createAndWriteConfigFiles(), IsNil)
+
+	c.Assert(client.configFiles, DeepEquals, map[string]struct{}{filepath.Join(dir, configFileName): {}})
+}
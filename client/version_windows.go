@@ -0,0 +1,7 @@
+package client
+
+// detectVersion doesn't ask the client for its version, since
+// on Windows Mumble shows it in a dialog instead of printing it
+func (c *client) detectVersion() mumbleVersion {
+	return mumbleVersion{}
+}
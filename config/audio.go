@@ -0,0 +1,104 @@
+package config
+
+import "time"
+
+// TransmitMode is how the Mumble client decides when to send the voice
+type TransmitMode string
+
+const (
+	// TransmitPushToTalk sends the voice only while a key is held down
+	TransmitPushToTalk TransmitMode = "ptt"
+	// TransmitVoiceActivity sends the voice when someone speaks
+	TransmitVoiceActivity TransmitMode = "vad"
+	// TransmitContinuous always sends the voice
+	TransmitContinuous TransmitMode = "continuous"
+)
+
+// NoiseSuppression is how much the Mumble client
+// attenuates the background noise of the microphone
+type NoiseSuppression string
+
+const (
+	// NoiseSuppressionOff leaves the background noise as it is
+	NoiseSuppressionOff NoiseSuppression = "off"
+	// NoiseSuppressionLow removes some of the background noise
+	NoiseSuppressionLow NoiseSuppression = "low"
+	// NoiseSuppressionMedium is the level Mumble uses by default
+	NoiseSuppressionMedium NoiseSuppression = "medium"
+	// NoiseSuppressionHigh removes most of the background noise,
+	// at the cost of making the voice sound less natural
+	NoiseSuppressionHigh NoiseSuppression = "high"
+)
+
+// AudioPreset contains the audio settings written in the configuration
+// of the Mumble client, so people joining their first meeting don't have
+// to find them in the Mumble settings
+type AudioPreset struct {
+	Transmit         TransmitMode
+	NoiseSuppression NoiseSuppression
+	// JitterBuffer is how much audio the Mumble client keeps before
+	// playing it. The latency of Tor varies a lot, so the voice breaks
+	// up with the short buffer Mumble uses by default
+	JitterBuffer time.Duration
+}
+
+const (
+	defaultTransmitMode     = TransmitPushToTalk
+	defaultNoiseSuppression = NoiseSuppressionMedium
+	defaultJitterBuffer     = 100 * time.Millisecond
+	maxJitterBuffer         = time.Second
+)
+
+// DefaultAudioPreset returns the audio settings that work
+// well for meetings going through Tor
+func DefaultAudioPreset() AudioPreset {
+	return AudioPreset{
+		Transmit:         defaultTransmitMode,
+		NoiseSuppression: defaultNoiseSuppression,
+		JitterBuffer:     defaultJitterBuffer,
+	}
+}
+
+func transmitModeOrDefault(m string) TransmitMode {
+	switch TransmitMode(m) {
+	case TransmitPushToTalk, TransmitVoiceActivity, TransmitContinuous:
+		return TransmitMode(m)
+	}
+	return defaultTransmitMode
+}
+
+func noiseSuppressionOrDefault(n string) NoiseSuppression {
+	switch NoiseSuppression(n) {
+	case NoiseSuppressionOff, NoiseSuppressionLow, NoiseSuppressionMedium, NoiseSuppressionHigh:
+		return NoiseSuppression(n)
+	}
+	return defaultNoiseSuppression
+}
+
+func jitterBufferOrDefault(milliseconds int) time.Duration {
+	d := time.Duration(milliseconds) * time.Millisecond
+	if d <= 0 {
+		return defaultJitterBuffer
+	}
+	if d > maxJitterBuffer {
+		return maxJitterBuffer
+	}
+	return d
+}
+
+// GetAudioPreset returns the configured audio settings, using
+// the defaults for the values that haven't been configured
+func (a *ApplicationConfig) GetAudioPreset() AudioPreset {
+	return AudioPreset{
+		Transmit:         transmitModeOrDefault(a.AudioTransmitMode),
+		NoiseSuppression: noiseSuppressionOrDefault(a.NoiseSuppression),
+		JitterBuffer:     jitterBufferOrDefault(a.JitterBufferMillis),
+	}
+}
+
+// SetAudioPreset sets the audio settings
+func (a *ApplicationConfig) SetAudioPreset(p AudioPreset) {
+	a.AudioTransmitMode = string(p.Transmit)
+	a.NoiseSuppression = string(p.NoiseSuppression)
+	a.JitterBufferMillis = int(p.JitterBuffer / time.Millisecond)
+}
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (cs *ConfigSuite) Test_GetAudioPreset_returnsTheDefaultsWhenNothingIsConfigured(c *C) {
+	ac := New()
+
+	c.Assert(ac.GetAudioPreset(), Equals, DefaultAudioPreset())
+}
+
+func (cs *ConfigSuite) Test_GetAudioPreset_returnsTheConfiguredValues(c *C) {
+	ac := New()
+	ac.SetAudioPreset(AudioPreset{
+		Transmit:         TransmitVoiceActivity,
+		NoiseSuppression: NoiseSuppressionOff,
+		JitterBuffer:     250 * time.Millisecond,
+	})
+
+	p := ac.GetAudioPreset()
+	c.Assert(p.Transmit, Equals, TransmitVoiceActivity)
+	c.Assert(p.NoiseSuppression, Equals, NoiseSuppressionOff)
+	c.Assert(p.JitterBuffer, Equals, 250*time.Millisecond)
+}
+
+func (cs *ConfigSuite) Test_GetAudioPreset_ignoresUnknownValues(c *C) {
+	ac := New()
+	ac.AudioTransmitMode = "shout"
+	ac.NoiseSuppression = "extreme"
+	ac.JitterBufferMillis = -5
+
+	c.Assert(ac.GetAudioPreset(), Equals, DefaultAudioPreset())
+}
+
+func (cs *ConfigSuite) Test_GetAudioPreset_limitsTheJitterBuffer(c *C) {
+	ac := New()
+	ac.JitterBufferMillis = 60000
+
+	c.Assert(ac.GetAudioPreset().JitterBuffer, Equals, maxJitterBuffer)
+}
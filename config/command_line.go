@@ -30,6 +30,14 @@ var (
 	DebugFunctionCalls = flag.Bool("debug-function-calls", false, "trace function calls in logging")
 	// Version contains the command line argument given for version
 	Version = flag.Bool("version", false, "display version information and exit")
+	// Headless contains the command line argument given for joining a meeting from the terminal
+	Headless = flag.Bool("headless", false, "join the meeting in the invitation from the terminal, without the graphical interface")
+	// HeadlessClient contains the command line argument given for the console Mumble client
+	HeadlessClient = flag.String("headless-client", "barnard", "the console Mumble client used to join meetings from the terminal")
+	// Username contains the command line argument given for the name used in the meeting
+	Username = flag.String("username", "", "the name used in the meeting when joining from the terminal")
+	// HostMeeting contains the command line argument given for hosting a meeting from the terminal
+	HostMeeting = flag.Bool("host-meeting", false, "host a meeting from the terminal, without the graphical interface, until Wahay is stopped")
 )
 
 // ProcessCommandLineArguments will parse the command line, check that
@@ -37,3 +45,16 @@ var (
 func ProcessCommandLineArguments() {
 	flag.Parse()
 }
+
+// DeepLink returns the meeting URL given after the flags, which is how
+// the desktop opens Wahay for a mumble:// or wahay:// link, or an empty
+// string if Wahay was opened without one
+func DeepLink() string {
+	return flag.Arg(0)
+}
+
+// Arguments returns what's given after the flags, like
+// the name of a subcommand followed by its own flags
+func Arguments() []string {
+	return flag.Args()
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -34,6 +35,80 @@ type ApplicationConfig struct {
 	PathMumble            string
 	PortMumble            string
 	ColorScheme           string
+	ClientAuthorization   bool
+	ClientAuthInvitees    int
+	SingleHopHosting      bool
+	TCPKeepAlivePeriod    int
+	MumblePingInterval    int
+	MumbleClientTimeout   int
+	CoHostCertificate     string
+	TorCheckAttempts      int
+	TorCheckTimeout       int
+	TorCheckEndpoint      string
+	TorCheckFallbacks     []string
+	NoOfflineTorCheck     bool
+	TorExtraOptions       map[string]string
+	EntryNodeCountries    []string
+	ExcludeExitCountries  []string
+	HTTPSProxy            string
+	Socks5Proxy           string
+	RemoteTorHost         string
+	RemoteTorControlPort  int
+	RemoteTorSocksPort    int
+	RemoteTorPassword     string
+	RemoteTorAccepted     bool
+	TorDiscovery          bool
+	TorDiscoveryControl   string
+	TorDiscoverySocks     string
+	DiscoveredTorControl  int
+	DiscoveredTorSocks    int
+	Bridges               []string
+	VanityOnionPrefix     string
+	PersistentOnion       bool
+	OnionServiceID        string
+	OnionPrivateKey       string
+	TorNiceness           int
+	TorMaxMemory          int
+	TorCPUQuota           int
+	MaxUsers              int
+	AudioProfile          string
+	PersistentCertificate bool
+	CertificateAlgorithm  string
+	ServerCertificate     string
+	ServerPrivateKey      string
+	MeetingName           string
+	MeetingRulesLink      string
+	MeetingAgenda         string
+	WaitingRoom           bool
+	IdleShutdownMinutes   int
+	SuperUserPassword     string
+	ListenAddress         string
+	ListenSocket          string
+	HostingDataDirectory  string
+	MemoryStorage         bool
+	BannedCertificates    []string
+	NoJoinNotifications   bool
+	ParticipantHookScript string
+	InvitationValidHours  int
+	PassphraseEntropy     int
+	NamedInvitations      bool
+	InviteeNames          []string
+	AudioTransmitMode     string
+	NoiseSuppression      string
+	JitterBufferMillis    int
+	PersistentIdentity    bool
+	ClientCertificate     string
+	AutoRejoin            bool
+	StartMuted            bool
+	StartDeafened         bool
+	WebGateway            bool
+	MumbleWebPath         string
+	ManagedClient         bool
+	TrayIcon              bool
+	Language              string
+	MeetingHistory        bool
+	PastMeetings          []PastMeeting
+	ScheduledMeetings     []ScheduledMeeting
 }
 
 var (
@@ -314,6 +389,43 @@ func (a *ApplicationConfig) SetAutoJoin(v bool) {
 	a.AutoJoin = v
 }
 
+// DefaultClientAuthInvitees is the number of invitation keys created for
+// a meeting with client authorization, when nothing else is configured
+const DefaultClientAuthInvitees = 5
+
+// GetClientAuthorization returns the setting value to require client
+// authorization for the meetings we host
+func (a *ApplicationConfig) GetClientAuthorization() bool {
+	return a.ClientAuthorization
+}
+
+// SetClientAuthorization sets the specified value to require client
+// authorization for the meetings we host
+func (a *ApplicationConfig) SetClientAuthorization(v bool) {
+	a.ClientAuthorization = v
+}
+
+// GetClientAuthInvitees returns the number of invitation keys to create
+// for a meeting with client authorization
+func (a *ApplicationConfig) GetClientAuthInvitees() int {
+	if a.ClientAuthInvitees <= 0 {
+		return DefaultClientAuthInvitees
+	}
+	return a.ClientAuthInvitees
+}
+
+// GetSingleHopHosting returns the setting value to host meetings without
+// hiding the location of the host, in exchange for a lower latency
+func (a *ApplicationConfig) GetSingleHopHosting() bool {
+	return a.SingleHopHosting
+}
+
+// SetSingleHopHosting sets the specified value to host meetings without
+// hiding the location of the host
+func (a *ApplicationConfig) SetSingleHopHosting(v bool) {
+	a.SingleHopHosting = v
+}
+
 // GetAsSuperUser returns the setting value to autojoin like superuser
 func (a *ApplicationConfig) GetAsSuperUser() bool {
 	return a.AsSuperUser
@@ -324,6 +436,20 @@ func (a *ApplicationConfig) SetAutoJoinSuperUser(v bool) {
 	a.AsSuperUser = v
 }
 
+// SetSuperUserPassword stores the password of the superuser of the meetings
+// we host, so the host can reclaim the superuser of a persistent meeting. An
+// empty password forgets it. This is sensitive, so the configuration file
+// should be encrypted when it's used
+func (a *ApplicationConfig) SetSuperUserPassword(v string) {
+	a.SuperUserPassword = v
+}
+
+// GetSuperUserPassword returns the password of the superuser of the
+// meetings we host, or an empty string if there is none yet
+func (a *ApplicationConfig) GetSuperUserPassword() string {
+	return a.SuperUserPassword
+}
+
 // IsPersistentConfiguration returns the setting value to persist the configuration file in the device
 func (a *ApplicationConfig) IsPersistentConfiguration() bool {
 	return a.persistentMode
@@ -404,6 +530,747 @@ func (a *ApplicationConfig) GetPortMumble() string {
 	return a.PortMumble
 }
 
+// SetCoHostCertificate sets the hash of the Mumble certificate of the
+// participant that moderates the hosted meetings while the host is away
+func (a *ApplicationConfig) SetCoHostCertificate(v string) {
+	a.CoHostCertificate = v
+}
+
+// GetCoHostCertificate returns the hash of the Mumble certificate of the
+// co-host, or an empty string if there is no co-host
+func (a *ApplicationConfig) GetCoHostCertificate() string {
+	return a.CoHostCertificate
+}
+
+// DefaultTorCheckAttempts is the number of times the checks of the system
+// Tor run, when nothing else is configured
+const DefaultTorCheckAttempts = 3
+
+// DefaultTorCheckTimeout is the maximum time spent checking the system Tor,
+// when nothing else is configured
+const DefaultTorCheckTimeout = 30 * time.Second
+
+// SetTorCheckAttempts sets the number of times the checks of the system Tor run
+func (a *ApplicationConfig) SetTorCheckAttempts(v int) {
+	a.TorCheckAttempts = v
+}
+
+// GetTorCheckAttempts returns the number of times the checks of the system
+// Tor run before giving up
+func (a *ApplicationConfig) GetTorCheckAttempts() int {
+	if a.TorCheckAttempts <= 0 {
+		return DefaultTorCheckAttempts
+	}
+	return a.TorCheckAttempts
+}
+
+// SetTorCheckTimeout sets the maximum time spent checking the system Tor
+func (a *ApplicationConfig) SetTorCheckTimeout(v time.Duration) {
+	a.TorCheckTimeout = int(v / time.Second)
+}
+
+// GetTorCheckTimeout returns the maximum time spent checking the system Tor
+func (a *ApplicationConfig) GetTorCheckTimeout() time.Duration {
+	return secondsOrDefault(a.TorCheckTimeout, DefaultTorCheckTimeout)
+}
+
+// SetTorCheckEndpoint sets the address used to confirm that the
+// connections go through Tor
+func (a *ApplicationConfig) SetTorCheckEndpoint(v string) {
+	a.TorCheckEndpoint = v
+}
+
+// GetTorCheckEndpoint returns the address used to confirm that the
+// connections go through Tor, or an empty string for the default one
+func (a *ApplicationConfig) GetTorCheckEndpoint() string {
+	return a.TorCheckEndpoint
+}
+
+// SetTorCheckFallbackEndpoints sets the addresses tried when the
+// endpoint that checks Tor can't be reached
+func (a *ApplicationConfig) SetTorCheckFallbackEndpoints(v []string) {
+	a.TorCheckFallbacks = v
+}
+
+// GetTorCheckFallbackEndpoints returns the addresses tried when the
+// endpoint that checks Tor can't be reached. Nil means the default ones
+func (a *ApplicationConfig) GetTorCheckFallbackEndpoints() []string {
+	return a.TorCheckFallbacks
+}
+
+// EnableOfflineTorCheck sets whether Tor can be used when no endpoint
+// confirms the connection, as long as Tor has established a circuit
+func (a *ApplicationConfig) EnableOfflineTorCheck(v bool) {
+	a.NoOfflineTorCheck = !v
+}
+
+// IsOfflineTorCheckEnabled returns true if Tor can be used when no endpoint
+// confirms the connection, as long as Tor has established a circuit
+func (a *ApplicationConfig) IsOfflineTorCheckEnabled() bool {
+	return !a.NoOfflineTorCheck
+}
+
+// SetTorExtraOptions sets the options appended to the configuration
+// of the Tor instances started by Wahay
+func (a *ApplicationConfig) SetTorExtraOptions(v map[string]string) {
+	a.TorExtraOptions = v
+}
+
+// GetTorExtraOptions returns the options appended to the configuration
+// of the Tor instances started by Wahay
+func (a *ApplicationConfig) GetTorExtraOptions() map[string]string {
+	return a.TorExtraOptions
+}
+
+// SetEntryNodeCountries sets the countries of the relays Tor can use as entry
+func (a *ApplicationConfig) SetEntryNodeCountries(v []string) {
+	a.EntryNodeCountries = v
+}
+
+// GetEntryNodeCountries returns the countries of the relays Tor can use as
+// entry. An empty list means there is no restriction
+func (a *ApplicationConfig) GetEntryNodeCountries() []string {
+	return a.EntryNodeCountries
+}
+
+// SetExcludedExitNodeCountries sets the countries of the relays Tor must
+// never use as exit
+func (a *ApplicationConfig) SetExcludedExitNodeCountries(v []string) {
+	a.ExcludeExitCountries = v
+}
+
+// GetExcludedExitNodeCountries returns the countries of the relays Tor must
+// never use as exit
+func (a *ApplicationConfig) GetExcludedExitNodeCountries() []string {
+	return a.ExcludeExitCountries
+}
+
+// SetHTTPSProxy sets the HTTPS proxy the Tor started by Wahay connects
+// through, as host:port or user:password@host:port
+func (a *ApplicationConfig) SetHTTPSProxy(v string) {
+	a.HTTPSProxy = v
+}
+
+// GetHTTPSProxy returns the HTTPS proxy the Tor started by Wahay connects
+// through, or an empty string if there is none
+func (a *ApplicationConfig) GetHTTPSProxy() string {
+	return a.HTTPSProxy
+}
+
+// SetSocks5Proxy sets the SOCKS5 proxy the Tor started by Wahay connects
+// through, as host:port or user:password@host:port
+func (a *ApplicationConfig) SetSocks5Proxy(v string) {
+	a.Socks5Proxy = v
+}
+
+// GetSocks5Proxy returns the SOCKS5 proxy the Tor started by Wahay connects
+// through, or an empty string if there is none
+func (a *ApplicationConfig) GetSocks5Proxy() string {
+	return a.Socks5Proxy
+}
+
+// SetRemoteTorHost sets the host of a Tor running on another computer,
+// which Wahay uses instead of a local one. Empty means a local Tor
+func (a *ApplicationConfig) SetRemoteTorHost(v string) {
+	a.RemoteTorHost = v
+}
+
+// GetRemoteTorHost returns the host of the remote Tor, or an empty
+// string if Wahay uses a Tor on this computer
+func (a *ApplicationConfig) GetRemoteTorHost() string {
+	return a.RemoteTorHost
+}
+
+// SetRemoteTorControlPort sets the control port of the remote Tor
+func (a *ApplicationConfig) SetRemoteTorControlPort(v int) {
+	a.RemoteTorControlPort = v
+}
+
+// GetRemoteTorControlPort returns the control port of the remote Tor,
+// or zero for the default one
+func (a *ApplicationConfig) GetRemoteTorControlPort() int {
+	return a.RemoteTorControlPort
+}
+
+// SetRemoteTorSocksPort sets the SOCKS port of the remote Tor
+func (a *ApplicationConfig) SetRemoteTorSocksPort(v int) {
+	a.RemoteTorSocksPort = v
+}
+
+// GetRemoteTorSocksPort returns the SOCKS port of the remote Tor,
+// or zero for the default one
+func (a *ApplicationConfig) GetRemoteTorSocksPort() int {
+	return a.RemoteTorSocksPort
+}
+
+// SetRemoteTorPassword sets the password of the control port of the remote Tor
+func (a *ApplicationConfig) SetRemoteTorPassword(v string) {
+	a.RemoteTorPassword = v
+}
+
+// GetRemoteTorPassword returns the password of the control port of the remote Tor
+func (a *ApplicationConfig) GetRemoteTorPassword() string {
+	return a.RemoteTorPassword
+}
+
+// AcceptRemoteTorControl sets whether the user accepts that the control
+// traffic of the remote Tor, including its password, travels over the network
+func (a *ApplicationConfig) AcceptRemoteTorControl(v bool) {
+	a.RemoteTorAccepted = v
+}
+
+// IsRemoteTorControlAccepted returns true if the user accepts that the
+// control traffic of the remote Tor travels over the network
+func (a *ApplicationConfig) IsRemoteTorControlAccepted() bool {
+	return a.RemoteTorAccepted
+}
+
+// EnableTorDiscovery sets whether other ports are probed looking
+// for a Tor when it can't be found on the usual ones
+func (a *ApplicationConfig) EnableTorDiscovery(v bool) {
+	a.TorDiscovery = v
+}
+
+// IsTorDiscoveryEnabled returns true if other ports are probed looking
+// for a Tor when it can't be found on the usual ones
+func (a *ApplicationConfig) IsTorDiscoveryEnabled() bool {
+	return a.TorDiscovery
+}
+
+// SetTorDiscoveryControlPorts sets the control ports probed looking for
+// a Tor, as a list of ports and ranges like "9051, 9100-9110"
+func (a *ApplicationConfig) SetTorDiscoveryControlPorts(v string) {
+	a.TorDiscoveryControl = v
+}
+
+// GetTorDiscoveryControlPorts returns the control ports probed looking
+// for a Tor, or an empty string for the default ones
+func (a *ApplicationConfig) GetTorDiscoveryControlPorts() string {
+	return a.TorDiscoveryControl
+}
+
+// SetTorDiscoverySocksPorts sets the SOCKS ports probed looking for
+// a Tor, as a list of ports and ranges like "9050, 9100-9110"
+func (a *ApplicationConfig) SetTorDiscoverySocksPorts(v string) {
+	a.TorDiscoverySocks = v
+}
+
+// GetTorDiscoverySocksPorts returns the SOCKS ports probed looking
+// for a Tor, or an empty string for the default ones
+func (a *ApplicationConfig) GetTorDiscoverySocksPorts() string {
+	return a.TorDiscoverySocks
+}
+
+// SetDiscoveredTorPorts remembers the ports where a Tor was discovered,
+// so they are tried first the next time
+func (a *ApplicationConfig) SetDiscoveredTorPorts(controlPort, socksPort int) {
+	a.DiscoveredTorControl = controlPort
+	a.DiscoveredTorSocks = socksPort
+}
+
+// GetDiscoveredTorPorts returns the ports where a Tor was discovered
+// the last time, or zeros if none was
+func (a *ApplicationConfig) GetDiscoveredTorPorts() (controlPort, socksPort int) {
+	return a.DiscoveredTorControl, a.DiscoveredTorSocks
+}
+
+// SetBridges sets the bridges the Tor started by Wahay uses to reach
+// the network, one bridge line per entry
+func (a *ApplicationConfig) SetBridges(v []string) {
+	a.Bridges = v
+}
+
+// GetBridges returns the bridges the Tor started by Wahay uses to reach
+// the network, or nil if it connects directly
+func (a *ApplicationConfig) GetBridges() []string {
+	return a.Bridges
+}
+
+// SetVanityOnionPrefix sets the characters the address of the meetings
+// we host starts with, or an empty string for a random address
+func (a *ApplicationConfig) SetVanityOnionPrefix(v string) {
+	a.VanityOnionPrefix = v
+}
+
+// GetVanityOnionPrefix returns the characters the address of the meetings
+// we host starts with, or an empty string for a random address
+func (a *ApplicationConfig) GetVanityOnionPrefix() string {
+	return a.VanityOnionPrefix
+}
+
+// EnablePersistentOnion sets whether the meetings we host keep the same
+// address across restarts, instead of getting a new one every time
+func (a *ApplicationConfig) EnablePersistentOnion(v bool) {
+	a.PersistentOnion = v
+}
+
+// IsPersistentOnionEnabled returns true if the meetings we host keep the
+// same address across restarts
+func (a *ApplicationConfig) IsPersistentOnionEnabled() bool {
+	return a.PersistentOnion
+}
+
+// SetPersistentOnionKey stores the address and the private key of the
+// onion service of our meetings. This is sensitive, so the configuration
+// file should be encrypted when it's used
+func (a *ApplicationConfig) SetPersistentOnionKey(serviceID, privateKey string) {
+	a.OnionServiceID = serviceID
+	a.OnionPrivateKey = privateKey
+}
+
+// GetPersistentOnionKey returns the address and the private key of the
+// onion service of our meetings, or empty strings if there is none yet
+func (a *ApplicationConfig) GetPersistentOnionKey() (serviceID, privateKey string) {
+	return a.OnionServiceID, a.OnionPrivateKey
+}
+
+// SetTorNiceness sets how much the scheduling priority of the Tor started
+// by Wahay is lowered, from 0, which leaves it unchanged, to 19
+func (a *ApplicationConfig) SetTorNiceness(v int) {
+	a.TorNiceness = v
+}
+
+// GetTorNiceness returns how much the scheduling priority of the Tor
+// started by Wahay is lowered
+func (a *ApplicationConfig) GetTorNiceness() int {
+	return a.TorNiceness
+}
+
+// SetTorMaxMemory sets the memory in megabytes the Tor started by Wahay
+// can use, or 0 for no limit
+func (a *ApplicationConfig) SetTorMaxMemory(v int) {
+	a.TorMaxMemory = v
+}
+
+// GetTorMaxMemory returns the memory in megabytes the Tor started by Wahay
+// can use, or 0 if there is no limit
+func (a *ApplicationConfig) GetTorMaxMemory() int {
+	return a.TorMaxMemory
+}
+
+// SetTorCPUQuota sets the percentage of one CPU the Tor started by Wahay
+// can use, or 0 for no limit
+func (a *ApplicationConfig) SetTorCPUQuota(v int) {
+	a.TorCPUQuota = v
+}
+
+// GetTorCPUQuota returns the percentage of one CPU the Tor started by
+// Wahay can use, or 0 if there is no limit
+func (a *ApplicationConfig) GetTorCPUQuota() int {
+	return a.TorCPUQuota
+}
+
+// EnablePersistentCertificate sets whether the meetings we host keep the
+// same TLS certificate across restarts, instead of getting a new one every time
+func (a *ApplicationConfig) EnablePersistentCertificate(v bool) {
+	a.PersistentCertificate = v
+}
+
+// IsPersistentCertificateEnabled returns true if the meetings we host
+// keep the same TLS certificate across restarts
+func (a *ApplicationConfig) IsPersistentCertificateEnabled() bool {
+	return a.PersistentCertificate
+}
+
+// SetServerCertificate stores the PEM encoded certificate and private key
+// of the meetings we host. The key is sensitive, so the configuration
+// file should be encrypted when it's used
+func (a *ApplicationConfig) SetServerCertificate(cert, key string) {
+	a.ServerCertificate = cert
+	a.ServerPrivateKey = key
+}
+
+// GetServerCertificate returns the PEM encoded certificate and private key
+// of the meetings we host, or empty strings if there is none yet
+func (a *ApplicationConfig) GetServerCertificate() (cert, key string) {
+	return a.ServerCertificate, a.ServerPrivateKey
+}
+
+// EnablePersistentIdentity sets whether the Mumble client uses the same
+// certificate in every meeting, so the servers recognize us when we return
+func (a *ApplicationConfig) EnablePersistentIdentity(v bool) {
+	a.PersistentIdentity = v
+}
+
+// IsPersistentIdentityEnabled returns true if the Mumble
+// client uses the same certificate in every meeting
+func (a *ApplicationConfig) IsPersistentIdentityEnabled() bool {
+	return a.PersistentIdentity
+}
+
+// SetClientCertificate stores the base64 encoded PKCS#12 bundle with the
+// certificate and private key of the Mumble client. Like the key of the
+// server, it's sensitive, so the configuration file should be encrypted
+func (a *ApplicationConfig) SetClientCertificate(v string) {
+	a.ClientCertificate = v
+}
+
+// GetClientCertificate returns the base64 encoded PKCS#12 bundle of
+// the Mumble client, or an empty string if there is none yet
+func (a *ApplicationConfig) GetClientCertificate() string {
+	return a.ClientCertificate
+}
+
+// SetCertificateAlgorithm sets the kind of key of the self-signed
+// certificate generated for the meetings we host
+func (a *ApplicationConfig) SetCertificateAlgorithm(v string) {
+	a.CertificateAlgorithm = v
+}
+
+// GetCertificateAlgorithm returns the kind of key of the self-signed certificate
+// generated for the meetings we host, or an empty string to use the default one
+func (a *ApplicationConfig) GetCertificateAlgorithm() string {
+	return a.CertificateAlgorithm
+}
+
+// EnableWaitingRoom sets whether the participants of the meetings
+// we host wait until the host admits them
+func (a *ApplicationConfig) EnableWaitingRoom(v bool) {
+	a.WaitingRoom = v
+}
+
+// IsWaitingRoomEnabled returns true if the participants of the
+// meetings we host wait until the host admits them
+func (a *ApplicationConfig) IsWaitingRoomEnabled() bool {
+	return a.WaitingRoom
+}
+
+// EnableAutoRejoin sets whether the meeting is joined again without
+// asking when the Mumble client closes unexpectedly in the middle of it
+func (a *ApplicationConfig) EnableAutoRejoin(v bool) {
+	a.AutoRejoin = v
+}
+
+// IsAutoRejoinEnabled returns true if the meeting is joined again
+// without asking when the Mumble client closes unexpectedly
+func (a *ApplicationConfig) IsAutoRejoinEnabled() bool {
+	return a.AutoRejoin
+}
+
+// EnableWebGateway sets whether the meetings we host can
+// be joined from Tor Browser, with mumble-web
+func (a *ApplicationConfig) EnableWebGateway(v bool) {
+	a.WebGateway = v
+}
+
+// IsWebGatewayEnabled returns true if the meetings we
+// host can be joined from Tor Browser
+func (a *ApplicationConfig) IsWebGatewayEnabled() bool {
+	return a.WebGateway
+}
+
+// SetMumbleWebPath sets the directory with the mumble-web served to
+// Tor Browser. When it's empty, the usual locations are looked in
+func (a *ApplicationConfig) SetMumbleWebPath(p string) {
+	a.MumbleWebPath = p
+}
+
+// GetMumbleWebPath returns the directory with the mumble-web served to Tor Browser
+func (a *ApplicationConfig) GetMumbleWebPath() string {
+	return a.MumbleWebPath
+}
+
+// EnableManagedClient sets whether Wahay uses the Mumble client
+// it downloads and keeps updated, instead of the one installed
+func (a *ApplicationConfig) EnableManagedClient(v bool) {
+	a.ManagedClient = v
+}
+
+// IsManagedClientEnabled returns true if Wahay uses the
+// Mumble client it downloads and keeps updated
+func (a *ApplicationConfig) IsManagedClientEnabled() bool {
+	return a.ManagedClient
+}
+
+// EnableTrayIcon sets whether Wahay shows an icon in the
+// system tray, with the actions of the current meeting
+func (a *ApplicationConfig) EnableTrayIcon(v bool) {
+	a.TrayIcon = v
+}
+
+// IsTrayIconEnabled returns true if Wahay shows
+// an icon in the system tray
+func (a *ApplicationConfig) IsTrayIconEnabled() bool {
+	return a.TrayIcon
+}
+
+// SetLanguage sets the language Wahay is shown in, as a BCP 47
+// tag, or an empty string to use the language of the system
+func (a *ApplicationConfig) SetLanguage(v string) {
+	a.Language = v
+}
+
+// GetLanguage returns the language Wahay is shown in, or an
+// empty string if it's the language of the system
+func (a *ApplicationConfig) GetLanguage() string {
+	return a.Language
+}
+
+// SetStartMuted sets whether the microphone is muted
+// by default when joining a meeting
+func (a *ApplicationConfig) SetStartMuted(v bool) {
+	a.StartMuted = v
+}
+
+// ShouldStartMuted returns true if the microphone
+// is muted by default when joining a meeting
+func (a *ApplicationConfig) ShouldStartMuted() bool {
+	return a.StartMuted
+}
+
+// SetStartDeafened sets whether both the microphone and the
+// sound of the meeting are off by default when joining it
+func (a *ApplicationConfig) SetStartDeafened(v bool) {
+	a.StartDeafened = v
+}
+
+// ShouldStartDeafened returns true if both the microphone and
+// the sound of the meeting are off by default when joining it
+func (a *ApplicationConfig) ShouldStartDeafened() bool {
+	return a.StartDeafened
+}
+
+// DefaultIdleShutdownMinutes is how long the meetings we host are kept
+// without participants, when no other time was configured
+const DefaultIdleShutdownMinutes = 120
+
+// SetIdleShutdownMinutes sets how many minutes the meetings we host are
+// kept without participants before closing them. 0 uses the default and
+// a negative number keeps them open until the host closes them
+func (a *ApplicationConfig) SetIdleShutdownMinutes(v int) {
+	a.IdleShutdownMinutes = v
+}
+
+// GetIdleShutdown returns how long the meetings we host are kept
+// without participants, or 0 if they are never closed for that
+func (a *ApplicationConfig) GetIdleShutdown() time.Duration {
+	switch {
+	case a.IdleShutdownMinutes < 0:
+		return 0
+	case a.IdleShutdownMinutes == 0:
+		return DefaultIdleShutdownMinutes * time.Minute
+	}
+	return time.Duration(a.IdleShutdownMinutes) * time.Minute
+}
+
+// SetListenAddress sets the address Tor reaches the meetings we host on,
+// for setups where it runs in another network namespace or container
+func (a *ApplicationConfig) SetListenAddress(v string) {
+	a.ListenAddress = v
+}
+
+// GetListenAddress returns the address Tor reaches the meetings we
+// host on, or an empty string to use the localhost interface
+func (a *ApplicationConfig) GetListenAddress() string {
+	return a.ListenAddress
+}
+
+// SetListenSocket sets the path of the unix socket the guests of
+// the meetings we host arrive through, instead of a TCP port
+func (a *ApplicationConfig) SetListenSocket(v string) {
+	a.ListenSocket = v
+}
+
+// GetListenSocket returns the path of the unix socket the guests of the
+// meetings we host arrive through, or an empty string to use a TCP port
+func (a *ApplicationConfig) GetListenSocket() string {
+	return a.ListenSocket
+}
+
+// SetHostingDataDirectory sets the directory where the data of the meetings
+// we host is kept while they run, like an encrypted filesystem
+func (a *ApplicationConfig) SetHostingDataDirectory(v string) {
+	a.HostingDataDirectory = v
+}
+
+// GetHostingDataDirectory returns the directory where the data of the meetings
+// we host is kept, or an empty string to use the temporary directory of the system
+func (a *ApplicationConfig) GetHostingDataDirectory() string {
+	return a.HostingDataDirectory
+}
+
+// EnableMemoryStorage sets whether the data of the meetings we host is kept
+// in memory, so it never touches the disk
+func (a *ApplicationConfig) EnableMemoryStorage(v bool) {
+	a.MemoryStorage = v
+}
+
+// IsMemoryStorageEnabled returns true if the data of the
+// meetings we host is kept in memory
+func (a *ApplicationConfig) IsMemoryStorageEnabled() bool {
+	return a.MemoryStorage
+}
+
+// GetBannedCertificates returns the hashes of the certificates of
+// the participants banned from the meetings we host
+func (a *ApplicationConfig) GetBannedCertificates() []string {
+	return a.BannedCertificates
+}
+
+// BanCertificate keeps the participant using the certificate with the
+// given hash out of the meetings we host, until it's unbanned
+func (a *ApplicationConfig) BanCertificate(certHash string) {
+	for _, h := range a.BannedCertificates {
+		if h == certHash {
+			return
+		}
+	}
+	a.BannedCertificates = append(a.BannedCertificates, certHash)
+}
+
+// UnbanCertificate lets the participant using the certificate with
+// the given hash join the meetings we host again
+func (a *ApplicationConfig) UnbanCertificate(certHash string) {
+	result := []string{}
+	for _, h := range a.BannedCertificates {
+		if h != certHash {
+			result = append(result, h)
+		}
+	}
+	a.BannedCertificates = result
+}
+
+// EnableParticipantNotifications sets whether the host gets a desktop
+// notification when a guest joins or leaves the meetings we host
+func (a *ApplicationConfig) EnableParticipantNotifications(v bool) {
+	a.NoJoinNotifications = !v
+}
+
+// AreParticipantNotificationsEnabled returns true if the host gets a desktop
+// notification when a guest joins or leaves the meetings we host
+func (a *ApplicationConfig) AreParticipantNotificationsEnabled() bool {
+	return !a.NoJoinNotifications
+}
+
+// SetParticipantHookScript sets the executable run when a guest
+// joins or leaves the meetings we host
+func (a *ApplicationConfig) SetParticipantHookScript(v string) {
+	a.ParticipantHookScript = v
+}
+
+// GetParticipantHookScript returns the executable run when a guest joins
+// or leaves the meetings we host, or an empty string if there is none
+func (a *ApplicationConfig) GetParticipantHookScript() string {
+	return a.ParticipantHookScript
+}
+
+// SetInvitationValidHours sets how many hours the invitations to the
+// meetings we host can be used. 0 or less leaves them unsigned and
+// valid for as long as the meeting lasts
+func (a *ApplicationConfig) SetInvitationValidHours(v int) {
+	a.InvitationValidHours = v
+}
+
+// SetPassphraseEntropy sets the strength, in bits, of the passphrases
+// generated for the meetings we host, or 0 to use the default
+func (a *ApplicationConfig) SetPassphraseEntropy(v int) {
+	a.PassphraseEntropy = v
+}
+
+// GetPassphraseEntropy returns the strength, in bits, of the passphrases
+// generated for the meetings we host, or 0 if the default should be used
+func (a *ApplicationConfig) GetPassphraseEntropy() int {
+	if a.PassphraseEntropy < 0 {
+		return 0
+	}
+	return a.PassphraseEntropy
+}
+
+// GetInvitationLifetime returns how long the signed invitations to
+// the meetings we host can be used, or 0 if they aren't signed
+func (a *ApplicationConfig) GetInvitationLifetime() time.Duration {
+	if a.InvitationValidHours <= 0 {
+		return 0
+	}
+	return time.Duration(a.InvitationValidHours) * time.Hour
+}
+
+// EnableNamedInvitations sets whether the invitations to the meetings we
+// host carry a different username for each invitee
+func (a *ApplicationConfig) EnableNamedInvitations(v bool) {
+	a.NamedInvitations = v
+}
+
+// AreNamedInvitationsEnabled returns true if the invitations to the
+// meetings we host carry a different username for each invitee
+func (a *ApplicationConfig) AreNamedInvitationsEnabled() bool {
+	return a.NamedInvitations
+}
+
+// SetInviteeNames sets the usernames in the invitations
+// to the meetings we host, when they are named
+func (a *ApplicationConfig) SetInviteeNames(v []string) {
+	a.InviteeNames = v
+}
+
+// GetInviteeNames returns the usernames in the invitations to the meetings
+// we host, or nothing if the default names should be used
+func (a *ApplicationConfig) GetInviteeNames() []string {
+	return a.InviteeNames
+}
+
+// SetMeetingName sets the name of the meetings we host,
+// which is shown to the participants when they join
+func (a *ApplicationConfig) SetMeetingName(v string) {
+	a.MeetingName = v
+}
+
+// GetMeetingName returns the name of the meetings we host, or an empty string
+func (a *ApplicationConfig) GetMeetingName() string {
+	return a.MeetingName
+}
+
+// SetMeetingRulesLink sets the address of the rules of the
+// meetings we host, which is shown to the participants when they join
+func (a *ApplicationConfig) SetMeetingRulesLink(v string) {
+	a.MeetingRulesLink = v
+}
+
+// GetMeetingRulesLink returns the address of the rules of
+// the meetings we host, or an empty string
+func (a *ApplicationConfig) GetMeetingRulesLink() string {
+	return a.MeetingRulesLink
+}
+
+// SetMeetingAgenda sets the agenda of the meetings we host,
+// which goes in the invitations along with the name
+func (a *ApplicationConfig) SetMeetingAgenda(v string) {
+	a.MeetingAgenda = v
+}
+
+// GetMeetingAgenda returns the agenda of the meetings
+// we host, or an empty string
+func (a *ApplicationConfig) GetMeetingAgenda() string {
+	return a.MeetingAgenda
+}
+
+// SetAudioProfile sets the name of the audio quality
+// profile for the meetings we host
+func (a *ApplicationConfig) SetAudioProfile(v string) {
+	a.AudioProfile = v
+}
+
+// GetAudioProfile returns the name of the audio quality profile for
+// the meetings we host, or an empty string to use the default one
+func (a *ApplicationConfig) GetAudioProfile() string {
+	return a.AudioProfile
+}
+
+// SetMaxUsers sets the maximum number of users, the host included,
+// in the meetings we host, or 0 to use the default
+func (a *ApplicationConfig) SetMaxUsers(v int) {
+	a.MaxUsers = v
+}
+
+// GetMaxUsers returns the maximum number of users, the host included,
+// in the meetings we host, or 0 if the default should be used
+func (a *ApplicationConfig) GetMaxUsers() int {
+	if a.MaxUsers < 0 {
+		return 0
+	}
+	return a.MaxUsers
+}
+
 // GetDefaultLogFile returns the default path for the log file
 func GetDefaultLogFile() string {
 	return filepath.Join(Dir(), GetDefaultLogFileName())
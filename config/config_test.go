@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"time"
 
 	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/mock"
@@ -499,3 +500,320 @@ func NewMockKeySupplier(t interface {
 
 	return mock
 }
+
+func (cs *ConfigSuite) Test_GetTorCheckAttempts_returnsTheDefaultWhenNothingIsConfigured(c *C) {
+	ac := New()
+	c.Assert(ac.GetTorCheckAttempts(), Equals, DefaultTorCheckAttempts)
+
+	ac.SetTorCheckAttempts(7)
+	c.Assert(ac.GetTorCheckAttempts(), Equals, 7)
+}
+
+func (cs *ConfigSuite) Test_GetTorCheckTimeout_returnsTheDefaultWhenNothingIsConfigured(c *C) {
+	ac := New()
+	c.Assert(ac.GetTorCheckTimeout(), Equals, DefaultTorCheckTimeout)
+
+	ac.SetTorCheckTimeout(10 * time.Second)
+	c.Assert(ac.GetTorCheckTimeout(), Equals, 10*time.Second)
+}
+
+func (cs *ConfigSuite) Test_IsOfflineTorCheckEnabled_isEnabledByDefault(c *C) {
+	ac := New()
+	c.Assert(ac.IsOfflineTorCheckEnabled(), Equals, true)
+
+	ac.EnableOfflineTorCheck(false)
+	c.Assert(ac.IsOfflineTorCheckEnabled(), Equals, false)
+}
+
+func (cs *ConfigSuite) Test_IsRemoteTorControlAccepted_isNotAcceptedByDefault(c *C) {
+	ac := New()
+	c.Assert(ac.GetRemoteTorHost(), Equals, "")
+	c.Assert(ac.IsRemoteTorControlAccepted(), Equals, false)
+
+	ac.SetRemoteTorHost("10.0.0.2")
+	ac.AcceptRemoteTorControl(true)
+	c.Assert(ac.GetRemoteTorHost(), Equals, "10.0.0.2")
+	c.Assert(ac.IsRemoteTorControlAccepted(), Equals, true)
+}
+
+func (cs *ConfigSuite) Test_GetDiscoveredTorPorts_remembersTheDiscoveredPorts(c *C) {
+	ac := New()
+	c.Assert(ac.IsTorDiscoveryEnabled(), Equals, false)
+	control, socks := ac.GetDiscoveredTorPorts()
+	c.Assert(control, Equals, 0)
+	c.Assert(socks, Equals, 0)
+
+	ac.SetDiscoveredTorPorts(9200, 9201)
+	control, socks = ac.GetDiscoveredTorPorts()
+	c.Assert(control, Equals, 9200)
+	c.Assert(socks, Equals, 9201)
+}
+
+func (cs *ConfigSuite) Test_GetBridges_returnsTheBridges(c *C) {
+	ac := New()
+	c.Assert(ac.GetBridges(), IsNil)
+
+	ac.SetBridges([]string{"obfs4 192.0.2.1:443 AAAA cert=x iat-mode=0"})
+	c.Assert(ac.GetBridges(), DeepEquals, []string{"obfs4 192.0.2.1:443 AAAA cert=x iat-mode=0"})
+}
+
+func (cs *ConfigSuite) Test_GetVanityOnionPrefix_isEmptyByDefault(c *C) {
+	ac := New()
+	c.Assert(ac.GetVanityOnionPrefix(), Equals, "")
+
+	ac.SetVanityOnionPrefix("wahay")
+	c.Assert(ac.GetVanityOnionPrefix(), Equals, "wahay")
+}
+
+func (cs *ConfigSuite) Test_GetPersistentOnionKey_returnsTheStoredKey(c *C) {
+	ac := New()
+	c.Assert(ac.IsPersistentOnionEnabled(), Equals, false)
+
+	ac.EnablePersistentOnion(true)
+	ac.SetPersistentOnionKey("abc.onion", "secret")
+	serviceID, privateKey := ac.GetPersistentOnionKey()
+	c.Assert(ac.IsPersistentOnionEnabled(), Equals, true)
+	c.Assert(serviceID, Equals, "abc.onion")
+	c.Assert(privateKey, Equals, "secret")
+}
+
+func (cs *ConfigSuite) Test_GetTorNiceness_hasNoLimitsByDefault(c *C) {
+	ac := New()
+	c.Assert(ac.GetTorNiceness(), Equals, 0)
+	c.Assert(ac.GetTorMaxMemory(), Equals, 0)
+	c.Assert(ac.GetTorCPUQuota(), Equals, 0)
+
+	ac.SetTorNiceness(10)
+	c.Assert(ac.GetTorNiceness(), Equals, 10)
+}
+
+func (cs *ConfigSuite) Test_GetMaxUsers_usesTheDefaultWhenNothingIsConfigured(c *C) {
+	ac := New()
+	c.Assert(ac.GetMaxUsers(), Equals, 0)
+
+	ac.SetMaxUsers(-3)
+	c.Assert(ac.GetMaxUsers(), Equals, 0)
+
+	ac.SetMaxUsers(4)
+	c.Assert(ac.GetMaxUsers(), Equals, 4)
+}
+
+func (cs *ConfigSuite) Test_GetAudioProfile_returnsTheChosenProfile(c *C) {
+	ac := New()
+	c.Assert(ac.GetAudioProfile(), Equals, "")
+
+	ac.SetAudioProfile("low")
+	c.Assert(ac.GetAudioProfile(), Equals, "low")
+}
+
+func (cs *ConfigSuite) Test_GetCertificateAlgorithm_returnsTheChosenAlgorithm(c *C) {
+	ac := New()
+	c.Assert(ac.GetCertificateAlgorithm(), Equals, "")
+
+	ac.SetCertificateAlgorithm("rsa")
+	c.Assert(ac.GetCertificateAlgorithm(), Equals, "rsa")
+}
+
+func (cs *ConfigSuite) Test_IsWaitingRoomEnabled_returnsTheChosenValue(c *C) {
+	ac := New()
+	c.Assert(ac.IsWaitingRoomEnabled(), Equals, false)
+
+	ac.EnableWaitingRoom(true)
+	c.Assert(ac.IsWaitingRoomEnabled(), Equals, true)
+}
+
+func (cs *ConfigSuite) Test_IsAutoRejoinEnabled_returnsTheChosenValue(c *C) {
+	ac := New()
+	c.Assert(ac.IsAutoRejoinEnabled(), Equals, false)
+
+	ac.EnableAutoRejoin(true)
+	c.Assert(ac.IsAutoRejoinEnabled(), Equals, true)
+}
+
+func (cs *ConfigSuite) Test_IsWebGatewayEnabled_returnsTheChosenValues(c *C) {
+	ac := New()
+	c.Assert(ac.IsWebGatewayEnabled(), Equals, false)
+	c.Assert(ac.GetMumbleWebPath(), Equals, "")
+
+	ac.EnableWebGateway(true)
+	ac.SetMumbleWebPath("/opt/mumble-web")
+	c.Assert(ac.IsWebGatewayEnabled(), Equals, true)
+	c.Assert(ac.GetMumbleWebPath(), Equals, "/opt/mumble-web")
+}
+
+func (cs *ConfigSuite) Test_IsManagedClientEnabled_returnsTheChosenValue(c *C) {
+	ac := New()
+	c.Assert(ac.IsManagedClientEnabled(), Equals, false)
+
+	ac.EnableManagedClient(true)
+	c.Assert(ac.IsManagedClientEnabled(), Equals, true)
+}
+
+func (cs *ConfigSuite) Test_IsTrayIconEnabled_returnsTheChosenValue(c *C) {
+	ac := New()
+	c.Assert(ac.IsTrayIconEnabled(), Equals, false)
+
+	ac.EnableTrayIcon(true)
+	c.Assert(ac.IsTrayIconEnabled(), Equals, true)
+}
+
+func (cs *ConfigSuite) Test_GetLanguage_returnsTheChosenValue(c *C) {
+	ac := New()
+	c.Assert(ac.GetLanguage(), Equals, "")
+
+	ac.SetLanguage("sv")
+	c.Assert(ac.GetLanguage(), Equals, "sv")
+}
+
+func (cs *ConfigSuite) Test_ShouldStartMuted_returnsTheChosenValues(c *C) {
+	ac := New()
+	c.Assert(ac.ShouldStartMuted(), Equals, false)
+	c.Assert(ac.ShouldStartDeafened(), Equals, false)
+
+	ac.SetStartMuted(true)
+	c.Assert(ac.ShouldStartMuted(), Equals, true)
+	c.Assert(ac.ShouldStartDeafened(), Equals, false)
+
+	ac.SetStartDeafened(true)
+	c.Assert(ac.ShouldStartDeafened(), Equals, true)
+}
+
+func (cs *ConfigSuite) Test_GetInviteeNames_returnsTheNamedInvitations(c *C) {
+	ac := New()
+	c.Assert(ac.AreNamedInvitationsEnabled(), Equals, false)
+	c.Assert(ac.GetInviteeNames(), HasLen, 0)
+
+	ac.EnableNamedInvitations(true)
+	ac.SetInviteeNames([]string{"Ana", "Luis"})
+	c.Assert(ac.AreNamedInvitationsEnabled(), Equals, true)
+	c.Assert(ac.GetInviteeNames(), DeepEquals, []string{"Ana", "Luis"})
+}
+
+func (cs *ConfigSuite) Test_GetMeetingRulesLink_returnsTheWelcomeDetails(c *C) {
+	ac := New()
+	c.Assert(ac.GetMeetingName(), Equals, "")
+	c.Assert(ac.GetMeetingRulesLink(), Equals, "")
+
+	ac.SetMeetingName("Weekly sync")
+	ac.SetMeetingRulesLink("https://example.org/rules")
+	c.Assert(ac.GetMeetingName(), Equals, "Weekly sync")
+	c.Assert(ac.GetMeetingRulesLink(), Equals, "https://example.org/rules")
+}
+
+func (cs *ConfigSuite) Test_GetMeetingAgenda_returnsTheChosenAgenda(c *C) {
+	ac := New()
+	c.Assert(ac.GetMeetingAgenda(), Equals, "")
+
+	ac.SetMeetingAgenda("Budget review")
+	c.Assert(ac.GetMeetingAgenda(), Equals, "Budget review")
+}
+
+func (cs *ConfigSuite) Test_GetClientCertificate_returnsTheStoredIdentity(c *C) {
+	ac := New()
+	c.Assert(ac.IsPersistentIdentityEnabled(), Equals, false)
+	c.Assert(ac.GetClientCertificate(), Equals, "")
+
+	ac.EnablePersistentIdentity(true)
+	ac.SetClientCertificate("bundle")
+	c.Assert(ac.IsPersistentIdentityEnabled(), Equals, true)
+	c.Assert(ac.GetClientCertificate(), Equals, "bundle")
+}
+
+func (cs *ConfigSuite) Test_GetServerCertificate_returnsTheStoredCertificate(c *C) {
+	ac := New()
+	c.Assert(ac.IsPersistentCertificateEnabled(), Equals, false)
+
+	ac.EnablePersistentCertificate(true)
+	ac.SetServerCertificate("cert", "key")
+	cert, key := ac.GetServerCertificate()
+	c.Assert(ac.IsPersistentCertificateEnabled(), Equals, true)
+	c.Assert(cert, Equals, "cert")
+	c.Assert(key, Equals, "key")
+}
+
+func (cs *ConfigSuite) Test_GetIdleShutdown_returnsTheChosenTime(c *C) {
+	ac := New()
+	c.Assert(ac.GetIdleShutdown(), Equals, DefaultIdleShutdownMinutes*time.Minute)
+
+	ac.SetIdleShutdownMinutes(30)
+	c.Assert(ac.GetIdleShutdown(), Equals, 30*time.Minute)
+
+	ac.SetIdleShutdownMinutes(-1)
+	c.Assert(ac.GetIdleShutdown(), Equals, time.Duration(0))
+}
+
+func (cs *ConfigSuite) Test_GetSuperUserPassword_returnsTheStoredPassword(c *C) {
+	ac := New()
+	c.Assert(ac.GetSuperUserPassword(), Equals, "")
+
+	ac.SetSuperUserPassword("secret")
+	c.Assert(ac.GetSuperUserPassword(), Equals, "secret")
+}
+
+func (cs *ConfigSuite) Test_GetListenAddress_returnsWhereTorReachesTheMeetings(c *C) {
+	ac := New()
+	c.Assert(ac.GetListenAddress(), Equals, "")
+	c.Assert(ac.GetListenSocket(), Equals, "")
+
+	ac.SetListenAddress("10.0.0.2")
+	ac.SetListenSocket("/run/wahay/meeting.sock")
+	c.Assert(ac.GetListenAddress(), Equals, "10.0.0.2")
+	c.Assert(ac.GetListenSocket(), Equals, "/run/wahay/meeting.sock")
+}
+
+func (cs *ConfigSuite) Test_IsMemoryStorageEnabled_returnsTheChosenStorage(c *C) {
+	ac := New()
+	c.Assert(ac.IsMemoryStorageEnabled(), Equals, false)
+	c.Assert(ac.GetHostingDataDirectory(), Equals, "")
+
+	ac.EnableMemoryStorage(true)
+	ac.SetHostingDataDirectory("/mnt/private")
+	c.Assert(ac.IsMemoryStorageEnabled(), Equals, true)
+	c.Assert(ac.GetHostingDataDirectory(), Equals, "/mnt/private")
+}
+
+func (cs *ConfigSuite) Test_BanCertificate_keepsTheCertificateUntilItsUnbanned(c *C) {
+	ac := New()
+	c.Assert(ac.GetBannedCertificates(), HasLen, 0)
+
+	ac.BanCertificate("abcdef")
+	ac.BanCertificate("abcdef")
+	ac.BanCertificate("123456")
+	c.Assert(ac.GetBannedCertificates(), DeepEquals, []string{"abcdef", "123456"})
+
+	ac.UnbanCertificate("abcdef")
+	c.Assert(ac.GetBannedCertificates(), DeepEquals, []string{"123456"})
+}
+
+func (cs *ConfigSuite) Test_AreParticipantNotificationsEnabled_isEnabledByDefault(c *C) {
+	ac := New()
+	c.Assert(ac.AreParticipantNotificationsEnabled(), Equals, true)
+	c.Assert(ac.GetParticipantHookScript(), Equals, "")
+
+	ac.EnableParticipantNotifications(false)
+	ac.SetParticipantHookScript("/usr/local/bin/wahay-hook")
+	c.Assert(ac.AreParticipantNotificationsEnabled(), Equals, false)
+	c.Assert(ac.GetParticipantHookScript(), Equals, "/usr/local/bin/wahay-hook")
+}
+
+func (cs *ConfigSuite) Test_GetPassphraseEntropy_returnsZeroForTheDefault(c *C) {
+	ac := New()
+	c.Assert(ac.GetPassphraseEntropy(), Equals, 0)
+
+	ac.SetPassphraseEntropy(60)
+	c.Assert(ac.GetPassphraseEntropy(), Equals, 60)
+
+	ac.SetPassphraseEntropy(-5)
+	c.Assert(ac.GetPassphraseEntropy(), Equals, 0)
+}
+
+func (cs *ConfigSuite) Test_GetInvitationLifetime_isZeroForUnsignedInvitations(c *C) {
+	ac := New()
+	c.Assert(ac.GetInvitationLifetime(), Equals, time.Duration(0))
+
+	ac.SetInvitationValidHours(24)
+	c.Assert(ac.GetInvitationLifetime(), Equals, 24*time.Hour)
+
+	ac.SetInvitationValidHours(-1)
+	c.Assert(ac.GetInvitationLifetime(), Equals, time.Duration(0))
+}
@@ -347,3 +347,32 @@ func GenerateKeysBasedOnPassword(password string, params EncryptionParameters) E
 
 	return r
 }
+
+func passwordKeySupplier(password string) KeySupplier {
+	return CreateKeySupplier(func(p EncryptionParameters, _ bool) EncryptionResult {
+		return GenerateKeysBasedOnPassword(password, p)
+	})
+}
+
+// EncryptWithPassword encrypts the content with a key derived from the
+// password, in the same format as the encrypted configuration file
+func EncryptWithPassword(content []byte, password string) ([]byte, error) {
+	if password == "" {
+		return nil, errorEncryptionNoPassword
+	}
+
+	p := newEncryptionParameters()
+	return encryptConfigContent(string(content), &p, passwordKeySupplier(password))
+}
+
+// DecryptWithPassword returns the content encrypted by EncryptWithPassword
+func DecryptWithPassword(content []byte, password string) ([]byte, error) {
+	res, _, err := decryptConfigContent(content, passwordKeySupplier(password))
+	return res, err
+}
+
+// IsEncrypted returns true if the content looks like it was
+// encrypted by EncryptWithPassword
+func IsEncrypted(content []byte) bool {
+	return isDataEncrypted(content)
+}
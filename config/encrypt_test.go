@@ -341,3 +341,20 @@ func (cs *ConfigSuite) Test_GenerateKeysBasedOnPassword_errorWhenKeyIsNotValid(c
 	c.Assert(result.valid, Equals, false)
 
 }
+
+func (cs *ConfigSuite) Test_EncryptWithPassword_canOnlyBeDecryptedWithThePassword(c *C) {
+	encrypted, err := EncryptWithPassword([]byte("hello"), "secret")
+	c.Assert(err, IsNil)
+	c.Assert(IsEncrypted(encrypted), Equals, true)
+	c.Assert(IsEncrypted([]byte("hello")), Equals, false)
+
+	plain, err := DecryptWithPassword(encrypted, "secret")
+	c.Assert(err, IsNil)
+	c.Assert(string(plain), Equals, "hello")
+
+	_, err = DecryptWithPassword(encrypted, "wrong")
+	c.Assert(err, Equals, errorEncryptionDecryptFailed)
+
+	_, err = EncryptWithPassword([]byte("hello"), "")
+	c.Assert(err, Equals, errorEncryptionNoPassword)
+}
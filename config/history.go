@@ -0,0 +1,67 @@
+package config
+
+import "time"
+
+// maxPastMeetings is how many meetings the history keeps.
+// The oldest ones are forgotten first
+const maxPastMeetings = 50
+
+// PastMeeting is a meeting joined or hosted, with what's
+// needed to join or host it again with the same settings
+type PastMeeting struct {
+	Time   time.Time
+	Title  string
+	Hosted bool
+	// Address is the invitation the meeting was joined with,
+	// or the address of the meeting for the ones hosted
+	Address  string
+	Username string
+	// The settings of the meetings hosted
+	Agenda       string `json:",omitempty"`
+	AudioProfile string `json:",omitempty"`
+}
+
+// EnableMeetingHistory sets whether the meetings joined and hosted are
+// remembered. The history is forgotten when it's disabled
+func (a *ApplicationConfig) EnableMeetingHistory(v bool) {
+	a.MeetingHistory = v
+	if !v {
+		a.ClearPastMeetings()
+	}
+}
+
+// IsMeetingHistoryEnabled returns true if the meetings
+// joined and hosted are remembered
+func (a *ApplicationConfig) IsMeetingHistoryEnabled() bool {
+	return a.MeetingHistory
+}
+
+// AddPastMeeting remembers a meeting, when the history is enabled
+func (a *ApplicationConfig) AddPastMeeting(m PastMeeting) {
+	if !a.MeetingHistory {
+		return
+	}
+
+	a.PastMeetings = append([]PastMeeting{m}, a.PastMeetings...)
+	if len(a.PastMeetings) > maxPastMeetings {
+		a.PastMeetings = a.PastMeetings[:maxPastMeetings]
+	}
+}
+
+// GetPastMeetings returns the meetings remembered, the last one first
+func (a *ApplicationConfig) GetPastMeetings() []PastMeeting {
+	return append([]PastMeeting{}, a.PastMeetings...)
+}
+
+// RemovePastMeeting forgets the meeting at index i of GetPastMeetings
+func (a *ApplicationConfig) RemovePastMeeting(i int) {
+	if i < 0 || i >= len(a.PastMeetings) {
+		return
+	}
+	a.PastMeetings = append(a.PastMeetings[:i:i], a.PastMeetings[i+1:]...)
+}
+
+// ClearPastMeetings forgets all the meetings remembered
+func (a *ApplicationConfig) ClearPastMeetings() {
+	a.PastMeetings = nil
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+
+	. "gopkg.in/check.v1"
+)
+
+func (cs *ConfigSuite) Test_AddPastMeeting_onlyRemembersWhenTheHistoryIsEnabled(c *C) {
+	ac := New()
+	ac.AddPastMeeting(PastMeeting{Title: "one"})
+	c.Assert(ac.GetPastMeetings(), HasLen, 0)
+
+	ac.EnableMeetingHistory(true)
+	c.Assert(ac.IsMeetingHistoryEnabled(), Equals, true)
+	ac.AddPastMeeting(PastMeeting{Title: "one"})
+	ac.AddPastMeeting(PastMeeting{Title: "two"})
+
+	c.Assert(ac.GetPastMeetings(), DeepEquals, []PastMeeting{{Title: "two"}, {Title: "one"}})
+}
+
+func (cs *ConfigSuite) Test_AddPastMeeting_forgetsTheOldestMeetings(c *C) {
+	ac := New()
+	ac.EnableMeetingHistory(true)
+	for i := 0; i <= maxPastMeetings; i++ {
+		ac.AddPastMeeting(PastMeeting{Title: fmt.Sprintf("%d", i)})
+	}
+
+	meetings := ac.GetPastMeetings()
+	c.Assert(meetings, HasLen, maxPastMeetings)
+	c.Assert(meetings[0].Title, Equals, fmt.Sprintf("%d", maxPastMeetings))
+	c.Assert(meetings[maxPastMeetings-1].Title, Equals, "1")
+}
+
+func (cs *ConfigSuite) Test_RemovePastMeeting_forgetsOnlyThatMeeting(c *C) {
+	ac := New()
+	ac.EnableMeetingHistory(true)
+	ac.AddPastMeeting(PastMeeting{Title: "one"})
+	ac.AddPastMeeting(PastMeeting{Title: "two"})
+	ac.AddPastMeeting(PastMeeting{Title: "three"})
+	shown := ac.GetPastMeetings()
+
+	ac.RemovePastMeeting(1)
+	ac.RemovePastMeeting(5)
+
+	c.Assert(ac.GetPastMeetings(), DeepEquals, []PastMeeting{{Title: "three"}, {Title: "one"}})
+	c.Assert(shown[1].Title, Equals, "two")
+}
+
+func (cs *ConfigSuite) Test_EnableMeetingHistory_forgetsTheMeetingsWhenDisabled(c *C) {
+	ac := New()
+	ac.EnableMeetingHistory(true)
+	ac.AddPastMeeting(PastMeeting{Title: "one"})
+
+	ac.EnableMeetingHistory(false)
+
+	c.Assert(ac.IsMeetingHistoryEnabled(), Equals, false)
+	c.Assert(ac.GetPastMeetings(), HasLen, 0)
+}
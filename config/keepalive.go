@@ -0,0 +1,72 @@
+package config
+
+import "time"
+
+// KeepAlive contains the intervals used to keep alive the connections
+// carried through Tor. Idle connections tend to die silently behind NATs
+// and on long-idle circuits, and Tor adds enough latency that the usual
+// Mumble defaults can disconnect participants on a slow circuit
+type KeepAlive struct {
+	// TCPPeriod is the period of the TCP keepalive probes on the
+	// sockets that carry the meeting traffic
+	TCPPeriod time.Duration
+	// PingInterval is how often the Mumble client pings the server
+	PingInterval time.Duration
+	// Timeout is how long the Mumble client waits for an answer
+	// from the server before considering the connection dead
+	Timeout time.Duration
+}
+
+const (
+	defaultTCPKeepAlivePeriod  = 30 * time.Second
+	defaultMumblePingInterval  = 5 * time.Second
+	defaultMumbleClientTimeout = 60 * time.Second
+)
+
+// DefaultKeepAlive returns the keepalive intervals that work
+// well for connections going through Tor
+func DefaultKeepAlive() KeepAlive {
+	return KeepAlive{
+		TCPPeriod:    defaultTCPKeepAlivePeriod,
+		PingInterval: defaultMumblePingInterval,
+		Timeout:      defaultMumbleClientTimeout,
+	}
+}
+
+// MaxInFlightPings returns the number of pings without answer the
+// Mumble client tolerates before disconnecting
+func (k KeepAlive) MaxInFlightPings() int {
+	if k.PingInterval <= 0 {
+		return 1
+	}
+
+	n := int(k.Timeout / k.PingInterval)
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+func secondsOrDefault(seconds int, d time.Duration) time.Duration {
+	if seconds <= 0 {
+		return d
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// GetKeepAlive returns the configured keepalive intervals, using
+// the defaults for the values that haven't been configured
+func (a *ApplicationConfig) GetKeepAlive() KeepAlive {
+	return KeepAlive{
+		TCPPeriod:    secondsOrDefault(a.TCPKeepAlivePeriod, defaultTCPKeepAlivePeriod),
+		PingInterval: secondsOrDefault(a.MumblePingInterval, defaultMumblePingInterval),
+		Timeout:      secondsOrDefault(a.MumbleClientTimeout, defaultMumbleClientTimeout),
+	}
+}
+
+// SetKeepAlive sets the keepalive intervals
+func (a *ApplicationConfig) SetKeepAlive(k KeepAlive) {
+	a.TCPKeepAlivePeriod = int(k.TCPPeriod / time.Second)
+	a.MumblePingInterval = int(k.PingInterval / time.Second)
+	a.MumbleClientTimeout = int(k.Timeout / time.Second)
+}
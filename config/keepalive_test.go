@@ -0,0 +1,43 @@
+package config
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (cs *ConfigSuite) Test_GetKeepAlive_returnsTheDefaultsWhenNothingIsConfigured(c *C) {
+	ac := New()
+
+	c.Assert(ac.GetKeepAlive(), Equals, DefaultKeepAlive())
+}
+
+func (cs *ConfigSuite) Test_GetKeepAlive_returnsTheConfiguredValues(c *C) {
+	ac := New()
+	ac.SetKeepAlive(KeepAlive{
+		TCPPeriod:    10 * time.Second,
+		PingInterval: 2 * time.Second,
+		Timeout:      30 * time.Second,
+	})
+
+	k := ac.GetKeepAlive()
+	c.Assert(k.TCPPeriod, Equals, 10*time.Second)
+	c.Assert(k.PingInterval, Equals, 2*time.Second)
+	c.Assert(k.Timeout, Equals, 30*time.Second)
+}
+
+func (cs *ConfigSuite) Test_GetKeepAlive_usesTheDefaultsForTheValuesNotConfigured(c *C) {
+	ac := New()
+	ac.MumblePingInterval = 7
+
+	k := ac.GetKeepAlive()
+	c.Assert(k.TCPPeriod, Equals, defaultTCPKeepAlivePeriod)
+	c.Assert(k.PingInterval, Equals, 7*time.Second)
+	c.Assert(k.Timeout, Equals, defaultMumbleClientTimeout)
+}
+
+func (cs *ConfigSuite) Test_KeepAlive_MaxInFlightPings_coversTheWholeTimeout(c *C) {
+	c.Assert(DefaultKeepAlive().MaxInFlightPings(), Equals, 12)
+	c.Assert(KeepAlive{PingInterval: 10 * time.Second, Timeout: 5 * time.Second}.MaxInFlightPings(), Equals, 1)
+	c.Assert(KeepAlive{Timeout: 5 * time.Second}.MaxInFlightPings(), Equals, 1)
+}
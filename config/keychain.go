@@ -0,0 +1,20 @@
+package config
+
+import "errors"
+
+// The password of the configuration file is remembered in the
+// keychain of the system as a generic password with these names
+const (
+	keychainService = "Wahay"
+	keychainAccount = "configuration file"
+)
+
+var (
+	// ErrNoKeychain is returned on systems where Wahay can't
+	// remember the password of the configuration file
+	ErrNoKeychain = errors.New("there is no keychain to remember the password in")
+
+	// ErrNoPasswordInKeychain is returned when the
+	// password hasn't been remembered yet
+	ErrNoPasswordInKeychain = errors.New("the password is not in the keychain")
+)
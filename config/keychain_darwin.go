@@ -0,0 +1,121 @@
+package config
+
+/*
+#cgo LDFLAGS: -framework Security -framework CoreFoundation
+#include <stdlib.h>
+#include <Security/Security.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+const (
+	keychainSuccess  = C.OSStatus(C.errSecSuccess)
+	keychainNotFound = C.OSStatus(C.errSecItemNotFound)
+)
+
+// IsKeychainAvailable returns true when the password of the
+// configuration file can be remembered by the system
+func IsKeychainAvailable() bool {
+	return true
+}
+
+type keychainStrings struct {
+	service, account *C.char
+}
+
+func newKeychainStrings() keychainStrings {
+	return keychainStrings{
+		service: C.CString(keychainService),
+		account: C.CString(keychainAccount),
+	}
+}
+
+func (s keychainStrings) free() {
+	C.free(unsafe.Pointer(s.service))
+	C.free(unsafe.Pointer(s.account))
+}
+
+func keychainError(status C.OSStatus) error {
+	if status == keychainNotFound {
+		return ErrNoPasswordInKeychain
+	}
+	return fmt.Errorf("keychain error %d", int(status))
+}
+
+// LoadPasswordFromKeychain returns the password of the configuration
+// file remembered in the login keychain of the user
+func LoadPasswordFromKeychain() (string, error) {
+	s := newKeychainStrings()
+	defer s.free()
+
+	var length C.UInt32
+	var data unsafe.Pointer
+	status := C.SecKeychainFindGenericPassword(nil,
+		C.UInt32(len(keychainService)), s.service,
+		C.UInt32(len(keychainAccount)), s.account,
+		&length, &data, nil)
+	if status != keychainSuccess {
+		return "", keychainError(status)
+	}
+	defer C.SecKeychainItemFreeContent(nil, data)
+
+	return C.GoStringN((*C.char)(data), C.int(length)), nil
+}
+
+// SavePasswordInKeychain remembers the password of the configuration
+// file in the login keychain, replacing the one remembered before
+func SavePasswordInKeychain(password string) error {
+	s := newKeychainStrings()
+	defer s.free()
+
+	p := C.CString(password)
+	defer C.free(unsafe.Pointer(p))
+
+	var item C.SecKeychainItemRef
+	status := C.SecKeychainFindGenericPassword(nil,
+		C.UInt32(len(keychainService)), s.service,
+		C.UInt32(len(keychainAccount)), s.account,
+		nil, nil, &item)
+
+	switch status {
+	case keychainSuccess:
+		defer C.CFRelease(C.CFTypeRef(unsafe.Pointer(item)))
+		status = C.SecKeychainItemModifyAttributesAndData(item, nil,
+			C.UInt32(len(password)), unsafe.Pointer(p))
+	case keychainNotFound:
+		status = C.SecKeychainAddGenericPassword(nil,
+			C.UInt32(len(keychainService)), s.service,
+			C.UInt32(len(keychainAccount)), s.account,
+			C.UInt32(len(password)), unsafe.Pointer(p), nil)
+	}
+
+	if status != keychainSuccess {
+		return keychainError(status)
+	}
+	return nil
+}
+
+// RemovePasswordFromKeychain forgets the password of the configuration file
+func RemovePasswordFromKeychain() error {
+	s := newKeychainStrings()
+	defer s.free()
+
+	var item C.SecKeychainItemRef
+	status := C.SecKeychainFindGenericPassword(nil,
+		C.UInt32(len(keychainService)), s.service,
+		C.UInt32(len(keychainAccount)), s.account,
+		nil, nil, &item)
+	if status != keychainSuccess {
+		return keychainError(status)
+	}
+	defer C.CFRelease(C.CFTypeRef(unsafe.Pointer(item)))
+
+	if status = C.SecKeychainItemDelete(item); status != keychainSuccess {
+		return keychainError(status)
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+//go:build !darwin || !cgo
+
+package config
+
+// IsKeychainAvailable returns true when the password of the
+// configuration file can be remembered by the system
+func IsKeychainAvailable() bool {
+	return false
+}
+
+// LoadPasswordFromKeychain fails, since only the macOS keychain is supported
+func LoadPasswordFromKeychain() (string, error) {
+	return "", ErrNoKeychain
+}
+
+// SavePasswordInKeychain fails, since only the macOS keychain is supported
+func SavePasswordInKeychain(string) error {
+	return ErrNoKeychain
+}
+
+// RemovePasswordFromKeychain fails, since only the macOS keychain is supported
+func RemovePasswordFromKeychain() error {
+	return ErrNoKeychain
+}
@@ -25,6 +25,10 @@ func appdataFolderPath() string {
 	b := make([]uint16, syscall.MAX_PATH)
 	ret, _, err := syscall.Syscall6(procGetFolderPath.Addr(), 5, 0, csidlAppdata, 0, 0, uintptr(unsafe.Pointer(&b[0])), 0)
 	if int(ret) != 0 {
+		// The environment has the same folder, unless it was changed
+		if appdata := os.Getenv("APPDATA"); appdata != "" {
+			return appdata
+		}
 		panic(fmt.Sprintf("SHGetFolderPathW : err %d", int(err)))
 	}
 	return syscall.UTF16ToString(b)
@@ -46,6 +50,7 @@ func firstEnvironmentVariable(vs ...string) string {
 	return ""
 }
 
+// localHome prefers USERPROFILE, since HOMEPATH doesn't have the drive
 func localHome() string {
-	return firstEnvironmentVariable("HOMEPATH", "USERPROFILE")
+	return firstEnvironmentVariable("USERPROFILE", "HOMEPATH")
 }
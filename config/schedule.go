@@ -0,0 +1,51 @@
+package config
+
+import (
+	"sort"
+	"time"
+)
+
+// ScheduledMeeting is a meeting to host later, which Wahay
+// publishes on its own when its start comes
+type ScheduledMeeting struct {
+	Start   time.Time
+	Minutes int
+	Title   string `json:",omitempty"`
+}
+
+// End returns when the meeting is over
+func (m ScheduledMeeting) End() time.Time {
+	return m.Start.Add(time.Duration(m.Minutes) * time.Minute)
+}
+
+// ScheduleMeeting adds a meeting to host later
+func (a *ApplicationConfig) ScheduleMeeting(m ScheduledMeeting) {
+	a.ScheduledMeetings = append(a.ScheduledMeetings, m)
+	sort.SliceStable(a.ScheduledMeetings, func(i, j int) bool {
+		return a.ScheduledMeetings[i].Start.Before(a.ScheduledMeetings[j].Start)
+	})
+}
+
+// GetScheduledMeetings returns the meetings to host later, the first one first
+func (a *ApplicationConfig) GetScheduledMeetings() []ScheduledMeeting {
+	return append([]ScheduledMeeting{}, a.ScheduledMeetings...)
+}
+
+// UnscheduleMeeting forgets the meeting at index i of GetScheduledMeetings
+func (a *ApplicationConfig) UnscheduleMeeting(i int) {
+	if i < 0 || i >= len(a.ScheduledMeetings) {
+		return
+	}
+	a.ScheduledMeetings = append(a.ScheduledMeetings[:i:i], a.ScheduledMeetings[i+1:]...)
+}
+
+// RemoveFinishedMeetings forgets the scheduled meetings that ended before now
+func (a *ApplicationConfig) RemoveFinishedMeetings(now time.Time) {
+	result := []ScheduledMeeting{}
+	for _, m := range a.ScheduledMeetings {
+		if m.End().After(now) {
+			result = append(result, m)
+		}
+	}
+	a.ScheduledMeetings = result
+}
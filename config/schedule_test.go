@@ -0,0 +1,44 @@
+package config
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (cs *ConfigSuite) Test_ScheduleMeeting_keepsTheMeetingsInTheOrderTheyStart(c *C) {
+	ac := New()
+	now := time.Now()
+	ac.ScheduleMeeting(ScheduledMeeting{Start: now.Add(2 * time.Hour), Title: "later"})
+	ac.ScheduleMeeting(ScheduledMeeting{Start: now.Add(time.Hour), Title: "sooner"})
+
+	meetings := ac.GetScheduledMeetings()
+	c.Assert(meetings, HasLen, 2)
+	c.Assert(meetings[0].Title, Equals, "sooner")
+	c.Assert(meetings[1].Title, Equals, "later")
+}
+
+func (cs *ConfigSuite) Test_UnscheduleMeeting_forgetsOnlyThatMeeting(c *C) {
+	ac := New()
+	now := time.Now()
+	ac.ScheduleMeeting(ScheduledMeeting{Start: now, Title: "one"})
+	ac.ScheduleMeeting(ScheduledMeeting{Start: now.Add(time.Hour), Title: "two"})
+
+	ac.UnscheduleMeeting(0)
+	ac.UnscheduleMeeting(5)
+
+	c.Assert(ac.GetScheduledMeetings(), HasLen, 1)
+	c.Assert(ac.GetScheduledMeetings()[0].Title, Equals, "two")
+}
+
+func (cs *ConfigSuite) Test_RemoveFinishedMeetings_keepsTheMeetingsNotOverYet(c *C) {
+	ac := New()
+	now := time.Now()
+	ac.ScheduleMeeting(ScheduledMeeting{Start: now.Add(-2 * time.Hour), Minutes: 60, Title: "over"})
+	ac.ScheduleMeeting(ScheduledMeeting{Start: now.Add(-30 * time.Minute), Minutes: 60, Title: "going on"})
+
+	ac.RemoveFinishedMeetings(now)
+
+	c.Assert(ac.GetScheduledMeetings(), HasLen, 1)
+	c.Assert(ac.GetScheduledMeetings()[0].Title, Equals, "going on")
+}
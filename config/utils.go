@@ -84,6 +84,16 @@ func CheckPort(port int) bool {
 
 var detectLanguage = jibberjabber.DetectLanguageTag
 
+// LanguageTag returns the language chosen for Wahay, or the one of
+// the system when there is none or it can't be understood
+func (a *ApplicationConfig) LanguageTag() language.Tag {
+	tag, err := language.Parse(a.GetLanguage())
+	if err != nil || tag == language.Und {
+		return DetectLanguage()
+	}
+	return tag
+}
+
 // DetectLanguage determine the language used in the host computer
 func DetectLanguage() language.Tag {
 	tag, _ := detectLanguage()
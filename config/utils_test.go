@@ -269,3 +269,21 @@ func (cs *ConfigSuite) Test_DetectLanguage_returnsEnglishIfEnglishIsDetected(c *
 
 	c.Assert(DetectLanguage(), Equals, language.English)
 }
+
+func (cs *ConfigSuite) Test_LanguageTag_returnsTheChosenLanguage(c *C) {
+	defer gostub.New().StubFunc(&detectLanguage, language.Hindi, nil).Reset()
+	ac := New()
+
+	ac.SetLanguage("es")
+	c.Assert(ac.LanguageTag(), Equals, language.Spanish)
+}
+
+func (cs *ConfigSuite) Test_LanguageTag_returnsTheLanguageOfTheSystemWithoutAChoice(c *C) {
+	defer gostub.New().StubFunc(&detectLanguage, language.Hindi, nil).Reset()
+	ac := New()
+
+	c.Assert(ac.LanguageTag(), Equals, language.Hindi)
+
+	ac.SetLanguage("not a language")
+	c.Assert(ac.LanguageTag(), Equals, language.Hindi)
+}
@@ -29,6 +29,12 @@ type Forwarder struct {
 	pauseLock     sync.Mutex
 	pausing       *pausing
 	dialer        proxy.Dialer
+	// KeepAlive is the period of the TCP keepalive probes on the
+	// forwarded connections. Zero leaves the Go defaults
+	KeepAlive time.Duration
+	// SocksAddr is the address of the SOCKS port of Tor. Empty
+	// means the default port on this computer
+	SocksAddr string
 	checker
 }
 
@@ -97,11 +103,15 @@ func (f *Forwarder) setupListener() error {
 }
 
 func (f *Forwarder) setupSocks5Dialer() error {
-	socks5Addr := fmt.Sprintf("%s:%d", f.LocalAddr, config.DefaultRoutePort)
+	socks5Addr := f.SocksAddr
+	if socks5Addr == "" {
+		socks5Addr = fmt.Sprintf("%s:%d", f.LocalAddr, config.DefaultRoutePort)
+	}
 	var err error
 
 	customDialer := &net.Dialer{
-		Timeout: 10 * time.Second,
+		Timeout:   10 * time.Second,
+		KeepAlive: f.KeepAlive,
 	}
 
 	f.dialer, err = proxy.SOCKS5("tcp", socks5Addr, nil, customDialer)
@@ -138,6 +148,11 @@ func (f *Forwarder) HandleConnection(clientConn net.Conn) {
 	tcpClientConn, _ := clientConn.(*net.TCPConn)
 	tcpServerConn, _ := serverConn.(*net.TCPConn)
 
+	if tcpClientConn != nil && f.KeepAlive > 0 {
+		_ = tcpClientConn.SetKeepAlive(true)
+		_ = tcpClientConn.SetKeepAlivePeriod(f.KeepAlive)
+	}
+
 	f.forwardTraffic(tcpClientConn, tcpServerConn)
 }
 
@@ -3,18 +3,23 @@ module github.com/digitalautonomy/wahay
 go 1.19
 
 require (
+	filippo.io/edwards25519 v1.0.0
 	github.com/atotto/clipboard v0.1.4
 	github.com/coyim/gotk3adapter v0.0.2
 	github.com/cubiest/jibberjabber v1.0.2-0.20200222172555-1351aa3fb4de
 	github.com/digitalautonomy/grumble v0.1.1
+	github.com/golang/protobuf v1.5.4
+	github.com/gorilla/websocket v1.5.3
 	github.com/kardianos/osext v0.0.0-20190222173326-2bc1f35cddc0
 	github.com/prashantv/gostub v1.1.0
 	github.com/sirupsen/logrus v1.9.3
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
 	github.com/stretchr/testify v1.8.1
 	github.com/wybiral/torgo v0.0.0-20201209223426-5fd9910eab31
 	golang.org/x/crypto v0.8.0
 	golang.org/x/net v0.10.0
 	golang.org/x/sys v0.21.0
+	golang.org/x/term v0.21.0
 	golang.org/x/text v0.9.0
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c
 )
@@ -22,9 +27,7 @@ require (
 require (
 	github.com/coyim/gotk3extra v0.0.2 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/golang/protobuf v1.5.4 // indirect
 	github.com/google/go-cmp v0.6.0 // indirect
-	github.com/gorilla/websocket v1.5.3 // indirect
 	github.com/gotk3/gotk3 v0.6.2 // indirect
 	github.com/kr/pretty v0.3.1 // indirect
 	github.com/kr/text v0.2.0 // indirect
@@ -34,3 +37,7 @@ require (
 	google.golang.org/protobuf v1.34.2 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+// gotk3adapter has no binding for gtk_widget_set_default_direction,
+// which switching to a right-to-left language at runtime needs
+replace github.com/coyim/gotk3adapter => ./third_party/gotk3adapter
@@ -0,0 +1,83 @@
+package gui
+
+import (
+	"time"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/hosting"
+	"github.com/digitalautonomy/wahay/tor"
+	log "github.com/sirupsen/logrus"
+)
+
+// openJoinAnotherMeeting opens the window to join a meeting of
+// somebody else while hosting this one. The windows of the meeting
+// being hosted stay as they are
+func (h *hostData) openJoinAnotherMeeting() {
+	var win gtki.ApplicationWindow
+	win = h.u.newJoinWindow("", func(data hosting.MeetingData) {
+		h.u.doInUIThread(win.Destroy)
+		h.u.joinAnotherMeeting(data, 0)
+	}, func(w gtki.Window) {
+		w.Destroy()
+	})
+
+	win.Show()
+}
+
+// joinAnotherMeeting joins the meeting with a client of its own, which
+// runs next to the one the host joins their meeting with. The meeting
+// gets a tab of its own, which is closed with the client. It knows
+// how many times in a row the client crashed shortly after joining it
+func (u *gtkUI) joinAnotherMeeting(data hosting.MeetingData, crashes int) {
+	if u.client == nil || !u.client.IsValid() {
+		u.reportError(i18n().Sprintf("The meeting can't be joined: there is no Mumble to join it with"))
+		return
+	}
+
+	c, err := u.client.Another()
+	if err != nil {
+		log.Errorf("joinAnotherMeeting(): %s", err)
+		u.reportError(i18n().Sprintf("An error occurred\n\n%s", err.Error()))
+		return
+	}
+
+	u.displayLoadingWindow()
+
+	var removeTab func()
+	var mumble tor.Service
+	launched := make(chan struct{})
+	joinedAt := time.Now()
+
+	mumble, err = c.Launch(data, func() {
+		<-launched
+		u.doInUIThread(func() {
+			if removeTab != nil {
+				removeTab()
+			}
+		})
+		c.Destroy()
+
+		if mumble.Crashed() {
+			attempt := rejoinAttempt(crashes, joinedAt, time.Now())
+			u.onMumbleCrash(attempt, func() {
+				go u.joinAnotherMeeting(data, attempt)
+			}, func() {})
+		}
+	})
+
+	// The tab is added before the client can be
+	// seen closed, which removes it in the UI thread
+	if err == nil {
+		u.doInUIThread(func() {
+			removeTab = u.addMeetingTab(mumble, data.MeetingID, c)
+		})
+	}
+	close(launched)
+
+	u.hideLoadingWindow()
+
+	if err != nil {
+		c.Destroy()
+		u.reportError(i18n().Sprintf("An error occurred\n\n%s", err.Error()))
+	}
+}
@@ -0,0 +1,21 @@
+package gui
+
+// configBanList keeps the participants banned from our meetings
+// in the configuration file, so they stay banned from the next ones
+type configBanList struct {
+	u *gtkUI
+}
+
+func (l configBanList) BannedCertificates() []string {
+	return l.u.config.GetBannedCertificates()
+}
+
+func (l configBanList) BanCertificate(certHash string) {
+	l.u.config.BanCertificate(certHash)
+	l.u.saveConfigOnly()
+}
+
+func (l configBanList) UnbanCertificate(certHash string) {
+	l.u.config.UnbanCertificate(certHash)
+	l.u.saveConfigOnly()
+}
@@ -0,0 +1,166 @@
+package gui
+
+import (
+	"context"
+	"strings"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+type bridgesWindow struct {
+	u          *gtkUI
+	dialog     gtki.Window
+	image      gtki.Image
+	entry      gtki.Entry
+	message    gtki.Label
+	newCaptcha gtki.Button
+	cancel     gtki.Button
+	submit     gtki.Button
+
+	moat      *tor.MoatClient
+	challenge *tor.MoatChallenge
+	ctx       context.Context
+	stop      func()
+}
+
+// openBridgesWindow asks the Tor Project for bridges. The user has to solve
+// a captcha, and the bridges received are stored in the configuration so
+// that our Tor uses them the next time it starts
+func (u *gtkUI) openBridgesWindow() {
+	builder := u.g.uiBuilderFor("BridgesWindow")
+
+	builder.i18nProperties(
+		"title", "bridgesWindow",
+		"label", "lblBridgesCaptchaDescription",
+		"label", "lblBridgesMessage",
+		"placeholder", "entryBridgesCaptcha",
+		"button", "btnBridgesNewCaptcha",
+		"button", "btnBridgesCancel",
+		"button", "btnBridgesSubmit",
+	)
+
+	w := &bridgesWindow{u: u, moat: tor.NewMoatClient()}
+	w.ctx, w.stop = context.WithCancel(context.Background())
+
+	builder.getItems(
+		"bridgesWindow", &w.dialog,
+		"imgBridgesCaptcha", &w.image,
+		"entryBridgesCaptcha", &w.entry,
+		"lblBridgesMessage", &w.message,
+		"btnBridgesNewCaptcha", &w.newCaptcha,
+		"btnBridgesCancel", &w.cancel,
+		"btnBridgesSubmit", &w.submit,
+	)
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_new_captcha":         w.fetchChallenge,
+		"on_submit":              w.checkSolution,
+		"on_close_window_signal": w.close,
+	})
+
+	if u.currentWindow != nil {
+		w.dialog.SetTransientFor(u.currentWindow)
+	}
+
+	u.doInUIThread(func() {
+		u.disableCurrentWindow()
+		w.dialog.Show()
+	})
+
+	w.fetchChallenge()
+}
+
+func (w *bridgesWindow) close() {
+	w.stop()
+	w.dialog.Destroy()
+	w.u.enableCurrentWindow()
+}
+
+// setBusy disables the controls while we wait for the Tor Project
+func (w *bridgesWindow) setBusy(busy bool, message string) {
+	w.entry.SetSensitive(!busy && w.challenge != nil)
+	w.submit.SetSensitive(!busy && w.challenge != nil)
+	w.newCaptcha.SetSensitive(!busy)
+	w.message.SetText(message)
+}
+
+func (w *bridgesWindow) fetchChallenge() {
+	w.requestChallenge("")
+}
+
+// requestChallenge gets a new captcha, and shows the note once it's ready
+func (w *bridgesWindow) requestChallenge(note string) {
+	w.challenge = nil
+	w.setBusy(true, i18n().Sprintf("Requesting a captcha..."))
+
+	go func() {
+		challenge, err := w.moat.FetchChallenge(w.ctx)
+		if w.ctx.Err() != nil {
+			return
+		}
+
+		w.u.doInUIThread(func() {
+			if err != nil {
+				log.Errorf("Requesting a captcha to get bridges: %v", err)
+				w.setBusy(false, i18n().Sprintf("The captcha couldn't be requested. Please try again later."))
+				return
+			}
+
+			pixbuf, err := w.u.g.getPixbufFromBytes(challenge.Image)
+			if err != nil {
+				log.Errorf("Loading the captcha to get bridges: %v", err)
+				w.setBusy(false, i18n().Sprintf("The captcha couldn't be shown. Please request a new one."))
+				return
+			}
+
+			w.challenge = challenge
+			w.image.SetFromPixbuf(pixbuf)
+			w.entry.SetText("")
+			w.setBusy(false, note)
+			w.entry.GrabFocus()
+		})
+	}()
+}
+
+func (w *bridgesWindow) checkSolution() {
+	solution, _ := w.entry.GetText()
+	solution = strings.TrimSpace(solution)
+	if w.challenge == nil || solution == "" {
+		return
+	}
+
+	challenge := w.challenge
+	w.setBusy(true, i18n().Sprintf("Checking the solution..."))
+
+	go func() {
+		bridges, err := w.moat.CheckSolution(w.ctx, challenge, solution)
+		if w.ctx.Err() != nil {
+			return
+		}
+
+		w.u.doInUIThread(func() {
+			switch {
+			case err == tor.ErrMoatWrongSolution:
+				w.requestChallenge(i18n().Sprintf("The solution wasn't correct. Please try with this new captcha."))
+			case err != nil:
+				log.Errorf("Getting bridges: %v", err)
+				w.setBusy(false, i18n().Sprintf("The bridges couldn't be requested. Please try again later."))
+			default:
+				w.onBridgesReceived(bridges)
+			}
+		})
+	}()
+}
+
+func (w *bridgesWindow) onBridgesReceived(bridges []string) {
+	w.u.config.SetBridges(bridges)
+	w.u.saveConfigOnly()
+
+	w.challenge = nil
+	w.setBusy(false, i18n().Sprintf("Wahay received %d bridges. They will be used the next time Wahay starts.", len(bridges)))
+	w.newCaptcha.SetSensitive(false)
+	w.cancel.SetLabel(i18n().Sprintf("Close"))
+}
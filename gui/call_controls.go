@@ -0,0 +1,145 @@
+package gui
+
+import (
+	"sync"
+	"time"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/client"
+	log "github.com/sirupsen/logrus"
+)
+
+// callControlsInterval is how often the buttons follow the state
+// of Mumble, since it can also be changed in its own window
+const callControlsInterval = 2 * time.Second
+
+// callControls are the mute and deafen buttons of the meeting windows
+type callControls struct {
+	u       *gtkUI
+	control client.RemoteControl
+	mute    gtki.ToggleButton
+	deafen  gtki.ToggleButton
+
+	// updating is true while the buttons show the state read from
+	// Mumble, so it's not sent back to it. It's only used in the UI thread
+	updating bool
+}
+
+type callState struct {
+	connected bool
+	muted     bool
+	deafened  bool
+}
+
+func (u *gtkUI) newCallControls(b *uiBuilder, control client.RemoteControl) *callControls {
+	b.i18nProperties(
+		"button", "btnMute",
+		"button", "btnDeafen",
+		"tooltip", "btnMute",
+		"tooltip", "btnDeafen",
+	)
+
+	return &callControls{
+		u:       u,
+		control: control,
+		mute:    b.get("btnMute").(gtki.ToggleButton),
+		deafen:  b.get("btnDeafen").(gtki.ToggleButton),
+	}
+}
+
+func (cc *callControls) onToggleMute() {
+	if cc.updating {
+		return
+	}
+
+	muted := cc.mute.GetActive()
+	go func() {
+		if err := cc.control.SetSelfMuted(muted); err != nil {
+			log.Debugf("callControls.onToggleMute(): %s", err)
+		}
+	}()
+}
+
+func (cc *callControls) onToggleDeafen() {
+	if cc.updating {
+		return
+	}
+
+	deafened := cc.deafen.GetActive()
+	go func() {
+		if err := cc.control.SetSelfDeafened(deafened); err != nil {
+			log.Debugf("callControls.onToggleDeafen(): %s", err)
+		}
+	}()
+}
+
+// readState asks Mumble how it is. The buttons can't be used
+// when the client doesn't answer or is not connected
+// toggle does what clicking the button does, for the keyboard
+// shortcuts. Like a click, it does nothing while the button is disabled
+func (cc *callControls) toggle(b gtki.ToggleButton) {
+	if b.IsSensitive() {
+		b.SetActive(!b.GetActive())
+	}
+}
+
+func (cc *callControls) readState() callState {
+	if !cc.control.IsConnected() {
+		return callState{}
+	}
+
+	muted, err := cc.control.IsSelfMuted()
+	if err != nil {
+		return callState{}
+	}
+
+	deafened, err := cc.control.IsSelfDeafened()
+	if err != nil {
+		return callState{}
+	}
+
+	return callState{connected: true, muted: muted, deafened: deafened}
+}
+
+func (cc *callControls) show(s callState) {
+	cc.updating = true
+	defer func() {
+		cc.updating = false
+	}()
+
+	cc.mute.SetSensitive(s.connected)
+	cc.deafen.SetSensitive(s.connected)
+	cc.mute.SetActive(s.muted)
+	cc.deafen.SetActive(s.deafened)
+}
+
+// watch keeps the buttons updated with the state of Mumble.
+// The returned function stops the updates
+func (cc *callControls) watch() func() {
+	done := make(chan bool)
+	var once sync.Once
+
+	go func() {
+		t := time.NewTicker(callControlsInterval)
+		defer t.Stop()
+
+		for {
+			s := cc.readState()
+			cc.u.doInUIThread(func() {
+				cc.show(s)
+			})
+
+			select {
+			case <-done:
+				return
+			case <-t.C:
+			}
+		}
+	}()
+
+	return func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
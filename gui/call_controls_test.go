@@ -0,0 +1,84 @@
+package gui
+
+import (
+	"github.com/coyim/gotk3adapter/gtk_mock"
+	"github.com/digitalautonomy/wahay/client"
+	. "gopkg.in/check.v1"
+)
+
+type WahayCallControlsSuite struct{}
+
+var _ = Suite(&WahayCallControlsSuite{})
+
+type fakeRemoteControl struct {
+	connected bool
+	muted     bool
+	deafened  bool
+	err       error
+}
+
+func (f *fakeRemoteControl) IsConnected() bool {
+	return f.connected
+}
+
+func (f *fakeRemoteControl) IsSelfMuted() (bool, error) {
+	return f.muted, f.err
+}
+
+func (f *fakeRemoteControl) SetSelfMuted(v bool) error {
+	f.muted = v
+	return f.err
+}
+
+func (f *fakeRemoteControl) IsSelfDeafened() (bool, error) {
+	return f.deafened, f.err
+}
+
+func (f *fakeRemoteControl) SetSelfDeafened(v bool) error {
+	f.deafened = v
+	return f.err
+}
+
+func (s *WahayCallControlsSuite) Test_callControls_readState_followsTheClient(c *C) {
+	cc := &callControls{control: &fakeRemoteControl{connected: true, deafened: true}}
+
+	c.Assert(cc.readState(), Equals, callState{connected: true, deafened: true})
+}
+
+func (s *WahayCallControlsSuite) Test_callControls_readState_isDisconnectedWhenTheClientDoesNotAnswer(c *C) {
+	cc := &callControls{control: &fakeRemoteControl{connected: true, muted: true, err: client.ErrRemoteControlUnavailable}}
+	c.Assert(cc.readState(), Equals, callState{})
+
+	cc = &callControls{control: &fakeRemoteControl{muted: true}}
+	c.Assert(cc.readState(), Equals, callState{})
+}
+
+type toggleButtonMock struct {
+	gtk_mock.MockToggleButton
+	sensitive bool
+	active    bool
+}
+
+func (b *toggleButtonMock) IsSensitive() bool {
+	return b.sensitive
+}
+
+func (b *toggleButtonMock) GetActive() bool {
+	return b.active
+}
+
+func (b *toggleButtonMock) SetActive(v bool) {
+	b.active = v
+}
+
+func (s *WahayCallControlsSuite) Test_callControls_toggle_clicksTheButtonOnlyWhenItsEnabled(c *C) {
+	cc := &callControls{}
+	b := &toggleButtonMock{}
+
+	cc.toggle(b)
+	c.Assert(b.active, Equals, false)
+
+	b.sensitive = true
+	cc.toggle(b)
+	c.Assert(b.active, Equals, true)
+}
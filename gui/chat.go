@@ -0,0 +1,192 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+// chatTimeLayout is how the time of the messages is shown
+const chatTimeLayout = "15:04"
+
+// chatLine is how a message is shown in the chat of the meeting
+func chatLine(at time.Time, from, text string) string {
+	return fmt.Sprintf("[%s] %s: %s", at.Format(chatTimeLayout), from, text)
+}
+
+// senderName returns the name the participant is shown with
+func senderName(u hosting.User) string {
+	if u.Name == "" {
+		return i18n().Sprintf("Somebody")
+	}
+	return u.Name
+}
+
+// hostChat is the chat of the meeting the host runs. The messages are
+// kept from the start of the meeting, so the ones that arrive while the
+// chat window is closed are there when it's opened
+type hostChat struct {
+	h    *hostData
+	serv hosting.Server
+	stop func()
+
+	// lines are only touched from the UI thread
+	lines []string
+
+	window     gtki.Window
+	buffer     gtki.TextBuffer
+	entry      gtki.Entry
+	lblMessage gtki.Label
+}
+
+// watchChat keeps the messages sent in the meeting for the chat window
+func (h *hostData) watchChat() {
+	serv := h.service.Server()
+	if serv == nil {
+		return
+	}
+
+	c := &hostChat{h: h, serv: serv}
+	messages, stop := serv.Messages()
+	c.stop = stop
+	h.chat = c
+
+	go func() {
+		for msg := range messages {
+			from := senderName(msg.From)
+			line := chatLine(msg.Received, from, msg.Text)
+			h.u.doInUIThread(func() {
+				c.add(line)
+				if c.window == nil && h.u.config.AreParticipantNotificationsEnabled() {
+					showDesktopNotification(i18n().Sprintf("New message from %s", from), msg.Text)
+				}
+			})
+		}
+	}()
+}
+
+func (h *hostData) stopWatchingChat() {
+	if h.chat == nil {
+		return
+	}
+
+	h.chat.stop()
+	h.chat.close()
+	h.chat = nil
+}
+
+// openChat shows the chat window, or brings it to the front
+// when it's open already
+func (h *hostData) openChat() {
+	c := h.chat
+	if c == nil {
+		return
+	}
+
+	if c.window != nil {
+		c.window.Present()
+		return
+	}
+
+	builder := h.u.g.uiBuilderFor("ChatWindow")
+
+	builder.i18nProperties(
+		"title", "chatWindow",
+		"label", "lblChatDescription",
+		"placeholder", "entChatMessage",
+		"button", "btnSendMessage",
+	)
+
+	builder.getItems(
+		"chatWindow", &c.window,
+		"chatBuffer", &c.buffer,
+		"entChatMessage", &c.entry,
+		"lblChatMessage", &c.lblMessage,
+	)
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_send":  c.send,
+		"on_close": c.close,
+	})
+
+	// The newest message is kept in sight as the messages arrive
+	adjustment := builder.get("scrollChat").(gtki.ScrolledWindow).GetVAdjustment()
+	_ = adjustment.Connect("changed", func() {
+		adjustment.SetValue(adjustment.GetUpper() - adjustment.GetPageSize())
+	})
+
+	c.buffer.SetText(strings.Join(c.lines, "\n"))
+
+	if h.u.currentWindow != nil {
+		c.window.SetTransientFor(h.u.currentWindow)
+	}
+
+	c.window.Show()
+}
+
+// add shows the line at the end of the chat
+func (c *hostChat) add(line string) {
+	c.lines = append(c.lines, line)
+
+	if c.buffer == nil {
+		return
+	}
+
+	if c.buffer.GetCharCount() > 0 {
+		line = "\n" + line
+	}
+	c.buffer.Insert(c.buffer.GetEndIter(), line)
+}
+
+func (c *hostChat) send() {
+	text, _ := c.entry.GetText()
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+
+	c.entry.SetSensitive(false)
+
+	go func() {
+		err := c.serv.SendMessage(text)
+
+		c.h.u.doInUIThread(func() {
+			if err == nil {
+				c.add(chatLine(time.Now(), i18n().Sprintf("You"), text))
+			} else {
+				log.Errorf("The message can't be sent: %s", err)
+			}
+
+			// The window may have been closed in the meantime
+			if c.entry == nil {
+				return
+			}
+			c.entry.SetSensitive(true)
+
+			if err != nil {
+				c.lblMessage.SetVisible(false)
+				go c.h.u.messageToLabel(c.lblMessage, i18n().Sprintf("The message can't be sent: %s", err), 5)
+				return
+			}
+			c.entry.SetText("")
+		})
+	}()
+}
+
+// close closes the window, keeping the messages for the next time
+func (c *hostChat) close() {
+	if c.window == nil {
+		return
+	}
+
+	c.window.Destroy()
+	c.window = nil
+	c.buffer = nil
+	c.entry = nil
+	c.lblMessage = nil
+}
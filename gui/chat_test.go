@@ -0,0 +1,23 @@
+package gui
+
+import (
+	"time"
+
+	"github.com/digitalautonomy/wahay/hosting"
+	. "gopkg.in/check.v1"
+)
+
+type WahayChatSuite struct{}
+
+var _ = Suite(&WahayChatSuite{})
+
+func (s *WahayChatSuite) Test_chatLine_showsTheTimeTheSenderAndTheText(c *C) {
+	at := time.Date(2026, 10, 17, 9, 5, 30, 0, time.Local)
+	c.Assert(chatLine(at, "Ana", "the pad is at https://example.org/pad"), Equals,
+		"[09:05] Ana: the pad is at https://example.org/pad")
+}
+
+func (s *WahayChatSuite) Test_senderName_namesTheParticipantsWithoutAName(c *C) {
+	c.Assert(senderName(hosting.User{Name: "Ana"}), Equals, "Ana")
+	c.Assert(senderName(hosting.User{}), Equals, "Somebody")
+}
@@ -0,0 +1,253 @@
+package gui
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/hosting"
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+type connectionQuality int
+
+const (
+	qualityUnknown connectionQuality = iota
+	qualityGood
+	qualityFair
+	qualityPoor
+)
+
+// connectionQualityInterval is how often the onion service of the
+// meeting is probed. A probe that takes longer than the interval is lost
+const connectionQualityInterval = 10 * time.Second
+
+// connectionQualityProbes is how many of the last probes are looked at
+const connectionQualityProbes = 6
+
+// The round trip to an onion service goes through six relays, so it's
+// much longer than the one of a call over the internet
+const (
+	fairLatency = 1500 * time.Millisecond
+	poorLatency = 3 * time.Second
+	poorLoss    = 1.0 / 3
+)
+
+var connectionQualityClasses = map[connectionQuality]string{
+	qualityFair: "network-slow",
+	qualityPoor: "network-stalled",
+}
+
+type connectionProbes struct {
+	sync.Mutex
+
+	// latencies are the results of the last probes, zero for the lost ones
+	latencies []time.Duration
+	// guestsSilent is true when the host hears nothing from the guests
+	guestsSilent bool
+}
+
+func (p *connectionProbes) add(d time.Duration) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.latencies = append(p.latencies, d)
+	if len(p.latencies) > connectionQualityProbes {
+		p.latencies = p.latencies[1:]
+	}
+}
+
+func (p *connectionProbes) setGuestsSilent(v bool) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.guestsSilent = v
+}
+
+// latency returns the average of the probes that were answered
+func (p *connectionProbes) latency() time.Duration {
+	var total time.Duration
+	answered := 0
+	for _, d := range p.latencies {
+		if d > 0 {
+			total += d
+			answered++
+		}
+	}
+
+	if answered == 0 {
+		return 0
+	}
+	return total / time.Duration(answered)
+}
+
+func (p *connectionProbes) loss() float64 {
+	if len(p.latencies) == 0 {
+		return 0
+	}
+
+	lost := 0
+	for _, d := range p.latencies {
+		if d == 0 {
+			lost++
+		}
+	}
+	return float64(lost) / float64(len(p.latencies))
+}
+
+func (p *connectionProbes) quality() connectionQuality {
+	p.Lock()
+	defer p.Unlock()
+
+	if len(p.latencies) == 0 {
+		return qualityUnknown
+	}
+
+	latency, loss := p.latency(), p.loss()
+	switch {
+	case p.guestsSilent || loss >= poorLoss || latency == 0 || latency >= poorLatency:
+		return qualityPoor
+	case loss > 0 || latency >= fairLatency:
+		return qualityFair
+	}
+	return qualityGood
+}
+
+func (p *connectionProbes) description(asHost bool) string {
+	q := p.quality()
+
+	p.Lock()
+	defer p.Unlock()
+
+	if q == qualityUnknown {
+		return i18n().Sprintf("Measuring the connection to the meeting...")
+	}
+
+	summary := i18n().Sprintf("Connection: %d ms, %d%% lost",
+		p.latency().Milliseconds(), int(p.loss()*100))
+
+	switch {
+	case p.guestsSilent:
+		return i18n().Sprintf("Nothing arrives from the participants. The problem is their connection, " +
+			"not your microphone")
+	case q != qualityPoor:
+		return summary
+	}
+
+	advice := i18n().Sprintf("Tor is slow right now. The problem is the network, " +
+		"not your microphone. If it stays like this, try new Tor circuits")
+	if asHost {
+		advice = i18n().Sprintf("Tor is slow right now, so the participants can hear you " +
+			"with delays. The problem is the network, not your microphone")
+	}
+	return summary + "\n" + advice
+}
+
+func (p *connectionProbes) showIn(lbl gtki.Label, asHost bool) {
+	lbl.SetLabel(p.description(asHost))
+	lbl.SetVisible(true)
+
+	ctx, err := lbl.GetStyleContext()
+	if err != nil {
+		log.Debugf("connectionProbes.showIn(): %s", err)
+		return
+	}
+
+	current := p.quality()
+	for q, class := range connectionQualityClasses {
+		if q == current {
+			ctx.AddClass(class)
+		} else {
+			ctx.RemoveClass(class)
+		}
+	}
+}
+
+// meetingOnion returns the onion address of a meeting ID,
+// which can come with the port of the meeting
+func meetingOnion(meetingID string) string {
+	if host, _, err := net.SplitHostPort(meetingID); err == nil {
+		return host
+	}
+	return meetingID
+}
+
+// watchConnectionQuality keeps the label updated with the latency to the
+// onion service of the meeting, and for the host, with whether anything
+// arrives from the guests. It returns the function to stop watching
+func (u *gtkUI) watchConnectionQuality(lbl gtki.Label, onion string, serv hosting.Server) func() {
+	if u.tor == nil {
+		return func() {}
+	}
+
+	p := &connectionProbes{}
+	asHost := serv != nil
+	done := make(chan bool)
+	var once sync.Once
+
+	var last hosting.Stats
+	if asHost {
+		last = serv.Stats()
+	}
+
+	check := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), connectionQualityInterval)
+		d, err := tor.ProbeLatency(ctx, u.tor.SOCKSAddress(), onion)
+		cancel()
+		if err != nil {
+			log.Debugf("watchConnectionQuality(): %s", err)
+		}
+		p.add(d)
+
+		if asHost {
+			current := serv.Stats()
+			p.setGuestsSilent(current.Participants > 0 && last.Participants > 0 &&
+				current.BytesReceived == last.BytesReceived)
+			last = current
+		}
+
+		u.doInUIThread(func() {
+			select {
+			case <-done:
+			default:
+				p.showIn(lbl, asHost)
+			}
+		})
+	}
+
+	go func() {
+		t := time.NewTicker(connectionQualityInterval)
+		defer t.Stop()
+
+		check()
+		for {
+			select {
+			case <-done:
+				return
+			case <-t.C:
+				check()
+			}
+		}
+	}()
+
+	stopTranslating := onLanguageChange(func() {
+		select {
+		case <-done:
+		default:
+			if lbl.IsVisible() {
+				p.showIn(lbl, asHost)
+			}
+		}
+	})
+
+	return func() {
+		once.Do(func() {
+			stopTranslating()
+			close(done)
+		})
+	}
+}
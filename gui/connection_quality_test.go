@@ -0,0 +1,57 @@
+package gui
+
+import (
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type WahayConnectionQualitySuite struct{}
+
+var _ = Suite(&WahayConnectionQualitySuite{})
+
+func probesWith(latencies ...time.Duration) *connectionProbes {
+	p := &connectionProbes{}
+	for _, d := range latencies {
+		p.add(d)
+	}
+	return p
+}
+
+func (s *WahayConnectionQualitySuite) Test_connectionProbes_quality_dependsOnLatencyAndLoss(c *C) {
+	c.Assert(probesWith().quality(), Equals, qualityUnknown)
+	c.Assert(probesWith(600*time.Millisecond, 800*time.Millisecond).quality(), Equals, qualityGood)
+	c.Assert(probesWith(2*time.Second).quality(), Equals, qualityFair)
+	c.Assert(probesWith(time.Second, time.Second, time.Second, 0).quality(), Equals, qualityFair)
+	c.Assert(probesWith(time.Second, 0).quality(), Equals, qualityPoor)
+	c.Assert(probesWith(4*time.Second).quality(), Equals, qualityPoor)
+}
+
+func (s *WahayConnectionQualitySuite) Test_connectionProbes_onlyKeepsTheLastProbes(c *C) {
+	p := probesWith(0, 0, 0)
+	for i := 0; i < connectionQualityProbes; i++ {
+		p.add(time.Second)
+	}
+
+	c.Assert(p.quality(), Equals, qualityGood)
+}
+
+func (s *WahayConnectionQualitySuite) Test_connectionProbes_quality_isPoorWhenTheGuestsAreSilent(c *C) {
+	p := probesWith(time.Second)
+	p.setGuestsSilent(true)
+
+	c.Assert(p.quality(), Equals, qualityPoor)
+}
+
+func (s *WahayConnectionQualitySuite) Test_connectionProbes_description_suggestsNewCircuitsToGuests(c *C) {
+	p := probesWith(5 * time.Second)
+
+	c.Assert(strings.Contains(p.description(false), "new Tor circuits"), Equals, true)
+	c.Assert(strings.Contains(p.description(true), "new Tor circuits"), Equals, false)
+}
+
+func (s *WahayConnectionQualitySuite) Test_meetingOnion_removesThePort(c *C) {
+	c.Assert(meetingOnion("abc.onion:64738"), Equals, "abc.onion")
+	c.Assert(meetingOnion("abc.onion"), Equals, "abc.onion")
+}
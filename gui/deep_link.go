@@ -0,0 +1,159 @@
+package gui
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/digitalautonomy/wahay/config"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// deepLinkSocketName is the socket where the running Wahay
+	// receives the links opened while it's running
+	deepLinkSocketName = "deep-link.sock"
+
+	maxDeepLinkLength = 4096
+	deepLinkTimeout   = 2 * time.Second
+)
+
+func deepLinkSocketPath() string {
+	return filepath.Join(config.Dir(), deepLinkSocketName)
+}
+
+// SendDeepLink gives the link to the Wahay that is already running, which
+// opens it, so a second Wahay doesn't start. It returns an error when
+// there is no Wahay running
+func SendDeepLink(link string) error {
+	return sendDeepLink(deepLinkSocketPath(), link)
+}
+
+func sendDeepLink(path, link string) error {
+	conn, err := net.DialTimeout("unix", path, deepLinkTimeout)
+	if err != nil {
+		return err
+	}
+	defer closeAndIgnore(conn)
+
+	_ = conn.SetDeadline(time.Now().Add(deepLinkTimeout))
+	_, err = io.WriteString(conn, strings.TrimSpace(link)+"\n")
+
+	return err
+}
+
+// listenDeepLinks calls open with the links sent by other invocations of
+// Wahay, until the returned function is called
+func listenDeepLinks(path string, open func(string)) (func(), error) {
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		// A socket left by a Wahay that didn't close properly keeps us
+		// from listening. It's only removed when nobody answers on it
+		conn, dialErr := net.DialTimeout("unix", path, deepLinkTimeout)
+		if dialErr == nil {
+			closeAndIgnore(conn)
+			return nil, err
+		}
+
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+
+		l, err = net.Listen("unix", path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	go acceptDeepLinks(l, open)
+
+	return func() {
+		closeAndIgnore(l)
+	}, nil
+}
+
+func acceptDeepLinks(l net.Listener, open func(string)) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go readDeepLink(conn, open)
+	}
+}
+
+func readDeepLink(conn net.Conn, open func(string)) {
+	defer closeAndIgnore(conn)
+
+	_ = conn.SetDeadline(time.Now().Add(deepLinkTimeout))
+
+	line, err := bufio.NewReader(io.LimitReader(conn, maxDeepLinkLength)).ReadString('\n')
+	if err != nil && err != io.EOF {
+		log.Debugf("readDeepLink(): %s", err)
+		return
+	}
+
+	if link := strings.TrimSpace(line); link != "" {
+		open(link)
+	}
+}
+
+func closeAndIgnore(c io.Closer) {
+	_ = c.Close()
+}
+
+// startDeepLinks opens the link Wahay was started with, if any, and
+// starts receiving the links opened while Wahay is running
+func (u *gtkUI) startDeepLinks() {
+	if link := config.DeepLink(); link != "" {
+		u.openDeepLink(link)
+	}
+
+	if err := os.MkdirAll(config.Dir(), 0700); err != nil {
+		log.Errorf("startDeepLinks(): %s", err)
+		return
+	}
+
+	stop, err := listenDeepLinks(deepLinkSocketPath(), func(link string) {
+		u.doInUIThread(func() {
+			u.openDeepLink(link)
+		})
+	})
+	if err != nil {
+		log.Errorf("startDeepLinks(): %s", err)
+		return
+	}
+
+	u.onExit(stop)
+}
+
+// openDeepLink takes the participant to the join window with the meeting
+// of the link. It's only done from the main window, so a link doesn't
+// interrupt a meeting or the settings
+func (u *gtkUI) openDeepLink(link string) {
+	if _, err := parseMeetingAddress(link); err != nil {
+		log.WithError(err).Error("Wahay was opened with an invalid meeting link")
+		return
+	}
+
+	if u.errorHandler.isThereAnyStartupError() {
+		log.Error("Wahay can't join meetings because of the errors found when it started")
+		return
+	}
+
+	if u.currentWindow != u.mainWindow {
+		log.Info("Ignoring a meeting link since Wahay is busy")
+		if u.currentWindow != nil {
+			u.currentWindow.Present()
+		}
+		return
+	}
+
+	u.hideMainWindow()
+	u.openJoinWindowWith(link)
+}
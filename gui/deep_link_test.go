@@ -0,0 +1,51 @@
+package gui
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type WahayDeepLinkSuite struct{}
+
+var _ = Suite(&WahayDeepLinkSuite{})
+
+func (s *WahayDeepLinkSuite) Test_sendDeepLink_givesTheLinkToTheRunningWahay(c *C) {
+	path := filepath.Join(c.MkDir(), deepLinkSocketName)
+
+	links := make(chan string, 1)
+	stop, err := listenDeepLinks(path, func(link string) {
+		links <- link
+	})
+	c.Assert(err, IsNil)
+	defer stop()
+
+	c.Assert(sendDeepLink(path, " mumble://abcdef.onion:1234 "), IsNil)
+
+	select {
+	case link := <-links:
+		c.Assert(link, Equals, "mumble://abcdef.onion:1234")
+	case <-time.After(5 * time.Second):
+		c.Fatal("the link wasn't received")
+	}
+}
+
+func (s *WahayDeepLinkSuite) Test_sendDeepLink_failsWithoutARunningWahay(c *C) {
+	path := filepath.Join(c.MkDir(), deepLinkSocketName)
+
+	c.Assert(sendDeepLink(path, "mumble://abcdef.onion"), NotNil)
+}
+
+func (s *WahayDeepLinkSuite) Test_listenDeepLinks_replacesAStaleSocket(c *C) {
+	path := filepath.Join(c.MkDir(), deepLinkSocketName)
+	c.Assert(os.WriteFile(path, nil, 0600), IsNil)
+
+	stop, err := listenDeepLinks(path, func(string) {})
+	c.Assert(err, IsNil)
+	defer stop()
+
+	_, err = listenDeepLinks(path, func(string) {})
+	c.Assert(err, NotNil)
+}
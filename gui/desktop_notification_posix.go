@@ -0,0 +1,25 @@
+//go:build !windows
+
+package gui
+
+import (
+	"os/exec"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// showDesktopNotification uses notify-send, which talks to the
+// notification daemon of the desktop, when it's installed
+func showDesktopNotification(title, body string) {
+	path, err := exec.LookPath("notify-send")
+	if err != nil {
+		log.Debugf("showDesktopNotification(): notify-send is not available: %s", err)
+		return
+	}
+
+	/* #nosec G204 */
+	err = exec.Command(path, "--app-name=Wahay", title, body).Run()
+	if err != nil {
+		log.Errorf("showDesktopNotification(): %s", err)
+	}
+}
@@ -0,0 +1,11 @@
+package gui
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// showDesktopNotification only logs the notification,
+// since there is no notification support on Windows yet
+func showDesktopNotification(title, body string) {
+	log.Debugf("showDesktopNotification(): %s: %s", title, body)
+}
@@ -0,0 +1,270 @@
+package gui
+
+import (
+	"time"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+// pastMeetingName returns the title of a past meeting, or the beginning
+// of its onion address for the ones without a title. The rest of the
+// invitation isn't shown, since it can have the password of the meeting
+func pastMeetingName(m config.PastMeeting) string {
+	if m.Title != "" {
+		return m.Title
+	}
+
+	data, err := hosting.ParseURL(m.Address)
+	if err != nil || data.MeetingID == "" {
+		return i18n().Sprintf("Meeting without a title")
+	}
+
+	const shown = 16
+	id := data.MeetingID
+	if len(id) > shown {
+		id = id[:shown] + "…"
+	}
+	return id
+}
+
+func pastMeetingDescription(m config.PastMeeting) string {
+	when := m.Time.Local().Format("2006-01-02 15:04")
+	if m.Hosted {
+		return i18n().Sprintf("Hosted on %s as %s", when, m.Username)
+	}
+	return i18n().Sprintf("Joined on %s as %s", when, m.Username)
+}
+
+// historyStorageText tells where the history is kept,
+// which depends on how the settings are kept
+func historyStorageText(persistent, encrypted bool) string {
+	switch {
+	case !persistent:
+		return i18n().Sprintf("Your settings are not remembered, so these meetings " +
+			"are forgotten when Wahay closes.")
+	case !encrypted:
+		return i18n().Sprintf("These meetings are kept in your settings file without encryption. " +
+			"Encrypt the configuration file in the settings to protect them with a password.")
+	}
+	return i18n().Sprintf("These meetings are kept in your encrypted settings file.")
+}
+
+// rememberJoinedMeeting adds a meeting joined to the history,
+// with the invitation it was joined with
+func (u *gtkUI) rememberJoinedMeeting(meetingURL string, data *hosting.MeetingData) {
+	if !u.config.IsMeetingHistoryEnabled() {
+		return
+	}
+
+	u.config.AddPastMeeting(config.PastMeeting{
+		Time:     time.Now(),
+		Title:    data.MeetingInfo.Title,
+		Address:  meetingURL,
+		Username: data.Username,
+	})
+	u.saveConfigOnly()
+}
+
+// rememberHostedMeeting adds the meeting to the history, with the
+// settings it was started with, once it has started
+func (h *hostData) rememberHostedMeeting() {
+	if !h.u.config.IsMeetingHistoryEnabled() {
+		return
+	}
+
+	h.u.config.AddPastMeeting(config.PastMeeting{
+		Time:         time.Now(),
+		Title:        h.u.config.GetMeetingName(),
+		Hosted:       true,
+		Address:      h.service.URL(),
+		Username:     h.meetingUsername,
+		Agenda:       h.u.config.GetMeetingAgenda(),
+		AudioProfile: string(h.audioProfile),
+	})
+	h.u.saveConfigOnly()
+}
+
+type historyWindow struct {
+	u          *gtkUI
+	dialog     gtki.Window
+	chkEnabled gtki.CheckButton
+	lblStorage gtki.Label
+	lblEmpty   gtki.Label
+	box        gtki.Box
+	btnClear   gtki.Button
+	rows       []gtki.Box
+}
+
+// openHistoryWindow shows the meetings joined and hosted before,
+// to join or host them again, or forget them
+func (u *gtkUI) openHistoryWindow() {
+	builder := u.g.uiBuilderFor("HistoryWindow")
+
+	builder.i18nProperties(
+		"title", "historyWindow",
+		"checkbox", "chkMeetingHistory",
+		"tooltip", "chkMeetingHistory",
+		"label", "lblNoPastMeetings",
+		"button", "btnClearHistory",
+		"button", "btnCloseHistory",
+	)
+
+	w := &historyWindow{u: u}
+
+	builder.getItems(
+		"historyWindow", &w.dialog,
+		"chkMeetingHistory", &w.chkEnabled,
+		"lblHistoryStorage", &w.lblStorage,
+		"lblNoPastMeetings", &w.lblEmpty,
+		"boxPastMeetings", &w.box,
+		"btnClearHistory", &w.btnClear,
+	)
+
+	w.chkEnabled.SetActive(u.config.IsMeetingHistoryEnabled())
+	w.lblStorage.SetText(historyStorageText(u.config.IsPersistentConfiguration(), u.config.ShouldEncrypt()))
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_history_toggled": w.onToggled,
+		"on_clear":           w.clear,
+		"on_close":           w.close,
+	})
+
+	w.refresh()
+
+	if u.currentWindow != nil {
+		w.dialog.SetTransientFor(u.currentWindow)
+	}
+
+	u.doInUIThread(func() {
+		u.disableCurrentWindow()
+		w.dialog.Show()
+	})
+}
+
+func (w *historyWindow) close() {
+	w.dialog.Destroy()
+	w.u.enableCurrentWindow()
+}
+
+func (w *historyWindow) onToggled() {
+	enabled := w.chkEnabled.GetActive()
+	if enabled == w.u.config.IsMeetingHistoryEnabled() {
+		return
+	}
+
+	w.u.config.EnableMeetingHistory(enabled)
+	w.u.saveConfigOnly()
+	w.refresh()
+}
+
+func (w *historyWindow) clear() {
+	w.u.showConfirmation(func(ok bool) {
+		if !ok {
+			return
+		}
+		w.u.config.ClearPastMeetings()
+		w.u.saveConfigOnly()
+		w.refresh()
+	}, i18n().Sprintf("All the past meetings will be forgotten."))
+}
+
+func (w *historyWindow) forget(i int) {
+	w.u.config.RemovePastMeeting(i)
+	w.u.saveConfigOnly()
+	w.refresh()
+}
+
+// refresh shows the meetings in the history, which are
+// shown again every time one of them is forgotten
+func (w *historyWindow) refresh() {
+	for _, row := range w.rows {
+		w.box.Remove(row)
+	}
+	w.rows = nil
+
+	meetings := w.u.config.GetPastMeetings()
+	for i, m := range meetings {
+		if row := w.newRow(i, m); row != nil {
+			w.rows = append(w.rows, row)
+		}
+	}
+
+	w.lblEmpty.SetVisible(len(meetings) == 0)
+	w.btnClear.SetSensitive(len(meetings) > 0)
+}
+
+func (w *historyWindow) newRow(i int, m config.PastMeeting) gtki.Box {
+	box, err := w.u.g.gtk.BoxNew(gtki.HorizontalOrientation, 10)
+	if err != nil {
+		log.Debugf("historyWindow.newRow(): %s", err)
+		return nil
+	}
+
+	labels, _ := w.u.g.gtk.BoxNew(gtki.VerticalOrientation, 2)
+	lblName, _ := w.u.g.gtk.LabelNew(pastMeetingName(m))
+	lblName.SetHAlign(gtki.ALIGN_START)
+	lblDescription, _ := w.u.g.gtk.LabelNew(pastMeetingDescription(m))
+	lblDescription.SetHAlign(gtki.ALIGN_START)
+	labels.PackStart(lblName, false, false, 0)
+	labels.PackStart(lblDescription, false, false, 0)
+
+	again := i18n().Sprintf("Join again")
+	if m.Hosted {
+		again = i18n().Sprintf("Host again")
+	}
+	btnAgain, _ := w.u.g.gtk.ButtonNewWithLabel(again)
+	_ = btnAgain.Connect("clicked", func() {
+		w.close()
+		w.u.openPastMeeting(m)
+	})
+
+	btnForget, _ := w.u.g.gtk.ButtonNewWithLabel(i18n().Sprintf("Forget"))
+	btnForget.SetTooltipText(i18n().Sprintf("Remove this meeting from the history"))
+	_ = btnForget.Connect("clicked", func() {
+		w.forget(i)
+	})
+
+	box.PackStart(labels, true, true, 0)
+	box.PackStart(btnAgain, false, false, 0)
+	box.PackStart(btnForget, false, false, 0)
+	w.box.PackStart(box, false, true, 0)
+	box.ShowAll()
+
+	return box
+}
+
+// openPastMeeting joins a past meeting again with the same invitation and
+// name, or hosts it again with the same title, agenda, audio quality and
+// name. A hosted meeting only keeps its address with a persistent onion
+func (u *gtkUI) openPastMeeting(m config.PastMeeting) {
+	if m.Hosted {
+		u.config.SetMeetingName(m.Title)
+		u.config.SetMeetingAgenda(m.Agenda)
+		u.config.SetAudioProfile(m.AudioProfile)
+		u.saveConfigOnly()
+
+		go u.realHostMeetingHandler(m.Username)
+		return
+	}
+
+	data, ok := u.meetingDataFor(m.Address)
+	if !ok {
+		return
+	}
+
+	if m.Username != "" {
+		data.Username = m.Username
+	}
+	if data.Username == "" {
+		data.Username = getRandomName()
+	}
+	data.StartMuted = u.config.ShouldStartMuted()
+	data.StartDeafened = u.config.ShouldStartDeafened()
+
+	u.rememberJoinedMeeting(m.Address, data)
+	go u.joinMeetingHandler(*data)
+}
@@ -0,0 +1,46 @@
+package gui
+
+import (
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+type WahayHistorySuite struct{}
+
+var _ = Suite(&WahayHistorySuite{})
+
+const historyTestOnion = "qvdjpoqcg572ibylv673qr76iwashlazh6spm47ly37w65iwwmkbmtid.onion"
+
+func (s *WahayHistorySuite) Test_pastMeetingName_isTheTitle(c *C) {
+	m := config.PastMeeting{Title: "Weekly meeting", Address: historyTestOnion}
+
+	c.Assert(pastMeetingName(m), Equals, "Weekly meeting")
+}
+
+func (s *WahayHistorySuite) Test_pastMeetingName_doesNotShowTheWholeInvitation(c *C) {
+	m := config.PastMeeting{Address: "mumble://guest:secret@" + historyTestOnion + ":64738"}
+
+	name := pastMeetingName(m)
+	c.Assert(strings.HasPrefix(name, historyTestOnion[:16]), Equals, true)
+	c.Assert(strings.Contains(name, "secret"), Equals, false)
+	c.Assert(strings.Contains(name, historyTestOnion), Equals, false)
+}
+
+func (s *WahayHistorySuite) Test_pastMeetingDescription_tellsWhetherItWasHosted(c *C) {
+	when := time.Date(2024, 3, 1, 10, 30, 0, 0, time.Local)
+
+	c.Assert(pastMeetingDescription(config.PastMeeting{Time: when, Username: "Ana"}),
+		Equals, "Joined on 2024-03-01 10:30 as Ana")
+	c.Assert(pastMeetingDescription(config.PastMeeting{Time: when, Username: "Ana", Hosted: true}),
+		Equals, "Hosted on 2024-03-01 10:30 as Ana")
+}
+
+func (s *WahayHistorySuite) Test_historyStorageText_dependsOnHowTheSettingsAreKept(c *C) {
+	c.Assert(historyStorageText(false, false), Matches, ".*forgotten when Wahay closes.*")
+	c.Assert(historyStorageText(true, false), Matches, ".*without encryption.*")
+	c.Assert(historyStorageText(true, true), Matches, ".*encrypted settings file.*")
+}
@@ -0,0 +1,379 @@
+package gui
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+// dashboardInterval is how often the timer and the
+// waiting room of the dashboard are updated
+const dashboardInterval = time.Second
+
+// meetingTimerText returns how long the meeting has lasted
+func meetingTimerText(d time.Duration) string {
+	d = d.Truncate(time.Second)
+	h := int(d / time.Hour)
+	m := int(d % time.Hour / time.Minute)
+	s := int(d % time.Minute / time.Second)
+	return i18n().Sprintf("Meeting time: %s", fmt.Sprintf("%02d:%02d:%02d", h, m, s))
+}
+
+// waitingChanges returns the guests that are not waiting anymore
+// and the ones that arrived, since the shown ones were shown
+func waitingChanges(shown, waiting []int) (gone, arrived []int) {
+	current := make(map[int]bool, len(waiting))
+	for _, id := range waiting {
+		current[id] = true
+	}
+
+	before := make(map[int]bool, len(shown))
+	for _, id := range shown {
+		before[id] = true
+		if !current[id] {
+			gone = append(gone, id)
+		}
+	}
+
+	for _, id := range waiting {
+		if !before[id] {
+			arrived = append(arrived, id)
+		}
+	}
+
+	return gone, arrived
+}
+
+// hostDashboard brings together what the host needs to run
+// the meeting: the time, the waiting room, the participants and
+// the bans, so they are not spread over several windows
+type hostDashboard struct {
+	h       *hostData
+	serv    hosting.Server
+	builder *uiBuilder
+	dialog  gtki.Window
+
+	lblTimer   gtki.Label
+	lblNobody  gtki.Label
+	boxWaiting gtki.Box
+	lblMessage gtki.Label
+
+	entRecordingPassword gtki.Entry
+	btnRecord            gtki.Button
+
+	waiting map[int]gtki.Box
+
+	// previousRoster is the window that showed the participants before
+	previousRoster *uiBuilder
+
+	// stopTranslating stops translating the texts
+	// of the dashboard when the language changes
+	stopTranslating func()
+
+	done chan bool
+	once sync.Once
+}
+
+func (h *hostData) openDashboard() {
+	builder := h.u.g.uiBuilderFor("HostDashboardWindow")
+
+	builder.i18nProperties(
+		"title", "hostDashboardWindow",
+		"label", "lblWaitingRoom",
+		"label", "lblNobodyWaiting",
+		"label", "lblParticipants",
+		"label", "lblNoParticipants",
+		"label", "lblRecording",
+		"label", "lblRecordingHelp",
+		"placeholder", "entRecordingPassword",
+		"button", "btnCloseDashboard",
+	)
+
+	d := &hostDashboard{
+		h:              h,
+		serv:           h.service.Server(),
+		builder:        builder,
+		waiting:        make(map[int]gtki.Box),
+		previousRoster: h.rosterBuilder,
+		done:           make(chan bool),
+	}
+
+	builder.getItems(
+		"hostDashboardWindow", &d.dialog,
+		"lblMeetingTimer", &d.lblTimer,
+		"lblNobodyWaiting", &d.lblNobody,
+		"boxWaiting", &d.boxWaiting,
+		"lblDashboardMessage", &d.lblMessage,
+		"entRecordingPassword", &d.entRecordingPassword,
+		"btnRecord", &d.btnRecord,
+	)
+
+	builder.get("boxWaitingRoom").(gtki.Box).SetVisible(d.serv != nil && h.u.config.IsWaitingRoomEnabled())
+	builder.get("boxRecording").(gtki.Box).SetVisible(d.serv != nil)
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_record": d.toggleRecording,
+		"on_close":  d.close,
+	})
+
+	d.showRecording()
+	d.update()
+	h.showParticipantRoster(builder, d.ban)
+	d.watch()
+	d.stopTranslating = onLanguageChange(d.translate)
+	h.dashboard = d
+
+	if h.u.currentWindow != nil {
+		d.dialog.SetTransientFor(h.u.currentWindow)
+	}
+
+	h.u.doInUIThread(func() {
+		h.u.disableCurrentWindow()
+		d.dialog.Show()
+	})
+}
+
+// watch updates the dashboard every dashboardInterval and
+// every time somebody arrives to the waiting room or leaves it
+func (d *hostDashboard) watch() {
+	var events <-chan hosting.ParticipantEvent
+	stopEvents := func() {}
+	if d.serv != nil {
+		events, stopEvents = d.serv.Subscribe()
+	}
+
+	go func() {
+		defer stopEvents()
+
+		t := time.NewTicker(dashboardInterval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-d.done:
+				return
+			case <-t.C:
+			case _, ok := <-events:
+				if !ok {
+					events = nil
+				}
+			}
+
+			d.h.u.doInUIThread(func() {
+				select {
+				case <-d.done:
+				default:
+					d.update()
+				}
+			})
+		}
+	}()
+}
+
+func (d *hostDashboard) update() {
+	d.lblTimer.SetText(meetingTimerText(time.Since(d.h.startedAt)))
+
+	if d.serv == nil || !d.h.u.config.IsWaitingRoomEnabled() {
+		return
+	}
+
+	shown := []int{}
+	for id := range d.waiting {
+		shown = append(shown, id)
+	}
+	sort.Ints(shown)
+
+	gone, arrived := waitingChanges(shown, d.serv.Waiting())
+	for _, id := range gone {
+		d.boxWaiting.Remove(d.waiting[id])
+		delete(d.waiting, id)
+	}
+	for _, id := range arrived {
+		if row := d.newWaitingRow(id); row != nil {
+			d.waiting[id] = row
+		}
+	}
+
+	d.lblNobody.SetVisible(len(d.waiting) == 0)
+}
+
+// translate shows the texts set from the code in the language chosen,
+// making the rows of the waiting room again
+func (d *hostDashboard) translate() {
+	for id, row := range d.waiting {
+		d.boxWaiting.Remove(row)
+		delete(d.waiting, id)
+	}
+
+	d.showRecording()
+	d.update()
+}
+
+func (d *hostDashboard) newWaitingRow(id int) gtki.Box {
+	box, err := d.h.u.g.gtk.BoxNew(gtki.HorizontalOrientation, 10)
+	if err != nil {
+		log.Debugf("hostDashboard.newWaitingRow(): %s", err)
+		return nil
+	}
+
+	lblName, _ := d.h.u.g.gtk.LabelNew(i18n().Sprintf("Guest %d is waiting", id))
+	lblName.SetHAlign(gtki.ALIGN_START)
+
+	btnAdmit, _ := d.h.u.g.gtk.ButtonNewWithLabel(i18n().Sprintf("Admit"))
+	_ = btnAdmit.Connect("clicked", func() {
+		d.answer(id, true)
+	})
+
+	btnReject, _ := d.h.u.g.gtk.ButtonNewWithLabel(i18n().Sprintf("Reject"))
+	_ = btnReject.Connect("clicked", func() {
+		d.answer(id, false)
+	})
+
+	box.PackStart(lblName, true, true, 0)
+	box.PackStart(btnAdmit, false, false, 0)
+	box.PackStart(btnReject, false, false, 0)
+	d.boxWaiting.PackStart(box, false, true, 0)
+	box.ShowAll()
+
+	return box
+}
+
+// answer admits or rejects the guest, and closes the question
+// about them, since it was already answered here
+func (d *hostDashboard) answer(id int, admit bool) {
+	d.h.closeAdmissionDialog(id)
+
+	var err error
+	if admit {
+		err = d.serv.Admit(id)
+	} else {
+		err = d.serv.Reject(id)
+	}
+
+	// The participant may have given up in the meantime
+	if err != nil {
+		log.Debugf("hostDashboard.answer(): %s", err)
+	}
+
+	d.update()
+}
+
+// ban keeps the user out of this meeting and the next ones, by the
+// certificate hash of their Mumble client. They are kicked if connected
+func (d *hostDashboard) ban(u hosting.User) {
+	go func() {
+		err := d.serv.Ban(u.CertHash, 0)
+
+		d.h.u.doInUIThread(func() {
+			if err != nil {
+				log.Errorf("The participant can't be banned: %s", err)
+				d.showMessage(i18n().Sprintf("%s can't be banned: %s", u.Name, err))
+				return
+			}
+			d.showMessage(i18n().Sprintf("%s is banned from this meeting and the next ones", u.Name))
+		})
+	}()
+}
+
+// recordingButtonText is what the button that starts
+// and stops the recording does next
+func recordingButtonText(recording bool) string {
+	if recording {
+		return i18n().Sprintf("Stop recording")
+	}
+	return i18n().Sprintf("Start recording")
+}
+
+func (d *hostDashboard) showRecording() {
+	if d.serv == nil {
+		return
+	}
+
+	recording := d.serv.IsRecording()
+	d.btnRecord.SetLabel(recordingButtonText(recording))
+	d.entRecordingPassword.SetSensitive(!recording)
+}
+
+// toggleRecording stops the recording, or asks where to save
+// a new one, encrypted with the password in the dashboard
+func (d *hostDashboard) toggleRecording() {
+	if d.serv.IsRecording() {
+		if err := d.serv.StopRecording(); err != nil {
+			log.Errorf("The recording can't be stopped: %s", err)
+			d.showMessage(i18n().Sprintf("The recording can't be stopped: %s", err))
+		} else {
+			d.showMessage(i18n().Sprintf("The recording was stopped and the participants were told"))
+		}
+		d.showRecording()
+		return
+	}
+
+	password, _ := d.entRecordingPassword.GetText()
+	if password == "" {
+		d.showMessage(i18n().Sprintf("Type the password the recording is encrypted with"))
+		return
+	}
+
+	d.btnRecord.SetSensitive(false)
+
+	go func() {
+		ok, path := d.h.u.chooseFile(gtki.FILE_CHOOSER_ACTION_SAVE, i18n().Sprintf("meeting")+hosting.RecordingFileExtension)
+
+		var err error
+		if ok {
+			err = d.serv.StartRecording(path, password)
+		}
+
+		d.h.u.doInUIThread(func() {
+			select {
+			case <-d.done:
+				return
+			default:
+			}
+
+			d.btnRecord.SetSensitive(true)
+			switch {
+			case !ok:
+			case err != nil:
+				log.Errorf("The recording can't be started: %s", err)
+				d.showMessage(i18n().Sprintf("The recording can't be started: %s", err))
+			default:
+				d.entRecordingPassword.SetText("")
+				d.showMessage(i18n().Sprintf("The meeting is being recorded and the participants were told"))
+			}
+			d.showRecording()
+		})
+	}()
+}
+
+func (d *hostDashboard) showMessage(message string) {
+	d.lblMessage.SetVisible(false)
+	go d.h.u.messageToLabel(d.lblMessage, message, 5)
+}
+
+// close gives the participants back to the window that showed them
+// before, unless another window took them while the dashboard was open
+func (d *hostDashboard) close() {
+	d.once.Do(func() {
+		d.stopTranslating()
+		close(d.done)
+
+		if d.h.rosterBuilder == d.builder {
+			if d.previousRoster != nil {
+				d.h.showParticipantRoster(d.previousRoster, nil)
+			} else {
+				d.h.hideParticipantRoster()
+			}
+		}
+
+		d.h.dashboard = nil
+		d.dialog.Destroy()
+		d.h.u.enableCurrentWindow()
+	})
+}
@@ -0,0 +1,36 @@
+package gui
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type WahayHostDashboardSuite struct{}
+
+var _ = Suite(&WahayHostDashboardSuite{})
+
+func (s *WahayHostDashboardSuite) Test_meetingTimerText_showsHoursMinutesAndSeconds(c *C) {
+	c.Assert(meetingTimerText(0), Equals, "Meeting time: 00:00:00")
+	c.Assert(meetingTimerText(90*time.Second+300*time.Millisecond), Equals, "Meeting time: 00:01:30")
+	c.Assert(meetingTimerText(26*time.Hour+3*time.Minute+4*time.Second), Equals, "Meeting time: 26:03:04")
+}
+
+func (s *WahayHostDashboardSuite) Test_waitingChanges_returnsTheGuestsThatLeftAndArrived(c *C) {
+	gone, arrived := waitingChanges([]int{1, 2, 3}, []int{2, 4})
+
+	c.Assert(gone, DeepEquals, []int{1, 3})
+	c.Assert(arrived, DeepEquals, []int{4})
+}
+
+func (s *WahayHostDashboardSuite) Test_waitingChanges_returnsNothingWhenNobodyChanged(c *C) {
+	gone, arrived := waitingChanges([]int{5}, []int{5})
+
+	c.Assert(gone, IsNil)
+	c.Assert(arrived, IsNil)
+}
+
+func (s *WahayHostDashboardSuite) Test_recordingButtonText_saysWhatTheButtonDoesNext(c *C) {
+	c.Assert(recordingButtonText(false), Equals, "Start recording")
+	c.Assert(recordingButtonText(true), Equals, "Stop recording")
+}
@@ -1,14 +1,20 @@
 package gui
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
 	"fmt"
+	"image/png"
 	"math/rand"
 	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/coyim/gotk3adapter/gdki"
 	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/config"
 	"github.com/digitalautonomy/wahay/hosting"
 	"github.com/digitalautonomy/wahay/tor"
 )
@@ -22,38 +28,67 @@ type hostData struct {
 	autoJoin          bool
 	meetingUsername   string
 	meetingPassword   string
+	audioProfile      hosting.AudioProfile
 	currentWindow     gtki.Window
 	next              func()
+	tor               tor.Instance
+
+	// admissionDialogs are the questions to admit the
+	// participants in the waiting room, by their ID
+	admissionDialogs map[int]gtki.Window
+	stopWaitingRoom  func()
+
+	stopParticipantHooks []func()
+
+	// stopRoster stops updating the participants
+	// shown in the current window of the host,
+	// which is the one of rosterBuilder
+	stopRoster    func()
+	rosterBuilder *uiBuilder
+
+	// startedAt is when the meeting started, and dashboard
+	// is the dashboard of the meeting, when it's open
+	startedAt time.Time
+	dashboard *hostDashboard
+	// chat keeps the messages of the meeting
+	chat *hostChat
+
+	// crashes is how many times in a row the client
+	// crashed shortly after the host joined at joinedAt
+	crashes  int
+	joinedAt time.Time
 }
 
 func (u *gtkUI) hostMeetingHandler() {
-	go u.realHostMeetingHandler()
+	go u.realHostMeetingHandler("")
 }
 
-func (u *gtkUI) realHostMeetingHandler() {
+// realHostMeetingHandler creates a meeting and shows its configuration,
+// with the name of the host filled in when it's not empty
+func (u *gtkUI) realHostMeetingHandler(username string) {
 	u.hideMainWindow()
 	u.displayLoadingWindow()
 
-	if u.servers == nil {
-		var err error
-		u.servers, err = hosting.CreateServerCollection()
-		if err != nil {
-			// TODO: should we check if u.servers !== nil here?
-			u.reportError(i18n().Sprintf("Something went wrong: %s", err))
-			u.switchToMainWindow()
-			return
-		}
+	if err := u.ensureServerCollection(); err != nil {
+		u.reportError(i18n().Sprintf("Something went wrong: %s", err))
+		u.switchToMainWindow()
+		return
 	}
 
 	h := &hostData{
-		u:           u,
-		asSuperUser: u.config.GetAsSuperUser(),
-		autoJoin:    u.config.GetAutoJoin(),
-		next:        nil,
+		u:                 u,
+		asSuperUser:       u.config.GetAsSuperUser(),
+		superUserPassword: u.superUserPassword(),
+		autoJoin:          u.config.GetAutoJoin(),
+		meetingUsername:   username,
+		next:              nil,
+		// The quality chosen for the last meeting is the default one
+		audioProfile: hosting.ParseAudioProfile(u.config.GetAudioProfile()),
 	}
 
 	echan := make(chan error)
 
+	u.showMeetingPublishing()
 	go h.createNewService(echan)
 
 	err := <-echan
@@ -70,6 +105,80 @@ func (u *gtkUI) realHostMeetingHandler() {
 	u.doInUIThread(h.showMeetingConfiguration)
 }
 
+// ensureServerCollection creates the collection of Mumble servers the first
+// time it's needed. It's kept until Wahay closes, since grumble can't be
+// initialized more than once
+func (u *gtkUI) ensureServerCollection() error {
+	if u.servers != nil {
+		return nil
+	}
+
+	servers, err := u.createServerCollection()
+	if err != nil {
+		return err
+	}
+	u.servers = servers
+	u.onExit(servers.Cleanup)
+
+	info := servers.Info()
+	log.WithFields(log.Fields{
+		"grumble":       info.GrumbleVersion,
+		"protocol":      info.ProtocolVersion,
+		"codecs":        info.Codecs,
+		"minimumClient": info.MinimumClientVersion,
+	}).Info("Hosting meetings with grumble")
+
+	return nil
+}
+
+// createServerCollection reuses the stored certificate of the server when
+// the host wants to keep it, and stores the new one when there is none yet
+func (u *gtkUI) createServerCollection() (hosting.Servers, error) {
+	persistent := u.config.IsPersistentCertificateEnabled()
+
+	opts := []hosting.CollectionOption{
+		hosting.WithCertificateAlgorithm(hosting.ParseCertificateAlgorithm(u.config.GetCertificateAlgorithm())),
+	}
+	if dir := u.config.GetHostingDataDirectory(); dir != "" {
+		opts = append(opts, hosting.WithDataDirectory(dir))
+	}
+	if u.config.IsMemoryStorageEnabled() {
+		opts = append(opts, hosting.WithMemoryStorage())
+	}
+
+	reused := false
+	cert, key := u.config.GetServerCertificate()
+	if persistent && cert != "" {
+		// A broken certificate is replaced, since grumble
+		// can't be initialized again to try without it
+		if _, err := tls.X509KeyPair([]byte(cert), []byte(key)); err != nil {
+			log.Errorf("createServerCollection(): the stored certificate can't be used: %s", err)
+		} else {
+			opts = append(opts, hosting.WithCertificate([]byte(cert), []byte(key)))
+			reused = true
+		}
+	}
+
+	servers, err := hosting.CreateServerCollection(opts...)
+	if err != nil || !persistent || reused {
+		return servers, err
+	}
+
+	newCert, newKey, err := servers.Certificate()
+	if err != nil {
+		log.Errorf("createServerCollection(): %s", err)
+		return servers, nil
+	}
+
+	if !u.config.ShouldEncrypt() {
+		log.Warnf("The key of the server certificate is stored in a configuration file that is not encrypted")
+	}
+	u.config.SetServerCertificate(string(newCert), string(newKey))
+	u.saveConfigOnly()
+
+	return servers, nil
+}
+
 func (h *hostData) showMeetingControls() {
 	builder := h.u.g.uiBuilderFor("StartHostingWindow")
 	win := builder.get("startHostingWindow").(gtki.ApplicationWindow)
@@ -92,12 +201,21 @@ func (h *hostData) showMeetingControls() {
 		"button", "btnFinishMeeting",
 		"button", "btnJoinMeeting",
 		"button", "btnInviteOthers",
+		"button", "btnDashboard",
+		"tooltip", "btnDashboard",
+		"button", "btnChat",
+		"tooltip", "btnChat",
+		"button", "btnJoinAnother",
+		"tooltip", "btnJoinAnother",
 		"button", "btnCopyMeetingID",
 		"tooltip", "btnJoinMeeting",
 		"tooltip", "btnInviteOthers",
 		"tooltip", "btnFinishMeeting")
 
 	builder.ConnectSignals(map[string]interface{}{
+		"on_delete_window": func() bool {
+			return h.onDeleteHostWindow(win)
+		},
 		"on_close_window_signal": h.finishMeetingReal,
 		"on_finish_meeting":      h.finishMeeting,
 		"on_join_meeting": func() {
@@ -107,6 +225,9 @@ func (h *hostData) showMeetingControls() {
 		"on_invite_others": func() {
 			h.onInviteParticipants(onInviteOpen, onInviteClose)
 		},
+		"on_open_dashboard": h.openDashboard,
+		"on_open_chat":      h.openChat,
+		"on_join_another":   h.openJoinAnotherMeeting,
 		"on_copy_meeting_id": func() {
 			h.copyMeetingIDToClipboard(builder, "")
 		},
@@ -123,6 +244,8 @@ func (h *hostData) showMeetingControls() {
 	_ = lblValuePassword.SetProperty("label", h.meetingPassword)
 	_ = lblValueMeetingID.SetProperty("label", h.service.ID())
 	h.u.connectShortcutsStartHostingWindow(win, h)
+	h.showParticipantRoster(builder, nil)
+	h.u.tray.showMeeting(trayHostingMeeting, h.copyInvitationFromTray, h.finishMeeting)
 	h.u.switchToWindow(win)
 }
 
@@ -153,25 +276,35 @@ func (h *hostData) joinMeetingHostHelper(validOpChannel chan bool) {
 			}
 			return h.meetingPassword
 		}(),
-		Username: h.meetingUsername,
-		IsHost:   true,
+		Username:      h.meetingUsername,
+		IsHost:        true,
+		ClientAuthKey: h.service.ClientAuthKey(),
+		StartMuted:    h.u.config.ShouldStartMuted(),
+		StartDeafened: h.u.config.ShouldStartDeafened(),
 	}
 
 	var err error
 	var mumble tor.Service
 
 	finish := make(chan bool)
+	launched := make(chan struct{})
+	h.joinedAt = time.Now()
 
 	go func() {
 		mumble, err = h.u.launchMumbleClient(
 			data,
 			// Callback to be executed when the client is closed
 			func() {
+				<-launched
+				if h.next == nil && mumble.Crashed() {
+					h.next = h.onMumbleCrash
+				}
 				if h.next == nil {
 					h.next = h.uiActionFinishMeeting
 				}
 				h.switchToHostOnFinishMeeting()
 			})
+		close(launched)
 
 		finish <- true
 	}()
@@ -191,6 +324,10 @@ func (h *hostData) joinMeetingHostHelper(validOpChannel chan bool) {
 
 func (h *hostData) switchToHostOnFinishMeeting() {
 	h.u.doInUIThread(func() {
+		// The client is closed, so there is nothing to close
+		// if the meeting finishes without the host
+		h.mumble = nil
+
 		if h.next != nil {
 			h.next()
 			h.next = nil
@@ -207,7 +344,17 @@ func (u *gtkUI) getCurrentHostMeetingWindow() *uiBuilder {
 		"tooltip", "btnFinishMeeting",
 		"tooltip", "btnLeaveMeeting",
 		"button", "btnInviteOthers",
+		"button", "btnVerifyMeeting",
+		"tooltip", "btnVerifyMeeting",
+		"button", "btnDashboard",
+		"tooltip", "btnDashboard",
+		"button", "btnChat",
+		"tooltip", "btnChat",
+		"button", "btnJoinAnother",
+		"tooltip", "btnJoinAnother",
 		"label", "lblTipPush",
+		"tooltip", "lblNetworkActivity",
+		"tooltip", "lblConnectionQuality",
 	)
 
 	return builder
@@ -218,6 +365,7 @@ func (h *hostData) openHostJoinMeetingWindow() {
 
 	builder := h.u.getCurrentHostMeetingWindow()
 	win := builder.get("hostMeetingWindow").(gtki.ApplicationWindow)
+	controls := h.u.newCallControls(builder, h.u.client.RemoteControl())
 	onInviteOpen := func(d gtki.Window) {
 		h.currentWindow = d
 		// Hide the current window because we don't want
@@ -230,7 +378,14 @@ func (h *hostData) openHostJoinMeetingWindow() {
 		h.currentWindow = nil
 	}
 
+	invite := func() {
+		h.onInviteParticipants(onInviteOpen, onInviteClose)
+	}
+
 	builder.ConnectSignals(map[string]interface{}{
+		"on_delete_window": func() bool {
+			return h.onDeleteHostWindow(win)
+		},
 		"on_close_window_signal": func() {
 			h.leaveHostMeeting()
 			h.finishMeetingReal()
@@ -238,12 +393,26 @@ func (h *hostData) openHostJoinMeetingWindow() {
 		},
 		"on_leave_meeting":  h.leaveHostMeeting,
 		"on_finish_meeting": h.finishMeetingMumble,
-		"on_invite_others": func() {
-			h.onInviteParticipants(onInviteOpen, onInviteClose)
-		},
+		"on_invite_others":  invite,
+		"on_verify_meeting": h.openVerificationWindow,
+		"on_open_dashboard": h.openDashboard,
+		"on_open_chat":      h.openChat,
+		"on_join_another":   h.openJoinAnotherMeeting,
+		"on_toggle_mute":    controls.onToggleMute,
+		"on_toggle_deafen":  controls.onToggleDeafen,
 	})
 
-	h.u.connectShortcutsCurrentHostMeetingWindow(win, h)
+	h.u.connectShortcutsCurrentHostMeetingWindow(win, h, invite)
+	h.u.connectShortcutsCallControls(win, controls)
+	h.showParticipantRoster(builder, nil)
+
+	stopWatchingNetwork := h.u.watchNetworkActivity(builder.get("lblNetworkActivity").(gtki.Label))
+	if h.mumble != nil {
+		h.mumble.OnClose(stopWatchingNetwork)
+		h.mumble.OnClose(h.u.watchConnectionQuality(builder.get("lblConnectionQuality").(gtki.Label),
+			h.service.ID(), h.service.Server()))
+		h.mumble.OnClose(controls.watch())
+	}
 
 	h.u.switchToWindow(win)
 }
@@ -253,10 +422,33 @@ func (h *hostData) uiActionLeaveMeeting() {
 	h.showMeetingControls()
 }
 
+// onMumbleCrash keeps the meeting running when the client of the
+// host crashes, and joins it again or goes back to the meeting controls
+func (h *hostData) onMumbleCrash() {
+	h.crashes = rejoinAttempt(h.crashes, h.joinedAt, time.Now())
+	h.u.onMumbleCrash(h.crashes, func() {
+		go h.joinMeetingHost()
+	}, h.uiActionLeaveMeeting)
+}
+
 func (h *hostData) uiActionFinishMeeting() {
 	h.finishMeetingReal()
 }
 
+// inviteeNames returns the usernames for the invitations of the meeting,
+// the configured ones or else one of the default names per invitation
+func (h *hostData) inviteeNames() []string {
+	if names := h.u.config.GetInviteeNames(); len(names) > 0 {
+		return names
+	}
+
+	n := config.DefaultClientAuthInvitees
+	if h.u.config.GetClientAuthorization() {
+		n = h.u.config.GetClientAuthInvitees()
+	}
+	return hosting.DefaultInviteeNames(n)
+}
+
 func (h *hostData) createNewService(err chan error) {
 	var port string
 
@@ -265,22 +457,143 @@ func (h *hostData) createNewService(err chan error) {
 		port = configuredPort
 	}
 
+	opts := []hosting.ServiceOption{
+		hosting.WithKeepAlive(h.u.config.GetKeepAlive().TCPPeriod),
+		hosting.WithBanList(configBanList{h.u}),
+		hosting.WithQueueTexts(hosting.QueueTexts{
+			Place: func(place int) string {
+				return i18n().Sprintf("The meeting is full. You are number %d in the queue, and will join it as soon as somebody leaves.", place)
+			},
+			Admitted: i18n().Sprintf("It's your turn. Mumble will take you into the meeting in a few seconds."),
+		}),
+	}
+	if h.u.config.GetClientAuthorization() {
+		opts = append(opts, hosting.WithClientAuthorization(h.u.config.GetClientAuthInvitees()))
+	}
+	if coHost := h.u.config.GetCoHostCertificate(); coHost != "" {
+		opts = append(opts, hosting.WithCoHost(coHost))
+	}
+	if maxUsers := h.u.config.GetMaxUsers(); maxUsers > 0 {
+		opts = append(opts, hosting.WithMaxUsers(maxUsers))
+	}
+	if h.u.config.IsWaitingRoomEnabled() {
+		opts = append(opts, hosting.WithWaitingRoom())
+	}
+	if h.u.config.IsWebGatewayEnabled() {
+		opts = append(opts, hosting.WithWebGateway(h.u.config.GetMumbleWebPath()))
+	}
+	if address := h.u.config.GetListenAddress(); address != "" {
+		opts = append(opts, hosting.WithListenAddress(address))
+	}
+	if socket := h.u.config.GetListenSocket(); socket != "" {
+		opts = append(opts, hosting.WithUnixSocket(socket))
+	}
+	if idle := h.u.config.GetIdleShutdown(); idle > 0 {
+		opts = append(opts, hosting.WithIdleShutdown(idle, h.onIdleShutdown))
+	}
+	if lifetime := h.u.config.GetInvitationLifetime(); lifetime > 0 {
+		opts = append(opts, hosting.WithSignedInvitations(lifetime))
+	}
+	if h.u.config.AreNamedInvitationsEnabled() {
+		opts = append(opts, hosting.WithInviteeNames(h.inviteeNames()...))
+	}
+
 	h.u.waitForTorInstance(func(t tor.Instance) {
-		s, e := h.u.servers.NewService(port, t)
+		if h.u.config.GetSingleHopHosting() {
+			var e error
+			t, e = h.u.getSingleHopTorInstance()
+			if e != nil {
+				log.Errorf("createNewService(): %s", e)
+				err <- e
+				return
+			}
+		}
+
+		key, e := h.u.onionKeyForMeeting()
 		if e != nil {
 			log.Errorf("createNewService(): %s", e)
 			err <- e
 			return
 		}
+		if key != nil {
+			opts = append(opts, hosting.WithOnionKey(key))
+		}
 
-		s.SetWelcomeText(i18n().Sprintf("Welcome to this server running <b>Wahay</b>."))
+		s, e := h.u.servers.NewService(port, t, opts...)
+		if e != nil {
+			log.Errorf("createNewService(): %s", e)
+			err <- e
+			return
+		}
 
 		h.service = s
+		h.tor = t
+		h.u.currentHost = h
+		h.setMeetingInfo()
 
 		err <- nil
 	})
 }
 
+// onionKeyForMeeting returns the key the meeting has to be published with,
+// or nil if Tor can create a new one. Hosts with a persistent address reuse
+// the stored key, unless they chose a prefix it doesn't have
+func (u *gtkUI) onionKeyForMeeting() (*tor.OnionKey, error) {
+	prefix := u.config.GetVanityOnionPrefix()
+	persistent := u.config.IsPersistentOnionEnabled()
+
+	if persistent {
+		serviceID, privateKey := u.config.GetPersistentOnionKey()
+		key := &tor.OnionKey{ServiceID: serviceID, PrivateKey: privateKey}
+		if privateKey != "" && key.HasVanityPrefix(prefix) {
+			return key, nil
+		}
+	}
+
+	var key *tor.OnionKey
+	var err error
+	switch {
+	case prefix != "":
+		key, err = u.mineOnionKey(prefix)
+	case persistent:
+		key, err = tor.GenerateOnionKey()
+	default:
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if persistent {
+		if !u.config.ShouldEncrypt() {
+			log.Warnf("The key of the meeting address is stored in a configuration file that is not encrypted")
+		}
+		u.config.SetPersistentOnionKey(key.ServiceID, key.PrivateKey)
+		u.saveConfigOnly()
+	}
+
+	return key, nil
+}
+
+// mineOnionKey finds a key for an address that starts with the prefix
+// chosen by the host. It can take some minutes, and it stops if Wahay
+// is closed meanwhile
+func (u *gtkUI) mineOnionKey(prefix string) (*tor.OnionKey, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	u.onExit(cancel)
+
+	log.Infof("Looking for an onion address starting with %q", prefix)
+	key, err := tor.MineOnionKey(ctx, prefix, 0)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Found the onion address %s", key.ServiceID)
+
+	return key, nil
+}
+
 func (h *hostData) createNewConferenceRoom(complete chan bool) {
 	var su hosting.SuperUserData
 	if h.asSuperUser {
@@ -306,6 +619,17 @@ func (h *hostData) finishMeetingReal() {
 	// We need to do a better controlling for each error
 	// and if multiple errors occurrs, show all the errors in the
 	// same window using the `u.reportError` function
+	if h.dashboard != nil {
+		h.dashboard.close()
+	}
+	if h.stopWaitingRoom != nil {
+		h.stopWaitingRoom()
+		h.stopWaitingRoom = nil
+	}
+	h.stopWatchingParticipants()
+	h.stopWatchingChat()
+	h.hideParticipantRoster()
+
 	err := h.service.Close()
 	if err != nil {
 		h.u.reportError(i18n().Sprintf("The meeting can't be closed: %s", err))
@@ -316,11 +640,58 @@ func (h *hostData) finishMeetingReal() {
 		h.currentWindow = nil
 	}
 
-	h.u.servers = nil
+	h.u.currentHost = nil
+	h.u.tray.showNoMeeting()
 
 	h.u.switchToMainWindow()
 }
 
+// onTorHealthChanged lets the co-host moderate the meeting while
+// the Tor instance of the meeting doesn't work
+func (h *hostData) onTorHealthChanged(t tor.Instance, e tor.HealthEvent) {
+	if h.tor != t {
+		return
+	}
+
+	var err error
+	switch e {
+	case tor.HealthTorDied, tor.HealthNetworkDown:
+		err = h.service.HandOffModeration(i18n().Sprintf("The host of this meeting lost their connection. " +
+			"The co-host is moderating the meeting until they come back."))
+	case tor.HealthRecovered:
+		err = h.service.RestoreModeration()
+	}
+
+	if err != nil {
+		log.Errorf("onTorHealthChanged(): %s", err)
+	}
+}
+
+// onIdleShutdown goes back to the main window after the meeting
+// was closed for having nobody connected for a long time
+func (h *hostData) onIdleShutdown(err error) {
+	if err != nil {
+		log.Errorf("onIdleShutdown(): %s", err)
+	}
+
+	h.u.doInUIThread(func() {
+		if h.u.currentHost != h {
+			return
+		}
+
+		minutes := int(h.u.config.GetIdleShutdown().Minutes())
+		h.u.reportError(i18n().Sprintf("The meeting was closed because nobody was connected to it for %d minutes", minutes))
+
+		if h.mumble != nil {
+			h.next = h.uiActionFinishMeeting
+			go h.mumble.Close()
+			return
+		}
+
+		h.finishMeetingReal()
+	})
+}
+
 func (h *hostData) finishMeetingMumble() {
 	h.wouldYouConfirmFinishMeeting(func(res bool) {
 		if res {
@@ -369,7 +740,7 @@ func (h *hostData) copyMeetingIDToClipboard(builder *uiBuilder, label string) {
 }
 
 func (h *hostData) copyInvitationToClipboard(builder *uiBuilder) {
-	err := h.u.copyToClipboard(h.getInvitationText())
+	err := h.u.copyToClipboard(h.invitationText("\n"))
 	if err != nil {
 		fatal("clipboard copying error")
 	}
@@ -381,6 +752,14 @@ func (h *hostData) copyInvitationToClipboard(builder *uiBuilder) {
 	}()
 }
 
+// copyInvitationFromTray copies the invitation when
+// there is no window to show the message in
+func (h *hostData) copyInvitationFromTray() {
+	if err := h.u.copyToClipboard(h.invitationText("\n")); err != nil {
+		h.u.reportError(err.Error())
+	}
+}
+
 func (h *hostData) sendInvitationByEmail(builder *uiBuilder) {
 	lnkEmail := builder.get("lnkEmail").(gtki.LinkButton)
 	_ = lnkEmail.SetProperty("uri", h.getInvitationEmailURI())
@@ -420,10 +799,31 @@ func (h *hostData) getInvitationSubject() string {
 }
 
 func (h *hostData) getInvitationText() string {
-	it := i18n().Sprintf("Please join the Wahay meeting with the following details:") + "%0D%0A%0D%0A"
-	if h.service.URL() != "" {
-		it = i18n().Sprintf("%sMeeting ID: %s", it, h.service.URL())
+	return h.invitationText("%0D%0A")
+}
+
+// invitationText returns the text of the invitation, with the
+// lines separated by newline
+func (h *hostData) invitationText(newline string) string {
+	it := i18n().Sprintf("Please join the Wahay meeting with the following details:") + newline + newline
+	if h.service.URL() == "" {
+		return it
 	}
+
+	invitations := h.service.Invitations()
+	if len(invitations) == 1 {
+		it = i18n().Sprintf("%sMeeting ID: %s", it, invitations[0])
+	} else {
+		it = i18n().Sprintf("%sEach invitee must use a different meeting ID:", it)
+		for _, inv := range invitations {
+			it = it + newline + inv
+		}
+	}
+
+	if web := h.service.WebGatewayURL(); web != "" {
+		it = it + newline + newline + i18n().Sprintf("Without Mumble, open this address in Tor Browser: %s", web)
+	}
+
 	return it
 }
 
@@ -446,6 +846,57 @@ func (h *hostData) wouldYouConfirmFinishMeeting(k func(bool)) {
 	k(result)
 }
 
+// keepHostingHelp explains where the window goes when the host
+// keeps the meeting going, which depends on the tray icon
+func keepHostingHelp(trayIcon bool) string {
+	if trayIcon {
+		return i18n().Sprintf("Closing the window ends the meeting for everybody. Keep hosting in the " +
+			"background to hide it, and show it again from the Wahay icon of the system tray.")
+	}
+	return i18n().Sprintf("Closing the window ends the meeting for everybody. Keep hosting in the " +
+		"background to minimize it instead.")
+}
+
+// onDeleteHostWindow asks the host whether to end the meeting or keep
+// hosting it before the window is closed, since closing it takes down
+// the onion service of the meeting. It returns true to keep the window,
+// which is only destroyed when the host chooses to end the meeting
+func (h *hostData) onDeleteHostWindow(win gtki.ApplicationWindow) bool {
+	builder := h.u.g.uiBuilderFor("StartHostingWindow")
+	dialog := builder.get("closeWhileHosting").(gtki.MessageDialog)
+
+	builder.i18nProperties(
+		"text", "closeWhileHosting",
+		"button", "btnCancelClose",
+		"button", "btnEndMeeting",
+		"button", "btnKeepHosting")
+	_ = dialog.SetProperty("secondary_text", keepHostingHelp(h.u.tray != nil))
+
+	dialog.SetTransientFor(win)
+
+	response := gtki.ResponseType(dialog.Run())
+	dialog.Destroy()
+
+	switch response {
+	case gtki.RESPONSE_YES:
+		win.Destroy()
+	case gtki.RESPONSE_ACCEPT:
+		h.keepHostingInBackground(win)
+	}
+
+	return true
+}
+
+// keepHostingInBackground hides the window while the meeting goes on. It's
+// only minimized without the tray icon, which is how it's shown again
+func (h *hostData) keepHostingInBackground(win gtki.ApplicationWindow) {
+	if h.u.tray != nil {
+		win.Hide()
+		return
+	}
+	win.Iconify()
+}
+
 func (u *gtkUI) getConfigureMeetingWindow() *uiBuilder {
 	builder := u.g.uiBuilderFor("ConfigureMeetingWindow")
 
@@ -454,12 +905,16 @@ func (u *gtkUI) getConfigureMeetingWindow() *uiBuilder {
 		"label", "labelUsername",
 		"label", "lblMessage",
 		"label", "labelMeetingPassword",
+		"label", "labelAudioProfile",
+		"tooltip", "cmbAudioProfile",
 		"placeholder", "inpMeetingUsername",
 		"placeholder", "inpMeetingPassword",
 		"checkbox", "chkAutoJoin",
 		"checkbox", "chkAutoJoinSuperUser",
 		"tooltip", "chkAutoJoin",
 		"tooltip", "chkAutoJoinSuperUser",
+		"button", "btnGeneratePassword",
+		"tooltip", "btnGeneratePassword",
 		"button", "btnCopyMeetingID",
 		"button", "btnInviteOthers",
 		"button", "btnCancel",
@@ -474,6 +929,7 @@ func (h *hostData) showMeetingConfiguration() {
 	chkAutoJoin := builder.get("chkAutoJoin").(gtki.CheckButton)
 	chkAutoJoinSuperUser := builder.get("chkAutoJoinSuperUser").(gtki.CheckButton)
 	btnStart := builder.get("btnStartMeeting").(gtki.Button)
+	cmbAudioProfile := builder.get("cmbAudioProfile").(gtki.ComboBoxText)
 
 	onInviteOpen := func(d gtki.Window) {
 		h.currentWindow = d
@@ -490,6 +946,8 @@ func (h *hostData) showMeetingConfiguration() {
 	chkAutoJoin.SetActive(h.autoJoin)
 	chkAutoJoinSuperUser.SetActive(h.asSuperUser)
 	h.changeStartButtonText(btnStart)
+	h.fillAudioProfiles(cmbAudioProfile)
+	builder.get("inpMeetingUsername").(gtki.Entry).SetText(h.meetingUsername)
 
 	btnCopyMeetingID := builder.get("btnCopyMeetingID").(gtki.Button)
 	btnCopyMeetingID.SetVisible(h.u.isCopyToClipboardSupported())
@@ -512,6 +970,12 @@ func (h *hostData) showMeetingConfiguration() {
 		"on_chkAutoJoinSuperUser_toggled": func() {
 			h.handlerOnAutoJoinSuperUserToggled(chkAutoJoinSuperUser)
 		},
+		"on_audio_profile_changed": func() {
+			h.handlerOnAudioProfileChanged(cmbAudioProfile)
+		},
+		"on_generate_password": func() {
+			h.handlerOnGeneratePassword(builder.get("inpMeetingPassword").(gtki.Entry))
+		},
 	})
 
 	h.u.connectShortcutsHostingMeetingConfigurationWindow(win, builder, h)
@@ -540,35 +1004,140 @@ func (h *hostData) seti18nProperties(b *uiBuilder) {
 		"label", "lblMessage")
 }
 
+// handlerOnGeneratePassword fills in a passphrase in the language of the
+// host, since they usually have to tell it to the participants
+func (h *hostData) handlerOnGeneratePassword(p gtki.Entry) {
+	passphrase, err := hosting.GeneratePassphrase(h.u.config.LanguageTag(), h.u.config.GetPassphraseEntropy())
+	if err != nil {
+		log.Errorf("handlerOnGeneratePassword(): %s", err)
+		return
+	}
+
+	p.SetText(passphrase)
+}
+
 func (h *hostData) handlerOnCancel() {
 	_ = h.service.Close()
-	h.u.servers = nil
 	h.u.switchToMainWindow()
 }
 
 func (h *hostData) handlerOnAutoJoinSuperUserToggled(ch gtki.CheckButton) {
 	h.asSuperUser = ch.GetActive()
-	h.superUserPassword = generateRandomPassword()
+	h.superUserPassword = h.u.superUserPassword()
 	h.u.config.SetAutoJoinSuperUser(h.asSuperUser)
 }
 
+// superUserPassword returns the password of the superuser of our meetings.
+// Persistent meetings keep the same one, so the host can reclaim the
+// superuser from any Mumble client
+func (u *gtkUI) superUserPassword() string {
+	persistent := u.config.IsPersistentOnionEnabled()
+	if p := u.config.GetSuperUserPassword(); persistent && p != "" {
+		return p
+	}
+
+	p, err := hosting.GenerateSuperUserPassword()
+	if err != nil {
+		log.Errorf("superUserPassword(): %s", err)
+		return generateRandomPassword()
+	}
+
+	if persistent {
+		u.config.SetSuperUserPassword(p)
+		u.saveConfigOnly()
+	}
+
+	return p
+}
+
 func (h *hostData) handlerOnAutoJoinToggled(ch gtki.CheckButton, b gtki.Button) {
 	h.autoJoin = ch.GetActive()
 	h.u.config.SetAutoJoin(h.autoJoin)
 	h.changeStartButtonText(b)
 }
 
+func audioProfileName(p hosting.AudioProfile) string {
+	switch p {
+	case hosting.AudioProfileLow:
+		return i18n().Sprintf("Low (for slow connections)")
+	case hosting.AudioProfileHigh:
+		return i18n().Sprintf("High")
+	default:
+		return i18n().Sprintf("Medium")
+	}
+}
+
+func (h *hostData) fillAudioProfiles(cmb gtki.ComboBoxText) {
+	for i, p := range hosting.AudioProfiles() {
+		cmb.AppendText(audioProfileName(p))
+		if p == h.audioProfile {
+			cmb.SetActive(i)
+		}
+	}
+}
+
+func (h *hostData) handlerOnAudioProfileChanged(cmb gtki.ComboBoxText) {
+	profiles := hosting.AudioProfiles()
+	i := cmb.GetActive()
+	if i < 0 || i >= len(profiles) {
+		return
+	}
+
+	h.audioProfile = profiles[i]
+	h.u.config.SetAudioProfile(string(h.audioProfile))
+}
+
 func (h *hostData) handlerOnStartMeeting(u, p gtki.Entry) {
 	h.meetingUsername, _ = u.GetText()
 	h.meetingPassword, _ = p.GetText()
+	h.service.SetAudioProfile(h.audioProfile)
 
 	if h.meetingUsername == "" {
 		h.meetingUsername = getRandomName()
 	}
 
+	h.setMeetingInfo()
+	h.setWelcome()
 	h.startMeetingHandler()
 }
 
+// setMeetingInfo puts the details of the meeting in its invitations,
+// so the guests can tell it apart from other meetings before joining.
+// The name of the host is only known once the meeting starts
+func (h *hostData) setMeetingInfo() {
+	h.service.SetMeetingInfo(hosting.MeetingInfo{
+		Title:       h.u.config.GetMeetingName(),
+		Description: h.u.config.GetMeetingAgenda(),
+		HostName:    h.meetingUsername,
+	})
+}
+
+// setWelcome builds the localized welcome text of the meeting out of
+// the sentences that apply to it, so each one can be translated alone
+func (h *hostData) setWelcome() {
+	w := hosting.Welcome{
+		MeetingName: h.u.config.GetMeetingName(),
+		HostName:    h.meetingUsername,
+		RulesLink:   h.u.config.GetMeetingRulesLink(),
+	}
+
+	if w.MeetingName != "" {
+		w.Template = i18n().Sprintf("Welcome to <b>{{.MeetingName}}</b>, hosted by {{.HostName}} with <b>Wahay</b>.")
+	} else {
+		w.Template = i18n().Sprintf("Welcome to this meeting, hosted by {{.HostName}} with <b>Wahay</b>.")
+	}
+
+	if w.RulesLink != "" {
+		w.Template += "<br/>" + i18n().Sprintf(`Please read the <a href="{{.RulesLink}}">rules of this meeting</a>.`)
+	}
+
+	if err := h.service.SetWelcome(w); err != nil {
+		// A broken translation shouldn't stop the meeting
+		log.Errorf("setWelcome(): %s", err)
+		h.service.SetWelcomeText(i18n().Sprintf("Welcome to this server running <b>Wahay</b>."))
+	}
+}
+
 func (h *hostData) changeStartButtonText(b gtki.Button) {
 	if h.autoJoin {
 		_ = b.SetProperty("label", i18n().Sprintf("Start Meeting & Join"))
@@ -602,6 +1171,12 @@ func (h *hostData) startMeetingRoutine() {
 		return
 	}
 
+	h.startedAt = time.Now()
+	h.watchWaitingRoom()
+	h.watchParticipants()
+	h.watchChat()
+	h.rememberHostedMeeting()
+
 	if h.autoJoin {
 		h.joinMeetingHost()
 	} else {
@@ -625,18 +1200,20 @@ func (h *hostData) getInvitePeopleBuilder() *uiBuilder {
 		"label", "lblYahoo",
 		"label", "lblOutlook",
 		"button", "btnCopyMeetingID",
-		"button", "btnCopyInvitation")
+		"button", "btnCopyInvitation",
+		"button", "btnSaveInvitation",
+		"tooltip", "btnSaveInvitation",
+		"button", "btnShareInvitation",
+		"tooltip", "btnShareInvitation")
+
+	_, canSave := h.invitationFileData()
+	builder.get("btnSaveInvitation").(gtki.Button).SetVisible(canSave)
 
 	btnEmail := builder.get("btnEmail").(gtki.LinkButton)
 	btnGmail := builder.get("btnGmail").(gtki.LinkButton)
 	btnYahoo := builder.get("btnYahoo").(gtki.LinkButton)
 	btnOutlook := builder.get("btnMicrosoft").(gtki.LinkButton)
 
-	_ = btnEmail.SetProperty("uri", h.getInvitationEmailURI())
-	_ = btnGmail.SetProperty("uri", h.getInvitationGmailURI())
-	_ = btnYahoo.SetProperty("uri", h.getInvitationYahooURI())
-	_ = btnOutlook.SetProperty("uri", h.getInvitationMicrosoftURI())
-
 	imagePixBuf, _ := h.u.g.getImagePixbufForSize("email.png", 100)
 	widgetImage, _ := h.u.g.gtk.ImageNewFromPixbuf(imagePixBuf)
 	btnEmail.SetImage(widgetImage)
@@ -653,12 +1230,81 @@ func (h *hostData) getInvitePeopleBuilder() *uiBuilder {
 	widgetImage, _ = h.u.g.gtk.ImageNewFromPixbuf(imagePixBuf)
 	btnOutlook.SetImage(widgetImage)
 
+	h.showInvitationQRCode(builder)
+
 	return builder
 }
 
+// setInvitationLinks makes the email buttons send the invitations
+// as they are, which changes when they are signed again or revoked
+func (h *hostData) setInvitationLinks(builder *uiBuilder) {
+	_ = builder.get("btnEmail").(gtki.LinkButton).SetProperty("uri", h.getInvitationEmailURI())
+	_ = builder.get("btnGmail").(gtki.LinkButton).SetProperty("uri", h.getInvitationGmailURI())
+	_ = builder.get("btnYahoo").(gtki.LinkButton).SetProperty("uri", h.getInvitationYahooURI())
+	_ = builder.get("btnMicrosoft").(gtki.LinkButton).SetProperty("uri", h.getInvitationMicrosoftURI())
+}
+
+// showInvitationQRCode shows a QR code with the URL of the meeting, for
+// the guests using Mumble on a phone. The keys of a meeting that only
+// allows invited participants can't go in a Mumble URL, so there's no
+// code for them. Like the rest of the invitation, it doesn't have the
+// password of the meeting
+func (h *hostData) showInvitationQRCode(builder *uiBuilder) {
+	box := builder.get("boxQRCode").(gtki.Box)
+	if h.service.ClientAuthKey() != "" {
+		box.SetVisible(false)
+		return
+	}
+
+	builder.i18nProperties(
+		"label", "lblQRCode",
+		"button", "btnCopyQRCode")
+
+	builder.get("btnCopyQRCode").(gtki.Button).SetVisible(isCopyImageToClipboardSupported())
+
+	pixbuf, err := h.invitationQRCode()
+	if err != nil {
+		log.WithError(err).Error("Couldn't generate the QR code of the invitation")
+		box.SetVisible(false)
+		return
+	}
+
+	builder.get("imgQRCode").(gtki.Image).SetFromPixbuf(pixbuf)
+}
+
+func (h *hostData) invitationQRCode() (gdki.Pixbuf, error) {
+	content, err := h.invitationQRCodePNG()
+	if err != nil {
+		return nil, err
+	}
+
+	return h.u.g.getPixbufFromBytes(content)
+}
+
+// invitationQRCodePNG returns the QR code of the invitation as a PNG image
+func (h *hostData) invitationQRCodePNG() ([]byte, error) {
+	data := hosting.MeetingData{
+		MeetingID: h.service.ID(),
+		Port:      h.service.ServicePort(),
+	}
+
+	img, err := data.GenerateQR(hosting.DefaultQRSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := png.Encode(&b, img); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
 // TODO: review this function and make a more pretty solution
 func (h *hostData) onInviteParticipants(onOpen func(d gtki.Window), onClose func(d gtki.Window)) {
 	builder := h.getInvitePeopleBuilder()
+	invitations := h.showInvitationList(builder)
 
 	dialog := builder.get("invitePeopleWindow").(gtki.Window)
 
@@ -682,6 +1328,19 @@ func (h *hostData) onInviteParticipants(onOpen func(d gtki.Window), onClose func
 		"on_copy_invitation": func() {
 			h.copyInvitationToClipboard(builder)
 		},
+		"on_copy_password": func() {
+			h.copyPasswordToClipboard(builder)
+		},
+		"on_invitation_expiry_changed": invitations.changeLifetime,
+		"on_save_invitation": func() {
+			h.saveInvitationFile(builder)
+		},
+		"on_copy_qr_code": func() {
+			h.copyQRCodeToClipboard(builder)
+		},
+		"on_share_invitation": func() {
+			h.shareInvitation(builder)
+		},
 	})
 
 	if onOpen == nil {
@@ -4,9 +4,11 @@ package gui
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/coyim/gotk3adapter/glibi"
+	"github.com/coyim/gotk3adapter/gtki"
 
 	"github.com/digitalautonomy/wahay/config"
 	// This is necessary because that's how the translation stuff works
@@ -14,25 +16,161 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
 	"golang.org/x/text/message"
 )
 
-var i18n = func() func() *message.Printer {
-	var o sync.Once
-	var p *message.Printer
+var printer struct {
+	sync.Mutex
+	p *message.Printer
+}
+
+// i18n returns the printer of the language Wahay is shown in,
+// which is the one of the system until another one is chosen
+func i18n() *message.Printer {
+	printer.Lock()
+	defer printer.Unlock()
 
-	initI18n := func() {
+	if printer.p == nil {
 		tag := config.DetectLanguage()
 
 		log.Infof("Detected language: %v\n", tag)
-		p = message.NewPrinter(tag, message.Catalog(message.DefaultCatalog))
+		printer.p = message.NewPrinter(tag, message.Catalog(message.DefaultCatalog))
+	}
+
+	return printer.p
+}
+
+func setPrinterLanguage(tag language.Tag) {
+	printer.Lock()
+	defer printer.Unlock()
+
+	printer.p = message.NewPrinter(tag, message.Catalog(message.DefaultCatalog))
+}
+
+// languageOptions are the languages Wahay is translated to, in the
+// order of the settings. The first one follows the language of the system
+func languageOptions() []string {
+	result := []string{}
+	for _, t := range message.DefaultCatalog.Languages() {
+		result = append(result, t.String())
+	}
+	sort.Strings(result)
+
+	return append([]string{""}, result...)
+}
+
+// languageName returns the name of a language in itself, so
+// people find theirs whatever the language Wahay is shown in
+func languageName(tag string) string {
+	if tag == "" {
+		return i18n().Sprintf("System language")
+	}
+	return display.Self.Name(language.Make(tag))
+}
+
+// translatedProperty is a property of an object of the definitions,
+// with the text it had there, so it can be translated again
+type translatedProperty struct {
+	obj      glibi.Object
+	id       string
+	kind     string
+	property string
+	original string
+}
+
+// translatedProperties are the ones of the objects not destroyed yet
+var translatedProperties = struct {
+	sync.Mutex
+	all map[*translatedProperty]bool
+}{all: make(map[*translatedProperty]bool)}
+
+// languageListeners translate what is shown from the code
+// instead of the definitions, when the language changes
+var languageListeners = struct {
+	sync.Mutex
+	next int
+	all  map[int]func()
+}{all: make(map[int]func())}
+
+// onLanguageChange calls f in the UI thread every time the
+// language changes, until the returned function is called
+func onLanguageChange(f func()) func() {
+	languageListeners.Lock()
+	defer languageListeners.Unlock()
+
+	id := languageListeners.next
+	languageListeners.next++
+	languageListeners.all[id] = f
+
+	return func() {
+		languageListeners.Lock()
+		defer languageListeners.Unlock()
+		delete(languageListeners.all, id)
 	}
+}
 
-	return func() *message.Printer {
-		o.Do(initI18n)
-		return p
+// rightToLeftScripts are the scripts written from right to left
+var rightToLeftScripts = map[string]bool{
+	"Adlm": true,
+	"Arab": true,
+	"Hebr": true,
+	"Nkoo": true,
+	"Rohg": true,
+	"Syrc": true,
+	"Thaa": true,
+}
+
+// isRightToLeft returns true if the language is written from right
+// to left, guessing its script when the tag doesn't have one
+func isRightToLeft(tag language.Tag) bool {
+	script, _ := tag.Script()
+	return rightToLeftScripts[script.String()]
+}
+
+// textDirection returns the direction of the windows for the language
+func textDirection(tag language.Tag) gtki.TextDirection {
+	if isRightToLeft(tag) {
+		return gtki.TEXT_DIR_RTL
 	}
-}()
+	return gtki.TEXT_DIR_LTR
+}
+
+// setLanguage shows Wahay in the given language, translating again
+// the texts of the windows that are open, without restarting it
+func (u *gtkUI) setLanguage(tag language.Tag) {
+	log.Infof("Language: %v", tag)
+	setPrinterLanguage(tag)
+
+	u.doInUIThread(func() {
+		// The windows already open change their direction too
+		u.g.gtk.WidgetSetDefaultDirection(textDirection(tag))
+		translatedProperties.Lock()
+		all := make([]*translatedProperty, 0, len(translatedProperties.all))
+		for t := range translatedProperties.all {
+			all = append(all, t)
+		}
+		translatedProperties.Unlock()
+
+		for _, t := range all {
+			if err := t.translate(); err != nil {
+				log.Debugf("setLanguage(): %s-%s: %v", t.id, t.kind, err)
+			}
+		}
+
+		languageListeners.Lock()
+		listeners := make([]func(), 0, len(languageListeners.all))
+		for _, f := range languageListeners.all {
+			listeners = append(listeners, f)
+		}
+		languageListeners.Unlock()
+
+		for _, f := range listeners {
+			f()
+		}
+	})
+}
 
 func (b *uiBuilder) i18nProperties(objs ...string) {
 	if len(objs)%2 == 1 {
@@ -46,10 +184,10 @@ func (b *uiBuilder) i18nProperties(objs ...string) {
 	}
 }
 
-func (b *uiBuilder) i18nProperty(id, property string) {
+func (b *uiBuilder) i18nProperty(id, kind string) {
 	obj := b.get(id).(glibi.Object)
-	orgProperty := property
-	switch property {
+	property := kind
+	switch kind {
 	case "placeholder":
 		property = "placeholder_text"
 	case "button", "checkbox":
@@ -65,15 +203,48 @@ func (b *uiBuilder) i18nProperty(id, property string) {
 		fatal(e)
 	}
 
-	composedID := fmt.Sprintf("%s-%s", id, orgProperty)
-	result := i18n().Sprintf(message.Key(composedID, currentVal.(string)))
-	if result == currentVal {
-		result = i18n().Sprintf(currentVal)
+	t := &translatedProperty{
+		obj:      obj,
+		id:       id,
+		kind:     kind,
+		property: property,
+		original: currentVal.(string),
 	}
 
-	e = obj.SetProperty(property, result)
+	e = t.translate()
 	if e != nil {
 		log.Errorf("Error setting property '%s' from object with id %s (%v): %v", property, id, obj, e)
 		fatal(e)
 	}
+
+	t.register()
+}
+
+// translationOf returns the translation of the text a property had in
+// the definitions, which can have its own for an object and property
+func translationOf(id, kind, original string) string {
+	composedID := fmt.Sprintf("%s-%s", id, kind)
+	result := i18n().Sprintf(message.Key(composedID, original))
+	if result == original {
+		result = i18n().Sprintf(original)
+	}
+	return result
+}
+
+func (t *translatedProperty) translate() error {
+	return t.obj.SetProperty(t.property, translationOf(t.id, t.kind, t.original))
+}
+
+// register keeps the property to translate it again
+// when the language changes, until its widget is destroyed
+func (t *translatedProperty) register() {
+	translatedProperties.Lock()
+	translatedProperties.all[t] = true
+	translatedProperties.Unlock()
+
+	_ = t.obj.Connect("destroy", func() {
+		translatedProperties.Lock()
+		delete(translatedProperties.all, t)
+		translatedProperties.Unlock()
+	})
 }
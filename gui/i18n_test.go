@@ -0,0 +1,49 @@
+package gui
+
+import (
+	"golang.org/x/text/language"
+	. "gopkg.in/check.v1"
+)
+
+type WahayI18nSuite struct{}
+
+var _ = Suite(&WahayI18nSuite{})
+
+func (s *WahayI18nSuite) TearDownTest(c *C) {
+	setPrinterLanguage(language.English)
+}
+
+func (s *WahayI18nSuite) Test_languageOptions_startWithTheLanguageOfTheSystem(c *C) {
+	options := languageOptions()
+
+	c.Assert(options[0], Equals, "")
+	c.Assert(options[1:], DeepEquals, []string{"ar", "en", "es", "fr", "sv"})
+}
+
+func (s *WahayI18nSuite) Test_languageName_isTheNameInTheLanguageItself(c *C) {
+	c.Assert(languageName("es"), Equals, "español")
+	c.Assert(languageName("sv"), Equals, "svenska")
+}
+
+func (s *WahayI18nSuite) Test_translationOf_usesTheCurrentLanguage(c *C) {
+	setPrinterLanguage(language.English)
+	c.Assert(translationOf("btnCancel", "button", "Cancel"), Equals, "Cancel")
+
+	setPrinterLanguage(language.Spanish)
+	c.Assert(translationOf("btnCancel", "button", "Cancel"), Equals, "Cancelar")
+}
+
+func (s *WahayI18nSuite) Test_isRightToLeft_guessesTheScriptOfTheLanguage(c *C) {
+	c.Assert(isRightToLeft(language.Arabic), Equals, true)
+	c.Assert(isRightToLeft(language.MustParse("he-IL")), Equals, true)
+	c.Assert(isRightToLeft(language.Spanish), Equals, false)
+	c.Assert(isRightToLeft(language.MustParse("az-Latn")), Equals, false)
+}
+
+func (s *WahayI18nSuite) Test_onLanguageChange_stopsCallingTheListenerOnceStopped(c *C) {
+	stop := onLanguageChange(func() {})
+	c.Assert(languageListeners.all, HasLen, 1)
+
+	stop()
+	c.Assert(languageListeners.all, HasLen, 0)
+}
@@ -40,6 +40,8 @@ func (u *gtkUI) ensureInstallation() {
 	i.ensureApplicationDesktop()
 }
 
+const desktopFileName = "wahay.desktop"
+
 var iconSizes = []int{16, 32, 48, 128, 256}
 
 func (i *installation) ensureApplicationIcons() {
@@ -85,7 +87,7 @@ func (i *installation) ensureApplicationDesktop() {
 		}).Errorf("ensureApplicationDesktop(): %s", err.Error())
 	}
 
-	fileName := filepath.Join(i.dataHome, "applications", "wahay.desktop")
+	fileName := filepath.Join(i.dataHome, "applications", desktopFileName)
 	content := i.generateDesktopFile()
 
 	err = ioutil.WriteFile(fileName, []byte(content), 0600)
@@ -93,7 +95,10 @@ func (i *installation) ensureApplicationDesktop() {
 		log.WithFields(log.Fields{
 			"desktopFileName": fileName,
 		}).Errorf("ensureApplicationDesktop(): %s", err.Error())
+		return
 	}
+
+	registerURLHandlers(desktopFileName)
 }
 
 func (i *installation) generateDesktopFile() string {
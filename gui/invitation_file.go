@@ -0,0 +1,139 @@
+package gui
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/hosting"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// invitationFileData returns the invitation to save in a file. Every
+// invitee of a meeting that only allows invited participants has their
+// own invitation, so there's no file for them
+func (h *hostData) invitationFileData() (*hosting.Invitation, bool) {
+	invitations := h.service.Invitations()
+	if len(invitations) != 1 {
+		return nil, false
+	}
+
+	data, err := hosting.ParseURL(invitations[0])
+	if err != nil {
+		log.Errorf("invitationFileData(): %s", err)
+		return nil, false
+	}
+
+	inv := &hosting.Invitation{Meeting: *data}
+
+	if cert, _, err := h.u.servers.Certificate(); err == nil {
+		inv.CertificateFingerprint, _ = hosting.CertificateFingerprint(cert)
+	}
+
+	return inv, true
+}
+
+// saveInvitationFile saves the invitation in a file the host can send
+// as an attachment. When the meeting has a password, the file is
+// encrypted with it, so the guests need it to open the invitation
+func (h *hostData) saveInvitationFile(builder *uiBuilder) {
+	inv, ok := h.invitationFileData()
+	if !ok {
+		return
+	}
+
+	go func() {
+		ok, path := h.u.chooseFile(gtki.FILE_CHOOSER_ACTION_SAVE, i18n().Sprintf("meeting")+hosting.InvitationFileExtension)
+		if !ok {
+			return
+		}
+
+		if filepath.Ext(path) != hosting.InvitationFileExtension {
+			path += hosting.InvitationFileExtension
+		}
+
+		var b bytes.Buffer
+		err := hosting.WriteInvitation(&b, *inv, h.meetingPassword)
+		if err == nil {
+			err = os.WriteFile(path, b.Bytes(), 0600)
+		}
+
+		if err != nil {
+			log.Errorf("saveInvitationFile(): %s", err)
+			h.u.doInUIThread(func() {
+				h.u.reportError(i18n().Sprintf("The invitation file couldn't be saved"))
+			})
+			return
+		}
+
+		lblMessage := builder.get("lblMessage").(gtki.Label)
+		h.u.messageToLabel(lblMessage, i18n().Sprintf("The invitation file has been saved"), 5)
+	}()
+}
+
+// openInvitationFile fills in the join window with an invitation file.
+// An encrypted invitation is opened with the password typed
+// in the window, which is the password of the meeting
+func (u *gtkUI) openInvitationFile(b *uiBuilder) {
+	entMeetingID := b.get("entMeetingID").(gtki.Entry)
+	entScreenName := b.get("entScreenName").(gtki.Entry)
+	entMeetingPassword := b.get("entMeetingPassword").(gtki.Entry)
+
+	password, _ := entMeetingPassword.GetText()
+
+	go func() {
+		ok, path := u.chooseFile(gtki.FILE_CHOOSER_ACTION_OPEN, "")
+		if !ok {
+			return
+		}
+
+		inv, err := readInvitationFile(path, password)
+		if err != nil {
+			log.WithError(err).Error("The invitation file couldn't be opened")
+			u.doInUIThread(func() {
+				u.reportError(invitationFileErrorMessage(err))
+			})
+			return
+		}
+
+		meeting := inv.Meeting
+		meetingPassword, username := meeting.Password, meeting.Username
+		meeting.Password, meeting.Username = "", ""
+
+		u.doInUIThread(func() {
+			entMeetingID.SetText(strings.TrimPrefix(meeting.GenerateURL(), "mumble://"))
+			if meetingPassword != "" {
+				entMeetingPassword.SetText(meetingPassword)
+			}
+			if name, _ := entScreenName.GetText(); name == "" && username != "" {
+				entScreenName.SetText(username)
+			}
+		})
+	}()
+}
+
+func readInvitationFile(path, passphrase string) (*hosting.Invitation, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	return hosting.ReadInvitation(f, passphrase)
+}
+
+func invitationFileErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, hosting.ErrInvitationPassphrase):
+		return i18n().Sprintf("This invitation is protected with the password of the meeting. Type it in the password field and open the invitation again.")
+	case errors.Is(err, hosting.ErrInvalidInvitationFile):
+		return i18n().Sprintf("The file is not a Wahay invitation, or it's from a newer version of Wahay.")
+	}
+	return i18n().Sprintf("The invitation file couldn't be opened")
+}
@@ -2,12 +2,11 @@ package gui
 
 import (
 	"errors"
-	"net"
-	"net/url"
-	"strconv"
 	"strings"
+	"time"
 
 	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/client"
 	"github.com/digitalautonomy/wahay/hosting"
 	"github.com/digitalautonomy/wahay/tor"
 
@@ -30,9 +29,16 @@ func (u *gtkUI) getInviteCodeEntities() (gtki.Window, *uiBuilder) {
 		"placeholder", "entScreenName",
 		"placeholder", "entMeetingID",
 		"placeholder", "entMeetingPassword",
+		"checkbox", "chkStartMuted",
+		"checkbox", "chkStartDeafened",
+		"tooltip", "chkStartMuted",
+		"tooltip", "chkStartDeafened",
 		"button", "btnCancel",
 		"button", "btnJoin",
-		"tooltip", "btnJoin")
+		"tooltip", "btnJoin",
+		"button", "btnTestAudio",
+		"tooltip", "btnTestAudio",
+		"button", "btnOpenInvitation")
 
 	win := builder.get("inviteWindow").(gtki.ApplicationWindow)
 
@@ -49,38 +55,87 @@ func (u *gtkUI) getCurrentMeetingWindow() *uiBuilder {
 		"secondary_text", "leaveMeeting",
 		"button", "btnLeaveMeeting",
 		"tooltip", "btnLeaveMeeting",
+		"button", "btnNewCircuits",
+		"tooltip", "btnNewCircuits",
+		"button", "btnVerifyMeeting",
+		"tooltip", "btnVerifyMeeting",
 		"label", "lblTipPush",
+		"tooltip", "lblNetworkActivity",
+		"tooltip", "lblConnectionQuality",
 	)
 
 	return builder
 }
 
-func (u *gtkUI) openCurrentMeetingWindow(m tor.Service) {
+func (u *gtkUI) openCurrentMeetingWindow(m tor.Service, meetingID string) {
 	if m.IsClosed() {
 		u.reportError(i18n().Sprintf("The Mumble process is down"))
 	}
 
 	u.hideCurrentWindow()
 
+	win := u.newCurrentMeetingWindow(m, meetingID, u.client, func() {
+		u.leaveMeeting(m)
+		u.quit()
+	})
+
+	u.tray.showMeeting(trayInMeeting, nil, func() {
+		u.leaveMeeting(m)
+	})
+	m.OnClose(u.tray.showNoMeeting)
+
+	u.switchToWindow(win)
+}
+
+// newCurrentMeetingWindow creates the window of a meeting joined with the
+// client, which shows how the connection to it goes and controls the
+// client. onDestroy is called when the window is closed
+func (u *gtkUI) newCurrentMeetingWindow(m tor.Service, meetingID string, c client.Instance, onDestroy func()) gtki.ApplicationWindow {
 	builder := u.getCurrentMeetingWindow()
 	win := builder.get("currentMeetingWindow").(gtki.ApplicationWindow)
+	controls := u.connectCurrentMeeting(builder, m, meetingID, c, onDestroy)
+
+	u.connectShortcutsCurrentMeetingWindow(win, m)
+	u.connectShortcutsCallControls(win, controls)
+
+	return win
+}
+
+// connectCurrentMeeting makes the window of the builder show how the
+// connection to the meeting goes and control the client. It returns the
+// controls of the call, for the shortcuts of the window they end up in
+func (u *gtkUI) connectCurrentMeeting(builder *uiBuilder, m tor.Service, meetingID string, c client.Instance, onDestroy func()) *callControls {
+	controls := u.newCallControls(builder, c.RemoteControl())
 
 	builder.ConnectSignals(map[string]interface{}{
-		"on_close_window_signal": func() {
-			u.leaveMeeting(m)
-			u.quit()
-		},
+		"on_close_window_signal": onDestroy,
 		"on_leave_meeting": func() {
 			u.leaveMeeting(m)
 		},
+		"on_new_circuits": func() {
+			u.rotateTorCircuits(builder.get("btnNewCircuits").(gtki.Button))
+		},
+		"on_verify_meeting": func() {
+			u.openVerificationWindow(meetingID, c.ServerCertificate(), false)
+		},
+		"on_toggle_mute":   controls.onToggleMute,
+		"on_toggle_deafen": controls.onToggleDeafen,
 	})
 
-	u.connectShortcutsCurrentMeetingWindow(win, m)
+	m.OnClose(u.watchNetworkActivity(builder.get("lblNetworkActivity").(gtki.Label)))
+	m.OnClose(u.watchConnectionQuality(builder.get("lblConnectionQuality").(gtki.Label), meetingOnion(meetingID), nil))
+	m.OnClose(controls.watch())
 
-	u.switchToWindow(win)
+	return controls
 }
 
 func (u *gtkUI) joinMeetingHandler(data hosting.MeetingData) {
+	u.joinMeetingWithClient(data, 0)
+}
+
+// joinMeetingWithClient launches the client to join the meeting, knowing
+// how many times in a row it crashed shortly after joining it before
+func (u *gtkUI) joinMeetingWithClient(data hosting.MeetingData, crashes int) {
 	if len(data.MeetingID) == 0 {
 		u.openErrorDialog(i18n().Sprintf("The Meeting ID cannot be blank"))
 		return
@@ -98,12 +153,27 @@ func (u *gtkUI) joinMeetingHandler(data hosting.MeetingData) {
 	var err error
 
 	finish := make(chan bool)
+	launched := make(chan struct{})
+	joinedAt := time.Now()
 
 	go func() {
 		mumble, err = u.launchMumbleClient(
 			data,
-			u.switchContextWhenMumbleFinish,
+			// Callback to be executed when the client is closed
+			func() {
+				<-launched
+				if !mumble.Crashed() {
+					u.switchContextWhenMumbleFinish()
+					return
+				}
+
+				attempt := rejoinAttempt(crashes, joinedAt, time.Now())
+				u.onMumbleCrash(attempt, func() {
+					go u.joinMeetingWithClient(data, attempt)
+				}, u.switchContextWhenMumbleFinish)
+			},
 		)
+		close(launched)
 
 		finish <- true
 	}()
@@ -118,128 +188,190 @@ func (u *gtkUI) joinMeetingHandler(data hosting.MeetingData) {
 		return
 	}
 
-	u.openCurrentMeetingWindow(mumble)
+	u.openCurrentMeetingWindow(mumble, data.MeetingID)
 }
 
-func (u *gtkUI) handleOnJoinMeeting(b *uiBuilder) {
+// handleOnJoinMeeting calls join in a goroutine with
+// the meeting filled in the join window
+func (u *gtkUI) handleOnJoinMeeting(b *uiBuilder, join func(hosting.MeetingData)) {
 	entMeetingID, _ := b.get("entMeetingID").(gtki.Entry)
 	entScreenName, _ := b.get("entScreenName").(gtki.Entry)
 	entMeetingPassword, _ := b.get("entMeetingPassword").(gtki.Entry)
 
-	url, _ := entMeetingID.GetText()
+	meetingURL, _ := entMeetingID.GetText()
 	username, _ := entScreenName.GetText()
-	if username == "" {
-		username = getRandomName()
-	}
 	password, _ := entMeetingPassword.GetText()
 
-	// TODO: remove this if we show a custom input field to enter
-	// the SERVICE URL and the PORT
-	meetingID, port, err := extractMeetingIDandPort(url)
+	data, ok := u.meetingDataFor(meetingURL)
+	if !ok {
+		return
+	}
+
+	// The name and password typed by the participant take
+	// precedence over the ones in the meeting URL
+	if username != "" {
+		data.Username = username
+	}
+	if data.Username == "" {
+		data.Username = getRandomName()
+	}
+	if password != "" {
+		data.Password = password
+	}
+
+	data.StartMuted = b.get("chkStartMuted").(gtki.CheckButton).GetActive()
+	data.StartDeafened = b.get("chkStartDeafened").(gtki.CheckButton).GetActive()
+
+	joinIt := func() {
+		u.rememberJoinedMeeting(meetingURL, data)
+		go join(*data)
+	}
+
+	if data.MeetingInfo.IsEmpty() {
+		joinIt()
+		return
+	}
+
+	u.showJoinConfirmation(data.MeetingInfo, func(ok bool) {
+		if ok {
+			joinIt()
+		}
+	})
+}
+
+// meetingDataFor returns the meeting data of a meeting URL or invitation,
+// telling the user why it can't be joined when it's not valid
+func (u *gtkUI) meetingDataFor(meetingURL string) (*hosting.MeetingData, bool) {
+	data, err := parseMeetingAddress(meetingURL)
 	if err != nil {
+		// The URL isn't logged, since it can have the meeting password
+		log.WithError(err).Error("Invalid meeting ID provided")
+		u.reportError(i18n().Sprintf("Invalid meeting ID provided"))
+		return nil, false
+	}
+
+	if data.ClientAuthKey != "" && !tor.IsValidClientAuthPrivateKey(data.ClientAuthKey) {
 		log.WithFields(log.Fields{
-			"url":  url,
-			"ID":   meetingID,
-			"port": port,
-		}).Error("Invalid meeting ID provided")
+			"ID": data.MeetingID,
+		}).Error("Invalid client authorization key provided")
 		u.reportError(i18n().Sprintf("Invalid meeting ID provided"))
-		return
+		return nil, false
 	}
 
-	data := hosting.MeetingData{
-		MeetingID: meetingID,
-		Port:      port,
-		Username:  username,
-		Password:  password,
+	if err := data.VerifyInvitation(time.Now()); err != nil {
+		log.WithFields(log.Fields{
+			"ID": data.MeetingID,
+		}).WithError(err).Error("Invalid invitation provided")
+		u.reportError(invitationErrorMessage(err))
+		return nil, false
 	}
 
-	go u.joinMeetingHandler(data)
+	return data, true
+}
+
+// showJoinConfirmation shows the details of the meeting in the
+// invitation, so the participant can check it's the one they
+// expect before joining
+func (u *gtkUI) showJoinConfirmation(info hosting.MeetingInfo, k func(bool)) {
+	builder := u.getConfirmWindow()
+
+	lblTitle := builder.get("lblTitle").(gtki.Label)
+	lblText := builder.get("lblText").(gtki.Label)
+	btnConfirm := builder.get("btnConfirm").(gtki.Button)
+
+	lblTitle.SetText(i18n().Sprintf("Do you want to join this meeting?"))
+	lblText.SetText(joinConfirmationText(info))
+	btnConfirm.SetLabel(i18n().Sprintf("Join"))
+
+	u.runConfirmation(builder, k)
+}
+
+func joinConfirmationText(info hosting.MeetingInfo) string {
+	lines := []string{}
+	if info.Title != "" {
+		lines = append(lines, i18n().Sprintf("Meeting: %s", info.Title))
+	}
+	if info.HostName != "" {
+		lines = append(lines, i18n().Sprintf("Hosted by: %s", info.HostName))
+	}
+	if info.Description != "" {
+		lines = append(lines, "", i18n().Sprintf("Agenda:"), info.Description)
+	}
+	return strings.Join(lines, "\n")
 }
 
 // Test Onion that can be used:
 // qvdjpoqcg572ibylv673qr76iwashlazh6spm47ly37w65iwwmkbmtid.onion
 func (u *gtkUI) openJoinWindow() {
+	u.openJoinWindowWith("")
+}
+
+// openJoinWindowWith opens the join window with the given meeting
+// address already filled in
+func (u *gtkUI) openJoinWindowWith(meetingURL string) {
+	win := u.newJoinWindow(meetingURL, u.joinMeetingHandler, u.closeWindow)
+
+	win.Show()
+	u.setCurrentWindow(win)
+}
+
+// newJoinWindow creates the window to join a meeting, with the given
+// meeting address already filled in. join is called with the meeting
+// chosen, and onClose when the window is closed
+func (u *gtkUI) newJoinWindow(meetingURL string, join func(hosting.MeetingData), onClose func(gtki.Window)) gtki.ApplicationWindow {
 	win, builder := u.getInviteCodeEntities()
 
+	if meetingURL != "" {
+		builder.get("entMeetingID").(gtki.Entry).SetText(meetingURL)
+	}
+
+	builder.get("chkStartMuted").(gtki.CheckButton).SetActive(u.config.ShouldStartMuted())
+	builder.get("chkStartDeafened").(gtki.CheckButton).SetActive(u.config.ShouldStartDeafened())
+
 	cleanup := func() {
 		win.Destroy()
-		u.switchToMainWindow()
+		onClose(win)
 	}
 
 	builder.ConnectSignals(map[string]interface{}{
 		"on_join": func() {
-			u.handleOnJoinMeeting(builder)
+			u.handleOnJoinMeeting(builder, join)
 		},
-		"on_cancel": cleanup,
-		"on_close":  cleanup,
+		"on_open_invitation": func() {
+			u.openInvitationFile(builder)
+		},
+		"on_test_audio": u.testAudio,
+		"on_cancel":     cleanup,
+		"on_close":      cleanup,
 	})
 
-	u.connectShortcutsInviteMeetingWindow(win, builder)
+	u.connectShortcutsInviteMeetingWindow(win, builder, join, onClose)
 
-	win.Show()
-	u.setCurrentWindow(win)
+	return win.(gtki.ApplicationWindow)
 }
 
 var errInvalidMeetingAddr = errors.New("invalid meeting address")
 
-func extractMeetingIDandPort(meetingURL string) (meetingID string, port int, err error) {
-	if !isAValidMeetingID(meetingURL) {
-		err = errInvalidMeetingAddr
-		return
-	}
-
-	if !strings.HasPrefix(meetingURL, "mumble://") {
-		v := url.URL{
-			Scheme: "mumble",
-			Host:   meetingURL,
-		}
-		meetingURL = v.String()
+func invitationErrorMessage(err error) string {
+	if errors.Is(err, hosting.ErrInvitationExpired) {
+		return i18n().Sprintf("This invitation has expired. Please ask the host of the meeting for a new one.")
 	}
-
-	h, p, e := extractURLAndPort(meetingURL)
-	if e != nil {
-		err = errInvalidMeetingAddr
-		return
-	}
-
-	meetingID = h
-	port = p
-
-	return
+	return i18n().Sprintf("This invitation was not created by the host of the meeting, or it was changed afterwards.")
 }
 
-func extractURLAndPort(urlToParse string) (host string, port int, err error) {
-	var h string
-	var p string
-	var e error
-
-	u, e := url.Parse(urlToParse)
-	if e != nil {
-		err = e
-		return
+// parseMeetingAddress returns the meeting data of a meeting URL or
+// invitation, which must point to an onion service
+func parseMeetingAddress(meetingURL string) (*hosting.MeetingData, error) {
+	data, err := hosting.ParseURL(meetingURL)
+	if err != nil {
+		return nil, err
 	}
 
-	if u.Port() != "" {
-		h, p, e = net.SplitHostPort(u.Host)
-		if e != nil {
-			err = e
-			return
-		}
-
-		host = h
-
-		port, e = strconv.Atoi(p)
-		if e != nil {
-			err = e
-		}
-
-		return
+	if !isAValidMeetingID(data.MeetingID) {
+		return nil, errInvalidMeetingAddr
 	}
 
-	host = u.Host
-	port = hosting.DefaultPort
-
-	return host, port, err
+	return data, nil
 }
 
 const onionServiceLength = 62
@@ -0,0 +1,258 @@
+package gui
+
+import (
+	"sort"
+	"time"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+// defaultInvitationLifetimes are the times the host can choose for the
+// invitations to be used. 0 leaves them valid while the meeting lasts
+var defaultInvitationLifetimes = []time.Duration{
+	time.Hour,
+	24 * time.Hour,
+	7 * 24 * time.Hour,
+	0,
+}
+
+// invitationLifetimeOptions returns the lifetimes to choose from,
+// with the current one among them even if it isn't one of the defaults
+func invitationLifetimeOptions(current time.Duration) []time.Duration {
+	result := []time.Duration{}
+	found := false
+	for _, d := range defaultInvitationLifetimes {
+		if d > 0 {
+			result = append(result, d)
+		}
+		found = found || d == current
+	}
+
+	if !found && current > 0 {
+		result = append(result, current)
+		sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	}
+
+	return append(result, 0)
+}
+
+func invitationLifetimeLabel(d time.Duration) string {
+	const day = 24 * time.Hour
+	const week = 7 * day
+
+	switch {
+	case d <= 0:
+		return i18n().Sprintf("As long as the meeting lasts")
+	case d == week:
+		return i18n().Sprintf("1 week")
+	case d%week == 0:
+		return i18n().Sprintf("%d weeks", d/week)
+	case d == day:
+		return i18n().Sprintf("1 day")
+	case d%day == 0:
+		return i18n().Sprintf("%d days", d/day)
+	case d == time.Hour:
+		return i18n().Sprintf("1 hour")
+	}
+	return i18n().Sprintf("%d hours", d/time.Hour)
+}
+
+func invitationValidUntilText(expiry time.Time) string {
+	if expiry.IsZero() {
+		return ""
+	}
+	return i18n().Sprintf("Until %s", expiry.Local().Format("2006-01-02 15:04"))
+}
+
+// inviteeName returns the name of the invitee of the invitation,
+// or its number for the invitations without a name
+func inviteeName(invitation string, i int) string {
+	data, err := hosting.ParseURL(invitation)
+	if err == nil && data.Username != "" {
+		return data.Username
+	}
+	return i18n().Sprintf("Invitation %d", i+1)
+}
+
+// invitationList shows one row per invitee in the invite window, to copy
+// every invitation apart and, with client authorization, to revoke it
+type invitationList struct {
+	h        *hostData
+	builder  *uiBuilder
+	box      gtki.Box
+	rows     []gtki.Box
+	lifetime time.Duration
+	options  []time.Duration
+}
+
+func (h *hostData) showInvitationList(builder *uiBuilder) *invitationList {
+	builder.i18nProperties(
+		"label", "lblInvitations",
+		"label", "lblInvitationExpiry",
+		"button", "btnCopyPassword",
+		"tooltip", "btnCopyPassword")
+
+	l := &invitationList{
+		h:       h,
+		builder: builder,
+		box:     builder.get("boxInvitees").(gtki.Box),
+	}
+
+	builder.get("btnCopyPassword").(gtki.Button).SetVisible(
+		h.u.isCopyToClipboardSupported() && h.meetingPassword != "")
+
+	if !h.service.InvitationExpiry().IsZero() {
+		l.lifetime = h.u.config.GetInvitationLifetime()
+	}
+	l.options = invitationLifetimeOptions(l.lifetime)
+
+	cmbExpiry := builder.get("cmbInvitationExpiry").(gtki.ComboBoxText)
+	for i, d := range l.options {
+		cmbExpiry.AppendText(invitationLifetimeLabel(d))
+		if d == l.lifetime {
+			cmbExpiry.SetActive(i)
+		}
+	}
+
+	l.refresh()
+
+	return l
+}
+
+// refresh shows the invitations again, after they were
+// signed again or one of them was revoked
+func (l *invitationList) refresh() {
+	for _, row := range l.rows {
+		l.box.Remove(row)
+	}
+	l.rows = nil
+
+	invitations := l.h.service.Invitations()
+	for i, inv := range invitations {
+		if row := l.newRow(i, inv); row != nil {
+			l.rows = append(l.rows, row)
+		}
+	}
+
+	l.builder.get("boxInvitations").(gtki.Box).SetVisible(len(invitations) > 1)
+	l.builder.get("lblInvitationValidUntil").(gtki.Label).SetText(
+		invitationValidUntilText(l.h.service.InvitationExpiry()))
+	l.h.setInvitationLinks(l.builder)
+}
+
+func (l *invitationList) newRow(i int, invitation string) gtki.Box {
+	box, err := l.h.u.g.gtk.BoxNew(gtki.HorizontalOrientation, 10)
+	if err != nil {
+		log.Debugf("invitationList.newRow(): %s", err)
+		return nil
+	}
+
+	lblName, _ := l.h.u.g.gtk.LabelNew(inviteeName(invitation, i))
+	lblName.SetHAlign(gtki.ALIGN_START)
+	box.PackStart(lblName, true, true, 0)
+
+	if l.h.u.isCopyToClipboardSupported() {
+		btnCopy, _ := l.h.u.g.gtk.ButtonNewWithLabel(i18n().Sprintf("Copy"))
+		btnCopy.SetTooltipText(i18n().Sprintf("Copy the meeting ID of this invitee"))
+		_ = btnCopy.Connect("clicked", func() {
+			l.copyInvitation(invitation)
+		})
+		box.PackStart(btnCopy, false, false, 0)
+	}
+
+	// Only the invitations with a key of their own can be revoked
+	if l.h.service.ClientAuthKey() != "" {
+		btnRevoke, _ := l.h.u.g.gtk.ButtonNewWithLabel(i18n().Sprintf("Revoke"))
+		btnRevoke.SetTooltipText(i18n().Sprintf("Stop this invitee from joining the meeting"))
+		_ = btnRevoke.Connect("clicked", func() {
+			l.revoke(invitation, btnRevoke)
+		})
+		box.PackStart(btnRevoke, false, false, 0)
+	}
+
+	l.box.PackStart(box, false, true, 0)
+	box.ShowAll()
+
+	return box
+}
+
+func (l *invitationList) copyInvitation(invitation string) {
+	if err := l.h.u.copyToClipboard(invitation); err != nil {
+		l.h.u.reportError(err.Error())
+		return
+	}
+	l.showMessage(i18n().Sprintf("The meeting ID of the invitee has been copied to the clipboard"))
+}
+
+// revoke stops the invitee from joining, which publishes the
+// meeting again, so it's done outside of the UI thread
+func (l *invitationList) revoke(invitation string, btn gtki.Button) {
+	l.h.u.showConfirmation(func(ok bool) {
+		if !ok {
+			return
+		}
+
+		btn.SetSensitive(false)
+		go func() {
+			err := l.h.service.RevokeInvitation(invitation)
+			l.h.u.doInUIThread(func() {
+				if err != nil {
+					log.Errorf("The invitation can't be revoked: %s", err)
+					btn.SetSensitive(true)
+					l.h.u.reportError(i18n().Sprintf("The invitation can't be revoked: %s", err))
+					return
+				}
+				l.refresh()
+				l.showMessage(i18n().Sprintf("The invitation has been revoked"))
+			})
+		}()
+	}, i18n().Sprintf("The invitee won't be able to join the meeting with this invitation anymore, "+
+		"and the participants will be reconnected."))
+}
+
+// changeLifetime signs the invitations again with the chosen lifetime.
+// The invitations sent before keep the lifetime they were sent with
+func (l *invitationList) changeLifetime() {
+	cmbExpiry := l.builder.get("cmbInvitationExpiry").(gtki.ComboBoxText)
+	i := cmbExpiry.GetActive()
+	if i < 0 || i >= len(l.options) || l.options[i] == l.lifetime {
+		return
+	}
+
+	if err := l.h.service.RenewInvitations(l.options[i]); err != nil {
+		log.Errorf("The invitations can't be signed again: %s", err)
+		l.h.u.reportError(i18n().Sprintf("The time the invitations can be used can't be changed: %s", err))
+		for j, d := range l.options {
+			if d == l.lifetime {
+				cmbExpiry.SetActive(j)
+			}
+		}
+		return
+	}
+
+	l.lifetime = l.options[i]
+	l.refresh()
+	l.showMessage(i18n().Sprintf("The invitations have been changed. The ones sent before keep their own expiry"))
+}
+
+func (l *invitationList) showMessage(message string) {
+	lblMessage := l.builder.get("lblMessage").(gtki.Label)
+	lblMessage.SetVisible(false)
+
+	go l.h.u.messageToLabel(lblMessage, message, 5)
+}
+
+func (h *hostData) copyPasswordToClipboard(builder *uiBuilder) {
+	if err := h.u.copyToClipboard(h.meetingPassword); err != nil {
+		h.u.reportError(err.Error())
+		return
+	}
+
+	lblMessage := builder.get("lblMessage").(gtki.Label)
+	lblMessage.SetVisible(false)
+
+	go h.u.messageToLabel(lblMessage, i18n().Sprintf("The meeting password has been copied to the clipboard"), 5)
+}
@@ -0,0 +1,43 @@
+package gui
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type WahayInvitePeopleSuite struct{}
+
+var _ = Suite(&WahayInvitePeopleSuite{})
+
+func (s *WahayInvitePeopleSuite) Test_invitationLifetimeOptions_areTheDefaultsWithTheCurrentOne(c *C) {
+	c.Assert(invitationLifetimeOptions(24*time.Hour), DeepEquals,
+		[]time.Duration{time.Hour, 24 * time.Hour, 7 * 24 * time.Hour, 0})
+
+	c.Assert(invitationLifetimeOptions(48*time.Hour), DeepEquals,
+		[]time.Duration{time.Hour, 24 * time.Hour, 48 * time.Hour, 7 * 24 * time.Hour, 0})
+
+	c.Assert(invitationLifetimeOptions(0), DeepEquals,
+		[]time.Duration{time.Hour, 24 * time.Hour, 7 * 24 * time.Hour, 0})
+}
+
+func (s *WahayInvitePeopleSuite) Test_invitationLifetimeLabel_usesTheLargestUnit(c *C) {
+	c.Assert(invitationLifetimeLabel(0), Equals, "As long as the meeting lasts")
+	c.Assert(invitationLifetimeLabel(time.Hour), Equals, "1 hour")
+	c.Assert(invitationLifetimeLabel(36*time.Hour), Equals, "36 hours")
+	c.Assert(invitationLifetimeLabel(24*time.Hour), Equals, "1 day")
+	c.Assert(invitationLifetimeLabel(48*time.Hour), Equals, "2 days")
+	c.Assert(invitationLifetimeLabel(7*24*time.Hour), Equals, "1 week")
+	c.Assert(invitationLifetimeLabel(14*24*time.Hour), Equals, "2 weeks")
+}
+
+func (s *WahayInvitePeopleSuite) Test_invitationValidUntilText_isEmptyForUnsignedInvitations(c *C) {
+	c.Assert(invitationValidUntilText(time.Time{}), Equals, "")
+	c.Assert(invitationValidUntilText(time.Date(2024, 3, 1, 10, 30, 0, 0, time.Local)),
+		Equals, "Until 2024-03-01 10:30")
+}
+
+func (s *WahayInvitePeopleSuite) Test_inviteeName_isTheUsernameOfTheInvitation(c *C) {
+	c.Assert(inviteeName("Alice@abcdef.onion?auth=ONE", 0), Equals, "Alice")
+	c.Assert(inviteeName("abcdef.onion?auth=TWO", 1), Equals, "Invitation 2")
+}
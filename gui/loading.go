@@ -17,6 +17,10 @@ func (u *gtkUI) hideLoadingWindow() {
 
 	u.doInUIThread(u.loadingWindow.Hide)
 	u.loadingWindow = nil
+	if u.startupStatus != nil {
+		u.startupStatus.close()
+	}
+	u.startupStatus = nil
 }
 
 func (u *gtkUI) displayLoadingWindowHelper(cb func()) {
@@ -39,5 +43,7 @@ func (u *gtkUI) displayLoadingWindowHelper(cb func()) {
 
 	win.SetApplication(u.app)
 	u.loadingWindow = win
+	u.startupStatus = newStartupStatus(builder)
+	u.startupStatus.stopTranslating = onLanguageChange(u.startupStatus.translate)
 	u.doInUIThread(win.Show)
 }
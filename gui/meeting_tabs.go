@@ -0,0 +1,148 @@
+package gui
+
+import (
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/client"
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+// meetingTabTitleLength is how much of the onion address
+// of a meeting its tab shows
+const meetingTabTitleLength = 16
+
+// meetingTabTitle returns the title of the tab of a meeting, which is
+// the beginning of its onion address, since that's all Wahay knows of it
+func meetingTabTitle(meetingID string) string {
+	runes := []rune(meetingID)
+	if len(runes) <= meetingTabTitleLength {
+		return meetingID
+	}
+	return string(runes[:meetingTabTitleLength]) + "…"
+}
+
+type meetingTab struct {
+	page     gtki.Widget
+	m        tor.Service
+	controls *callControls
+}
+
+// meetingTabs is the window with the meetings joined while hosting one,
+// a tab for every meeting. The window goes away with the last meeting
+type meetingTabs struct {
+	u        *gtkUI
+	win      gtki.ApplicationWindow
+	notebook gtki.Notebook
+	// tabs are in the same order as the pages of the notebook
+	tabs   []*meetingTab
+	closed bool
+}
+
+func (u *gtkUI) newMeetingTabs() *meetingTabs {
+	builder := u.g.uiBuilderFor("MeetingTabsWindow")
+	builder.i18nProperties("title", "meetingTabsWindow")
+
+	t := &meetingTabs{u: u}
+	builder.getItems(
+		"meetingTabsWindow", &t.win,
+		"notebookMeetings", &t.notebook,
+	)
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_close": t.leaveAll,
+	})
+
+	t.win.SetApplication(u.app)
+	u.connectShortcutsMeetingTabs(t)
+
+	return t
+}
+
+// addMeetingTab shows the meeting joined with the client in a tab of its
+// own, opening the window of the tabs for the first one. The returned
+// function takes the tab away, and must be called when the meeting is left
+func (u *gtkUI) addMeetingTab(m tor.Service, meetingID string, c client.Instance) func() {
+	if u.meetingTabs == nil {
+		u.meetingTabs = u.newMeetingTabs()
+	}
+	t := u.meetingTabs
+
+	// The window of the meeting gives its contents to the tab
+	builder := u.getCurrentMeetingWindow()
+	win := builder.get("currentMeetingWindow").(gtki.ApplicationWindow)
+	controls := u.connectCurrentMeeting(builder, m, meetingID, c, func() {})
+	page := win.GetChild()
+	win.Remove(page)
+	win.Destroy()
+
+	tab := &meetingTab{page: page, m: m, controls: controls}
+	t.tabs = append(t.tabs, tab)
+	t.notebook.SetCurrentPage(t.notebook.AppendPage(page, nil))
+	t.notebook.SetTabLabelText(page, meetingTabTitle(meetingID))
+	t.notebook.SetShowTabs(len(t.tabs) > 1)
+
+	t.win.Show()
+	t.win.Present()
+
+	return func() {
+		t.remove(tab)
+	}
+}
+
+func (t *meetingTabs) remove(tab *meetingTab) {
+	if t.closed {
+		return
+	}
+
+	for i, other := range t.tabs {
+		if other == tab {
+			t.tabs = append(t.tabs[:i], t.tabs[i+1:]...)
+			t.notebook.Remove(tab.page)
+			break
+		}
+	}
+
+	if len(t.tabs) == 0 {
+		t.close()
+		return
+	}
+	t.notebook.SetShowTabs(len(t.tabs) > 1)
+}
+
+// current returns the tab the user is looking at, if any
+func (t *meetingTabs) current() *meetingTab {
+	i := t.notebook.GetCurrentPage()
+	if i < 0 || i >= len(t.tabs) {
+		return nil
+	}
+	return t.tabs[i]
+}
+
+// leaveAll asks the user before leaving every meeting in the tabs, which
+// closes the window. It returns true so the window isn't closed before
+func (t *meetingTabs) leaveAll() bool {
+	t.u.wouldYouConfirmLeaveMeeting(func(leave bool) {
+		if !leave {
+			return
+		}
+
+		tabs := t.tabs
+		t.close()
+		for _, tab := range tabs {
+			tab.m.Close()
+		}
+	})
+	return true
+}
+
+func (t *meetingTabs) close() {
+	if t.closed {
+		return
+	}
+
+	t.closed = true
+	t.tabs = nil
+	if t.u.meetingTabs == t {
+		t.u.meetingTabs = nil
+	}
+	t.win.Destroy()
+}
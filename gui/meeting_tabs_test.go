@@ -0,0 +1,14 @@
+package gui
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type WahayMeetingTabsSuite struct{}
+
+var _ = Suite(&WahayMeetingTabsSuite{})
+
+func (s *WahayMeetingTabsSuite) Test_meetingTabTitle_showsTheBeginningOfTheOnionAddress(c *C) {
+	c.Assert(meetingTabTitle("short.onion"), Equals, "short.onion")
+	c.Assert(meetingTabTitle("abcdefghijklmnopqrstuvwxyz234567abcdefghijklmnopqrstuvwx.onion"), Equals, "abcdefghijklmnop…")
+}
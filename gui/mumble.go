@@ -7,6 +7,7 @@ import (
 	"github.com/digitalautonomy/wahay/client"
 	"github.com/digitalautonomy/wahay/hosting"
 	"github.com/digitalautonomy/wahay/tor"
+	log "github.com/sirupsen/logrus"
 )
 
 func (u *gtkUI) ensureMumble(wg *sync.WaitGroup) {
@@ -15,6 +16,18 @@ func (u *gtkUI) ensureMumble(wg *sync.WaitGroup) {
 		go func() {
 			defer wg.Done()
 
+			changed, err := client.EnsureIdentity(u.config)
+			if err != nil {
+				log.Errorf("The Mumble client certificate can't be generated: %s", err)
+			} else if changed {
+				u.saveConfigOnly()
+			}
+
+			err = client.EnsureManagedClient(u.config, t)
+			if err != nil {
+				log.Errorf("The Mumble client managed by Wahay can't be used: %s", err)
+			}
+
 			c := client.InitSystem(u.config, t)
 
 			if !c.IsValid() {
@@ -39,6 +52,35 @@ func (u *gtkUI) launchMumbleClient(data hosting.MeetingData, onClose func()) (to
 	return c.Launch(data, onClose)
 }
 
+// testAudio opens the audio wizard of Mumble, which plays the microphone
+// back, so the audio devices are tried before the meeting starts
+func (u *gtkUI) testAudio() {
+	if u.client == nil || !u.client.IsValid() {
+		u.reportError(i18n().Sprintf("The audio can't be tested: there is no Mumble to test it with"))
+		return
+	}
+
+	if u.testingAudio {
+		return
+	}
+	u.testingAudio = true
+
+	done := func() {
+		u.doInUIThread(func() {
+			u.testingAudio = false
+		})
+	}
+
+	go func() {
+		_, err := u.client.TestAudio(done)
+		if err != nil {
+			log.Errorf("The audio can't be tested: %s", err)
+			u.reportError(i18n().Sprintf("The audio can't be tested: %s", err))
+			done()
+		}
+	}()
+}
+
 func (u *gtkUI) switchContextWhenMumbleFinish() {
 	u.hideCurrentWindow()
 	u.switchToMainWindow()
@@ -0,0 +1,254 @@
+package gui
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+type networkState int
+
+const (
+	networkStateGood networkState = iota
+	networkStateSlow
+	networkStateStalled
+)
+
+// The number of seconds without incoming traffic before we warn
+// the user. Mumble sends audio packets continuously, even when nobody
+// is talking, so a few seconds of silence means something is wrong
+const (
+	networkSlowAfter    = 3
+	networkStalledAfter = 8
+)
+
+var networkStateClasses = map[networkState]string{
+	networkStateSlow:    "network-slow",
+	networkStateStalled: "network-stalled",
+}
+
+type networkActivity struct {
+	sync.Mutex
+
+	read                  int64
+	written               int64
+	secondsWithoutTraffic int
+	circuitNotEstablished bool
+	staleNetwork          bool
+	// used is the number of bytes sent and received during the meeting
+	used int64
+}
+
+// update processes a Tor event and returns true if the event
+// changed something the user should see
+func (n *networkActivity) update(e tor.Event) bool {
+	n.Lock()
+	defer n.Unlock()
+
+	if bw, ok := tor.ParseBandwidthEvent(e); ok {
+		n.read = bw.Read
+		n.written = bw.Written
+		if bw.Read == 0 {
+			n.secondsWithoutTraffic++
+		} else {
+			n.secondsWithoutTraffic = 0
+		}
+		return true
+	}
+
+	if established, ok := tor.ParseCircuitStatusEvent(e); ok {
+		n.circuitNotEstablished = !established
+		return true
+	}
+
+	return false
+}
+
+// updateNetworkStatus remembers whether Tor's view of the network is
+// stale, and returns true if that changed
+func (n *networkActivity) updateNetworkStatus(s tor.NetworkStatus, now time.Time) bool {
+	n.Lock()
+	defer n.Unlock()
+
+	stale := s.Stale(now)
+	changed := stale != n.staleNetwork
+	n.staleNetwork = stale
+
+	return changed
+}
+
+func (n *networkActivity) setUsed(s tor.BandwidthStats) {
+	n.Lock()
+	defer n.Unlock()
+
+	n.used = s.TotalRead + s.TotalWritten
+}
+
+func (n *networkActivity) state() networkState {
+	n.Lock()
+	defer n.Unlock()
+
+	switch {
+	case n.circuitNotEstablished || n.staleNetwork || n.secondsWithoutTraffic >= networkStalledAfter:
+		return networkStateStalled
+	case n.secondsWithoutTraffic >= networkSlowAfter:
+		return networkStateSlow
+	}
+
+	return networkStateGood
+}
+
+func (n *networkActivity) description() string {
+	n.Lock()
+	defer n.Unlock()
+
+	if n.circuitNotEstablished {
+		return i18n().Sprintf("Tor can't reach the network")
+	}
+
+	if n.staleNetwork {
+		return i18n().Sprintf("Tor's information about the network is out of date")
+	}
+
+	return i18n().Sprintf("Network: %s down, %s up, %s used in this meeting",
+		formatBandwidth(n.read), formatBandwidth(n.written), formatDataSize(n.used))
+}
+
+func formatBandwidth(bytes int64) string {
+	if bytes < 1024 {
+		return i18n().Sprintf("%d B/s", bytes)
+	}
+	return i18n().Sprintf("%.1f KB/s", float64(bytes)/1024)
+}
+
+// formatDataSize shows the data used in the units people on
+// metered connections are used to
+func formatDataSize(bytes int64) string {
+	switch {
+	case bytes < 1024:
+		return i18n().Sprintf("%d B", bytes)
+	case bytes < 1024*1024:
+		return i18n().Sprintf("%.1f KB", float64(bytes)/1024)
+	}
+	return i18n().Sprintf("%.1f MB", float64(bytes)/(1024*1024))
+}
+
+// watchNetworkActivity keeps the given label updated with the current
+// throughput and circuit status of our Tor instance. The returned function
+// stops the updates
+func (u *gtkUI) watchNetworkActivity(lbl gtki.Label) func() {
+	if u.tor == nil {
+		return func() {}
+	}
+
+	n := &networkActivity{}
+	start := u.tor.BandwidthStats()
+
+	stop, err := u.tor.GetController().WatchEvents(
+		[]string{tor.EventBandwidth, tor.EventClientStatus},
+		func(e tor.Event) {
+			if e.Type == tor.EventBandwidth {
+				n.setUsed(u.tor.BandwidthStats().Since(start))
+			}
+
+			if n.update(e) {
+				u.doInUIThread(func() {
+					n.showIn(lbl)
+				})
+			}
+		})
+
+	if err != nil {
+		log.Errorf("watchNetworkActivity(): %s", err)
+		return func() {}
+	}
+
+	stopStatus := u.watchNetworkStatus(n, lbl)
+
+	stopTranslating := onLanguageChange(func() {
+		if lbl.IsVisible() {
+			n.showIn(lbl)
+		}
+	})
+
+	return func() {
+		stopTranslating()
+		stopStatus()
+		stop()
+	}
+}
+
+// networkStatusInterval is how often we check whether Tor's view of the
+// network is still valid. It doesn't change quickly, except after
+// resuming the computer
+const networkStatusInterval = 30 * time.Second
+
+// watchNetworkStatus warns the user when Tor's view of the network is
+// stale, since then the audio will fail no matter what the meeting does
+func (u *gtkUI) watchNetworkStatus(n *networkActivity, lbl gtki.Label) func() {
+	done := make(chan bool)
+	var once sync.Once
+
+	check := func() {
+		s, err := u.tor.NetworkStatus()
+		if err != nil {
+			log.Debugf("watchNetworkStatus(): %s", err)
+			return
+		}
+
+		if n.updateNetworkStatus(s, time.Now()) {
+			if s.Stale(time.Now()) {
+				log.WithField("status", s).Warn("Tor's view of the network is stale")
+			}
+
+			u.doInUIThread(func() {
+				n.showIn(lbl)
+			})
+		}
+	}
+
+	go func() {
+		t := time.NewTicker(networkStatusInterval)
+		defer t.Stop()
+
+		check()
+		for {
+			select {
+			case <-done:
+				return
+			case <-t.C:
+				check()
+			}
+		}
+	}()
+
+	return func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
+
+func (n *networkActivity) showIn(lbl gtki.Label) {
+	lbl.SetLabel(n.description())
+	lbl.SetVisible(true)
+
+	ctx, err := lbl.GetStyleContext()
+	if err != nil {
+		log.Debugf("networkActivity.showIn(): %s", err)
+		return
+	}
+
+	current := n.state()
+	for s, class := range networkStateClasses {
+		if s == current {
+			ctx.AddClass(class)
+		} else {
+			ctx.RemoveClass(class)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package gui
+
+import (
+	"time"
+
+	"github.com/digitalautonomy/wahay/tor"
+	. "gopkg.in/check.v1"
+)
+
+type WahayNetworkActivitySuite struct{}
+
+var _ = Suite(&WahayNetworkActivitySuite{})
+
+func bandwidthEvent(read, written string) tor.Event {
+	return tor.Event{Type: tor.EventBandwidth, Args: []string{read, written}}
+}
+
+func (s *WahayNetworkActivitySuite) Test_networkActivity_isGoodWhileThereIsTraffic(c *C) {
+	n := &networkActivity{}
+
+	c.Assert(n.update(bandwidthEvent("2048", "1024")), Equals, true)
+	c.Assert(n.state(), Equals, networkStateGood)
+}
+
+func (s *WahayNetworkActivitySuite) Test_networkActivity_warnsWhenTheTrafficStalls(c *C) {
+	n := &networkActivity{}
+
+	for i := 0; i < networkSlowAfter; i++ {
+		n.update(bandwidthEvent("0", "512"))
+	}
+	c.Assert(n.state(), Equals, networkStateSlow)
+
+	for i := networkSlowAfter; i < networkStalledAfter; i++ {
+		n.update(bandwidthEvent("0", "512"))
+	}
+	c.Assert(n.state(), Equals, networkStateStalled)
+
+	n.update(bandwidthEvent("100", "512"))
+	c.Assert(n.state(), Equals, networkStateGood)
+}
+
+func (s *WahayNetworkActivitySuite) Test_networkActivity_isStalledWithoutCircuits(c *C) {
+	n := &networkActivity{}
+
+	changed := n.update(tor.Event{Type: tor.EventClientStatus, Args: []string{"NOTICE", "CIRCUIT_NOT_ESTABLISHED"}})
+	c.Assert(changed, Equals, true)
+	c.Assert(n.state(), Equals, networkStateStalled)
+
+	n.update(tor.Event{Type: tor.EventClientStatus, Args: []string{"NOTICE", "CIRCUIT_ESTABLISHED"}})
+	c.Assert(n.state(), Equals, networkStateGood)
+}
+
+func (s *WahayNetworkActivitySuite) Test_networkActivity_ignoresOtherEvents(c *C) {
+	n := &networkActivity{}
+
+	c.Assert(n.update(tor.Event{Type: "CIRC"}), Equals, false)
+}
+
+func (s *WahayNetworkActivitySuite) Test_formatBandwidth_usesKilobytesForBigValues(c *C) {
+	c.Assert(formatBandwidth(512), Equals, "512 B/s")
+	c.Assert(formatBandwidth(2048), Equals, "2.0 KB/s")
+}
+
+func (s *WahayNetworkActivitySuite) Test_networkActivity_isStalledWhileTheNetworkStatusIsStale(c *C) {
+	n := &networkActivity{}
+	now := time.Now()
+
+	c.Assert(n.updateNetworkStatus(tor.NetworkStatus{Live: true}, now), Equals, true)
+	c.Assert(n.state(), Equals, networkStateStalled)
+
+	fresh := tor.NetworkStatus{Live: true, ValidAfter: now.Add(-time.Hour), ValidUntil: now.Add(time.Hour)}
+	c.Assert(n.updateNetworkStatus(fresh, now), Equals, true)
+	c.Assert(n.updateNetworkStatus(fresh, now), Equals, false)
+	c.Assert(n.state(), Equals, networkStateGood)
+}
+
+func (s *WahayNetworkActivitySuite) Test_networkActivity_showsTheDataUsedInTheMeeting(c *C) {
+	n := &networkActivity{}
+
+	n.setUsed(tor.BandwidthStats{TotalRead: 2 * 1024 * 1024, TotalWritten: 1024 * 1024})
+	n.update(bandwidthEvent("2048", "1024"))
+
+	c.Assert(n.description(), Equals, "Network: 2.0 KB/s down, 1.0 KB/s up, 3.0 MB used in this meeting")
+}
+
+func (s *WahayNetworkActivitySuite) Test_formatDataSize_usesTheBiggestUnitThatFits(c *C) {
+	c.Assert(formatDataSize(512), Equals, "512 B")
+	c.Assert(formatDataSize(1536), Equals, "1.5 KB")
+	c.Assert(formatDataSize(5*1024*1024), Equals, "5.0 MB")
+}
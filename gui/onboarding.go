@@ -0,0 +1,247 @@
+package gui
+
+import (
+	"github.com/coyim/gotk3adapter/gtki"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+// needsOnboarding tells whether Wahay starts without a configuration
+// file, which is the first time it runs, or every time for the
+// users that don't want it to remember their settings
+func needsOnboarding(conf *config.ApplicationConfig) bool {
+	return !conf.IsPersistentConfiguration()
+}
+
+// onboardingChoices are the answers of the user in the first-run guide
+type onboardingChoices struct {
+	remember bool
+	encrypt  bool
+	transmit config.TransmitMode
+	testCall bool
+}
+
+// applyTo changes the configuration with the choices. The password
+// to encrypt it is asked for apart, since it needs its own window
+func (c onboardingChoices) applyTo(conf *config.ApplicationConfig) {
+	conf.SetPersistentConfiguration(c.remember)
+
+	p := conf.GetAudioPreset()
+	p.Transmit = c.transmit
+	conf.SetAudioPreset(p)
+}
+
+type onboarding struct {
+	u         *gtkUI
+	assistant gtki.Assistant
+
+	chkRemember gtki.CheckButton
+	chkEncrypt  gtki.CheckButton
+	cmbTransmit gtki.ComboBoxText
+	chkTestCall gtki.CheckButton
+
+	// onDone starts Wahay once the guide is closed, with the
+	// function to run when the main window is shown, if any
+	onDone func(afterwards func())
+}
+
+// showOnboarding guides the user through the settings a working setup
+// needs before Tor and Mumble start, since some of them, like the
+// bridges, are only used when they start
+func (u *gtkUI) showOnboarding(onDone func(afterwards func())) {
+	builder := u.g.uiBuilderFor("OnboardingWindow")
+
+	builder.i18nProperties(
+		"title", "onboardingAssistant",
+		"label", "lblOnboardingWelcome",
+		"label", "lblOnboardingIntro",
+		"label", "lblOnboardingSkip",
+		"label", "lblOnboardingSettings",
+		"checkbox", "chkRememberSettings",
+		"checkbox", "chkEncryptSettings",
+		"label", "lblOnboardingBridges",
+		"label", "lblOnboardingNoBridges",
+		"button", "btnOnboardingBridges",
+		"label", "lblOnboardingAudio",
+		"label", "lblOnboardingTransmitModeHelp",
+		"label", "lblOnboardingTestCall",
+		"checkbox", "chkTestCall",
+	)
+
+	o := &onboarding{u: u, onDone: onDone}
+
+	builder.getItems(
+		"onboardingAssistant", &o.assistant,
+		"chkRememberSettings", &o.chkRemember,
+		"chkEncryptSettings", &o.chkEncrypt,
+		"cmbOnboardingTransmitMode", &o.cmbTransmit,
+		"chkTestCall", &o.chkTestCall,
+	)
+
+	titles := map[string]string{
+		"pageWelcome":  i18n().Sprintf("Welcome"),
+		"pageSettings": i18n().Sprintf("Your settings"),
+		"pageBridges":  i18n().Sprintf("Connecting to Tor"),
+		"pageAudio":    i18n().Sprintf("Your voice"),
+		"pageTestCall": i18n().Sprintf("Test call"),
+	}
+	for id, title := range titles {
+		o.assistant.SetPageTitle(builder.get(id).(gtki.Widget), title)
+	}
+
+	current := u.config.GetAudioPreset().Transmit
+	for i, m := range transmitModeOptions {
+		o.cmbTransmit.AppendText(transmitModeLabel(m))
+		if m == current {
+			o.cmbTransmit.SetActive(i)
+		}
+	}
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_remember_toggled": func() {
+			o.chkEncrypt.SetSensitive(o.chkRemember.GetActive())
+		},
+		"on_get_bridges": u.openBridgesWindow,
+		"on_apply":       o.apply,
+		"on_cancel":      o.skip,
+		// The guide closes itself after it's applied
+		"on_close": func() {},
+	})
+
+	o.assistant.SetApplication(u.app)
+	o.assistant.SetIcon(getApplicationIcon().getPixbuf())
+	u.setCurrentWindow(o.assistant)
+	o.assistant.Show()
+}
+
+func (o *onboarding) choices() onboardingChoices {
+	c := onboardingChoices{
+		remember: o.chkRemember.GetActive(),
+		testCall: o.chkTestCall.GetActive(),
+		transmit: config.DefaultAudioPreset().Transmit,
+	}
+	c.encrypt = c.remember && o.chkEncrypt.GetActive()
+
+	if i := o.cmbTransmit.GetActive(); i >= 0 && i < len(transmitModeOptions) {
+		c.transmit = transmitModeOptions[i]
+	}
+
+	return c
+}
+
+func (o *onboarding) apply() {
+	c := o.choices()
+	c.applyTo(o.u.config)
+
+	var afterwards func()
+	if c.testCall {
+		afterwards = o.u.startTestCall
+	}
+
+	if !c.encrypt {
+		o.u.saveConfigOnly()
+		o.finish(afterwards)
+		return
+	}
+
+	o.u.disableCurrentWindow()
+	o.u.captureMasterPassword(func() {
+		o.u.config.SetShouldEncrypt(true)
+		o.u.saveConfigOnly()
+		o.finish(afterwards)
+	}, func() {
+		// Without a password the settings are still remembered,
+		// like when the user doesn't want them encrypted
+		o.u.saveConfigOnly()
+		o.finish(afterwards)
+	})
+}
+
+// skip starts Wahay with the settings it had, which are the defaults
+func (o *onboarding) skip() {
+	o.finish(nil)
+}
+
+func (o *onboarding) finish(afterwards func()) {
+	o.u.doInUIThread(o.assistant.Destroy)
+	o.u.currentWindow = nil
+	go o.onDone(afterwards)
+}
+
+// testCallName is the name of the test call, which is
+// the name of the channel Mumble shows once joined
+const testCallName = "Wahay test call"
+
+// startTestCall joins a meeting hosted on this computer, only reachable
+// from it, so the user can try the microphone and the speakers with Mumble
+// before a real meeting, when nothing depends on Tor being fast
+func (u *gtkUI) startTestCall() {
+	go func() {
+		serv, err := u.startTestCallServer()
+		if err != nil {
+			log.Errorf("The test call can't be started: %s", err)
+			u.reportError(i18n().Sprintf("The test call can't be started: %s", err))
+			return
+		}
+
+		data := hosting.MeetingData{
+			MeetingID: testCallAddress,
+			Port:      serv.port,
+			Username:  i18n().Sprintf("Me"),
+			IsHost:    true,
+		}
+
+		_, err = u.launchMumbleClient(data, func() {
+			u.stopTestCallServer(serv.Server)
+		})
+		if err != nil {
+			log.Errorf("The test call can't be joined: %s", err)
+			u.reportError(i18n().Sprintf("The test call can't be joined: %s", err))
+			u.stopTestCallServer(serv.Server)
+		}
+	}()
+}
+
+// testCallAddress is where Mumble connects for the test call. It's
+// the loopback interface, the only place the server can be reached from
+const testCallAddress = "127.0.0.1"
+
+type testCallServer struct {
+	hosting.Server
+	port int
+}
+
+func (u *gtkUI) startTestCallServer() (*testCallServer, error) {
+	if err := u.ensureServerCollection(); err != nil {
+		return nil, err
+	}
+
+	port := config.GetRandomPort()
+	serv, err := u.servers.CreateServer(hosting.ServerOptions{
+		Port:    port,
+		Address: testCallAddress,
+		Name:    testCallName,
+		WelcomeText: i18n().Sprintf("This is a test call on your computer. Talk and listen to yourself with " +
+			"the loopback test of Mumble: open Configure, then Settings, then Audio Output, " +
+			"and choose Server as the loopback mode."),
+		MaxUsers: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := serv.Start(); err != nil {
+		u.stopTestCallServer(serv)
+		return nil, err
+	}
+
+	return &testCallServer{Server: serv, port: port}, nil
+}
+
+func (u *gtkUI) stopTestCallServer(serv hosting.Server) {
+	if err := u.servers.DestroyServer(serv); err != nil {
+		log.Debugf("stopTestCallServer(): %s", err)
+	}
+}
@@ -0,0 +1,32 @@
+package gui
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+type WahayOnboardingSuite struct{}
+
+var _ = Suite(&WahayOnboardingSuite{})
+
+func (s *WahayOnboardingSuite) Test_needsOnboarding_whenTheSettingsAreNotRemembered(c *C) {
+	conf := config.New()
+
+	conf.SetPersistentConfiguration(false)
+	c.Assert(needsOnboarding(conf), Equals, true)
+
+	conf.SetPersistentConfiguration(true)
+	c.Assert(needsOnboarding(conf), Equals, false)
+}
+
+func (s *WahayOnboardingSuite) Test_onboardingChoices_applyTo_changesTheConfiguration(c *C) {
+	conf := config.New()
+	noiseSuppression := conf.GetAudioPreset().NoiseSuppression
+
+	onboardingChoices{remember: true, transmit: config.TransmitVoiceActivity}.applyTo(conf)
+
+	c.Assert(conf.IsPersistentConfiguration(), Equals, true)
+	c.Assert(conf.GetAudioPreset().Transmit, Equals, config.TransmitVoiceActivity)
+	c.Assert(conf.GetAudioPreset().NoiseSuppression, Equals, noiseSuppression)
+}
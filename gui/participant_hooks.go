@@ -0,0 +1,42 @@
+package gui
+
+import (
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+// watchParticipants tells the host about the guests joining and leaving
+// the meeting, and runs the hook script configured for it, if any
+func (h *hostData) watchParticipants() {
+	serv := h.service.Server()
+	if serv == nil {
+		return
+	}
+
+	if h.u.config.AreParticipantNotificationsEnabled() {
+		h.stopParticipantHooks = append(h.stopParticipantHooks, serv.OnParticipantEvent(notifyParticipantEvent))
+	}
+
+	if script := h.u.config.GetParticipantHookScript(); script != "" {
+		h.stopParticipantHooks = append(h.stopParticipantHooks, serv.OnParticipantEvent(hosting.ScriptHook(script)))
+	}
+}
+
+func (h *hostData) stopWatchingParticipants() {
+	for _, stop := range h.stopParticipantHooks {
+		stop()
+	}
+	h.stopParticipantHooks = nil
+}
+
+// notifyParticipantEvent shows a desktop notification for the guests joining
+// and leaving. The waiting room has its own dialog, so it's not notified
+func notifyParticipantEvent(ev hosting.ParticipantEvent) {
+	switch ev.Type {
+	case hosting.ParticipantConnected:
+		showDesktopNotification(i18n().Sprintf("A participant joined the meeting"),
+			i18n().Sprintf("Participants connected: %d", ev.Participants))
+	case hosting.ParticipantDisconnected:
+		showDesktopNotification(i18n().Sprintf("A participant left the meeting"),
+			i18n().Sprintf("Participants connected: %d", ev.Participants))
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/coyim/gotk3adapter/gtki"
 	"github.com/digitalautonomy/wahay/config"
+	log "github.com/sirupsen/logrus"
 )
 
 const passwordMinSize = 1
@@ -61,6 +62,8 @@ func (u *gtkUI) getMasterPasswordBuilder() *uiBuilder {
 		"placeholder", "entryPassword",
 		"tooltip", "btnTogglePassword",
 		"label", "lblMasterPasswordText",
+		"checkbox", "chkRememberPassword",
+		"tooltip", "chkRememberPassword",
 		"button", "btnMasterPasswordCancel",
 		"button", "btnMasterPasswordContinue")
 
@@ -70,6 +73,12 @@ func (u *gtkUI) getMasterPasswordBuilder() *uiBuilder {
 // This function should be only called on startup, never call this function
 // or should not be called during the execution of this app
 func (u *gtkUI) getMasterPassword(p config.EncryptionParameters, lastAttemptFailed bool) config.EncryptionResult {
+	if !lastAttemptFailed {
+		if password, err := config.LoadPasswordFromKeychain(); err == nil {
+			return config.GenerateKeysBasedOnPassword(password, p)
+		}
+	}
+
 	u.hideLoadingWindow()
 
 	passwordResultCh := make(chan string)
@@ -79,6 +88,8 @@ func (u *gtkUI) getMasterPassword(p config.EncryptionParameters, lastAttemptFail
 	win := builder.get("masterPasswordWindow").(gtki.Window)
 	txtPassword := builder.get("entryPassword").(gtki.Entry)
 	btnTogglePassword := builder.get("btnTogglePassword").(gtki.CheckButton)
+	chkRememberPassword := builder.get("chkRememberPassword").(gtki.CheckButton)
+	chkRememberPassword.SetVisible(config.IsKeychainAvailable())
 
 	win.SetApplication(u.app)
 
@@ -109,6 +120,9 @@ func (u *gtkUI) getMasterPassword(p config.EncryptionParameters, lastAttemptFail
 					hadSubmission = false
 				} else {
 					txtPassword.SetSensitive(false)
+					if chkRememberPassword.GetActive() {
+						rememberPassword(text)
+					}
 					passwordResultCh <- text
 					close(passwordResultCh)
 				}
@@ -176,6 +190,8 @@ func (u *gtkUI) captureMasterPassword(onSuccess func(), onCancel func()) {
 					savedPassword:  password,
 					realKeySuplier: u.keySupplier,
 				}
+				// The password remembered before doesn't open the file anymore
+				forgetPassword()
 				isValidPassword = true
 				passwordWindow.Destroy()
 				u.enableWindow(u.currentWindow)
@@ -194,6 +210,21 @@ func (u *gtkUI) captureMasterPassword(onSuccess func(), onCancel func()) {
 	u.doInUIThread(passwordWindow.Show)
 }
 
+// rememberPassword keeps the password of the configuration
+// file in the keychain of the system
+func rememberPassword(password string) {
+	if err := config.SavePasswordInKeychain(password); err != nil {
+		log.Errorf("rememberPassword(): %s", err)
+	}
+}
+
+func forgetPassword() {
+	err := config.RemovePasswordFromKeychain()
+	if err != nil && err != config.ErrNoKeychain && err != config.ErrNoPasswordInKeychain {
+		log.Errorf("forgetPassword(): %s", err)
+	}
+}
+
 func validatePasswords(pass1, pass2 string) error {
 	if len(pass1) == 0 {
 		return errors.New(i18n().Sprintf("please enter a valid password"))
@@ -0,0 +1,49 @@
+package gui
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// maxAutomaticRejoins is how many times in a row the meeting is joined
+	// again without asking, so a client that can't start doesn't loop forever
+	maxAutomaticRejoins = 3
+
+	// stableMeetingDuration is how long the client has to work before
+	// crashing for the automatic rejoins to be counted from zero again
+	stableMeetingDuration = time.Minute
+)
+
+// rejoinAttempt returns how many times in a row the client has crashed
+// shortly after joining, counting the crash that happened at crashedAt
+func rejoinAttempt(previous int, joinedAt, crashedAt time.Time) int {
+	if crashedAt.Sub(joinedAt) >= stableMeetingDuration {
+		return 1
+	}
+	return previous + 1
+}
+
+// onMumbleCrash is called when the Mumble client closes unexpectedly
+// in the middle of a meeting. The meeting is joined again with rejoin,
+// without asking when the user chose so, and giveUp goes back to where
+// Wahay would be if the user had closed the client
+func (u *gtkUI) onMumbleCrash(attempt int, rejoin, giveUp func()) {
+	log.Warnf("The Mumble client closed unexpectedly (attempt %d)", attempt)
+
+	if u.config.IsAutoRejoinEnabled() && attempt <= maxAutomaticRejoins {
+		rejoin()
+		return
+	}
+
+	u.doInUIThread(func() {
+		u.showConfirmation(func(ok bool) {
+			if ok {
+				rejoin()
+				return
+			}
+			giveUp()
+		}, i18n().Sprintf("Mumble closed unexpectedly. Do you want to join the meeting again?"))
+	})
+}
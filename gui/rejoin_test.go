@@ -0,0 +1,24 @@
+package gui
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type WahayRejoinSuite struct{}
+
+var _ = Suite(&WahayRejoinSuite{})
+
+func (s *WahayRejoinSuite) Test_rejoinAttempt_countsTheCrashesShortlyAfterJoining(c *C) {
+	joinedAt := time.Now()
+
+	c.Assert(rejoinAttempt(0, joinedAt, joinedAt.Add(time.Second)), Equals, 1)
+	c.Assert(rejoinAttempt(2, joinedAt, joinedAt.Add(time.Second)), Equals, 3)
+}
+
+func (s *WahayRejoinSuite) Test_rejoinAttempt_startsAgainAfterAStableMeeting(c *C) {
+	joinedAt := time.Now()
+
+	c.Assert(rejoinAttempt(maxAutomaticRejoins, joinedAt, joinedAt.Add(stableMeetingDuration)), Equals, 1)
+}
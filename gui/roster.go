@@ -0,0 +1,269 @@
+package gui
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+// leftUsers returns the sessions of the shown
+// users that are not on the server anymore
+func leftUsers(shown map[uint32]*rosterRow, users []hosting.User) []uint32 {
+	current := make(map[uint32]bool, len(users))
+	for _, u := range users {
+		current[u.Session] = true
+	}
+
+	var result []uint32
+	for session := range shown {
+		if !current[session] {
+			result = append(result, session)
+		}
+	}
+	return result
+}
+
+// muteButtonText is what the button that mutes
+// and unmutes a user does next
+func muteButtonText(muted bool) string {
+	if muted {
+		return i18n().Sprintf("Unmute")
+	}
+	return i18n().Sprintf("Mute")
+}
+
+type rosterRow struct {
+	box        gtki.Box
+	lblName    gtki.Label
+	lblTalking gtki.Label
+	btnMute    gtki.Button
+	muted      bool
+}
+
+// roster shows the users in the meeting to the host, the host included,
+// as the session of Wahay on the Mumble server knows them
+type roster struct {
+	u        *gtkUI
+	serv     hosting.Server
+	box      gtki.Box
+	lblEmpty gtki.Label
+	rows     map[uint32]*rosterRow
+	// ban bans the user of a row, when the roster has a Ban button
+	ban func(hosting.User)
+}
+
+// watchParticipantRoster keeps the list of users in the builder
+// updated until the returned function is called. It's updated every
+// time somebody joins, leaves, changes or starts or stops talking.
+// The users can be banned from it when ban is given
+func (h *hostData) watchParticipantRoster(builder *uiBuilder, ban func(hosting.User)) func() {
+	serv := h.service.Server()
+	if serv == nil {
+		return func() {}
+	}
+
+	r := &roster{
+		u:        h.u,
+		serv:     serv,
+		box:      builder.get("boxParticipants").(gtki.Box),
+		lblEmpty: builder.get("lblNoParticipants").(gtki.Label),
+		rows:     make(map[uint32]*rosterRow),
+		ban:      ban,
+	}
+
+	events, stopEvents := serv.Subscribe()
+	done := make(chan bool)
+	talking := make(map[uint32]bool)
+
+	// The rows are made again in the language chosen
+	translated := make(chan bool, 1)
+	stopTranslating := onLanguageChange(func() {
+		r.clear()
+		select {
+		case translated <- true:
+		default:
+		}
+	})
+
+	refresh := func() {
+		users := serv.Users()
+		sort.Slice(users, func(i, j int) bool { return users[i].Session < users[j].Session })
+
+		nowTalking := make(map[uint32]bool, len(talking))
+		for session := range talking {
+			nowTalking[session] = true
+		}
+
+		h.u.doInUIThread(func() {
+			// The window could be gone by the time this runs
+			select {
+			case <-done:
+			default:
+				r.update(users, nowTalking)
+			}
+		})
+	}
+
+	go func() {
+		refresh()
+		for {
+			select {
+			case <-done:
+				return
+			case <-translated:
+			case ev, ok := <-events:
+				if !ok {
+					return
+				}
+				switch ev.Type {
+				case hosting.UserStartedTalking:
+					talking[ev.User.Session] = true
+				case hosting.UserStoppedTalking, hosting.UserDisconnected:
+					delete(talking, ev.User.Session)
+				}
+			}
+			refresh()
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			stopTranslating()
+			close(done)
+			stopEvents()
+		})
+	}
+}
+
+func (r *roster) update(users []hosting.User, talking map[uint32]bool) {
+	for _, session := range leftUsers(r.rows, users) {
+		r.box.Remove(r.rows[session].box)
+		delete(r.rows, session)
+	}
+
+	for _, u := range users {
+		row, ok := r.rows[u.Session]
+		if !ok {
+			row = r.newRow(u)
+			if row == nil {
+				continue
+			}
+			r.rows[u.Session] = row
+		}
+
+		row.lblName.SetText(u.Name)
+		row.muted = u.Muted
+		row.btnMute.SetLabel(muteButtonText(u.Muted))
+		row.btnMute.SetSensitive(true)
+
+		if talking[u.Session] {
+			row.lblTalking.SetText(i18n().Sprintf("Talking"))
+		} else {
+			row.lblTalking.SetText("")
+		}
+	}
+
+	r.lblEmpty.SetVisible(len(r.rows) == 0)
+}
+
+// clear takes all the users out of the roster
+func (r *roster) clear() {
+	for session, row := range r.rows {
+		r.box.Remove(row.box)
+		delete(r.rows, session)
+	}
+}
+
+func (r *roster) newRow(u hosting.User) *rosterRow {
+	session := u.Session
+
+	box, err := r.u.g.gtk.BoxNew(gtki.HorizontalOrientation, 10)
+	if err != nil {
+		log.Debugf("newRow(): %s", err)
+		return nil
+	}
+
+	lblName, _ := r.u.g.gtk.LabelNew("")
+	lblName.SetHAlign(gtki.ALIGN_START)
+	lblName.SetHExpand(true)
+
+	lblTalking, _ := r.u.g.gtk.LabelNew("")
+	btnMute, _ := r.u.g.gtk.ButtonNewWithLabel(muteButtonText(false))
+	btnMute.SetTooltipText(i18n().Sprintf("Keep this participant from talking until you unmute them. " +
+		"They can't unmute themselves"))
+
+	btnKick, _ := r.u.g.gtk.ButtonNewWithLabel(i18n().Sprintf("Kick"))
+	btnKick.SetTooltipText(i18n().Sprintf("Take this participant out of the meeting. " +
+		"Their Mumble client can connect again"))
+
+	row := &rosterRow{box: box, lblName: lblName, lblTalking: lblTalking, btnMute: btnMute}
+
+	_ = btnMute.Connect("clicked", func() {
+		btnMute.SetSensitive(false)
+		mute := !row.muted
+		go func() {
+			var err error
+			if mute {
+				err = r.serv.Mute(session)
+			} else {
+				err = r.serv.Unmute(session)
+			}
+			// The user may have left in the meantime
+			if err != nil {
+				log.Debugf("roster: %s", err)
+			}
+		}()
+	})
+
+	_ = btnKick.Connect("clicked", func() {
+		btnKick.SetSensitive(false)
+		reason := i18n().Sprintf("The host took you out of the meeting")
+		go func() {
+			if err := r.serv.Kick(session, reason); err != nil {
+				log.Debugf("roster: %s", err)
+			}
+		}()
+	})
+
+	box.PackStart(lblName, true, true, 0)
+	box.PackStart(lblTalking, false, false, 0)
+	box.PackStart(btnMute, false, false, 0)
+	box.PackStart(btnKick, false, false, 0)
+
+	// The certificate of a user doesn't change while they are connected
+	if r.ban != nil && u.CertHash != "" {
+		btnBan, _ := r.u.g.gtk.ButtonNewWithLabel(i18n().Sprintf("Ban"))
+		btnBan.SetTooltipText(i18n().Sprintf("Take this participant out of the meeting and keep them " +
+			"out of this meeting and the next ones, by the certificate of their Mumble client"))
+		_ = btnBan.Connect("clicked", func() {
+			btnBan.SetSensitive(false)
+			r.ban(u)
+		})
+		box.PackStart(btnBan, false, false, 0)
+	}
+
+	r.box.PackStart(box, false, true, 0)
+	box.ShowAll()
+
+	return row
+}
+
+// showParticipantRoster shows the participants in the window of the
+// builder, instead of in the window the host was looking at before
+func (h *hostData) showParticipantRoster(builder *uiBuilder, ban func(hosting.User)) {
+	h.hideParticipantRoster()
+	h.stopRoster = h.watchParticipantRoster(builder, ban)
+	h.rosterBuilder = builder
+}
+
+func (h *hostData) hideParticipantRoster() {
+	if h.stopRoster != nil {
+		h.stopRoster()
+		h.stopRoster = nil
+	}
+}
@@ -0,0 +1,27 @@
+package gui
+
+import (
+	"github.com/digitalautonomy/wahay/hosting"
+	. "gopkg.in/check.v1"
+)
+
+type WahayRosterSuite struct{}
+
+var _ = Suite(&WahayRosterSuite{})
+
+func (s *WahayRosterSuite) Test_muteButtonText_saysWhatTheButtonDoesNext(c *C) {
+	c.Assert(muteButtonText(false), Equals, "Mute")
+	c.Assert(muteButtonText(true), Equals, "Unmute")
+}
+
+func (s *WahayRosterSuite) Test_leftUsers_returnsTheUsersThatAreGone(c *C) {
+	shown := map[uint32]*rosterRow{1: nil, 2: nil, 3: nil}
+	users := []hosting.User{{Session: 2}, {Session: 4}}
+
+	left := leftUsers(shown, users)
+
+	c.Assert(left, HasLen, 2)
+	for _, session := range left {
+		c.Assert(session == 1 || session == 3, Equals, true)
+	}
+}
@@ -3,6 +3,7 @@ package gui
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -17,6 +18,16 @@ type settings struct {
 	dialog gtki.Window
 
 	chkAutojoin                gtki.CheckButton
+	chkClientAuthorization     gtki.CheckButton
+	chkSingleHopHosting        gtki.CheckButton
+	chkPersistentOnion         gtki.CheckButton
+	chkPersistentCertificate   gtki.CheckButton
+	chkWaitingRoom             gtki.CheckButton
+	chkWebGateway              gtki.CheckButton
+	chkPersistentIdentity      gtki.CheckButton
+	chkAutoRejoin              gtki.CheckButton
+	chkManagedClient           gtki.CheckButton
+	chkTrayIcon                gtki.CheckButton
 	chkPersistentConfiguration gtki.CheckButton
 	chkEncryptFile             gtki.CheckButton
 	lblMessage                 gtki.Label
@@ -28,8 +39,24 @@ type settings struct {
 	lblPortMumbleMessage       gtki.Label
 	torBinaryLocation          gtki.Entry
 	cmbBoxColorScheme          gtki.ComboBoxText
+	cmbLanguage                gtki.ComboBoxText
+	cmbTransmitMode            gtki.ComboBoxText
+	cmbNoiseSuppression        gtki.ComboBoxText
+	cmbJitterBuffer            gtki.ComboBoxText
+	chkStartMuted              gtki.CheckButton
+	chkStartDeafened           gtki.CheckButton
 
 	autoJoinOriginalValue          bool
+	clientAuthOriginalValue        bool
+	singleHopOriginalValue         bool
+	persistentOnionOriginalValue   bool
+	persistentCertOriginalValue    bool
+	waitingRoomOriginalValue       bool
+	webGatewayOriginalValue        bool
+	identityOriginalValue          bool
+	autoRejoinOriginalValue        bool
+	managedClientOriginalValue     bool
+	trayIconOriginalValue          bool
 	persistConfigFileOriginalValue bool
 	encryptFileOriginalValue       bool
 	logOriginalValue               bool
@@ -37,6 +64,13 @@ type settings struct {
 	mumbleBinaryOriginalValue      string
 	mumblePortOriginalValue        string
 	torBinaryOriginalValue         string
+	audioPresetOriginalValue       config.AudioPreset
+	startMutedOriginalValue        bool
+	startDeafenedOriginalValue     bool
+
+	// stopTranslating stops translating the options
+	// of the combo boxes when the language changes
+	stopTranslating func()
 }
 
 func createSettings(u *gtkUI) *settings {
@@ -51,6 +85,16 @@ func createSettings(u *gtkUI) *settings {
 
 	s.b.getItems(
 		"chkAutojoin", &s.chkAutojoin,
+		"chkClientAuthorization", &s.chkClientAuthorization,
+		"chkSingleHopHosting", &s.chkSingleHopHosting,
+		"chkPersistentOnion", &s.chkPersistentOnion,
+		"chkPersistentCertificate", &s.chkPersistentCertificate,
+		"chkWaitingRoom", &s.chkWaitingRoom,
+		"chkWebGateway", &s.chkWebGateway,
+		"chkPersistentIdentity", &s.chkPersistentIdentity,
+		"chkAutoRejoin", &s.chkAutoRejoin,
+		"chkManagedClient", &s.chkManagedClient,
+		"chkTrayIcon", &s.chkTrayIcon,
 		"chkPersistentConfiguration", &s.chkPersistentConfiguration,
 		"chkEncryptFile", &s.chkEncryptFile,
 		"lblMessage", &s.lblMessage,
@@ -62,6 +106,12 @@ func createSettings(u *gtkUI) *settings {
 		"lblPortMumbleMessage", &s.lblPortMumbleMessage,
 		"torBinaryLocation", &s.torBinaryLocation,
 		"cmbBoxColorScheme", &s.cmbBoxColorScheme,
+		"cmbLanguage", &s.cmbLanguage,
+		"cmbTransmitMode", &s.cmbTransmitMode,
+		"cmbNoiseSuppression", &s.cmbNoiseSuppression,
+		"cmbJitterBuffer", &s.cmbJitterBuffer,
+		"chkStartMuted", &s.chkStartMuted,
+		"chkStartDeafened", &s.chkStartDeafened,
 	)
 
 	s.init()
@@ -75,6 +125,36 @@ func (s *settings) init() {
 	s.autoJoinOriginalValue = conf.GetAutoJoin()
 	s.chkAutojoin.SetActive(s.autoJoinOriginalValue)
 
+	s.clientAuthOriginalValue = conf.GetClientAuthorization()
+	s.chkClientAuthorization.SetActive(s.clientAuthOriginalValue)
+
+	s.singleHopOriginalValue = conf.GetSingleHopHosting()
+	s.chkSingleHopHosting.SetActive(s.singleHopOriginalValue)
+
+	s.persistentOnionOriginalValue = conf.IsPersistentOnionEnabled()
+	s.chkPersistentOnion.SetActive(s.persistentOnionOriginalValue)
+
+	s.persistentCertOriginalValue = conf.IsPersistentCertificateEnabled()
+	s.chkPersistentCertificate.SetActive(s.persistentCertOriginalValue)
+
+	s.waitingRoomOriginalValue = conf.IsWaitingRoomEnabled()
+	s.chkWaitingRoom.SetActive(s.waitingRoomOriginalValue)
+
+	s.webGatewayOriginalValue = conf.IsWebGatewayEnabled()
+	s.chkWebGateway.SetActive(s.webGatewayOriginalValue)
+
+	s.identityOriginalValue = conf.IsPersistentIdentityEnabled()
+	s.chkPersistentIdentity.SetActive(s.identityOriginalValue)
+
+	s.autoRejoinOriginalValue = conf.IsAutoRejoinEnabled()
+	s.chkAutoRejoin.SetActive(s.autoRejoinOriginalValue)
+
+	s.managedClientOriginalValue = conf.IsManagedClientEnabled()
+	s.chkManagedClient.SetActive(s.managedClientOriginalValue)
+
+	s.trayIconOriginalValue = conf.IsTrayIconEnabled()
+	s.chkTrayIcon.SetActive(s.trayIconOriginalValue)
+
 	s.persistConfigFileOriginalValue = conf.IsPersistentConfiguration()
 	s.chkPersistentConfiguration.SetActive(s.persistConfigFileOriginalValue)
 	s.lblMessage.SetVisible(!s.persistConfigFileOriginalValue)
@@ -99,16 +179,13 @@ func (s *settings) init() {
 	s.torBinaryLocation.SetText(s.torBinaryOriginalValue)
 	s.torBinaryLocation.SetPlaceholderText(placeholders.GetPlaceholderConfigTor())
 
+	s.initAudioPreset()
+	s.initStartState()
+
 	// Set color scheme combo box based on config
-	colorScheme := conf.GetColorScheme()
-	switch colorScheme {
-	case "light-mode-gui":
-		s.cmbBoxColorScheme.SetActive(0)
-	case "dark-mode-gui":
-		s.cmbBoxColorScheme.SetActive(1)
-	default:
-		s.cmbBoxColorScheme.SetActive(2) // System
-	}
+	s.cmbBoxColorScheme.SetActive(colorSchemeIndex(conf.GetColorScheme()))
+
+	s.initLanguage()
 }
 
 func (u *gtkUI) getSettingsBuilder() *uiBuilder {
@@ -116,18 +193,46 @@ func (u *gtkUI) getSettingsBuilder() *uiBuilder {
 
 	builder.i18nProperties(
 		"checkbox", "chkAutojoin",
+		"checkbox", "chkClientAuthorization",
+		"checkbox", "chkSingleHopHosting",
+		"checkbox", "chkPersistentOnion",
+		"checkbox", "chkPersistentCertificate",
+		"checkbox", "chkWaitingRoom",
+		"checkbox", "chkWebGateway",
+		"checkbox", "chkPersistentIdentity",
+		"checkbox", "chkAutoRejoin",
+		"checkbox", "chkManagedClient",
+		"checkbox", "chkTrayIcon",
 		"checkbox", "chkPersistentConfiguration",
 		"checkbox", "chkEncryptFile",
 		"checkbox", "chkEnableLogging",
 		"tooltip", "chkAutojoin",
+		"tooltip", "chkClientAuthorization",
+		"tooltip", "chkSingleHopHosting",
+		"tooltip", "chkPersistentOnion",
+		"tooltip", "chkPersistentCertificate",
+		"tooltip", "chkWaitingRoom",
+		"tooltip", "chkWebGateway",
+		"tooltip", "chkPersistentIdentity",
+		"tooltip", "chkAutoRejoin",
+		"tooltip", "chkManagedClient",
+		"tooltip", "chkTrayIcon",
 		"tooltip", "chkPersistentConfiguration",
 		"tooltip", "chkEnableLogging",
 		"label", "lblAutojoin",
+		"label", "lblClientAuthorization",
+		"label", "lblSingleHopHosting",
+		"label", "lblPersistentOnion",
+		"label", "lblPersistentCertificate",
+		"label", "lblWaitingRoom",
+		"label", "lblWebGateway",
+		"label", "lblPersistentIdentity",
 		"label", "lblHostingGroup",
 		"label", "tabGeneral",
 		"label", "tabSecurity",
 		"label", "tabDebug",
 		"label", "tabMumble",
+		"label", "tabAudio",
 		"label", "tabTor",
 		"label", "lblStoreConfigDescription",
 		"label", "lblDebugWarning",
@@ -143,13 +248,31 @@ func (u *gtkUI) getSettingsBuilder() *uiBuilder {
 		"label", "lblTorLocation",
 		"label", "lblTorBinaryDescription",
 		"label", "lblTorBinaryBrowse",
+		"label", "lblBridgesDescription",
 		"label", "lblMessage",
 		"label", "lblSettingsWarning",
 		"label", "lblConfigFileCorrupted",
 		"label", "lblConfigFileCorruptedHelp",
 		"label", "lblMumbleBinaryDescription",
+		"label", "lblTransmitMode",
+		"label", "lblTransmitModeHelp",
+		"label", "lblNoiseSuppression",
+		"label", "lblNoiseSuppressionHelp",
+		"label", "lblJitterBuffer",
+		"label", "lblJitterBufferHelp",
+		"checkbox", "chkStartMuted",
+		"checkbox", "chkStartDeafened",
+		"label", "lblStartMutedHelp",
+		"button", "btnTestAudio",
+		"label", "lblTestAudioHelp",
+		"label", "lblLanguage",
+		"tooltip", "cmbLanguage",
 		"button", "btnCancelSettings",
 		"button", "btnSaveSettings",
+		"button", "btnShowTorLog",
+		"tooltip", "btnShowTorLog",
+		"button", "btnGetBridges",
+		"tooltip", "btnGetBridges",
 		"button", "btnConfigFileCorruptedCancel",
 		"button", "btnConfigFileCorruptedBackup",
 		"placeholder", "mumbleBinaryLocation",
@@ -168,6 +291,112 @@ func (s *settings) processAutojoinOption() {
 	}
 }
 
+func (s *settings) processClientAuthorizationOption() {
+	conf := s.u.config
+
+	if s.chkClientAuthorization.GetActive() != s.clientAuthOriginalValue {
+		conf.SetClientAuthorization(!s.clientAuthOriginalValue)
+		s.clientAuthOriginalValue = !s.clientAuthOriginalValue
+	}
+}
+
+func (s *settings) processSingleHopHostingOption() {
+	conf := s.u.config
+
+	if s.chkSingleHopHosting.GetActive() != s.singleHopOriginalValue {
+		conf.SetSingleHopHosting(!s.singleHopOriginalValue)
+		s.singleHopOriginalValue = !s.singleHopOriginalValue
+	}
+}
+
+// processPersistentOnionOption forgets the stored key and superuser password
+// when the option is disabled, since they are sensitive and won't be used anymore
+func (s *settings) processPersistentOnionOption() {
+	conf := s.u.config
+
+	if s.chkPersistentOnion.GetActive() != s.persistentOnionOriginalValue {
+		s.persistentOnionOriginalValue = !s.persistentOnionOriginalValue
+		conf.EnablePersistentOnion(s.persistentOnionOriginalValue)
+		if !s.persistentOnionOriginalValue {
+			conf.SetPersistentOnionKey("", "")
+			conf.SetSuperUserPassword("")
+		}
+	}
+}
+
+// processPersistentCertificateOption forgets the stored certificate when
+// the option is disabled, since its key is sensitive
+func (s *settings) processPersistentCertificateOption() {
+	conf := s.u.config
+
+	if s.chkPersistentCertificate.GetActive() != s.persistentCertOriginalValue {
+		s.persistentCertOriginalValue = !s.persistentCertOriginalValue
+		conf.EnablePersistentCertificate(s.persistentCertOriginalValue)
+		if !s.persistentCertOriginalValue {
+			conf.SetServerCertificate("", "")
+		}
+	}
+}
+
+// processPersistentIdentityOption forgets the certificate of the Mumble
+// client when the option is disabled, since its key is sensitive
+func (s *settings) processPersistentIdentityOption() {
+	conf := s.u.config
+
+	if s.chkPersistentIdentity.GetActive() != s.identityOriginalValue {
+		s.identityOriginalValue = !s.identityOriginalValue
+		conf.EnablePersistentIdentity(s.identityOriginalValue)
+		if !s.identityOriginalValue {
+			conf.SetClientCertificate("")
+		}
+	}
+}
+
+func (s *settings) processAutoRejoinOption() {
+	conf := s.u.config
+
+	if s.chkAutoRejoin.GetActive() != s.autoRejoinOriginalValue {
+		s.autoRejoinOriginalValue = !s.autoRejoinOriginalValue
+		conf.EnableAutoRejoin(s.autoRejoinOriginalValue)
+	}
+}
+
+func (s *settings) processManagedClientOption() {
+	conf := s.u.config
+
+	if s.chkManagedClient.GetActive() != s.managedClientOriginalValue {
+		s.managedClientOriginalValue = !s.managedClientOriginalValue
+		conf.EnableManagedClient(s.managedClientOriginalValue)
+	}
+}
+
+func (s *settings) processTrayIconOption() {
+	conf := s.u.config
+
+	if s.chkTrayIcon.GetActive() != s.trayIconOriginalValue {
+		s.trayIconOriginalValue = !s.trayIconOriginalValue
+		conf.EnableTrayIcon(s.trayIconOriginalValue)
+	}
+}
+
+func (s *settings) processWebGatewayOption() {
+	conf := s.u.config
+
+	if s.chkWebGateway.GetActive() != s.webGatewayOriginalValue {
+		conf.EnableWebGateway(!s.webGatewayOriginalValue)
+		s.webGatewayOriginalValue = !s.webGatewayOriginalValue
+	}
+}
+
+func (s *settings) processWaitingRoomOption() {
+	conf := s.u.config
+
+	if s.chkWaitingRoom.GetActive() != s.waitingRoomOriginalValue {
+		conf.EnableWaitingRoom(!s.waitingRoomOriginalValue)
+		s.waitingRoomOriginalValue = !s.waitingRoomOriginalValue
+	}
+}
+
 func (s *settings) processPersistentConfigOption() {
 	conf := s.u.config
 
@@ -226,12 +455,24 @@ func (s *settings) processMumblePort() {
 
 func (u *gtkUI) onSettingsToggleOption(s *settings) {
 	s.processAutojoinOption()
+	s.processClientAuthorizationOption()
+	s.processSingleHopHostingOption()
+	s.processPersistentOnionOption()
+	s.processPersistentCertificateOption()
+	s.processWaitingRoomOption()
+	s.processWebGatewayOption()
+	s.processPersistentIdentityOption()
+	s.processAutoRejoinOption()
+	s.processManagedClientOption()
+	s.processTrayIconOption()
+	s.processStartStateOptions()
 	s.processPersistentConfigOption()
 	s.processEncryptFileOption()
 	s.processLogsOption()
 }
 
 func (u *gtkUI) cleanupSettings(s *settings) {
+	s.stopTranslating()
 	if u.mainWindow != nil {
 		u.enableWindow(u.mainWindow)
 	}
@@ -241,6 +482,7 @@ func (u *gtkUI) cleanupSettings(s *settings) {
 
 func (u *gtkUI) handleOnSaveSettings(s *settings) {
 	s.processMumblePort()
+	s.processAudioPreset()
 	u.saveConfigOnly()
 	u.cleanupSettings(s)
 }
@@ -267,6 +509,10 @@ func (u *gtkUI) openSettingsWindow() {
 		"on_torBinaryLocation_icon_press":       s.setCustomPathForTor,
 		"on_torBinaryLocation_clicked_event":    s.setCustomPathForTor,
 		"on_colorScheme_changed_event":          s.changeColorScheme,
+		"on_language_changed_event":             s.changeLanguage,
+		"on_show_tor_log":                       u.openTorLogWindow,
+		"on_test_audio":                         u.testAudio,
+		"on_get_bridges":                        u.openBridgesWindow,
 	})
 
 	u.connectShortcutsSettingsWindow(s.dialog)
@@ -360,20 +606,77 @@ func (s *settings) setCustomPathForTor() {
 
 func (s *settings) changeColorScheme() {
 	s.u.colorManager.disableAutomaticThemeChange()
-	var css string
-	switch s.cmbBoxColorScheme.GetActive() {
-	case 0:
-		css = "light-mode-gui"
-		s.u.addCSSProvider(css)
-		s.u.config.SetColorScheme(css)
-	case 1:
-		css = "dark-mode-gui"
-		s.u.addCSSProvider(css)
-		s.u.config.SetColorScheme(css)
-	default:
+
+	scheme := colorSchemeAt(s.cmbBoxColorScheme.GetActive())
+	if scheme == "" {
 		s.u.colorManager.enableAutomaticThemeChange()
-		s.u.config.SetColorScheme("")
+	} else {
+		s.u.applyColorScheme(scheme)
+	}
+
+	s.u.config.SetColorScheme(scheme)
+}
+
+func (s *settings) initLanguage() {
+	current := s.u.config.GetLanguage()
+	for i, l := range languageOptions() {
+		s.cmbLanguage.AppendText(languageName(l))
+		if l == current {
+			s.cmbLanguage.SetActive(i)
+		}
+	}
+
+	s.stopTranslating = onLanguageChange(s.translateOptions)
+}
+
+// changeLanguage shows Wahay in the language chosen right away. Like the
+// color scheme, it's kept in the configuration when the settings are saved
+func (s *settings) changeLanguage() {
+	i := s.cmbLanguage.GetActive()
+	options := languageOptions()
+	if i < 0 || i >= len(options) || options[i] == s.u.config.GetLanguage() {
+		return
 	}
+
+	s.u.config.SetLanguage(options[i])
+	s.u.setLanguage(s.u.config.LanguageTag())
+}
+
+// translateOptions shows the options of the combo boxes
+// in the current language, keeping the chosen ones
+func (s *settings) translateOptions() {
+	languages := []string{}
+	for _, l := range languageOptions() {
+		languages = append(languages, languageName(l))
+	}
+	refillComboBox(s.cmbLanguage, languages)
+
+	transmitModes := []string{}
+	for _, m := range transmitModeOptions {
+		transmitModes = append(transmitModes, transmitModeLabel(m))
+	}
+	refillComboBox(s.cmbTransmitMode, transmitModes)
+
+	noiseSuppressions := []string{}
+	for _, n := range noiseSuppressionOptions {
+		noiseSuppressions = append(noiseSuppressions, noiseSuppressionLabel(n))
+	}
+	refillComboBox(s.cmbNoiseSuppression, noiseSuppressions)
+
+	jitterBuffers := []string{}
+	for _, d := range jitterBufferOptions {
+		jitterBuffers = append(jitterBuffers, i18n().Sprintf("%d ms", d/time.Millisecond))
+	}
+	refillComboBox(s.cmbJitterBuffer, jitterBuffers)
+}
+
+func refillComboBox(cmb gtki.ComboBoxText, options []string) {
+	active := cmb.GetActive()
+	cmb.RemoveAll()
+	for _, o := range options {
+		cmb.AppendText(o)
+	}
+	cmb.SetActive(active)
 }
 
 func (u *gtkUI) initConfig() {
@@ -385,6 +688,9 @@ func (u *gtkUI) loadConfig() {
 
 	u.config.WhenLoaded(func(c *config.ApplicationConfig) {
 		u.config = c
+		if c.GetLanguage() != "" {
+			u.setLanguage(c.LanguageTag())
+		}
 		u.doInUIThread(u.initialSetupWindow)
 		u.configLoaded()
 	})
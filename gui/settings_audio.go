@@ -0,0 +1,143 @@
+package gui
+
+import (
+	"time"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// The options of the audio combo boxes, in the order they are shown
+var (
+	transmitModeOptions = []config.TransmitMode{
+		config.TransmitPushToTalk,
+		config.TransmitVoiceActivity,
+		config.TransmitContinuous,
+	}
+
+	noiseSuppressionOptions = []config.NoiseSuppression{
+		config.NoiseSuppressionOff,
+		config.NoiseSuppressionLow,
+		config.NoiseSuppressionMedium,
+		config.NoiseSuppressionHigh,
+	}
+
+	jitterBufferOptions = []time.Duration{
+		50 * time.Millisecond,
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+	}
+)
+
+func transmitModeLabel(m config.TransmitMode) string {
+	switch m {
+	case config.TransmitVoiceActivity:
+		return i18n().Sprintf("Voice activity")
+	case config.TransmitContinuous:
+		return i18n().Sprintf("Continuous")
+	default:
+		return i18n().Sprintf("Push to talk")
+	}
+}
+
+func noiseSuppressionLabel(n config.NoiseSuppression) string {
+	switch n {
+	case config.NoiseSuppressionOff:
+		return i18n().Sprintf("Off")
+	case config.NoiseSuppressionLow:
+		return i18n().Sprintf("Low")
+	case config.NoiseSuppressionHigh:
+		return i18n().Sprintf("High")
+	default:
+		return i18n().Sprintf("Medium")
+	}
+}
+
+// closestJitterBufferOption returns the option nearest to the configured
+// jitter buffer, which can be any value when edited in the configuration
+func closestJitterBufferOption(d time.Duration) int {
+	closest := 0
+	for i, o := range jitterBufferOptions {
+		if absDuration(o-d) < absDuration(jitterBufferOptions[closest]-d) {
+			closest = i
+		}
+	}
+	return closest
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+func (s *settings) initAudioPreset() {
+	s.audioPresetOriginalValue = s.u.config.GetAudioPreset()
+
+	for i, m := range transmitModeOptions {
+		s.cmbTransmitMode.AppendText(transmitModeLabel(m))
+		if m == s.audioPresetOriginalValue.Transmit {
+			s.cmbTransmitMode.SetActive(i)
+		}
+	}
+
+	for i, n := range noiseSuppressionOptions {
+		s.cmbNoiseSuppression.AppendText(noiseSuppressionLabel(n))
+		if n == s.audioPresetOriginalValue.NoiseSuppression {
+			s.cmbNoiseSuppression.SetActive(i)
+		}
+	}
+
+	for _, d := range jitterBufferOptions {
+		s.cmbJitterBuffer.AppendText(i18n().Sprintf("%d ms", d/time.Millisecond))
+	}
+	s.cmbJitterBuffer.SetActive(closestJitterBufferOption(s.audioPresetOriginalValue.JitterBuffer))
+}
+
+func (s *settings) initStartState() {
+	s.startMutedOriginalValue = s.u.config.ShouldStartMuted()
+	s.chkStartMuted.SetActive(s.startMutedOriginalValue)
+
+	s.startDeafenedOriginalValue = s.u.config.ShouldStartDeafened()
+	s.chkStartDeafened.SetActive(s.startDeafenedOriginalValue)
+}
+
+// processStartStateOptions saves how the client joins meetings
+// by default, which can still be changed when joining each one
+func (s *settings) processStartStateOptions() {
+	conf := s.u.config
+
+	if s.chkStartMuted.GetActive() != s.startMutedOriginalValue {
+		s.startMutedOriginalValue = !s.startMutedOriginalValue
+		conf.SetStartMuted(s.startMutedOriginalValue)
+	}
+
+	if s.chkStartDeafened.GetActive() != s.startDeafenedOriginalValue {
+		s.startDeafenedOriginalValue = !s.startDeafenedOriginalValue
+		conf.SetStartDeafened(s.startDeafenedOriginalValue)
+	}
+}
+
+// processAudioPreset saves the audio settings chosen. They are written
+// in the configuration of the Mumble client the next time Wahay starts
+func (s *settings) processAudioPreset() {
+	p := s.audioPresetOriginalValue
+
+	if i := s.cmbTransmitMode.GetActive(); i >= 0 && i < len(transmitModeOptions) {
+		p.Transmit = transmitModeOptions[i]
+	}
+
+	if i := s.cmbNoiseSuppression.GetActive(); i >= 0 && i < len(noiseSuppressionOptions) {
+		p.NoiseSuppression = noiseSuppressionOptions[i]
+	}
+
+	// The jitter buffer is only changed when another option is chosen,
+	// so a value edited in the configuration isn't lost by saving
+	i := s.cmbJitterBuffer.GetActive()
+	if i >= 0 && i < len(jitterBufferOptions) && i != closestJitterBufferOption(p.JitterBuffer) {
+		p.JitterBuffer = jitterBufferOptions[i]
+	}
+
+	s.u.config.SetAudioPreset(p)
+}
@@ -0,0 +1,21 @@
+package gui
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type WahayAudioSettingsSuite struct{}
+
+var _ = Suite(&WahayAudioSettingsSuite{})
+
+func (s *WahayAudioSettingsSuite) Test_closestJitterBufferOption_findsTheSameValue(c *C) {
+	c.Assert(closestJitterBufferOption(200*time.Millisecond), Equals, 2)
+}
+
+func (s *WahayAudioSettingsSuite) Test_closestJitterBufferOption_findsTheNearestValue(c *C) {
+	c.Assert(closestJitterBufferOption(10*time.Millisecond), Equals, 0)
+	c.Assert(closestJitterBufferOption(130*time.Millisecond), Equals, 1)
+	c.Assert(closestJitterBufferOption(time.Second), Equals, 3)
+}
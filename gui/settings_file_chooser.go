@@ -26,9 +26,15 @@ func (u *gtkUI) setCustomFilePathFor(
 }
 
 func (u *gtkUI) getCustomFilePath() (ok bool, path string) {
+	return u.chooseFile(gtki.FILE_CHOOSER_ACTION_OPEN, "")
+}
+
+// chooseFile shows a dialog to choose a file to open or, with
+// FILE_CHOOSER_ACTION_SAVE, where to save one with the suggested name
+func (u *gtkUI) chooseFile(action gtki.FileChooserAction, suggestedName string) (ok bool, path string) {
 	channel := make(chan string)
 	errChannel := make(chan bool)
-	go u.showCustomFilePathDialog(channel, errChannel)
+	go u.showCustomFilePathDialog(action, suggestedName, channel, errChannel)
 	select {
 	case v := <-channel:
 		return true, v
@@ -37,15 +43,20 @@ func (u *gtkUI) getCustomFilePath() (ok bool, path string) {
 	}
 }
 
-func (u *gtkUI) showCustomFilePathDialog(channel chan string, errChannel chan bool) {
+func (u *gtkUI) showCustomFilePathDialog(action gtki.FileChooserAction, suggestedName string, channel chan string, errChannel chan bool) {
+	title, accept := i18n().Sprintf("Open file"), i18n().Sprintf("Open")
+	if action == gtki.FILE_CHOOSER_ACTION_SAVE {
+		title, accept = i18n().Sprintf("Save file"), i18n().Sprintf("Save")
+	}
+
 	u.doInUIThread(func() {
 		dialog, err := u.g.gtk.FileChooserDialogNewWith2Buttons(
-			i18n().Sprintf("Open file"),
+			title,
 			u.currentWindow,
-			gtki.FILE_CHOOSER_ACTION_OPEN,
+			action,
 			i18n().Sprintf("Cancel"),
 			gtki.RESPONSE_CANCEL,
-			i18n().Sprintf("Open"),
+			accept,
 			gtki.RESPONSE_ACCEPT)
 
 		if err != nil {
@@ -55,6 +66,9 @@ func (u *gtkUI) showCustomFilePathDialog(channel chan string, errChannel chan bo
 
 		chooser := (dialog).(gtki.FileChooser)
 		chooser.SetDoOverwriteConfirmation(true)
+		if suggestedName != "" {
+			chooser.SetCurrentName(suggestedName)
+		}
 
 		if u.currentWindow != nil {
 			dialog.SetTransientFor(u.currentWindow)
@@ -0,0 +1,57 @@
+package gui
+
+import (
+	"errors"
+
+	"github.com/coyim/gotk3adapter/gtki"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	errImageClipboardUnsupported = errors.New("images can't be copied to the clipboard on this system")
+	errShareUnsupported          = errors.New("the desktop has no way to share the invitation")
+)
+
+// copyQRCodeToClipboard copies the QR code of the invitation as an image,
+// so it can be pasted in a chat application
+func (h *hostData) copyQRCodeToClipboard(builder *uiBuilder) {
+	lblMessage := builder.get("lblMessage").(gtki.Label)
+
+	go func() {
+		content, err := h.invitationQRCodePNG()
+		if err == nil {
+			err = copyImageToClipboard(content, "image/png")
+		}
+
+		if err != nil {
+			log.WithError(err).Error("Couldn't copy the QR code of the invitation to the clipboard")
+			h.u.doInUIThread(func() {
+				h.u.reportError(i18n().Sprintf("The QR code couldn't be copied to the clipboard"))
+			})
+			return
+		}
+
+		h.u.messageToLabel(lblMessage, i18n().Sprintf("The QR code has been copied to the clipboard"), 5)
+	}()
+}
+
+// shareInvitation opens a new message with the invitation in the email
+// client the host chose in the desktop, through its portal. When there's
+// no portal, the invitation goes through a mailto link instead
+func (h *hostData) shareInvitation(builder *uiBuilder) {
+	subject := h.getInvitationSubject()
+	body := h.invitationText("\n")
+
+	go func() {
+		err := composeEmailWithPortal(subject, body)
+		if err == nil {
+			return
+		}
+
+		log.WithError(err).Debug("Sharing the invitation through a mailto link")
+		h.u.doInUIThread(func() {
+			h.sendInvitationByEmail(builder)
+		})
+	}()
+}
@@ -0,0 +1,96 @@
+//go:build !windows
+
+package gui
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+const (
+	portalDestination = "org.freedesktop.portal.Desktop"
+	portalObjectPath  = "/org/freedesktop/portal/desktop"
+	emailPortalMethod = "org.freedesktop.portal.Email.ComposeEmail"
+	// portalTimeout is how long we wait for the portal to answer,
+	// which only opens the email client and doesn't wait for it
+	portalTimeout = 10 * time.Second
+)
+
+// imageClipboardCommand returns the command that puts content of the
+// given type in the clipboard: wl-copy under Wayland and xclip under X11,
+// the same tools the text clipboard uses
+func imageClipboardCommand(mimeType string) (string, []string, error) {
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		if path, err := exec.LookPath("wl-copy"); err == nil {
+			return path, []string{"--type", mimeType}, nil
+		}
+	}
+
+	path, err := exec.LookPath("xclip")
+	if err != nil {
+		return "", nil, errImageClipboardUnsupported
+	}
+	return path, []string{"-selection", "clipboard", "-t", mimeType}, nil
+}
+
+func isCopyImageToClipboardSupported() bool {
+	_, _, err := imageClipboardCommand("image/png")
+	return err == nil
+}
+
+// copyImageToClipboard puts the image in the clipboard. The tools keep
+// running in the background to serve it until something else is copied
+func copyImageToClipboard(content []byte, mimeType string) error {
+	path, args, err := imageClipboardCommand(mimeType)
+	if err != nil {
+		return err
+	}
+
+	/* #nosec G204 */
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = bytes.NewReader(content)
+	return cmd.Run()
+}
+
+// composeEmailWithPortal asks the Email portal of the desktop to open the
+// email client of the user with a new message. It goes through gdbus,
+// which comes with GLib, so it works inside Flatpak and Snap sandboxes
+func composeEmailWithPortal(subject, body string) error {
+	path, err := exec.LookPath("gdbus")
+	if err != nil {
+		return errShareUnsupported
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), portalTimeout)
+	defer cancel()
+
+	/* #nosec G204 */
+	out, err := exec.CommandContext(ctx, path, emailPortalArgs(subject, body)...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errShareUnsupported, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func emailPortalArgs(subject, body string) []string {
+	options := fmt.Sprintf("{'subject': <%s>, 'body': <%s>}", gvariantString(subject), gvariantString(body))
+	return []string{
+		"call", "--session",
+		"--dest", portalDestination,
+		"--object-path", portalObjectPath,
+		"--method", emailPortalMethod,
+		"", options,
+	}
+}
+
+// gvariantString quotes s in the text format of GVariant, which is
+// what gdbus takes its arguments in
+func gvariantString(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+	return "'" + r.Replace(s) + "'"
+}
@@ -0,0 +1,23 @@
+//go:build !windows
+
+package gui
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type WahayShareInvitationSuite struct{}
+
+var _ = Suite(&WahayShareInvitationSuite{})
+
+func (s *WahayShareInvitationSuite) Test_gvariantString_escapesQuotesAndNewlines(c *C) {
+	c.Assert(gvariantString("it's\na\\b"), Equals, `'it\'s\na\\b'`)
+}
+
+func (s *WahayShareInvitationSuite) Test_emailPortalArgs_callsTheEmailPortal(c *C) {
+	args := emailPortalArgs("Join", "Meeting ID: abc.onion")
+
+	c.Assert(args[len(args)-2], Equals, "")
+	c.Assert(args[len(args)-1], Equals, "{'subject': <'Join'>, 'body': <'Meeting ID: abc.onion'>}")
+	c.Assert(args[7], Equals, emailPortalMethod)
+}
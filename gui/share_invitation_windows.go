@@ -0,0 +1,17 @@
+package gui
+
+// isCopyImageToClipboardSupported returns false, since only
+// text can be copied to the clipboard on Windows yet
+func isCopyImageToClipboardSupported() bool {
+	return false
+}
+
+func copyImageToClipboard(content []byte, mimeType string) error {
+	return errImageClipboardUnsupported
+}
+
+// composeEmailWithPortal always fails, since there are no desktop
+// portals on Windows, so the invitation goes through a mailto link
+func composeEmailWithPortal(subject, body string) error {
+	return errShareUnsupported
+}
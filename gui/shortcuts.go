@@ -2,6 +2,7 @@ package gui
 
 import (
 	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/hosting"
 	"github.com/digitalautonomy/wahay/tor"
 )
 
@@ -23,6 +24,9 @@ func (u *gtkUI) connectShortcutsMainWindow(w gtki.Window) {
 	u.connectShortcut("<Primary>h", w, func(_ gtki.Window) {
 		u.openHelpWindow()
 	})
+	u.connectShortcut("F1", w, func(_ gtki.Window) {
+		u.openHelpWindow()
+	})
 	u.connectShortcut("<Primary>comma", w, func(_ gtki.Window) {
 		u.openSettingsWindow()
 	})
@@ -73,7 +77,7 @@ func (u *gtkUI) connectShortcutsStartHostingWindow(w gtki.Window, h *hostData) {
 
 }
 
-func (u *gtkUI) connectShortcutsCurrentHostMeetingWindow(w gtki.Window, h *hostData) {
+func (u *gtkUI) connectShortcutsCurrentHostMeetingWindow(w gtki.Window, h *hostData, invite func()) {
 	// <Primary> maps to Command and OS X, but Control on other platforms
 	u.connectShortcut("<Primary>l", w, func(_ gtki.Window) {
 		h.leaveHostMeeting()
@@ -81,6 +85,19 @@ func (u *gtkUI) connectShortcutsCurrentHostMeetingWindow(w gtki.Window, h *hostD
 	u.connectShortcut("<Primary>w", w, func(_ gtki.Window) {
 		h.finishMeetingMumble()
 	})
+	u.connectShortcut("<Primary>i", w, func(_ gtki.Window) {
+		invite()
+	})
+}
+
+func (u *gtkUI) connectShortcutsCallControls(w gtki.Window, cc *callControls) {
+	// <Primary> maps to Command and OS X, but Control on other platforms
+	u.connectShortcut("<Primary>m", w, func(_ gtki.Window) {
+		cc.toggle(cc.mute)
+	})
+	u.connectShortcut("<Primary>d", w, func(_ gtki.Window) {
+		cc.toggle(cc.deafen)
+	})
 }
 
 func (u *gtkUI) connectShortcutsCurrentMeetingWindow(w gtki.Window, m tor.Service) {
@@ -100,13 +117,37 @@ func (u *gtkUI) connectShortcutsCurrentMeetingWindow(w gtki.Window, m tor.Servic
 	})
 }
 
-func (u *gtkUI) connectShortcutsInviteMeetingWindow(w gtki.Window, b *uiBuilder) {
+func (u *gtkUI) connectShortcutsMeetingTabs(t *meetingTabs) {
+	inCurrent := func(action func(*meetingTab)) func(gtki.Window) {
+		return func(_ gtki.Window) {
+			if tab := t.current(); tab != nil {
+				action(tab)
+			}
+		}
+	}
+
+	// <Primary> maps to Command and OS X, but Control on other platforms
+	u.connectShortcut("<Primary>F4", t.win, inCurrent(func(tab *meetingTab) {
+		u.leaveMeeting(tab.m)
+	}))
+	u.connectShortcut("<Primary>l", t.win, inCurrent(func(tab *meetingTab) {
+		u.leaveMeeting(tab.m)
+	}))
+	u.connectShortcut("<Primary>m", t.win, inCurrent(func(tab *meetingTab) {
+		tab.controls.toggle(tab.controls.mute)
+	}))
+	u.connectShortcut("<Primary>d", t.win, inCurrent(func(tab *meetingTab) {
+		tab.controls.toggle(tab.controls.deafen)
+	}))
+}
+
+func (u *gtkUI) connectShortcutsInviteMeetingWindow(w gtki.Window, b *uiBuilder, join func(hosting.MeetingData), closeWindow func(gtki.Window)) {
 	// <Primary> maps to Command and OS X, but Control on other platforms
 	u.connectShortcut("<Primary>q", w, u.closeApplicationWindow)
-	u.connectShortcut("<Primary>F4", w, u.closeWindow)
-	u.connectShortcut("Escape", w, u.closeWindow)
+	u.connectShortcut("<Primary>F4", w, closeWindow)
+	u.connectShortcut("Escape", w, closeWindow)
 	u.connectShortcut("<Primary>j", w, func(_ gtki.Window) {
-		u.handleOnJoinMeeting(b)
+		u.handleOnJoinMeeting(b, join)
 	})
 }
 
@@ -0,0 +1,169 @@
+package gui
+
+import (
+	"fmt"
+
+	"github.com/coyim/gotk3adapter/gtki"
+
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+// stageState is how a stage is shown in the loading window
+type stageState int
+
+const (
+	stagePending stageState = iota
+	stageCurrent
+	stageDone
+	stageFailed
+)
+
+// stageStateOf returns the state of stage s, when the checks reached
+// the given stage. The stage after it is the one in progress, or the
+// one that failed when the checks found a problem
+func stageStateOf(s, reached tor.StartupStage, failed bool) stageState {
+	switch {
+	case s <= reached:
+		return stageDone
+	case s > reached+1:
+		return stagePending
+	case failed:
+		return stageFailed
+	}
+	return stageCurrent
+}
+
+// stageMarks don't rely on colors, so every theme
+// and screen reader can tell the states apart
+var stageMarks = map[stageState]string{
+	stagePending: "○",
+	stageCurrent: "…",
+	stageDone:    "✓",
+	stageFailed:  "✗",
+}
+
+func stageText(s tor.StartupStage) string {
+	switch s {
+	case tor.StageControlPortFound:
+		return i18n().Sprintf("Tor control port found")
+	case tor.StageAuthenticated:
+		return i18n().Sprintf("Authenticated with Tor")
+	case tor.StageCircuitsBuilt:
+		return i18n().Sprintf("Circuits built")
+	case tor.StageOnionPublished:
+		return i18n().Sprintf("Meeting published as an onion service")
+	}
+	return ""
+}
+
+// stageErrorText tells the user what they can do when
+// the given stage is the one that doesn't work
+func stageErrorText(failed tor.StartupStage, err error) string {
+	switch failed {
+	case tor.StageControlPortFound:
+		return i18n().Sprintf("No Tor answered on its control port. Install Tor, " +
+			"or check the Tor settings if you use a Tor on another port or computer.")
+	case tor.StageAuthenticated:
+		return i18n().Sprintf("Wahay can't authenticate with the control port of Tor. " +
+			"Check the control port password in the Tor settings.")
+	case tor.StageCircuitsBuilt:
+		switch err {
+		case tor.ErrPartialTorTooOld:
+			return i18n().Sprintf("The Tor found is too old for Wahay. Please update it.")
+		case tor.ErrTorProxyUnreachable:
+			return i18n().Sprintf("The proxy configured for Tor can't be reached. Check the proxy settings.")
+		}
+		return i18n().Sprintf("Tor can't connect to the Tor network yet. If Tor is blocked " +
+			"where you are, get bridges in the Tor settings.")
+	}
+	return ""
+}
+
+// startupStatus shows in the loading window how far Tor got, instead of
+// only a spinner, so the user knows what failed when it takes too long
+type startupStatus struct {
+	spinner  gtki.Widget
+	box      gtki.Widget
+	stages   map[tor.StartupStage]gtki.Label
+	progress gtki.ProgressBar
+	lblError gtki.Label
+
+	// showAgain shows the last status again, in the current language
+	showAgain func()
+	// stopTranslating stops showing it again when the language changes
+	stopTranslating func()
+}
+
+func newStartupStatus(builder *uiBuilder) *startupStatus {
+	return &startupStatus{
+		spinner: builder.get("spinner").(gtki.Widget),
+		box:     builder.get("boxStages").(gtki.Widget),
+		stages: map[tor.StartupStage]gtki.Label{
+			tor.StageControlPortFound: builder.get("lblStageControlPort").(gtki.Label),
+			tor.StageAuthenticated:    builder.get("lblStageAuthenticated").(gtki.Label),
+			tor.StageCircuitsBuilt:    builder.get("lblStageCircuits").(gtki.Label),
+			tor.StageOnionPublished:   builder.get("lblStageOnion").(gtki.Label),
+		},
+		progress: builder.get("progressBootstrap").(gtki.ProgressBar),
+		lblError: builder.get("lblStageError").(gtki.Label),
+	}
+}
+
+// show updates the stages up to last. The bootstrap is
+// shown while the circuits are not built, when it's known
+func (s *startupStatus) show(last, reached tor.StartupStage, bootstrap int, err error) {
+	s.showAgain = func() { s.show(last, reached, bootstrap, err) }
+	s.spinner.SetVisible(false)
+	s.box.SetVisible(true)
+
+	for stage, lbl := range s.stages {
+		lbl.SetVisible(stage <= last)
+		state := stageStateOf(stage, reached, err != nil)
+		lbl.SetText(fmt.Sprintf("%s %s", stageMarks[state], stageText(stage)))
+	}
+
+	s.progress.SetVisible(reached < tor.StageCircuitsBuilt && bootstrap >= 0)
+	if bootstrap >= 0 {
+		s.progress.SetFraction(float64(bootstrap) / 100)
+		s.progress.SetText(i18n().Sprintf("Bootstrapped %d%%", bootstrap))
+	}
+
+	s.lblError.SetVisible(err != nil)
+	if err != nil {
+		s.lblError.SetText(stageErrorText(reached+1, err))
+	}
+}
+
+// translate shows the status in the language chosen, if it was shown
+func (s *startupStatus) translate() {
+	if s.showAgain != nil {
+		s.showAgain()
+	}
+}
+
+// close stops translating the status, once the loading window is gone
+func (s *startupStatus) close() {
+	if s.stopTranslating != nil {
+		s.stopTranslating()
+	}
+}
+
+// showTorStartupProgress is called with the result of every check
+// of the Tor that Wahay is going to use, while it starts
+func (u *gtkUI) showTorStartupProgress(r *tor.ConnectivityReport) {
+	u.doInUIThread(func() {
+		if u.startupStatus != nil {
+			u.startupStatus.show(tor.StageCircuitsBuilt, r.Stage(), r.BootstrapProgress, r.Err())
+		}
+	})
+}
+
+// showMeetingPublishing shows that Tor works and
+// the onion service of the meeting is being added
+func (u *gtkUI) showMeetingPublishing() {
+	u.doInUIThread(func() {
+		if u.startupStatus != nil {
+			u.startupStatus.show(tor.StageOnionPublished, tor.StageCircuitsBuilt, -1, nil)
+		}
+	})
+}
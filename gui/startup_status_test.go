@@ -0,0 +1,64 @@
+package gui
+
+import (
+	"github.com/coyim/gotk3adapter/gtk_mock"
+	"github.com/coyim/gotk3adapter/gtki"
+	"github.com/digitalautonomy/wahay/tor"
+	"golang.org/x/text/language"
+	. "gopkg.in/check.v1"
+)
+
+type WahayStartupStatusSuite struct{}
+
+var _ = Suite(&WahayStartupStatusSuite{})
+
+func (s *WahayStartupStatusSuite) Test_stageStateOf_marksTheStageAfterTheLastOneReached(c *C) {
+	reached := tor.StageControlPortFound
+
+	c.Assert(stageStateOf(tor.StageControlPortFound, reached, false), Equals, stageDone)
+	c.Assert(stageStateOf(tor.StageAuthenticated, reached, false), Equals, stageCurrent)
+	c.Assert(stageStateOf(tor.StageAuthenticated, reached, true), Equals, stageFailed)
+	c.Assert(stageStateOf(tor.StageCircuitsBuilt, reached, true), Equals, stagePending)
+}
+
+func (s *WahayStartupStatusSuite) Test_stageErrorText_explainsEveryStageThatCanFail(c *C) {
+	for _, stage := range []tor.StartupStage{tor.StageControlPortFound, tor.StageAuthenticated, tor.StageCircuitsBuilt} {
+		c.Assert(stageErrorText(stage, tor.ErrFatalTorNoConnectionAllowed), Not(Equals), "")
+	}
+
+	c.Assert(stageErrorText(tor.StageCircuitsBuilt, tor.ErrTorProxyUnreachable), Not(Equals),
+		stageErrorText(tor.StageCircuitsBuilt, tor.ErrFatalTorNoConnectionAllowed))
+}
+
+type startupStatusMockLabel struct {
+	gtk_mock.MockLabel
+	text string
+}
+
+func (l *startupStatusMockLabel) SetText(text string) {
+	l.text = text
+}
+
+func (s *WahayStartupStatusSuite) Test_startupStatus_translate_showsTheLastStatusAgain(c *C) {
+	defer setPrinterLanguage(language.English)
+
+	lblError := &startupStatusMockLabel{}
+	status := &startupStatus{
+		spinner:  &gtk_mock.MockWidget{},
+		box:      &gtk_mock.MockWidget{},
+		stages:   map[tor.StartupStage]gtki.Label{},
+		progress: &gtk_mock.MockProgressBar{},
+		lblError: lblError,
+	}
+
+	status.translate()
+	c.Assert(lblError.text, Equals, "")
+
+	status.show(tor.StageCircuitsBuilt, tor.StageAuthenticated, 10, tor.ErrFatalTorNoConnectionAllowed)
+	lblError.text = ""
+
+	setPrinterLanguage(language.Spanish)
+	status.translate()
+
+	c.Assert(lblError.text, Equals, stageErrorText(tor.StageCircuitsBuilt, tor.ErrFatalTorNoConnectionAllowed))
+}
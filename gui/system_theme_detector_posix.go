@@ -178,10 +178,10 @@ func (cm *colorManager) enableAutomaticThemeChange() {
 }
 
 func (cm *colorManager) updateTheme() {
-	css := "light-mode-gui"
+	css := lightColorScheme
 	isDark := cm.isDarkThemeVariant()
 	if isDark {
-		css = "dark-mode-gui"
+		css = darkColorScheme
 	}
 
 	cm.ui.addCSSProvider(css)
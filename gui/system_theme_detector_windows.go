@@ -141,10 +141,10 @@ func (cm *colorManager) handleThemeChange() bool {
 }
 
 func (cm *colorManager) updateTheme() {
-	css := "light-mode-gui"
+	css := lightColorScheme
 	isDark := cm.isDarkThemeVariant()
 	if isDark {
-		css = "dark-mode-gui"
+		css = darkColorScheme
 	}
 
 	cm.ui.addCSSProvider(css)
@@ -0,0 +1,78 @@
+package gui
+
+import (
+	"github.com/coyim/gotk3adapter/gtki"
+)
+
+// The color schemes are stored in the configuration by the name of their
+// styles. An empty one follows the color scheme of the system
+const (
+	lightColorScheme        = "light-mode-gui"
+	darkColorScheme         = "dark-mode-gui"
+	highContrastColorScheme = "high-contrast-gui"
+)
+
+// colorSchemes are the color schemes in the order of the settings
+var colorSchemes = []string{lightColorScheme, darkColorScheme, "", highContrastColorScheme}
+
+func colorSchemeIndex(scheme string) int {
+	for i, s := range colorSchemes {
+		if s == scheme {
+			return i
+		}
+	}
+	return colorSchemeIndex("")
+}
+
+func colorSchemeAt(index int) string {
+	if index < 0 || index >= len(colorSchemes) {
+		return ""
+	}
+	return colorSchemes[index]
+}
+
+// themeStyles are the styles of every window, including the dialogs
+// created later, since they are added to the screen. GTK only keeps
+// adding providers to the screen in the version Wahay uses, so the
+// same ones are loaded with the styles of a new color scheme
+type themeStyles struct {
+	base     gtki.CssProvider
+	contrast gtki.CssProvider
+}
+
+// applyColorScheme shows every window with the color scheme. The high
+// contrast one goes on top of the dark mode styles
+func (u *gtkUI) applyColorScheme(scheme string) {
+	if scheme != highContrastColorScheme {
+		u.addCSSProvider(scheme)
+		return
+	}
+
+	u.addCSSProvider(darkColorScheme)
+	u.g.loadCSSInto(u.styleProvider(&u.styles.contrast, gtki.STYLE_PROVIDER_PRIORITY_APPLICATION+1), highContrastColorScheme)
+}
+
+// addCSSProvider shows every window with the given styles
+func (u *gtkUI) addCSSProvider(css string) {
+	u.g.loadCSSInto(u.styleProvider(&u.styles.base, gtki.STYLE_PROVIDER_PRIORITY_APPLICATION), css)
+	if u.styles.contrast != nil {
+		u.g.loadCSSInto(u.styles.contrast, "")
+	}
+}
+
+func (u *gtkUI) styleProvider(p *gtki.CssProvider, priority gtki.StyleProviderPriority) gtki.CssProvider {
+	if *p != nil {
+		return *p
+	}
+
+	prov, err := u.g.gtk.CssProviderNew()
+	if err != nil {
+		fatal(err)
+	}
+
+	screen, _ := u.g.gdk.ScreenGetDefault()
+	u.g.gtk.AddProviderForScreen(screen, prov, uint(priority))
+	*p = prov
+
+	return prov
+}
@@ -0,0 +1,29 @@
+package gui
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type WahayThemeSuite struct{}
+
+var _ = Suite(&WahayThemeSuite{})
+
+func (s *WahayThemeSuite) Test_colorSchemeIndex_followsTheSystemWithAnUnknownScheme(c *C) {
+	c.Assert(colorSchemeIndex(darkColorScheme), Equals, 1)
+	c.Assert(colorSchemeIndex(highContrastColorScheme), Equals, 3)
+	c.Assert(colorSchemeIndex("solarized"), Equals, 2)
+}
+
+func (s *WahayThemeSuite) Test_colorSchemeAt_followsTheSystemWithoutASelection(c *C) {
+	c.Assert(colorSchemeAt(0), Equals, lightColorScheme)
+	c.Assert(colorSchemeAt(-1), Equals, "")
+	c.Assert(colorSchemeAt(len(colorSchemes)), Equals, "")
+}
+
+func (s *WahayThemeSuite) Test_colorSchemes_haveTheirStyles(c *C) {
+	for _, scheme := range colorSchemes {
+		if scheme != "" {
+			c.Assert(getCSSFileWithFallback(scheme), Not(Equals), "")
+		}
+	}
+}
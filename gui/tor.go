@@ -1,9 +1,14 @@
 package gui
 
 import (
+	"context"
 	"errors"
 	"sync"
+	"time"
 
+	log "github.com/sirupsen/logrus"
+
+	"github.com/coyim/gotk3adapter/gtki"
 	"github.com/digitalautonomy/wahay/tor"
 )
 
@@ -15,7 +20,18 @@ func (u *gtkUI) ensureTor(wg *sync.WaitGroup) {
 		defer wg.Done()
 		defer u.torInitialized.Done()
 
-		instance, e := tor.NewInstance(u.config, u.onTorInstanceCreated)
+		// Closing Wahay while Tor is starting must not wait for
+		// the checks of a Tor that doesn't answer
+		ctx, cancel := context.WithCancel(context.Background())
+		u.onExit(cancel)
+		ctx = tor.WithStartupProgress(ctx, u.showTorStartupProgress)
+
+		controlPort, socksPort := u.config.GetDiscoveredTorPorts()
+		instance, e := tor.NewInstanceContext(ctx, u.config, u.onTorInstanceCreated)
+		if c, s := u.config.GetDiscoveredTorPorts(); c != controlPort || s != socksPort {
+			u.saveConfigOnly()
+		}
+
 		if e != nil {
 			u.errorHandler.addNewStartupError(e, errGroupTor)
 			return
@@ -27,6 +43,56 @@ func (u *gtkUI) ensureTor(wg *sync.WaitGroup) {
 		}
 
 		u.tor = instance
+		u.watchTorHealth(instance)
+	}()
+}
+
+const torHealthCheckInterval = 15 * time.Second
+
+// watchTorHealth keeps Tor working during the whole session, restarting
+// it if necessary. The user only hears about it when that fails, since
+// until Tor is back no meeting can work
+func (u *gtkUI) watchTorHealth(i tor.Instance) {
+	i.WatchHealth(torHealthCheckInterval, func(e tor.HealthEvent) {
+		log.WithField("event", e).Info("The state of Tor changed")
+
+		if h := u.currentHost; h != nil {
+			h.onTorHealthChanged(i, e)
+		}
+
+		if e == tor.HealthRecoveryFailed {
+			u.reportError(i18n().Sprintf("Tor stopped working and it couldn't be restarted. " +
+				"Wahay will keep trying, but meetings won't work until then."))
+		}
+	})
+}
+
+// rotateTorCircuits asks our Tor for new circuits, for users who suspect
+// that the current ones are the reason of a bad connection. The button
+// stays disabled until Tor has built them
+func (u *gtkUI) rotateTorCircuits(btn gtki.Button) {
+	if u.tor == nil {
+		return
+	}
+
+	btn.SetSensitive(false)
+
+	go func() {
+		err := u.tor.RotateCircuits()
+
+		u.doInUIThread(func() {
+			btn.SetSensitive(true)
+		})
+
+		switch err {
+		case nil:
+			log.Info("Tor is using new circuits")
+		case tor.ErrNoNewCircuits:
+			log.Warn("Tor didn't build new circuits yet, it will do it when they are needed")
+		default:
+			log.Errorf("rotateTorCircuits(): %s", err)
+			u.reportError(i18n().Sprintf("Tor couldn't change its circuits: %s", err))
+		}
 	}()
 }
 
@@ -37,6 +103,25 @@ func (u *gtkUI) onTorInstanceCreated(i tor.Instance) {
 	u.onExit(i.Destroy)
 }
 
+// getSingleHopTorInstance returns the Tor instance used to host meetings
+// without anonymity, starting it the first time it's needed
+func (u *gtkUI) getSingleHopTorInstance() (tor.Instance, error) {
+	if u.singleHopTor != nil {
+		return u.singleHopTor, nil
+	}
+
+	i, err := tor.NewSingleHopInstance(u.config)
+	if err != nil {
+		return nil, err
+	}
+
+	u.onExit(i.Destroy)
+	u.singleHopTor = i
+	u.watchTorHealth(i)
+
+	return i, nil
+}
+
 func (u *gtkUI) waitForTorInstance(f func(tor.Instance)) {
 	go func() {
 		u.torInitialized.Wait()
@@ -74,6 +159,19 @@ func torErrorTranslator(err error) string {
 			"is not available.\n\nPlease check the information available at " +
 			"https://tb-manual.torproject.org/troubleshooting/ to know what you can do.")
 
+	case tor.ErrTorProxyUnreachable:
+		return i18n().Sprintf("The proxy configured for Tor can't be reached.\n\n" +
+			"Please check the proxy settings of Wahay.")
+
+	case tor.ErrRemoteTorNotAccepted:
+		return i18n().Sprintf("Wahay is configured to use a Tor on another computer, but the control traffic " +
+			"would travel over the network without protection.\n\n" +
+			"Please confirm in the configuration that you understand this, or use a Tor on this computer.")
+
+	case tor.ErrRemoteTorNeedsPassword:
+		return i18n().Sprintf("Wahay is configured to use a Tor on another computer, " +
+			"but there is no password for its control port.")
+
 	case tor.ErrTorVersionNotCompatible:
 		return i18n().Sprintf("The current version of Tor is incompatible with Wahay.")
 
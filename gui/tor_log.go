@@ -0,0 +1,81 @@
+package gui
+
+import (
+	"strings"
+
+	"github.com/coyim/gotk3adapter/gtki"
+)
+
+func (u *gtkUI) openTorLogWindow() {
+	builder := u.g.uiBuilderFor("TorLogWindow")
+
+	builder.i18nProperties(
+		"title", "torLogWindow",
+		"label", "lblTorLogDescription",
+		"button", "btnTorLogRefresh",
+		"button", "btnTorLogClose",
+	)
+
+	dialog := builder.get("torLogWindow").(gtki.Window)
+	buffer := builder.get("torLogBuffer").(gtki.TextBuffer)
+
+	refresh := func() {
+		buffer.SetText(u.torLogText())
+	}
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_refresh": refresh,
+		"on_close_window_signal": func() {
+			u.closeTorLogWindow(dialog)
+		},
+	})
+
+	if u.currentWindow != nil {
+		dialog.SetTransientFor(u.currentWindow)
+	}
+
+	refresh()
+
+	u.doInUIThread(func() {
+		u.disableCurrentWindow()
+		dialog.Show()
+	})
+}
+
+func (u *gtkUI) closeTorLogWindow(dialog gtki.Window) {
+	dialog.Destroy()
+	u.enableCurrentWindow()
+}
+
+// torLogText puts together the logs of the Tor instances we started. When
+// Wahay uses the system Tor there is nothing we can show
+func (u *gtkUI) torLogText() string {
+	var lines []string
+
+	if u.tor != nil {
+		if r := u.tor.ConnectivityReport(); r != nil {
+			lines = append(lines, i18n().Sprintf("Last connectivity check: %s", r), "")
+		}
+		lines = append(lines, u.tor.TorLog()...)
+	}
+
+	if u.singleHopTor != nil {
+		if single := u.singleHopTor.TorLog(); len(single) > 0 {
+			lines = append(lines, "", i18n().Sprintf("Non-anonymous hosting:"))
+			lines = append(lines, single...)
+		}
+	}
+
+	if u.client != nil {
+		if mumble := u.client.ClientLog(); len(mumble) > 0 {
+			lines = append(lines, "", i18n().Sprintf("Mumble client:"))
+			lines = append(lines, mumble...)
+		}
+	}
+
+	if len(lines) == 0 {
+		return i18n().Sprintf("There are no messages from Tor. Either Wahay is using the Tor of your system, or Tor hasn't started yet.")
+	}
+
+	return strings.Join(lines, "\n")
+}
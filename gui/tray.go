@@ -0,0 +1,185 @@
+package gui
+
+import (
+	"github.com/coyim/gotk3adapter/gtki"
+	log "github.com/sirupsen/logrus"
+)
+
+type trayState int
+
+const (
+	trayNoMeeting trayState = iota
+	trayInMeeting
+	trayHostingMeeting
+)
+
+// trayIcon is the icon of Wahay in the system tray. It shows whether
+// we are in a meeting and has its most common actions, so the windows
+// of Wahay can stay minimized during long meetings. It uses the status
+// icons of GTK, which the desktops with a StatusNotifier tray also show
+type trayIcon struct {
+	u     *gtkUI
+	icon  gtki.StatusIcon
+	menu  gtki.Menu
+	state trayState
+
+	showWindow     gtki.MenuItem
+	mute           gtki.MenuItem
+	copyInvitation gtki.MenuItem
+	endMeeting     gtki.MenuItem
+
+	// onCopyInvitation and onEndMeeting are the
+	// actions of the meeting we are in
+	onCopyInvitation func()
+	onEndMeeting     func()
+}
+
+// initTrayIcon shows the icon when the user wants it. It's only
+// used from the UI thread
+func (u *gtkUI) initTrayIcon() {
+	if !u.config.IsTrayIconEnabled() || u.tray != nil {
+		return
+	}
+
+	icon, err := u.g.gtk.StatusIconNewFromPixbuf(getApplicationIcon().getPixbuf())
+	if err != nil {
+		log.Errorf("initTrayIcon(): %s", err)
+		return
+	}
+
+	t := &trayIcon{u: u, icon: icon}
+	if err := t.createMenu(); err != nil {
+		log.Errorf("initTrayIcon(): %s", err)
+		return
+	}
+
+	_ = icon.Connect("activate", t.showCurrentWindow)
+	_ = icon.Connect("popup-menu", func() {
+		t.menu.PopupAtPointer(nil)
+	})
+
+	u.tray = t
+	t.translate()
+
+	// The icon stays until Wahay quits
+	_ = onLanguageChange(t.translate)
+}
+
+func (t *trayIcon) createMenu() error {
+	var err error
+
+	t.menu, err = t.u.g.gtk.MenuNew()
+	if err != nil {
+		return err
+	}
+
+	// The labels are given by translate
+	items := []struct {
+		item   *gtki.MenuItem
+		action func()
+	}{
+		{&t.showWindow, t.showCurrentWindow},
+		{&t.mute, t.toggleMute},
+		{&t.copyInvitation, func() {
+			if t.onCopyInvitation != nil {
+				t.onCopyInvitation()
+			}
+		}},
+		{&t.endMeeting, func() {
+			if t.onEndMeeting != nil {
+				t.onEndMeeting()
+			}
+		}},
+	}
+
+	for _, i := range items {
+		mi, err := t.u.g.gtk.MenuItemNew()
+		if err != nil {
+			return err
+		}
+		_ = mi.Connect("activate", i.action)
+		t.menu.Append(mi)
+		*i.item = mi
+	}
+
+	t.menu.ShowAll()
+	return nil
+}
+
+// trayTooltip describes the meeting we are in
+func trayTooltip(s trayState) string {
+	switch s {
+	case trayInMeeting:
+		return i18n().Sprintf("Wahay: in a meeting")
+	case trayHostingMeeting:
+		return i18n().Sprintf("Wahay: hosting a meeting")
+	}
+	return i18n().Sprintf("Wahay: not in a meeting")
+}
+
+// trayEndMeetingLabel returns what ending the meeting means, since
+// the host finishes it for everybody but the participants only leave
+func trayEndMeetingLabel(s trayState) string {
+	if s == trayHostingMeeting {
+		return i18n().Sprintf("Finish the meeting")
+	}
+	return i18n().Sprintf("Leave the meeting")
+}
+
+// translate shows the menu and the tooltip in the language chosen
+func (t *trayIcon) translate() {
+	t.showWindow.SetLabel(i18n().Sprintf("Show Wahay"))
+	t.mute.SetLabel(i18n().Sprintf("Mute or unmute"))
+	t.copyInvitation.SetLabel(i18n().Sprintf("Copy the invitation"))
+	t.show(t.state)
+}
+
+func (t *trayIcon) show(s trayState) {
+	t.state = s
+	t.icon.SetTooltipText(trayTooltip(s))
+
+	t.mute.SetVisible(s != trayNoMeeting)
+	t.copyInvitation.SetVisible(s == trayHostingMeeting)
+	t.endMeeting.SetVisible(s != trayNoMeeting)
+	t.endMeeting.SetLabel(trayEndMeetingLabel(s))
+}
+
+// showMeeting updates the icon with the meeting we are in, and the
+// actions to invite more people and to end it
+func (t *trayIcon) showMeeting(s trayState, onCopyInvitation, onEndMeeting func()) {
+	if t == nil {
+		return
+	}
+
+	t.u.doInUIThread(func() {
+		t.onCopyInvitation = onCopyInvitation
+		t.onEndMeeting = onEndMeeting
+		t.show(s)
+	})
+}
+
+func (t *trayIcon) showNoMeeting() {
+	t.showMeeting(trayNoMeeting, nil, nil)
+}
+
+func (t *trayIcon) showCurrentWindow() {
+	if w := t.u.currentWindow; w != nil {
+		w.Deiconify()
+		w.Present()
+	}
+}
+
+// toggleMute asks the client how it is, since it
+// can also be muted in the window of Mumble
+func (t *trayIcon) toggleMute() {
+	control := t.u.client.RemoteControl()
+	go func() {
+		muted, err := control.IsSelfMuted()
+		if err == nil {
+			err = control.SetSelfMuted(!muted)
+		}
+		if err != nil {
+			log.Debugf("trayIcon.toggleMute(): %s", err)
+		}
+	}()
+}
@@ -0,0 +1,32 @@
+package gui
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type WahayTraySuite struct{}
+
+var _ = Suite(&WahayTraySuite{})
+
+func (s *WahayTraySuite) Test_trayTooltip_describesTheMeeting(c *C) {
+	c.Assert(trayTooltip(trayNoMeeting), Equals, "Wahay: not in a meeting")
+	c.Assert(trayTooltip(trayInMeeting), Equals, "Wahay: in a meeting")
+	c.Assert(trayTooltip(trayHostingMeeting), Equals, "Wahay: hosting a meeting")
+}
+
+func (s *WahayTraySuite) Test_trayEndMeetingLabel_finishesTheMeetingOnlyForTheHost(c *C) {
+	c.Assert(trayEndMeetingLabel(trayInMeeting), Equals, "Leave the meeting")
+	c.Assert(trayEndMeetingLabel(trayHostingMeeting), Equals, "Finish the meeting")
+}
+
+func (s *WahayTraySuite) Test_trayIcon_showMeeting_doesNothingWithoutTheIcon(c *C) {
+	var t *trayIcon
+
+	t.showMeeting(trayHostingMeeting, func() {}, func() {})
+	t.showNoMeeting()
+}
+
+func (s *WahayTraySuite) Test_keepHostingHelp_pointsToTheTrayIconWhenThereIsOne(c *C) {
+	c.Assert(keepHostingHelp(true), Matches, ".*system tray.*")
+	c.Assert(keepHostingHelp(false), Matches, ".*minimize it.*")
+}
@@ -56,15 +56,22 @@ type gtkUI struct {
 	mainWindow     gtki.ApplicationWindow
 	currentWindow  gtki.Window
 	loadingWindow  gtki.Window
+	startupStatus  *startupStatus
 	g              Graphics
 	tor            tor.Instance
+	singleHopTor   tor.Instance
 	torInitialized *sync.WaitGroup
 	client         client.Instance
 	keySupplier    config.KeySupplier
 	config         *config.ApplicationConfig
 	servers        hosting.Servers
+	currentHost    *hostData
+	meetingTabs    *meetingTabs
+	testingAudio   bool
+	tray           *trayIcon
 	errorHandler   *errorHandler
 	cleanupHandler *cleanupHandler
+	styles         themeStyles
 	colorManager
 }
 
@@ -127,7 +134,21 @@ func (u *gtkUI) quit() {
 }
 
 func (u *gtkUI) configLoaded() {
-	u.displayLoadingWindow()
+	if needsOnboarding(u.config) {
+		u.hideLoadingWindow()
+		u.doInUIThread(func() {
+			u.showOnboarding(u.startWithConfig)
+		})
+		return
+	}
+
+	u.startWithConfig(nil)
+}
+
+// startWithConfig starts Tor and Mumble and shows the main window once
+// they are ready. Then afterwards is called, when it's not nil
+func (u *gtkUI) startWithConfig(afterwards func()) {
+	u.displayLoadingWindowWithCallback(u.quit)
 
 	go u.initLogs()
 
@@ -136,6 +157,9 @@ func (u *gtkUI) configLoaded() {
 
 		u.doInUIThread(func() {
 			u.createMainWindow()
+			if afterwards != nil {
+				afterwards()
+			}
 		})
 	})
 }
@@ -148,6 +172,7 @@ func (u *gtkUI) getMainWindowBuilder() *uiBuilder {
 		"button", "btnErrorsAccept",
 		"tooltip", "btnSettings",
 		"tooltip", "btnHelp",
+		"tooltip", "btnHistory",
 		"tooltip", "btnJoinMeeting",
 		"tooltip", "btnHostMeeting",
 		"label", "lblWelcome",
@@ -161,6 +186,7 @@ func (u *gtkUI) getMainWindowBuilder() *uiBuilder {
 	imgJoinMeeting := builder.get("imgJoinMeeting").(gtki.Image)
 	imgSettings := builder.get("imgSettings").(gtki.Image)
 	imgHelp := builder.get("imgHelp").(gtki.Image)
+	imgHistory := builder.get("imgHistory").(gtki.Image)
 
 	icon1, _ := u.g.getImagePixbufForSize("host-meeting.svg", 32)
 	icon2, _ := u.g.getImagePixbufForSize("join-meeting.svg", 32)
@@ -168,6 +194,7 @@ func (u *gtkUI) getMainWindowBuilder() *uiBuilder {
 	imgHostMeeting.SetFromPixbuf(icon1)
 	imgJoinMeeting.SetFromPixbuf(icon2)
 	imgHelp.SetFromIconName("help-contents-symbolic", gtki.ICON_SIZE_LARGE_TOOLBAR)
+	imgHistory.SetFromIconName("document-open-recent-symbolic", gtki.ICON_SIZE_LARGE_TOOLBAR)
 	imgSettings.SetFromIconName("applications-system-symbolic", gtki.ICON_SIZE_LARGE_TOOLBAR)
 
 	return builder
@@ -189,6 +216,7 @@ func (u *gtkUI) createMainWindow() {
 		"on_join_meeting":        u.joinMeeting,
 		"on_open_settings":       u.openSettingsWindow,
 		"on_open_help":           u.openHelpWindow,
+		"on_open_history":        u.openHistoryWindow,
 		"on_show_errors": func() {
 			u.showStatusErrorsWindow(builder)
 		},
@@ -203,6 +231,9 @@ func (u *gtkUI) createMainWindow() {
 	u.disableMainWindowControls(builder)
 
 	win.Show()
+
+	u.initTrayIcon()
+	u.startDeepLinks()
 }
 
 func (u *gtkUI) updateMainWindowStatusBar(builder *uiBuilder) {
@@ -246,24 +277,18 @@ func (u *gtkUI) setGlobalStyles() {
 	configuredTheme := u.config.GetColorScheme()
 	if configuredTheme != "" {
 		u.colorManager.disableAutomaticThemeChange()
-		u.addCSSProvider(configuredTheme)
+		u.applyColorScheme(configuredTheme)
 		return
 	}
 
-	css := "light-mode-gui"
+	css := lightColorScheme
 	if u.colorManager.isDarkThemeVariant() {
-		css = "dark-mode-gui"
+		css = darkColorScheme
 	}
 
 	u.addCSSProvider(css)
 }
 
-func (u *gtkUI) addCSSProvider(css string) {
-	prov := u.g.cssFor(css)
-	screen, _ := u.g.gdk.ScreenGetDefault()
-	u.g.gtk.AddProviderForScreen(screen, prov, uint(gtki.STYLE_PROVIDER_PRIORITY_APPLICATION))
-}
-
 func (u *gtkUI) initialSetupWindow() {
 	u.saveConfigOnly()
 }
@@ -283,20 +308,27 @@ func (u *gtkUI) getConfirmWindow() *uiBuilder {
 }
 
 func (u *gtkUI) showConfirmation(onConfirm func(bool), text string) {
+	builder := u.getConfirmWindow()
+
+	if len(text) > 0 {
+		lbl, _ := builder.get("lblText").(gtki.Label)
+		lbl.SetText(text)
+	}
+
+	u.runConfirmation(builder, onConfirm)
+}
+
+// runConfirmation shows a confirmation window built with
+// getConfirmWindow and calls onConfirm with the answer
+func (u *gtkUI) runConfirmation(builder *uiBuilder, onConfirm func(bool)) {
 	u.disableCurrentWindow()
 
-	builder := u.getConfirmWindow()
 	dialog := builder.get("dialog").(gtki.Window)
 
 	if u.currentWindow != nil {
 		dialog.SetTransientFor(u.currentWindow)
 	}
 
-	if len(text) > 0 {
-		lbl, _ := builder.get("lblText").(gtki.Label)
-		lbl.SetText(text)
-	}
-
 	clean := func(op bool) {
 		dialog.Destroy()
 		u.enableCurrentWindow()
@@ -36,8 +36,20 @@ type uiBuilder struct {
 	gtki.Builder
 }
 
-func (g *Graphics) cssFor(name string) gtki.CssProvider {
-	return g.loadCSSFor(name)
+// loadCSSInto replaces the styles of the provider with the ones in
+// the named file, or takes them all out when the name is empty
+func (g *Graphics) loadCSSInto(prov gtki.CssProvider, name string) {
+	cssData := ""
+	if name != "" && isCSSVersionSufficient(g.gtk) {
+		cssData = getCSSFileWithFallback(name)
+	}
+
+	builderMutex.Lock()
+	defer builderMutex.Unlock()
+
+	if err := prov.LoadFromData(cssData); err != nil {
+		fatalf("gui: failed load %s: %s", name, err.Error())
+	}
 }
 
 func (g *Graphics) uiBuilderFor(name string) *uiBuilder {
@@ -98,37 +110,6 @@ func isCSSVersionSufficient(gg gtki.Gtk) bool {
 	return major > uint(3) || (major == uint(3) && minor > uint(18))
 }
 
-func (g *Graphics) loadCSSFor(cssFile string) gtki.CssProvider {
-	if isCSSVersionSufficient(g.gtk) {
-		cssData := getCSSFileWithFallback(cssFile)
-
-		builderMutex.Lock()
-		defer builderMutex.Unlock()
-
-		cssProvider, err := g.gtk.CssProviderNew()
-		if err != nil {
-			fatal(err)
-		}
-
-		err = cssProvider.LoadFromData(cssData)
-		if err != nil {
-			fatalf("gui: failed load %s: %s", cssFile, err.Error())
-		}
-
-		return cssProvider
-	}
-
-	// We just return an empty css provider if we don't have a
-	// late enough version of GTK. Later we can fallback
-	// to other CSS versions or something like that
-	cssProvider, err := g.gtk.CssProviderNew()
-	if err != nil {
-		fatal(err)
-	}
-
-	return cssProvider
-}
-
 // This must be called from the UI thread - otherwise bad things will happen sooner or later
 func (g *Graphics) builderForDefinition(uiName string) gtki.Builder {
 	template := getDefinitionWithFileFallback(uiName)
@@ -217,3 +198,22 @@ func (g Graphics) getImagePixbufForSize(imageName string, size int) (gdki.Pixbuf
 
 	return pl.GetPixbuf()
 }
+
+// getPixbufFromBytes loads an image that doesn't come with Wahay,
+// like the ones downloaded from the network
+func (g Graphics) getPixbufFromBytes(content []byte) (gdki.Pixbuf, error) {
+	pl, err := g.gdk.PixbufLoaderNew()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := pl.Write(content); err != nil {
+		return nil, err
+	}
+
+	if err := pl.Close(); err != nil {
+		return nil, err
+	}
+
+	return pl.GetPixbuf()
+}
@@ -3,6 +3,8 @@ package gui
 import (
 	"errors"
 	"os"
+	"path"
+	"regexp"
 	"strings"
 
 	. "github.com/digitalautonomy/wahay/test"
@@ -128,8 +130,9 @@ func (s *WahayGUIUIReaderSuite) Test_readFile_failsIfErrorHappens(c *C) {
 func (s *WahayGUIUIReaderSuite) Test_getConfigFileFor_returnsTheWahayDesktopConfigFile(c *C) {
 	val := getConfigFileFor("wahay", ".desktop")
 
-	c.Assert(val, HasLen, 221)
+	c.Assert(val, HasLen, 282)
 	c.Assert(val, Contains, "Terminal=false")
+	c.Assert(val, Contains, "x-scheme-handler/wahay")
 	c.Assert(val, Contains, "Secure and Decentralized Conference")
 }
 
@@ -164,3 +167,18 @@ func (s *WahayGUIUIReaderSuite) Test_getCSSFileWithFallback_panicsWhenAskedForAC
 		getCSSFileWithFallback("foobar")
 	}, PanicMatches, "(?ms).*Developer error.*")
 }
+
+func (s *WahayGUIUIReaderSuite) Test_definitions_labelWidgetsThatExist(c *C) {
+	entries, err := files.ReadDir(definitionsDir)
+	c.Assert(err, IsNil)
+
+	mnemonic := regexp.MustCompile(`<property name="mnemonic[_-]widget">(\w+)</property>`)
+	for _, e := range entries {
+		content, err := files.ReadFile(path.Join(definitionsDir, e.Name()))
+		c.Assert(err, IsNil)
+
+		for _, m := range mnemonic.FindAllStringSubmatch(string(content), -1) {
+			c.Assert(strings.Contains(string(content), `id="`+m[1]+`"`), Equals, true, Commentf("%s: %s", e.Name(), m[1]))
+		}
+	}
+}
@@ -16,7 +16,9 @@ func noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt1() {
 	// TODO: fix a better solution! Maybe patch gotext to have a flag to change
 	// this behavior or something else.
 
+	_ = i18n().Sprintf("Non-anonymous hosting (lower latency)")
 	_ = i18n().Sprintf("Ok")
+	_ = i18n().Sprintf("Only allow invited participants")
 	_ = i18n().Sprintf("Allow the host to automatically join a newly created meeting")
 	_ = i18n().Sprintf("Are you sure you want to do this action?")
 	_ = i18n().Sprintf("Are you sure you want to end this meeting?")
@@ -31,6 +33,7 @@ func noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt1() {
 	_ = i18n().Sprintf("By clicking Yes, you will leave this meeting.")
 	_ = i18n().Sprintf("Cancel")
 	_ = i18n().Sprintf("Client binary location")
+	_ = i18n().Sprintf("Close")
 	_ = i18n().Sprintf("Configuration settings will be lost in the next session")
 	_ = i18n().Sprintf("Configure master password")
 }
@@ -41,6 +44,7 @@ func noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt2() {
 	_ = i18n().Sprintf("Continue")
 	_ = i18n().Sprintf("Copy Invitation")
 	_ = i18n().Sprintf("Copy Meeting ID")
+	_ = i18n().Sprintf("Copy QR Code")
 	_ = i18n().Sprintf("Copy URL")
 	_ = i18n().Sprintf("Check this option to automatically join every meeting you host")
 	_ = i18n().Sprintf("Choose your email service to send invitation")
@@ -48,11 +52,14 @@ func noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt2() {
 	_ = i18n().Sprintf("Default Email")
 	_ = i18n().Sprintf("Encrypt the configuration file")
 	_ = i18n().Sprintf("Error")
+	_ = i18n().Sprintf("Fill in a password made of words, easy to read out over a phone call")
 	_ = i18n().Sprintf("Finish")
 	_ = i18n().Sprintf("End this meeting")
 	_ = i18n().Sprintf("End this meeting for all")
 	_ = i18n().Sprintf("General")
+	_ = i18n().Sprintf("Generate")
 	_ = i18n().Sprintf("Gmail")
+	_ = i18n().Sprintf("Guests using Mumble on a phone can scan this code to join")
 	_ = i18n().Sprintf("Host a new meeting")
 	_ = i18n().Sprintf("Hosting")
 	_ = i18n().Sprintf("Host meeting")
@@ -71,8 +78,13 @@ func noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt2() {
 	_ = i18n().Sprintf("Join the meeting")
 	_ = i18n().Sprintf("Join this meeting")
 	_ = i18n().Sprintf("Keep configuration file when Wahay closes")
+	_ = i18n().Sprintf("Last connectivity check: %s")
 	_ = i18n().Sprintf("Leave")
 	_ = i18n().Sprintf("Leave this meeting")
+	_ = i18n().Sprintf("Mute")
+	_ = i18n().Sprintf("Stop sending your voice to the meeting")
+	_ = i18n().Sprintf("Deafen")
+	_ = i18n().Sprintf("Stop hearing the meeting, which also mutes you")
 	_ = i18n().Sprintf("Log debug info")
 	_ = i18n().Sprintf("Log debug output to the selected log file. If no file is " +
 		"selected then the log output will be written to the default log file.")
@@ -87,42 +99,111 @@ func noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt2() {
 func noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt3() {
 	_ = i18n().Sprintf("Meeting ID:")
 	_ = i18n().Sprintf("Meeting password")
+	_ = i18n().Sprintf("Audio quality")
+	_ = i18n().Sprintf("A lower quality uses less bandwidth, which avoids choppy audio on slow Tor connections")
 	_ = i18n().Sprintf("Mumble")
+	_ = i18n().Sprintf("New Tor circuits")
+	_ = i18n().Sprintf("Ask Tor to use new circuits, which can help when the connection is slow or breaks up. " +
+		"The meeting only uses them after reconnecting")
 	_ = i18n().Sprintf("No, cancel")
 	_ = i18n().Sprintf("Now you are hosting a meeting.")
+	_ = i18n().Sprintf("Open a new message with the invitation in your email application")
+	_ = i18n().Sprintf("Open Invitation File")
 	_ = i18n().Sprintf("Outlook")
+	_ = i18n().Sprintf("Participants will be able to find out where the meeting is hosted")
 	_ = i18n().Sprintf("Password")
 	_ = i18n().Sprintf("Please enter the master password for the configuration file.")
 	_ = i18n().Sprintf("Port")
 	_ = i18n().Sprintf("Port out of range")
+	_ = i18n().Sprintf("Protect the meetings you host with a personal key for every participant")
 	_ = i18n().Sprintf("Raw log file")
+	_ = i18n().Sprintf("Refresh")
 	_ = i18n().Sprintf("Repeat the password")
 	_ = i18n().Sprintf("Save changes")
+	_ = i18n().Sprintf("Save Invitation File")
+	_ = i18n().Sprintf("Save the invitation in a file to send as an attachment. When the meeting has a password, the file is protected with it")
 	_ = i18n().Sprintf("Security")
 	_ = i18n().Sprintf("btnSettings-tooltip")
 	_ = i18n().Sprintf("Settings")
+	_ = i18n().Sprintf("Share…")
 	_ = i18n().Sprintf("Show")
+	_ = i18n().Sprintf("Show the latest messages of the Tor started by Wahay and of the Mumble client, " +
+		"without addresses or user names")
+	_ = i18n().Sprintf("Show the Tor log")
+	_ = i18n().Sprintf("Keep the same meeting ID")
+	_ = i18n().Sprintf("Use the same meeting ID every time you host a meeting, for recurring meetings")
+	_ = i18n().Sprintf("When this option is checked, the key of the meeting ID is stored in the configuration file, " +
+		"so the participants can use the same meeting ID every time. " +
+		"Anybody with the configuration file could host meetings with it, so please encrypt it")
+	_ = i18n().Sprintf("Keep the same server certificate")
+	_ = i18n().Sprintf("Use the same certificate every time you host a meeting, so the participants are not asked to accept a new one")
+	_ = i18n().Sprintf("When this option is checked, the certificate of the server and its private key are stored " +
+		"in the configuration file, so the Mumble clients of returning participants recognize the server. " +
+		"Please encrypt the configuration file. The change is applied the next time Wahay starts")
+	_ = i18n().Sprintf("Keep the same identity in every meeting")
+	_ = i18n().Sprintf("Use the same certificate every time you join a meeting, so the hosts can recognize you")
+	_ = i18n().Sprintf("When this option is checked, the certificate of your Mumble client and its private key are stored " +
+		"in the configuration file. Servers can then register you, give you permissions or ban you, " +
+		"and it also lets them link the meetings you join. " +
+		"Please encrypt the configuration file. The change is applied the next time Wahay starts")
+	_ = i18n().Sprintf("Join the meeting again when Mumble closes unexpectedly")
+	_ = i18n().Sprintf("Start Mumble again without asking when it crashes in the middle of a meeting")
+	_ = i18n().Sprintf("Use a Mumble client managed by Wahay")
+	_ = i18n().Sprintf("Show an icon in the system tray")
+	_ = i18n().Sprintf("High contrast")
+	_ = i18n().Sprintf("The icon shows whether you are in a meeting and lets you mute, invite people " +
+		"or end the meeting while the windows of Wahay are minimized. The change is applied the next time Wahay starts")
+	_ = i18n().Sprintf("Download a verified build of Mumble through Tor " +
+		"and use it instead of the one installed in the system")
+	_ = i18n().Sprintf("Admit participants from a waiting room")
+	_ = i18n().Sprintf("Ask me before letting each participant into the meeting")
+	_ = i18n().Sprintf("When this option is checked, participants joining the meetings you host wait until you admit them, " +
+		"so a leaked meeting ID doesn't let anybody in")
+	_ = i18n().Sprintf("Let participants join from Tor Browser")
+	_ = i18n().Sprintf("Serve mumble-web with the meetings you host, for participants without Mumble")
+	_ = i18n().Sprintf("When this option is checked and mumble-web is installed, the invitations include an address " +
+		"to open the meeting in Tor Browser. Tor Browser asks to accept the certificate of the meeting the first time")
+	_ = i18n().Sprintf("Ask the Tor Project for bridges, for networks where Tor is blocked")
+	_ = i18n().Sprintf("Bridges are relays that help the Tor started by Wahay to connect where Tor is blocked. " +
+		"The bridges you get are used the next time Wahay starts.")
+	_ = i18n().Sprintf("Get bridges")
+	_ = i18n().Sprintf("New captcha")
+	_ = i18n().Sprintf("The Tor Project gives bridges to people who solve this captcha. " +
+		"Please type the characters you see in the image.")
+	_ = i18n().Sprintf("Type the characters of the image")
+	_ = i18n().Sprintf("Participants")
+	_ = i18n().Sprintf("Nobody else has joined the meeting yet")
 	_ = i18n().Sprintf("Specify a password for the meeting")
 	_ = i18n().Sprintf("Start meeting")
 	_ = i18n().Sprintf("The error message")
 	_ = i18n().Sprintf("The meeting ID has been copied to the clipboard")
 	_ = i18n().Sprintf("A valid port is between 1 and 65535")
 	_ = i18n().Sprintf("This action cannot be undone")
+	_ = i18n().Sprintf("These are the latest messages of the Tor started by Wahay and of the Mumble client " +
+		"in the last meeting. Addresses and user names have been removed from them.")
 	_ = i18n().Sprintf("Toggle password visibility")
+	_ = i18n().Sprintf("Tor log")
+	_ = i18n().Sprintf("Time for an answer from the meeting over Tor, and how many of the last answers were lost")
+	_ = i18n().Sprintf("Traffic of your Tor connection during the last second")
 	_ = i18n().Sprintf("Type the Meeting ID (normally a .onion address)")
 	_ = i18n().Sprintf("Type the password")
 	_ = i18n().Sprintf("Type the password to join the meeting")
 	_ = i18n().Sprintf("Type your screen name (or leave empty for a random one)")
 	_ = i18n().Sprintf("Username")
 	_ = i18n().Sprintf("Wahay is ready to use")
+	_ = i18n().Sprintf("Warning: when this option is checked, the meetings you host are still end-to-end encrypted, " +
+		"but your location is not hidden anymore. Only use it if you don't need to stay anonymous as a host")
 	_ = i18n().Sprintf("We have detected that the configuration file is invalid or corrupted. " +
 		"Do you want to make a copy (backup) of it and continue?")
 	_ = i18n().Sprintf("Welcome")
+	_ = i18n().Sprintf("When this option is checked, every invitee receives a different meeting ID and nobody else can connect to the meeting")
 	_ = i18n().Sprintf("When this option is checked, the configuration settings will be stored in the device.")
 	_ = i18n().Sprintf("Yahoo Mail")
 	_ = i18n().Sprintf("Yes, back it up &amp; continue")
 	_ = i18n().Sprintf("Yes, confirm")
 	_ = i18n().Sprintf("You will not be asked for this password again until you restart Wahay.")
+	_ = i18n().Sprintf("Remember the password in the keychain")
+	_ = i18n().Sprintf("Wahay opens the configuration file without asking for the password, as long as you are logged in")
 }
 
 func noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt4() {
@@ -134,6 +215,22 @@ func noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt4() {
 	_ = i18n().Sprintf("Ex. 9800")
 	_ = i18n().Sprintf("If you want to set up a custom port to run the Mumble service, " +
 		"please a port number between 1 and 65535")
+	_ = i18n().Sprintf("Audio")
+	_ = i18n().Sprintf("Transmit mode")
+	_ = i18n().Sprintf("With push to talk, your voice is only sent while you hold down the right Control key")
+	_ = i18n().Sprintf("Noise suppression")
+	_ = i18n().Sprintf("Removes the background noise of your microphone. Higher levels make voices sound less natural")
+	_ = i18n().Sprintf("Audio buffer")
+	_ = i18n().Sprintf("A longer buffer keeps the voices from breaking up on slow Tor circuits, " +
+		"but adds delay to the conversation")
+	_ = i18n().Sprintf("Join meetings with the microphone muted")
+	_ = i18n().Sprintf("Join meetings with the sound off")
+	_ = i18n().Sprintf("So you don't interrupt a meeting that already started. " +
+		"You can still change it for each meeting when joining")
+	_ = i18n().Sprintf("Join with the microphone muted")
+	_ = i18n().Sprintf("Nobody will hear you until you unmute the microphone in Mumble")
+	_ = i18n().Sprintf("Join with the sound of the meeting off")
+	_ = i18n().Sprintf("You won't hear the meeting and nobody will hear you until you turn the sound on in Mumble")
 	_ = i18n().Sprintf("What is Wahay?")
 	_ = i18n().Sprintf("Communication is a basic need of the human being, in its beginnings it is carried out verbally " +
 		"from person to person through the use of technology, various tools have been developed for this purpose stories " +
@@ -179,4 +276,72 @@ func noPointInEverCallingThisButYouCanIfYouReallyFeelLikeIt5() {
 		"such as silencing another user or expelling him/her from the meeting, etc.")
 	_ = i18n().Sprintf("Start a new meeting \u0026 join")
 	_ = i18n().Sprintf("Start a new meeting")
+	_ = i18n().Sprintf("Get ready for your first meeting")
+	_ = i18n().Sprintf("Welcome to Wahay")
+	_ = i18n().Sprintf("Wahay lets you have voice meetings that nobody can listen to or trace back to you. " +
+		"This guide helps you get ready for your first meeting in a few steps.")
+	_ = i18n().Sprintf("You can change everything later in the settings, or skip this guide with Cancel. " +
+		"It's shown every time Wahay starts, until you choose to remember your settings.")
+	_ = i18n().Sprintf("Wahay can remember your settings in a file on this computer. " +
+		"If you share the computer, protect the file with a password, " +
+		"which Wahay asks for every time it starts.")
+	_ = i18n().Sprintf("Remember my settings on this computer")
+	_ = i18n().Sprintf("Protect my settings with a password")
+	_ = i18n().Sprintf("Wahay connects through the Tor network. If Tor is blocked where you are, " +
+		"get bridges from the Tor Project. They help Tor connect anyway.")
+	_ = i18n().Sprintf("If Tor isn't blocked where you are, you don't need bridges.")
+	_ = i18n().Sprintf("Choose when Mumble, the program Wahay uses for the calls, sends your voice. " +
+		"Push to talk is the safest choice: nobody hears you by accident.")
+	_ = i18n().Sprintf("Wahay can start a test call on this computer when it's ready, " +
+		"so you can check your microphone and speakers before your first meeting. " +
+		"Nobody else can join it, and it doesn't go through Tor.")
+	_ = i18n().Sprintf("Start a test call when Wahay is ready")
+	_ = i18n().Sprintf("Language")
+	_ = i18n().Sprintf("The windows of Wahay change to the language chosen right away")
+	_ = i18n().Sprintf("Join or host a past meeting again")
+	_ = i18n().Sprintf("Past meetings")
+	_ = i18n().Sprintf("Remember the meetings I join and host")
+	_ = i18n().Sprintf("The meetings are kept in the settings of Wahay, together with the invitations and " +
+		"names used. Unchecking it forgets all of them")
+	_ = i18n().Sprintf("There are no past meetings")
+	_ = i18n().Sprintf("Forget all")
+	_ = i18n().Sprintf("Each invitee has their own invitation")
+	_ = i18n().Sprintf("The invitations can be used for")
+	_ = i18n().Sprintf("Copy Password")
+	_ = i18n().Sprintf("Copy the password of the meeting, to send it apart from the invitation")
+	_ = i18n().Sprintf("Verify the meeting")
+	_ = i18n().Sprintf("If the symbols are different, leave the meeting: the invitation was changed " +
+		"on its way, and somebody else could be listening.")
+	_ = i18n().Sprintf("Verify")
+	_ = i18n().Sprintf("Compare a few symbols with the host to check that nobody changed the invitation on its way")
+	_ = i18n().Sprintf("Compare a few symbols with the participants to check that nobody changed " +
+		"the invitation on its way")
+	_ = i18n().Sprintf("Meeting dashboard")
+	_ = i18n().Sprintf("Nobody is waiting to join")
+	_ = i18n().Sprintf("Dashboard")
+	_ = i18n().Sprintf("See the time, the waiting room and the participants of the meeting together, " +
+		"and ban participants")
+	_ = i18n().Sprintf("Test Audio")
+	_ = i18n().Sprintf("Try your microphone and speakers with Mumble before joining, so nobody has to ask " +
+		"if they can be heard")
+	_ = i18n().Sprintf("Opens the audio wizard of Mumble, which plays your microphone back so you can hear " +
+		"yourself. The devices chosen in it are only used while it's open")
+	_ = i18n().Sprintf("The meeting is still going on")
+	_ = i18n().Sprintf("End the meeting")
+	_ = i18n().Sprintf("Keep hosting in the background")
+	_ = i18n().Sprintf("Meeting chat")
+	_ = i18n().Sprintf("Meetings")
+	_ = i18n().Sprintf("Your messages are shown to everybody in the meeting. Here you see the messages sent " +
+		"to the whole meeting, to its main channel and to Wahay.")
+	_ = i18n().Sprintf("Write a message or paste a link")
+	_ = i18n().Sprintf("Send")
+	_ = i18n().Sprintf("Chat")
+	_ = i18n().Sprintf("Exchange short messages and links with the participants")
+	_ = i18n().Sprintf("Record the meeting")
+	_ = i18n().Sprintf("Everybody in the meeting is told when the recording starts, and so is everybody who " +
+		"joins while it goes on. The recording is encrypted with this password, which is needed to export " +
+		"it with \"wahay export\".")
+	_ = i18n().Sprintf("Password of the recording")
+	_ = i18n().Sprintf("Join another meeting")
+	_ = i18n().Sprintf("Join a meeting of somebody else while hosting this one")
 }
@@ -0,0 +1,41 @@
+//go:build !windows
+
+package gui
+
+import (
+	"os/exec"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	wahaySchemeHandler  = "x-scheme-handler/wahay"
+	mumbleSchemeHandler = "x-scheme-handler/mumble"
+)
+
+// registerURLHandlers makes the desktop open the wahay:// links with
+// Wahay. The mumble:// links are only taken when no other application
+// handles them, so an installed Mumble client keeps them
+func registerURLHandlers(desktopFile string) {
+	path, err := exec.LookPath("xdg-mime")
+	if err != nil {
+		log.Debugf("registerURLHandlers(): xdg-mime is not available: %s", err)
+		return
+	}
+
+	setDefault := func(handler string) {
+		/* #nosec G204 */
+		if err := exec.Command(path, "default", desktopFile, handler).Run(); err != nil {
+			log.WithField("handler", handler).Errorf("registerURLHandlers(): %s", err)
+		}
+	}
+
+	setDefault(wahaySchemeHandler)
+
+	/* #nosec G204 */
+	current, err := exec.Command(path, "query", "default", mumbleSchemeHandler).Output()
+	if err == nil && strings.TrimSpace(string(current)) == "" {
+		setDefault(mumbleSchemeHandler)
+	}
+}
@@ -0,0 +1,11 @@
+package gui
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// registerURLHandlers does nothing yet, since the handlers
+// of the links are kept in the registry on Windows
+func registerURLHandlers(desktopFile string) {
+	log.Debugf("registerURLHandlers(): not supported for %s", desktopFile)
+}
@@ -0,0 +1,185 @@
+package gui
+
+import (
+	"fmt"
+
+	"github.com/coyim/gotk3adapter/gtki"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+type verificationSymbol struct {
+	emoji string
+	name  string
+}
+
+// verificationSymbols are the symbols of the verification codes. The
+// emoji can be compared at a glance, and the names read aloud
+func verificationSymbols() []verificationSymbol {
+	return []verificationSymbol{
+		{"🐶", i18n().Sprintf("Dog")},
+		{"🐱", i18n().Sprintf("Cat")},
+		{"🦁", i18n().Sprintf("Lion")},
+		{"🐎", i18n().Sprintf("Horse")},
+		{"🦄", i18n().Sprintf("Unicorn")},
+		{"🐷", i18n().Sprintf("Pig")},
+		{"🐘", i18n().Sprintf("Elephant")},
+		{"🐰", i18n().Sprintf("Rabbit")},
+		{"🐼", i18n().Sprintf("Panda")},
+		{"🐓", i18n().Sprintf("Rooster")},
+		{"🐧", i18n().Sprintf("Penguin")},
+		{"🐢", i18n().Sprintf("Turtle")},
+		{"🐟", i18n().Sprintf("Fish")},
+		{"🐙", i18n().Sprintf("Octopus")},
+		{"🦋", i18n().Sprintf("Butterfly")},
+		{"🌷", i18n().Sprintf("Flower")},
+		{"🌳", i18n().Sprintf("Tree")},
+		{"🌵", i18n().Sprintf("Cactus")},
+		{"🍄", i18n().Sprintf("Mushroom")},
+		{"🌏", i18n().Sprintf("Globe")},
+		{"🌙", i18n().Sprintf("Moon")},
+		{"☁️", i18n().Sprintf("Cloud")},
+		{"🔥", i18n().Sprintf("Fire")},
+		{"🍌", i18n().Sprintf("Banana")},
+		{"🍎", i18n().Sprintf("Apple")},
+		{"🍓", i18n().Sprintf("Strawberry")},
+		{"🌽", i18n().Sprintf("Corn")},
+		{"🍕", i18n().Sprintf("Pizza")},
+		{"🎂", i18n().Sprintf("Cake")},
+		{"❤️", i18n().Sprintf("Heart")},
+		{"😀", i18n().Sprintf("Smiley")},
+		{"🤖", i18n().Sprintf("Robot")},
+		{"🎩", i18n().Sprintf("Hat")},
+		{"👓", i18n().Sprintf("Glasses")},
+		{"🔧", i18n().Sprintf("Spanner")},
+		{"🎅", i18n().Sprintf("Santa")},
+		{"👍", i18n().Sprintf("Thumbs up")},
+		{"☂️", i18n().Sprintf("Umbrella")},
+		{"⌛", i18n().Sprintf("Hourglass")},
+		{"⏰", i18n().Sprintf("Clock")},
+		{"🎁", i18n().Sprintf("Gift")},
+		{"💡", i18n().Sprintf("Light bulb")},
+		{"📕", i18n().Sprintf("Book")},
+		{"✏️", i18n().Sprintf("Pencil")},
+		{"📎", i18n().Sprintf("Paperclip")},
+		{"✂️", i18n().Sprintf("Scissors")},
+		{"🔒", i18n().Sprintf("Lock")},
+		{"🔑", i18n().Sprintf("Key")},
+		{"🔨", i18n().Sprintf("Hammer")},
+		{"☎️", i18n().Sprintf("Telephone")},
+		{"🏁", i18n().Sprintf("Flag")},
+		{"🚂", i18n().Sprintf("Train")},
+		{"🚲", i18n().Sprintf("Bicycle")},
+		{"✈️", i18n().Sprintf("Aeroplane")},
+		{"🚀", i18n().Sprintf("Rocket")},
+		{"🏆", i18n().Sprintf("Trophy")},
+		{"⚽", i18n().Sprintf("Ball")},
+		{"🎸", i18n().Sprintf("Guitar")},
+		{"🎺", i18n().Sprintf("Trumpet")},
+		{"🔔", i18n().Sprintf("Bell")},
+		{"⚓", i18n().Sprintf("Anchor")},
+		{"🎧", i18n().Sprintf("Headphones")},
+		{"📁", i18n().Sprintf("Folder")},
+		{"📌", i18n().Sprintf("Pin")},
+	}
+}
+
+// verificationCodeSymbols returns the symbols to show for the
+// meeting, or an error when the meeting can't be verified
+func verificationCodeSymbols(meetingID string, certPEM []byte) ([]verificationSymbol, error) {
+	code, err := hosting.VerificationCode(meetingID, certPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := verificationSymbols()
+	result := make([]verificationSymbol, len(code))
+	for i, s := range code {
+		result[i] = symbols[s]
+	}
+	return result, nil
+}
+
+func verificationHelpText(asHost bool) string {
+	if asHost {
+		return i18n().Sprintf("Ask the participants to read you the symbols Wahay shows them, " +
+			"over a channel you trust, like a phone call or in person. " +
+			"They must be the same as these.")
+	}
+	return i18n().Sprintf("Read these symbols to the host over a channel you trust, " +
+		"like a phone call or in person. The host must see the same symbols.")
+}
+
+// openVerificationWindow shows the symbols the host and the guests compare
+// to check that they are in the same meeting, with the same certificate
+func (u *gtkUI) openVerificationWindow(meetingID string, certPEM []byte, asHost bool) {
+	builder := u.g.uiBuilderFor("VerifyMeetingWindow")
+
+	builder.i18nProperties(
+		"title", "verifyMeetingWindow",
+		"label", "lblVerificationWarning",
+		"button", "btnCloseVerification",
+	)
+
+	dialog := builder.get("verifyMeetingWindow").(gtki.Window)
+	lblHelp := builder.get("lblVerificationHelp").(gtki.Label)
+	box := builder.get("boxVerificationCode").(gtki.Box)
+
+	symbols, err := verificationCodeSymbols(meetingID, certPEM)
+	if err != nil {
+		log.Errorf("openVerificationWindow(): %s", err)
+		lblHelp.SetText(i18n().Sprintf("The certificate of the meeting isn't known, " +
+			"so the meeting can't be verified. Joining it again can help."))
+		box.SetVisible(false)
+		builder.get("lblVerificationWarning").(gtki.Label).SetVisible(false)
+	} else {
+		lblHelp.SetText(verificationHelpText(asHost))
+		for _, s := range symbols {
+			u.addVerificationSymbol(box, s)
+		}
+	}
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_close": func() {
+			dialog.Destroy()
+			u.enableCurrentWindow()
+		},
+	})
+
+	if u.currentWindow != nil {
+		dialog.SetTransientFor(u.currentWindow)
+	}
+
+	u.doInUIThread(func() {
+		u.disableCurrentWindow()
+		dialog.Show()
+	})
+}
+
+func (u *gtkUI) addVerificationSymbol(box gtki.Box, s verificationSymbol) {
+	symbol, err := u.g.gtk.BoxNew(gtki.VerticalOrientation, 4)
+	if err != nil {
+		log.Debugf("addVerificationSymbol(): %s", err)
+		return
+	}
+
+	lblEmoji, _ := u.g.gtk.LabelNew("")
+	lblEmoji.SetMarkup(fmt.Sprintf("<span size=\"xx-large\">%s</span>", s.emoji))
+	lblName, _ := u.g.gtk.LabelNew(s.name)
+
+	symbol.PackStart(lblEmoji, false, false, 0)
+	symbol.PackStart(lblName, false, false, 0)
+	box.PackStart(symbol, false, false, 0)
+	symbol.ShowAll()
+}
+
+// openVerificationWindow shows the host the symbols of the meeting, made
+// with the certificate the guests receive from the server
+func (h *hostData) openVerificationWindow() {
+	cert, _, err := h.u.servers.Certificate()
+	if err != nil {
+		log.Errorf("openVerificationWindow(): %s", err)
+	}
+	h.u.openVerificationWindow(h.service.ID(), cert, true)
+}
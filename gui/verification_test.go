@@ -0,0 +1,44 @@
+package gui
+
+import (
+	"encoding/pem"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+type WahayVerificationSuite struct{}
+
+var _ = Suite(&WahayVerificationSuite{})
+
+func (s *WahayVerificationSuite) Test_verificationSymbols_areAllDifferent(c *C) {
+	symbols := verificationSymbols()
+	c.Assert(symbols, HasLen, hosting.VerificationSymbols)
+
+	emoji := map[string]bool{}
+	names := map[string]bool{}
+	for _, s := range symbols {
+		emoji[s.emoji] = true
+		names[s.name] = true
+	}
+	c.Assert(emoji, HasLen, hosting.VerificationSymbols)
+	c.Assert(names, HasLen, hosting.VerificationSymbols)
+}
+
+func (s *WahayVerificationSuite) Test_verificationCodeSymbols_areTheSymbolsOfTheCode(c *C) {
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("the certificate")})
+	code, _ := hosting.VerificationCode("abcdef.onion", cert)
+
+	symbols, err := verificationCodeSymbols("abcdef.onion", cert)
+	c.Assert(err, IsNil)
+	c.Assert(symbols, HasLen, len(code))
+	for i, n := range code {
+		c.Assert(symbols[i], Equals, verificationSymbols()[n])
+	}
+}
+
+func (s *WahayVerificationSuite) Test_verificationCodeSymbols_failsWithoutTheCertificate(c *C) {
+	_, err := verificationCodeSymbols("abcdef.onion", nil)
+	c.Assert(err, NotNil)
+}
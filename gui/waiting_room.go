@@ -0,0 +1,113 @@
+package gui
+
+import (
+	"github.com/coyim/gotk3adapter/gtki"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+// watchWaitingRoom asks the host about every participant
+// arriving to the waiting room of the meeting
+func (h *hostData) watchWaitingRoom() {
+	if !h.u.config.IsWaitingRoomEnabled() {
+		return
+	}
+
+	serv := h.service.Server()
+	if serv == nil {
+		return
+	}
+
+	events, stop := serv.Subscribe()
+	h.stopWaitingRoom = stop
+	h.admissionDialogs = make(map[int]gtki.Window)
+
+	go func() {
+		for ev := range events {
+			id := ev.ID
+			switch ev.Type {
+			case hosting.ParticipantWaiting:
+				h.u.doInUIThread(func() {
+					h.askToAdmit(serv, id)
+				})
+			case hosting.ParticipantStoppedWaiting:
+				h.u.doInUIThread(func() {
+					h.closeAdmissionDialog(id)
+				})
+			}
+		}
+
+		h.u.doInUIThread(h.closeAdmissionDialogs)
+	}()
+}
+
+func (h *hostData) askToAdmit(serv hosting.Server, id int) {
+	builder := h.u.getConfirmWindow()
+	dialog := builder.get("dialog").(gtki.Window)
+	lblTitle := builder.get("lblTitle").(gtki.Label)
+	lblText := builder.get("lblText").(gtki.Label)
+	btnReject := builder.get("btnCancel").(gtki.Button)
+	btnAdmit := builder.get("btnConfirm").(gtki.Button)
+
+	dialog.SetTitle(i18n().Sprintf("Waiting room"))
+	lblTitle.SetText(i18n().Sprintf("Somebody wants to join the meeting"))
+	lblText.SetText(i18n().Sprintf("Participants can't be identified before they join, " +
+		"so only admit them if you are expecting somebody."))
+	btnReject.SetLabel(i18n().Sprintf("Reject"))
+	btnAdmit.SetLabel(i18n().Sprintf("Admit"))
+
+	if h.currentWindow != nil {
+		dialog.SetTransientFor(h.currentWindow)
+	}
+
+	// Destroying the dialog emits its cancel signal too,
+	// so only the first answer counts
+	answered := false
+	answer := func(admit bool) {
+		if answered {
+			return
+		}
+		answered = true
+		h.closeAdmissionDialog(id)
+
+		var err error
+		if admit {
+			err = serv.Admit(id)
+		} else {
+			err = serv.Reject(id)
+		}
+
+		// The participant may have given up in the meantime
+		if err != nil {
+			log.Debugf("askToAdmit(): %s", err)
+		}
+	}
+
+	builder.ConnectSignals(map[string]interface{}{
+		"on_cancel": func() {
+			answer(false)
+		},
+		"on_confirm": func() {
+			answer(true)
+		},
+	})
+
+	h.admissionDialogs[id] = dialog
+
+	dialog.Present()
+	dialog.Show()
+}
+
+func (h *hostData) closeAdmissionDialog(id int) {
+	if dialog, ok := h.admissionDialogs[id]; ok {
+		delete(h.admissionDialogs, id)
+		dialog.Destroy()
+	}
+}
+
+func (h *hostData) closeAdmissionDialogs() {
+	for id := range h.admissionDialogs {
+		h.closeAdmissionDialog(id)
+	}
+}
@@ -0,0 +1,205 @@
+package headless
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+var (
+	// ErrUnknownCommand is returned for a subcommand Wahay doesn't have
+	ErrUnknownCommand = errors.New("unknown command")
+	// ErrNoRecordingPassword is returned when recording a meeting, or
+	// exporting a recording, without the password of the recording
+	ErrNoRecordingPassword = errors.New("the recording needs a password: give a file with it or set WAHAY_RECORDING_PASSWORD")
+)
+
+// command is a subcommand of Wahay, like "host" in "wahay host". Its
+// flags come after its name, and the flags of Wahay before it
+type command struct {
+	usage string
+	run   func(args []string) error
+}
+
+var commands = map[string]command{
+	"export": {
+		usage: "write the voice of every speaker of a recording to Ogg Opus files",
+		run:   runExportCommand,
+	},
+	"host": {
+		usage: "host a meeting until Wahay is stopped, writing it as JSON",
+		run:   runHostCommand,
+	},
+	"join": {
+		usage: "join the meeting in the invitation with a console Mumble client",
+		run:   runJoinCommand,
+	},
+	"schedule": {
+		usage: "add, list or remove meetings to host later, or host them with run",
+		run:   runScheduleCommand,
+	},
+	"status": {
+		usage: "check that Tor and the meeting hosted by Wahay work",
+		run:   runStatusCommand,
+	},
+}
+
+// IsCommand returns true if name is a subcommand of Wahay
+func IsCommand(name string) bool {
+	_, ok := commands[name]
+	return ok
+}
+
+// Usage writes the subcommands of Wahay
+func Usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "Commands:")
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-8s %s\n", name, commands[name].usage)
+	}
+}
+
+// RunCommand runs the subcommand in args, the first of them being its name
+func RunCommand(args []string) error {
+	if len(args) == 0 {
+		return ErrUnknownCommand
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownCommand, args[0])
+	}
+
+	err := cmd.run(args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+	return err
+}
+
+func runHostCommand(args []string) error {
+	fs := flag.NewFlagSet("host", flag.ContinueOnError)
+	passwordFile := fs.String("password-file", "", "the file with the password of the meeting")
+	record := fs.String("record", "", "record the meeting to this file, telling the participants")
+	recordingPasswordFile := fs.String("recording-password-file", "", "the file with the password the recording is encrypted with")
+	events := fs.String("events", "", "serve the events of the meeting as Server-Sent Events on this localhost address, like 127.0.0.1:8090")
+	certificate := certificateFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	password, err := passwordFrom(*passwordFile)
+	if err != nil {
+		return err
+	}
+
+	o := HostOptions{Password: password, JSON: true, Record: *record, Events: *events}
+	o.Certificate = certificate()
+	if o.Record != "" {
+		o.RecordingPassword, err = recordingPasswordFrom(*recordingPasswordFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	conf, k, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	return Host(conf, k, o)
+}
+
+// certificateFlags adds the flags of the certificate the Mumble server
+// serves with, and returns the function that gives it after parsing
+func certificateFlags(fs *flag.FlagSet) func() hosting.CertificateSource {
+	certificate := fs.String("certificate", "", "the PEM file with the certificate the Mumble server serves with")
+	key := fs.String("key", "", "the PEM file with the private key of the certificate")
+
+	return func() hosting.CertificateSource {
+		return hosting.CertificateSource{CertificateFile: *certificate, KeyFile: *key}
+	}
+}
+
+func runJoinCommand(args []string) error {
+	fs := flag.NewFlagSet("join", flag.ContinueOnError)
+	username := fs.String("username", "", "the name used in the meeting")
+	passwordFile := fs.String("password-file", "", "the file with the password of the meeting, instead of the one in the invitation")
+	client := fs.String("client", DefaultConsoleClient, "the console Mumble client")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	password, err := passwordFrom(*passwordFile)
+	if err != nil {
+		return err
+	}
+
+	conf, _, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	return Join(conf, Options{
+		MeetingURL:    fs.Arg(0),
+		Username:      *username,
+		Password:      password,
+		ConsoleClient: *client,
+	})
+}
+
+func runStatusCommand(args []string) error {
+	fs := flag.NewFlagSet("status", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	conf, _, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	return Status(conf, os.Stdout)
+}
+
+// passwordFrom reads the password of the meeting from the file, or takes
+// it from the environment without one. Neither of them shows up in the
+// list of processes, unlike a password given on the command line
+func passwordFrom(file string) (string, error) {
+	return secretFrom(file, "WAHAY_MEETING_PASSWORD")
+}
+
+// recordingPasswordFrom reads the password of a recording the same way,
+// from the file or from WAHAY_RECORDING_PASSWORD. A recording can't be
+// made without one
+func recordingPasswordFrom(file string) (string, error) {
+	password, err := secretFrom(file, "WAHAY_RECORDING_PASSWORD")
+	if err == nil && password == "" {
+		err = ErrNoRecordingPassword
+	}
+	return password, err
+}
+
+func secretFrom(file, env string) (string, error) {
+	if file == "" {
+		return os.Getenv(env), nil
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
@@ -0,0 +1,66 @@
+package headless
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/digitalautonomy/wahay/hosting"
+	"github.com/prashantv/gostub"
+	. "gopkg.in/check.v1"
+)
+
+func (s *HeadlessSuite) Test_RunCommand_failsWithAnUnknownCommand(c *C) {
+	err := RunCommand([]string{"publish"})
+
+	c.Assert(err, ErrorMatches, "unknown command: publish")
+}
+
+func (s *HeadlessSuite) Test_RunCommand_showsTheHelpOfTheCommand(c *C) {
+	c.Assert(RunCommand([]string{"status", "-h"}), IsNil)
+}
+
+func (s *HeadlessSuite) Test_passwordFrom_readsTheFileWithoutTheNewLine(c *C) {
+	file := filepath.Join(c.MkDir(), "password")
+	c.Assert(os.WriteFile(file, []byte("secret\n"), 0600), IsNil)
+
+	password, err := passwordFrom(file)
+
+	c.Assert(err, IsNil)
+	c.Assert(password, Equals, "secret")
+}
+
+func (s *HeadlessSuite) Test_passwordFrom_takesThePasswordFromTheEnvironmentWithoutAFile(c *C) {
+	defer gostub.New().SetEnv("WAHAY_MEETING_PASSWORD", "from the environment").Reset()
+
+	password, err := passwordFrom("")
+
+	c.Assert(err, IsNil)
+	c.Assert(password, Equals, "from the environment")
+}
+
+func (s *HeadlessSuite) Test_recordingPasswordFrom_failsWithoutAPassword(c *C) {
+	defer gostub.New().SetEnv("WAHAY_RECORDING_PASSWORD", "").Reset()
+
+	_, err := recordingPasswordFrom("")
+
+	c.Assert(err, Equals, ErrNoRecordingPassword)
+}
+
+func (s *HeadlessSuite) Test_Export_failsWithAFileThatIsNotARecording(c *C) {
+	file := filepath.Join(c.MkDir(), "meeting.wahayrec")
+	c.Assert(os.WriteFile(file, []byte("not a recording at all, really"), 0600), IsNil)
+
+	err := Export(file, "secret", c.MkDir(), io.Discard)
+
+	c.Assert(err, Equals, hosting.ErrNotARecording)
+}
+
+func (s *HeadlessSuite) Test_certificateFlags_givesTheCertificateOfTheServer(c *C) {
+	fs := flag.NewFlagSet("host", flag.ContinueOnError)
+	certificate := certificateFlags(fs)
+
+	c.Assert(fs.Parse([]string{"-certificate", "cert.pem", "-key", "key.pem"}), IsNil)
+	c.Assert(certificate(), DeepEquals, hosting.CertificateSource{CertificateFile: "cert.pem", KeyFile: "key.pem"})
+}
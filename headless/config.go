@@ -0,0 +1,79 @@
+package headless
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/digitalautonomy/wahay/config"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/term"
+)
+
+const maxPasswordAttempts = 3
+
+var (
+	// ErrWrongMasterPassword is returned when the configuration can't be
+	// decrypted with the master passwords the user typed
+	ErrWrongMasterPassword = errors.New("the configuration can't be decrypted with that password")
+	// ErrInvalidConfig is returned when the configuration file is corrupted
+	ErrInvalidConfig = errors.New("the configuration file is invalid: open Wahay to fix it")
+)
+
+var readPassword = func() (string, error) {
+	fmt.Fprint(os.Stderr, "Master password: ")
+	defer fmt.Fprintln(os.Stderr)
+
+	p, err := term.ReadPassword(int(os.Stdin.Fd()))
+	return string(p), err
+}
+
+// LoadConfig loads the configuration of Wahay, asking in the terminal
+// for the master password when the configuration is encrypted. The
+// returned key supplier saves the configuration with the same password
+func LoadConfig() (*config.ApplicationConfig, config.KeySupplier, error) {
+	conf := config.New()
+	conf.Init()
+
+	configFile, err := conf.DetectPersistence()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := config.CreateKeySupplier(func(p config.EncryptionParameters, _ bool) config.EncryptionResult {
+		password, _ := readPassword()
+		return config.GenerateKeysBasedOnPassword(password, p)
+	})
+
+	if !conf.IsPersistentConfiguration() {
+		return conf, k, nil
+	}
+
+	for attempt := 0; attempt < maxPasswordAttempts; attempt++ {
+		invalid, repeat, err := conf.LoadFromFile(configFile, k)
+		if invalid {
+			return nil, nil, ErrInvalidConfig
+		}
+
+		if !repeat {
+			return conf, k, err
+		}
+
+		k.Invalidate()
+		k.LastAttemptFailed()
+	}
+
+	return nil, nil, ErrWrongMasterPassword
+}
+
+// saveConfig stores what changed in the configuration, when the
+// user wants to keep it
+func saveConfig(conf *config.ApplicationConfig, k config.KeySupplier) {
+	if !conf.IsPersistentConfiguration() {
+		return
+	}
+
+	if err := conf.Save(k); err != nil {
+		log.Errorf("The configuration file couldn't be saved: %s", err)
+	}
+}
@@ -0,0 +1,303 @@
+package headless
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/hosting"
+	"github.com/digitalautonomy/wahay/tor"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrEventsAddressNotLocal is returned when the events of the meeting
+// would be served to the network. They name the participants, so a
+// dashboard elsewhere has to reach them through a proxy of its own
+var ErrEventsAddressNotLocal = errors.New("the events of the meeting can only be served on a localhost address")
+
+const (
+	// eventsPath is where the dashboards read the events from
+	eventsPath = "/events"
+	// eventsKeepAlive is how often a comment is sent while nothing
+	// happens, so proxies in between don't close the stream
+	eventsKeepAlive = 15 * time.Second
+	// qualityInterval is how often the onion service of the meeting
+	// is probed. A probe that takes longer than that is lost
+	qualityInterval = 10 * time.Second
+	// torHealthInterval is how often Tor is asked about the network
+	torHealthInterval = 30 * time.Second
+	// eventsBuffer is the number of events kept for a dashboard that
+	// is not reading them. Events arriving after that are dropped
+	eventsBuffer = 16
+)
+
+// sessionEvent is an event of the stream, with the name dashboards
+// listen to and what is sent as JSON in its data
+type sessionEvent struct {
+	name string
+	data interface{}
+}
+
+// participantJSON is a change in the participants of the meeting
+type participantJSON struct {
+	Type string `json:"type"`
+	// Participants is the number of guests in the meeting after the
+	// change. It's only given for the guests, not for the users
+	Participants int    `json:"participants"`
+	ID           int    `json:"id,omitempty"`
+	Session      uint32 `json:"session,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Muted        bool   `json:"muted,omitempty"`
+	PreviousName string `json:"previousName,omitempty"`
+}
+
+// qualityJSON is the result of a probe of the onion service of the meeting
+type qualityJSON struct {
+	LatencyMs int64 `json:"latencyMs"`
+	Lost      bool  `json:"lost"`
+}
+
+// torJSON says whether Tor can reach the network
+type torJSON struct {
+	Running   bool `json:"running"`
+	Reachable bool `json:"reachable"`
+}
+
+var participantEventTypes = map[hosting.ParticipantEventType]string{
+	hosting.ParticipantConnected:      "joined",
+	hosting.ParticipantDisconnected:   "left",
+	hosting.ParticipantWaiting:        "waiting",
+	hosting.ParticipantStoppedWaiting: "stoppedWaiting",
+	hosting.UserConnected:             "userJoined",
+	hosting.UserDisconnected:          "userLeft",
+	hosting.UserChanged:               "userChanged",
+	hosting.UserRenamed:               "userRenamed",
+	hosting.UserStartedTalking:        "userStartedTalking",
+	hosting.UserStoppedTalking:        "userStoppedTalking",
+}
+
+func participantEvent(ev hosting.ParticipantEvent) sessionEvent {
+	return sessionEvent{name: "participant", data: participantJSON{
+		Type:         participantEventTypes[ev.Type],
+		Participants: ev.Participants,
+		ID:           ev.ID,
+		Session:      ev.User.Session,
+		Name:         ev.User.Name,
+		Muted:        ev.User.Muted,
+		PreviousName: ev.PreviousName,
+	}}
+}
+
+func qualityEvent(latency time.Duration, err error) sessionEvent {
+	if err != nil {
+		return sessionEvent{name: "quality", data: qualityJSON{Lost: true}}
+	}
+	return sessionEvent{name: "quality", data: qualityJSON{LatencyMs: latency.Milliseconds()}}
+}
+
+// writeEvent writes the event in the format of Server-Sent Events
+func writeEvent(w io.Writer, ev sessionEvent) error {
+	data, err := json.Marshal(ev.data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.name, data)
+	return err
+}
+
+// sessionEvents sends the events of the meeting to every dashboard
+// reading them. The state of Tor is kept for the ones that come later,
+// since it's only sent when it changes
+type sessionEvents struct {
+	sync.Mutex
+	subscribers map[chan sessionEvent]bool
+	tor         *sessionEvent
+}
+
+func newSessionEvents() *sessionEvents {
+	return &sessionEvents{subscribers: make(map[chan sessionEvent]bool)}
+}
+
+func (e *sessionEvents) subscribe() (<-chan sessionEvent, func()) {
+	e.Lock()
+	defer e.Unlock()
+
+	ch := make(chan sessionEvent, eventsBuffer)
+	if e.tor != nil {
+		ch <- *e.tor
+	}
+	e.subscribers[ch] = true
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			e.Lock()
+			defer e.Unlock()
+			delete(e.subscribers, ch)
+			close(ch)
+		})
+	}
+}
+
+// publish never blocks, so a slow dashboard can't hold back the others
+func (e *sessionEvents) publish(ev sessionEvent) {
+	e.Lock()
+	defer e.Unlock()
+
+	for ch := range e.subscribers {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+// publishTor sends the state of Tor when it changed
+func (e *sessionEvents) publishTor(state torJSON) {
+	e.Lock()
+	changed := e.tor == nil || e.tor.data != state
+	if changed {
+		e.tor = &sessionEvent{name: "tor", data: state}
+	}
+	e.Unlock()
+
+	if changed {
+		e.publish(sessionEvent{name: "tor", data: state})
+	}
+}
+
+func (e *sessionEvents) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	events, stop := e.subscribe()
+	defer stop()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	t := time.NewTicker(eventsKeepAlive)
+	defer t.Stop()
+
+	for {
+		var err error
+		select {
+		case <-r.Context().Done():
+			return
+		case ev := <-events:
+			err = writeEvent(w, ev)
+		case <-t.C:
+			_, err = io.WriteString(w, ": keep-alive\n\n")
+		}
+
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// isLocalAddress returns true if the address only takes
+// connections from the computer Wahay runs on
+func isLocalAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// serveEvents streams the participants joining and leaving, the quality
+// of the connection to the onion service and the health of Tor as
+// Server-Sent Events on the address, until the context is done
+func serveEvents(ctx context.Context, address string, conf *config.ApplicationConfig, t tor.Instance, s hosting.Service) error {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	events := newSessionEvents()
+
+	mux := http.NewServeMux()
+	mux.Handle(eventsPath, events)
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	go func() {
+		if err := srv.Serve(l); err != nil && err != http.ErrServerClosed {
+			log.Errorf("The events of the meeting can't be served: %s", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+
+	if serv := s.Server(); serv != nil {
+		go watchParticipants(ctx, serv, events)
+	}
+	go every(ctx, qualityInterval, func() {
+		probeCtx, cancel := context.WithTimeout(ctx, qualityInterval)
+		defer cancel()
+		events.publish(qualityEvent(tor.ProbeLatency(probeCtx, t.SOCKSAddress(), s.ID())))
+	})
+	go every(ctx, torHealthInterval, func() {
+		ns, _, err := tor.QueryNetworkStatus(ctx, conf, t.ControlAddress())
+		events.publishTor(torJSON{Running: err == nil, Reachable: err == nil && !ns.Stale(time.Now())})
+	})
+
+	log.WithField("address", "http://"+l.Addr().String()+eventsPath).Info("Serving the events of the meeting")
+	return nil
+}
+
+func watchParticipants(ctx context.Context, serv hosting.Server, events *sessionEvents) {
+	participants, stop := serv.Subscribe()
+	defer stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-participants:
+			if !ok {
+				return
+			}
+			events.publish(participantEvent(ev))
+		}
+	}
+}
+
+// every calls f right away and then every interval, until the context is done
+func every(ctx context.Context, interval time.Duration, f func()) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		f()
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
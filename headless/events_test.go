@@ -0,0 +1,126 @@
+package headless
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/digitalautonomy/wahay/hosting"
+	. "gopkg.in/check.v1"
+)
+
+func (s *HeadlessSuite) Test_isLocalAddress_onlyAcceptsLocalhost(c *C) {
+	c.Assert(isLocalAddress("127.0.0.1:8090"), Equals, true)
+	c.Assert(isLocalAddress("[::1]:8090"), Equals, true)
+	c.Assert(isLocalAddress("localhost:8090"), Equals, true)
+	c.Assert(isLocalAddress("0.0.0.0:8090"), Equals, false)
+	c.Assert(isLocalAddress(":8090"), Equals, false)
+	c.Assert(isLocalAddress("192.168.1.2:8090"), Equals, false)
+	c.Assert(isLocalAddress("127.0.0.1"), Equals, false)
+}
+
+func (s *HeadlessSuite) Test_writeEvent_writesTheNameAndTheDataAsJSON(c *C) {
+	var out bytes.Buffer
+
+	err := writeEvent(&out, participantEvent(hosting.ParticipantEvent{
+		Type: hosting.UserConnected,
+		User: hosting.User{Session: 3, Name: "Ana"},
+	}))
+
+	c.Assert(err, IsNil)
+	c.Assert(out.String(), Equals, "event: participant\n"+
+		`data: {"type":"userJoined","participants":0,"session":3,"name":"Ana"}`+"\n\n")
+}
+
+func (s *HeadlessSuite) Test_qualityEvent_tellsTheLostProbes(c *C) {
+	c.Assert(qualityEvent(1500*time.Millisecond, nil).data, Equals, qualityJSON{LatencyMs: 1500})
+	c.Assert(qualityEvent(0, errors.New("timeout")).data, Equals, qualityJSON{Lost: true})
+}
+
+func (s *HeadlessSuite) Test_sessionEvents_onlySendsTheStateOfTorWhenItChanges(c *C) {
+	events := newSessionEvents()
+	ch, stop := events.subscribe()
+	defer stop()
+
+	events.publishTor(torJSON{Running: true, Reachable: true})
+	events.publishTor(torJSON{Running: true, Reachable: true})
+	events.publishTor(torJSON{Running: true})
+
+	c.Assert((<-ch).data, Equals, torJSON{Running: true, Reachable: true})
+	c.Assert((<-ch).data, Equals, torJSON{Running: true})
+	c.Assert(ch, HasLen, 0)
+
+	late, stopLate := events.subscribe()
+	defer stopLate()
+	c.Assert((<-late).data, Equals, torJSON{Running: true})
+}
+
+func (s *HeadlessSuite) Test_sessionEvents_streamsTheEventsToTheDashboards(c *C) {
+	events := newSessionEvents()
+	events.publishTor(torJSON{Running: true, Reachable: true})
+
+	srv := httptest.NewServer(events)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	c.Assert(err, IsNil)
+	defer resp.Body.Close()
+	c.Assert(resp.Header.Get("Content-Type"), Equals, "text/event-stream")
+
+	r := bufio.NewReader(resp.Body)
+	readEvent := func() string {
+		var lines []string
+		for {
+			line, err := r.ReadString('\n')
+			c.Assert(err, IsNil)
+			if line == "\n" {
+				return strings.Join(lines, "")
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	c.Assert(readEvent(), Equals, "event: tor\n"+`data: {"running":true,"reachable":true}`+"\n")
+
+	events.publish(participantEvent(hosting.ParticipantEvent{Type: hosting.ParticipantConnected, Participants: 1}))
+	c.Assert(readEvent(), Equals, "event: participant\n"+`data: {"type":"joined","participants":1}`+"\n")
+}
+
+type fakeEventsServer struct {
+	hosting.Server
+	events chan hosting.ParticipantEvent
+}
+
+func (s *fakeEventsServer) Subscribe() (<-chan hosting.ParticipantEvent, func()) {
+	return s.events, func() {}
+}
+
+func (s *HeadlessSuite) Test_watchParticipants_publishesTheEventsOfTheMeeting(c *C) {
+	serv := &fakeEventsServer{events: make(chan hosting.ParticipantEvent, 1)}
+	events := newSessionEvents()
+	ch, stop := events.subscribe()
+	defer stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan bool)
+	go func() {
+		watchParticipants(ctx, serv, events)
+		close(done)
+	}()
+
+	serv.events <- hosting.ParticipantEvent{Type: hosting.ParticipantDisconnected}
+	select {
+	case ev := <-ch:
+		c.Assert(ev.data.(participantJSON).Type, Equals, "left")
+	case <-time.After(5 * time.Second):
+		c.Fatal("the event of the meeting was not published")
+	}
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,192 @@
+// Package headless joins meetings from a terminal, without GTK and without
+// the graphical Mumble client, so Wahay can be used on a computer with no
+// display or over SSH. The audio goes through a console Mumble client
+// such as barnard, which is controlled from the same terminal
+package headless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/forwarder"
+	"github.com/digitalautonomy/wahay/hosting"
+	"github.com/digitalautonomy/wahay/tor"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultConsoleClient is the console Mumble client used when none is given
+const DefaultConsoleClient = "barnard"
+
+const forwarderStartTimeout = 10 * time.Second
+
+var (
+	// ErrNoMeeting is returned when there's no meeting to join
+	ErrNoMeeting = errors.New("there is no meeting to join: give the invitation link after the flags")
+	// ErrNoConsoleClient is returned when the console Mumble client isn't installed
+	ErrNoConsoleClient = errors.New("the console Mumble client can't be found")
+)
+
+var (
+	lookPath = exec.LookPath
+	dialTCP  = net.DialTimeout
+)
+
+// Options are what the user gives on the command line to join a meeting
+type Options struct {
+	MeetingURL string
+	Username   string
+	// Password overrides the one in the invitation. It's given to the
+	// console client on its command line, so it's visible to the other
+	// users of the computer in the list of processes
+	Password      string
+	ConsoleClient string
+}
+
+// Join connects to the meeting over Tor and runs the console client
+// attached to the terminal until it finishes or the user interrupts it
+func Join(conf *config.ApplicationConfig, o Options) error {
+	data, err := o.meetingData()
+	if err != nil {
+		return err
+	}
+
+	if err = data.VerifyInvitation(time.Now()); err != nil {
+		return err
+	}
+
+	client, err := o.consoleClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Info("Connecting to Tor")
+	t, err := tor.NewInstanceContext(ctx, conf, nil)
+	if err != nil {
+		return err
+	}
+	defer t.Destroy()
+
+	if data.ClientAuthKey != "" {
+		if err = t.AddClientAuthorization(data.MeetingID, data.ClientAuthKey); err != nil {
+			return err
+		}
+	}
+
+	f := forwarder.NewForwarder(*data)
+	f.SocksAddr = t.SOCKSAddress()
+	f.KeepAlive = conf.GetKeepAlive().TCPPeriod
+	go f.StartForwarder()
+	defer f.StopForwarder()
+
+	addr := net.JoinHostPort(f.LocalAddr, strconv.Itoa(f.ListeningPort))
+	if err = waitForForwarder(ctx, addr, forwarderStartTimeout); err != nil {
+		return err
+	}
+
+	log.Infof("Joining the meeting as %s", data.Username)
+
+	/* #nosec G204 */
+	cmd := exec.CommandContext(ctx, client, clientArgs(addr, *data)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err = cmd.Run()
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	return err
+}
+
+// meetingData returns the meeting in the invitation, with the
+// username and password given by the user instead of those in it
+func (o Options) meetingData() (*hosting.MeetingData, error) {
+	if o.MeetingURL == "" {
+		return nil, ErrNoMeeting
+	}
+
+	data, err := hosting.ParseURL(o.MeetingURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Username != "" {
+		data.Username = o.Username
+	}
+	if data.Username == "" {
+		data.Username = guestName()
+	}
+
+	if o.Password != "" {
+		data.Password = o.Password
+	}
+
+	return data, nil
+}
+
+func (o Options) consoleClient() (string, error) {
+	name := o.ConsoleClient
+	if name == "" {
+		name = DefaultConsoleClient
+	}
+
+	path, err := lookPath(name)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrNoConsoleClient, name)
+	}
+
+	return path, nil
+}
+
+// clientArgs returns the arguments for the console client to join the
+// meeting through the forwarder. The certificate of the server can't be
+// checked, since it's self-signed, but the onion address already
+// authenticates the server
+func clientArgs(addr string, data hosting.MeetingData) []string {
+	args := []string{"-server", addr, "-username", data.Username, "-insecure"}
+	if data.Password != "" {
+		args = append(args, "-password", data.Password)
+	}
+
+	return args
+}
+
+// waitForForwarder waits until the forwarder accepts connections at addr
+func waitForForwarder(ctx context.Context, addr string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		conn, err := dialTCP("tcp", addr, time.Second)
+		if err == nil {
+			_ = conn.Close()
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("the connection to the meeting couldn't be started: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func guestName() string {
+	return fmt.Sprintf("Guest %04d", rand.Intn(10000)) //nolint:gosec // G404: the name doesn't need to be unpredictable
+}
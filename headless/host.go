@@ -0,0 +1,348 @@
+package headless
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/hosting"
+	"github.com/digitalautonomy/wahay/tor"
+	log "github.com/sirupsen/logrus"
+)
+
+// HostOptions are what the user gives on the command line to host a meeting
+type HostOptions struct {
+	// Password protects the meeting. It's read from the environment,
+	// so it doesn't show up in the list of processes
+	Password string
+	// Output is where the invitations are written, the standard output
+	// when it's nil. The logs go to the standard error
+	Output io.Writer
+	// JSON writes the meeting as a JSON object instead of
+	// one invitation per line, for scripts
+	JSON bool
+	// Record is the file the meeting is recorded to from the start,
+	// encrypted with RecordingPassword. Nothing is recorded without it
+	Record            string
+	RecordingPassword string
+	// Events is the localhost address where the events of the meeting
+	// are served as Server-Sent Events, for dashboards. They are not
+	// served without it
+	Events string
+	// Certificate is the one the Mumble server of the meeting serves
+	// with, instead of the one Wahay generated, when it's given
+	Certificate hosting.CertificateSource
+}
+
+func (o HostOptions) output() io.Writer {
+	if o.Output == nil {
+		return os.Stdout
+	}
+	return o.Output
+}
+
+// Host publishes a meeting with the hosting settings of Wahay and keeps it
+// running until Wahay is interrupted or terminated, or the meeting closes
+// itself after being idle. The invitations are written again on SIGHUP
+func Host(conf *config.ApplicationConfig, k config.KeySupplier, o HostOptions) error {
+	if o.Events != "" && !isLocalAddress(o.Events) {
+		return ErrEventsAddressNotLocal
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Info("Connecting to Tor")
+	t, err := tor.NewInstanceContext(ctx, conf, nil)
+	if err != nil {
+		return err
+	}
+	defer t.Destroy()
+
+	servers, err := createServerCollection(conf, k)
+	if err != nil {
+		return err
+	}
+	defer servers.Cleanup()
+
+	idle := make(chan error, 1)
+	s, err := publishMeeting(ctx, conf, k, t, servers, o, func(err error) {
+		idle <- err
+	})
+	if err != nil {
+		return err
+	}
+
+	if o.Record != "" {
+		if err := s.Server().StartRecording(o.Record, o.RecordingPassword); err != nil {
+			_ = s.Close()
+			return err
+		}
+	}
+
+	// The events stop being served when the context is done, which
+	// happens when Host returns too
+	if o.Events != "" {
+		if err := serveEvents(ctx, o.Events, conf, t, s); err != nil {
+			_ = s.Close()
+			return err
+		}
+	}
+
+	o.writeMeeting(s)
+
+	writeHostState(hostState{
+		PID:         os.Getpid(),
+		MeetingID:   s.ID(),
+		ServicePort: s.ServicePort(),
+		ServerPort:  s.Port(),
+		Started:     time.Now(),
+		TorControl:  t.ControlAddress(),
+	})
+	defer removeHostState()
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-hup:
+			o.writeMeeting(s)
+		case err := <-idle:
+			log.Info("The meeting was closed after being idle")
+			return err
+		case <-ctx.Done():
+			log.Info("Finishing the meeting")
+			return s.Close()
+		}
+	}
+}
+
+// publishMeeting creates the meeting with the hosting settings of Wahay,
+// the password and the certificate of the options, and its onion
+// service. onIdle is called if it closes after being idle
+func publishMeeting(ctx context.Context, conf *config.ApplicationConfig, k config.KeySupplier,
+	t tor.Instance, servers hosting.Servers, o HostOptions, onIdle func(error)) (hosting.Service, error) {
+	key, err := onionKeyForMeeting(ctx, conf, k)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := serviceOptions(conf, k, onIdle)
+	if key != nil {
+		opts = append(opts, hosting.WithOnionKey(key))
+	}
+	opts = append(opts, hosting.WithServerCertificate(o.Certificate))
+
+	log.Info("Publishing the meeting")
+	s, err := servers.NewService(conf.GetPortMumble(), t, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.NewConferenceRoom(o.Password, hosting.SuperUserData{}); err != nil {
+		_ = s.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (o HostOptions) writeMeeting(s hosting.Service) {
+	if !o.JSON {
+		writeInvitations(o.output(), s)
+		return
+	}
+
+	if err := writeMeetingJSON(o.output(), s, o.Password); err != nil {
+		log.Errorf("The meeting couldn't be written: %s", err)
+	}
+}
+
+// meetingJSON is the MeetingData of the guests for scripts, in a
+// format kept apart from MeetingData so it doesn't change along with it
+type meetingJSON struct {
+	MeetingID     string   `json:"meetingID"`
+	Port          int      `json:"port"`
+	Password      string   `json:"password,omitempty"`
+	ClientAuthKey string   `json:"clientAuthKey,omitempty"`
+	Invitations   []string `json:"invitations"`
+	WebGateway    string   `json:"webGateway,omitempty"`
+}
+
+// writeMeetingJSON writes the meeting data of the guests as one line of JSON
+func writeMeetingJSON(w io.Writer, s hosting.Service, password string) error {
+	return json.NewEncoder(w).Encode(meetingJSON{
+		MeetingID:     s.ID(),
+		Port:          s.ServicePort(),
+		Password:      password,
+		ClientAuthKey: s.ClientAuthKey(),
+		Invitations:   s.Invitations(),
+		WebGateway:    s.WebGatewayURL(),
+	})
+}
+
+// writeInvitations writes one invitation per line, so scripts can
+// read them, with the address for Tor Browser after them if there is one
+func writeInvitations(w io.Writer, s hosting.Service) {
+	for _, inv := range s.Invitations() {
+		fmt.Fprintln(w, inv)
+	}
+
+	if web := s.WebGatewayURL(); web != "" {
+		fmt.Fprintln(w, web)
+	}
+}
+
+// createServerCollection reuses the stored certificate of the server
+// when the host wants to keep it, and stores the new one otherwise
+func createServerCollection(conf *config.ApplicationConfig, k config.KeySupplier) (hosting.Servers, error) {
+	persistent := conf.IsPersistentCertificateEnabled()
+
+	opts := []hosting.CollectionOption{
+		hosting.WithCertificateAlgorithm(hosting.ParseCertificateAlgorithm(conf.GetCertificateAlgorithm())),
+	}
+	if dir := conf.GetHostingDataDirectory(); dir != "" {
+		opts = append(opts, hosting.WithDataDirectory(dir))
+	}
+	if conf.IsMemoryStorageEnabled() {
+		opts = append(opts, hosting.WithMemoryStorage())
+	}
+
+	reused := false
+	cert, key := conf.GetServerCertificate()
+	if persistent && cert != "" {
+		if _, err := tls.X509KeyPair([]byte(cert), []byte(key)); err != nil {
+			log.Errorf("The stored certificate of the server can't be used: %s", err)
+		} else {
+			opts = append(opts, hosting.WithCertificate([]byte(cert), []byte(key)))
+			reused = true
+		}
+	}
+
+	servers, err := hosting.CreateServerCollection(opts...)
+	if err != nil || !persistent || reused {
+		return servers, err
+	}
+
+	newCert, newKey, err := servers.Certificate()
+	if err != nil {
+		log.Errorf("The certificate of the server can't be stored: %s", err)
+		return servers, nil
+	}
+
+	conf.SetServerCertificate(string(newCert), string(newKey))
+	saveConfig(conf, k)
+
+	return servers, nil
+}
+
+// serviceOptions are the hosting settings of Wahay. There is nobody to
+// answer the questions of the waiting room or of a co-host, so those
+// are left to the co-host, who moderates the meeting from Mumble. The
+// meeting only closes itself after being idle when onIdle is given
+func serviceOptions(conf *config.ApplicationConfig, k config.KeySupplier, onIdle func(error)) []hosting.ServiceOption {
+	opts := []hosting.ServiceOption{
+		hosting.WithKeepAlive(conf.GetKeepAlive().TCPPeriod),
+		hosting.WithBanList(configBanList{conf, k}),
+	}
+	if conf.GetClientAuthorization() {
+		opts = append(opts, hosting.WithClientAuthorization(conf.GetClientAuthInvitees()))
+	}
+	if coHost := conf.GetCoHostCertificate(); coHost != "" {
+		opts = append(opts, hosting.WithCoHost(coHost))
+	}
+	if maxUsers := conf.GetMaxUsers(); maxUsers > 0 {
+		opts = append(opts, hosting.WithMaxUsers(maxUsers))
+	}
+	if conf.IsWebGatewayEnabled() {
+		opts = append(opts, hosting.WithWebGateway(conf.GetMumbleWebPath()))
+	}
+	if address := conf.GetListenAddress(); address != "" {
+		opts = append(opts, hosting.WithListenAddress(address))
+	}
+	if socket := conf.GetListenSocket(); socket != "" {
+		opts = append(opts, hosting.WithUnixSocket(socket))
+	}
+	if idle := conf.GetIdleShutdown(); idle > 0 && onIdle != nil {
+		opts = append(opts, hosting.WithIdleShutdown(idle, onIdle))
+	}
+	if lifetime := conf.GetInvitationLifetime(); lifetime > 0 {
+		opts = append(opts, hosting.WithSignedInvitations(lifetime))
+	}
+	if conf.AreNamedInvitationsEnabled() {
+		if names := conf.GetInviteeNames(); len(names) > 0 {
+			opts = append(opts, hosting.WithInviteeNames(names...))
+		}
+	}
+
+	return opts
+}
+
+// onionKeyForMeeting returns the key the meeting is published with, or
+// nil if Tor can create a new one. A standing meeting usually keeps its
+// address, so the stored key is reused when the host wants that
+func onionKeyForMeeting(ctx context.Context, conf *config.ApplicationConfig, k config.KeySupplier) (*tor.OnionKey, error) {
+	prefix := conf.GetVanityOnionPrefix()
+	persistent := conf.IsPersistentOnionEnabled()
+
+	if persistent {
+		serviceID, privateKey := conf.GetPersistentOnionKey()
+		key := &tor.OnionKey{ServiceID: serviceID, PrivateKey: privateKey}
+		if privateKey != "" && key.HasVanityPrefix(prefix) {
+			return key, nil
+		}
+	}
+
+	var key *tor.OnionKey
+	var err error
+	switch {
+	case prefix != "":
+		log.Infof("Looking for an onion address starting with %q", prefix)
+		key, err = tor.MineOnionKey(ctx, prefix, 0)
+	case persistent:
+		key, err = tor.GenerateOnionKey()
+	default:
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if persistent {
+		conf.SetPersistentOnionKey(key.ServiceID, key.PrivateKey)
+		saveConfig(conf, k)
+	}
+
+	return key, nil
+}
+
+// configBanList keeps the participants banned by the co-host
+// in the configuration file, like the graphical interface does
+type configBanList struct {
+	conf *config.ApplicationConfig
+	k    config.KeySupplier
+}
+
+func (l configBanList) BannedCertificates() []string {
+	return l.conf.GetBannedCertificates()
+}
+
+func (l configBanList) BanCertificate(certHash string) {
+	l.conf.BanCertificate(certHash)
+	saveConfig(l.conf, l.k)
+}
+
+func (l configBanList) UnbanCertificate(certHash string) {
+	l.conf.UnbanCertificate(certHash)
+	saveConfig(l.conf, l.k)
+}
@@ -0,0 +1,91 @@
+package headless
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/hosting"
+	. "gopkg.in/check.v1"
+)
+
+type fakeService struct {
+	hosting.Service
+	invitations []string
+	web         string
+}
+
+func (s *fakeService) ID() string {
+	return "a.onion"
+}
+
+func (s *fakeService) ServicePort() int {
+	return hosting.DefaultPort
+}
+
+func (s *fakeService) ClientAuthKey() string {
+	return ""
+}
+
+func (s *fakeService) Invitations() []string {
+	return s.invitations
+}
+
+func (s *fakeService) WebGatewayURL() string {
+	return s.web
+}
+
+func (s *HeadlessSuite) Test_writeInvitations_writesOneInvitationPerLine(c *C) {
+	var out bytes.Buffer
+
+	writeInvitations(&out, &fakeService{invitations: []string{"mumble://a.onion", "mumble://b.onion"}})
+
+	c.Assert(out.String(), Equals, "mumble://a.onion\nmumble://b.onion\n")
+}
+
+func (s *HeadlessSuite) Test_writeInvitations_writesTheAddressForTorBrowserLast(c *C) {
+	var out bytes.Buffer
+
+	writeInvitations(&out, &fakeService{invitations: []string{"mumble://a.onion"}, web: "https://a.onion/"})
+
+	c.Assert(out.String(), Equals, "mumble://a.onion\nhttps://a.onion/\n")
+}
+
+func (s *HeadlessSuite) Test_onionKeyForMeeting_letsTorCreateTheKeyByDefault(c *C) {
+	key, err := onionKeyForMeeting(context.Background(), config.New(), nil)
+
+	c.Assert(err, IsNil)
+	c.Assert(key, IsNil)
+}
+
+func (s *HeadlessSuite) Test_onionKeyForMeeting_reusesTheStoredKey(c *C) {
+	conf := config.New()
+	conf.EnablePersistentOnion(true)
+	conf.SetPersistentOnionKey("abcdef", "ED25519-V3:secret")
+
+	key, err := onionKeyForMeeting(context.Background(), conf, nil)
+
+	c.Assert(err, IsNil)
+	c.Assert(key.ServiceID, Equals, "abcdef")
+	c.Assert(key.PrivateKey, Equals, "ED25519-V3:secret")
+}
+
+func (s *HeadlessSuite) Test_configBanList_keepsTheBansInTheConfiguration(c *C) {
+	conf := config.New()
+	l := configBanList{conf: conf}
+
+	l.BanCertificate("abc")
+	c.Assert(l.BannedCertificates(), DeepEquals, []string{"abc"})
+
+	l.UnbanCertificate("abc")
+	c.Assert(l.BannedCertificates(), HasLen, 0)
+}
+
+func (s *HeadlessSuite) Test_writeMeetingJSON_writesTheMeetingForTheGuests(c *C) {
+	var out bytes.Buffer
+
+	err := writeMeetingJSON(&out, &fakeService{invitations: []string{"mumble://a.onion"}}, "secret")
+
+	c.Assert(err, IsNil)
+	c.Assert(out.String(), Equals, `{"meetingID":"a.onion","port":64738,"password":"secret","invitations":["mumble://a.onion"]}`+"\n")
+}
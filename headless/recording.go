@@ -0,0 +1,53 @@
+package headless
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/digitalautonomy/wahay/hosting"
+)
+
+func runExportCommand(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	passwordFile := fs.String("password-file", "", "the file with the password of the recording")
+	dir := fs.String("dir", ".", "the directory the audio files are written to")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("export takes the recording to export: wahay export [flags] FILE")
+	}
+
+	password, err := recordingPasswordFrom(*passwordFile)
+	if err != nil {
+		return err
+	}
+
+	return Export(fs.Arg(0), password, *dir, os.Stdout)
+}
+
+// Export writes the voice of every speaker of the recording to an Ogg
+// Opus file of its own in the directory, and the files written to w,
+// one per line. What was recorded before a recording was cut off is
+// still written, and the error says it was cut off
+func Export(recording, password, dir string, w io.Writer) error {
+	f, err := os.Open(recording)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r, err := hosting.OpenRecording(f, password)
+	if err != nil {
+		return err
+	}
+
+	files, err := hosting.ExportRecording(r, dir)
+	for _, file := range files {
+		fmt.Fprintln(w, file)
+	}
+	return err
+}
@@ -0,0 +1,244 @@
+package headless
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/hosting"
+	"github.com/digitalautonomy/wahay/tor"
+	log "github.com/sirupsen/logrus"
+)
+
+// scheduleLead is how long before its start a scheduled meeting is
+// published, since Tor needs a while to make a new onion service reachable
+const scheduleLead = 5 * time.Minute
+
+// scheduleTimeLayout is how the start of a meeting is written,
+// in local time. RFC 3339 is also accepted
+const scheduleTimeLayout = "2006-01-02 15:04"
+
+var (
+	// ErrUnknownScheduleAction is returned for an action of the
+	// schedule command other than add, list, remove and run
+	ErrUnknownScheduleAction = errors.New("unknown schedule action: use add, list, remove or run")
+	// ErrNothingScheduled is returned when there are no
+	// meetings scheduled to host
+	ErrNothingScheduled = errors.New("there are no meetings scheduled")
+
+	errInvalidStart         = errors.New("the start of the meeting must be like 2006-01-02 15:04")
+	errInvalidDuration      = errors.New("the meeting must last at least a minute")
+	errMeetingAlreadyOver   = errors.New("the meeting would be over already")
+	errUnknownScheduleIndex = errors.New("there is no scheduled meeting with that number")
+)
+
+func runScheduleCommand(args []string) error {
+	if len(args) == 0 {
+		return ErrUnknownScheduleAction
+	}
+
+	switch args[0] {
+	case "add":
+		return runScheduleAdd(args[1:])
+	case "list":
+		return runScheduleList(args[1:])
+	case "remove":
+		return runScheduleRemove(args[1:])
+	case "run":
+		return runScheduleRun(args[1:])
+	}
+
+	return ErrUnknownScheduleAction
+}
+
+func runScheduleAdd(args []string) error {
+	fs := flag.NewFlagSet("schedule add", flag.ContinueOnError)
+	start := fs.String("start", "", "when the meeting starts, like "+scheduleTimeLayout)
+	duration := fs.Duration("duration", time.Hour, "how long the meeting lasts")
+	title := fs.String("title", "", "what the meeting is about")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	m, err := scheduledMeeting(*start, *duration, *title, time.Now())
+	if err != nil {
+		return err
+	}
+
+	conf, k, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	conf.ScheduleMeeting(m)
+	saveConfig(conf, k)
+
+	return nil
+}
+
+// scheduledMeeting returns the meeting to schedule
+// for what the user gave on the command line
+func scheduledMeeting(start string, duration time.Duration, title string, now time.Time) (config.ScheduledMeeting, error) {
+	t, err := time.ParseInLocation(scheduleTimeLayout, start, time.Local)
+	if err != nil {
+		t, err = time.Parse(time.RFC3339, start)
+	}
+	if err != nil {
+		return config.ScheduledMeeting{}, errInvalidStart
+	}
+
+	if duration < time.Minute {
+		return config.ScheduledMeeting{}, errInvalidDuration
+	}
+
+	m := config.ScheduledMeeting{Start: t, Minutes: int(duration / time.Minute), Title: title}
+	if !m.End().After(now) {
+		return config.ScheduledMeeting{}, errMeetingAlreadyOver
+	}
+
+	return m, nil
+}
+
+func runScheduleList(args []string) error {
+	fs := flag.NewFlagSet("schedule list", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	conf, _, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	writeSchedule(os.Stdout, conf.GetScheduledMeetings())
+	return nil
+}
+
+// writeSchedule writes one meeting per line, with the
+// number the remove action takes first
+func writeSchedule(w io.Writer, meetings []config.ScheduledMeeting) {
+	for i, m := range meetings {
+		fmt.Fprintf(w, "%d\t%s\t%dm\t%s\n", i+1, m.Start.Local().Format(scheduleTimeLayout), m.Minutes, m.Title)
+	}
+}
+
+func runScheduleRemove(args []string) error {
+	fs := flag.NewFlagSet("schedule remove", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	conf, k, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	n, err := strconv.Atoi(fs.Arg(0))
+	if err != nil || n < 1 || n > len(conf.GetScheduledMeetings()) {
+		return errUnknownScheduleIndex
+	}
+
+	conf.UnscheduleMeeting(n - 1)
+	saveConfig(conf, k)
+
+	return nil
+}
+
+func runScheduleRun(args []string) error {
+	fs := flag.NewFlagSet("schedule run", flag.ContinueOnError)
+	passwordFile := fs.String("password-file", "", "the file with the password of the meetings")
+	certificate := certificateFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	password, err := passwordFrom(*passwordFile)
+	if err != nil {
+		return err
+	}
+
+	conf, k, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	return HostScheduled(conf, k, HostOptions{Password: password, JSON: true, Certificate: certificate()})
+}
+
+// HostScheduled hosts the meetings scheduled in the configuration, each
+// one from shortly before its start until it's over and there has been
+// nobody in it for the idle timeout. The meetings are written when they
+// are published and forgotten when they end. It returns when all the
+// meetings ended or Wahay is interrupted or terminated
+func HostScheduled(conf *config.ApplicationConfig, k config.KeySupplier, o HostOptions) error {
+	conf.RemoveFinishedMeetings(time.Now())
+	saveConfig(conf, k)
+
+	meetings := conf.GetScheduledMeetings()
+	if len(meetings) == 0 {
+		return ErrNothingScheduled
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Info("Connecting to Tor")
+	t, err := tor.NewInstanceContext(ctx, conf, nil)
+	if err != nil {
+		return err
+	}
+	defer t.Destroy()
+
+	servers, err := createServerCollection(conf, k)
+	if err != nil {
+		return err
+	}
+	defer servers.Cleanup()
+
+	ended := make(chan error, len(meetings))
+	for _, m := range meetings {
+		m := m
+		sch := hosting.Schedule{
+			Start:       m.Start,
+			Duration:    m.End().Sub(m.Start),
+			Lead:        scheduleLead,
+			IdleTimeout: conf.GetIdleShutdown(),
+		}
+
+		log.WithField("start", m.Start).Info("Waiting for a scheduled meeting")
+		scheduled := hosting.ScheduleMeeting(sch, func() (hosting.Service, error) {
+			s, err := publishMeeting(ctx, conf, k, t, servers, o, nil)
+			if err == nil {
+				o.writeMeeting(s)
+			}
+			return s, err
+		}, func(_ hosting.Service, err error) {
+			ended <- err
+		})
+		defer scheduled.Cancel()
+	}
+
+	for remaining := len(meetings); remaining > 0; remaining-- {
+		select {
+		case err := <-ended:
+			if err != nil {
+				log.Errorf("A scheduled meeting failed: %s", err)
+			}
+			conf.RemoveFinishedMeetings(time.Now())
+			saveConfig(conf, k)
+		case <-ctx.Done():
+			log.Info("Finishing the scheduled meetings")
+			return nil
+		}
+	}
+
+	return nil
+}
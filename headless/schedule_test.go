@@ -0,0 +1,56 @@
+package headless
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/digitalautonomy/wahay/config"
+	. "gopkg.in/check.v1"
+)
+
+func (s *HeadlessSuite) Test_scheduledMeeting_readsTheStartInLocalTime(c *C) {
+	now := time.Date(2030, 5, 1, 9, 0, 0, 0, time.Local)
+
+	m, err := scheduledMeeting("2030-05-01 10:30", 90*time.Minute, "planning", now)
+
+	c.Assert(err, IsNil)
+	c.Assert(m.Start.Equal(time.Date(2030, 5, 1, 10, 30, 0, 0, time.Local)), Equals, true)
+	c.Assert(m.Minutes, Equals, 90)
+	c.Assert(m.Title, Equals, "planning")
+}
+
+func (s *HeadlessSuite) Test_scheduledMeeting_acceptsRFC3339(c *C) {
+	now := time.Date(2030, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	m, err := scheduledMeeting("2030-05-01T10:30:00Z", time.Hour, "", now)
+
+	c.Assert(err, IsNil)
+	c.Assert(m.Start.Equal(time.Date(2030, 5, 1, 10, 30, 0, 0, time.UTC)), Equals, true)
+}
+
+func (s *HeadlessSuite) Test_scheduledMeeting_rejectsInvalidMeetings(c *C) {
+	now := time.Date(2030, 5, 1, 9, 0, 0, 0, time.Local)
+
+	_, err := scheduledMeeting("tomorrow", time.Hour, "", now)
+	c.Assert(err, Equals, errInvalidStart)
+
+	_, err = scheduledMeeting("2030-05-01 10:30", time.Second, "", now)
+	c.Assert(err, Equals, errInvalidDuration)
+
+	_, err = scheduledMeeting("2030-05-01 07:00", time.Hour, "", now)
+	c.Assert(err, Equals, errMeetingAlreadyOver)
+}
+
+func (s *HeadlessSuite) Test_writeSchedule_numbersTheMeetings(c *C) {
+	var out bytes.Buffer
+	start := time.Date(2030, 5, 1, 10, 30, 0, 0, time.Local)
+
+	writeSchedule(&out, []config.ScheduledMeeting{{Start: start, Minutes: 45, Title: "planning"}})
+
+	c.Assert(out.String(), Equals, "1\t2030-05-01 10:30\t45m\tplanning\n")
+}
+
+func (s *HeadlessSuite) Test_RunCommand_failsWithAnUnknownScheduleAction(c *C) {
+	c.Assert(RunCommand([]string{"schedule", "publish"}), Equals, ErrUnknownScheduleAction)
+	c.Assert(RunCommand([]string{"schedule"}), Equals, ErrUnknownScheduleAction)
+}
@@ -0,0 +1,133 @@
+package headless
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/tor"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrUnhealthy is returned by Status when Tor or the meeting don't work,
+// so scripts can tell from the exit code of Wahay
+var ErrUnhealthy = errors.New("something isn't working")
+
+const hostStateFileName = "hosting.json"
+
+// hostState is what a running Wahay writes about the meeting it hosts,
+// so "wahay status" can find it from another process
+type hostState struct {
+	PID         int       `json:"pid"`
+	MeetingID   string    `json:"meetingID"`
+	ServicePort int       `json:"servicePort"`
+	ServerPort  int       `json:"serverPort"`
+	Started     time.Time `json:"started"`
+	// TorControl is the control address of the Tor the meeting uses
+	TorControl string `json:"torControl,omitempty"`
+}
+
+var hostStateFile = func() string {
+	return filepath.Join(config.Dir(), hostStateFileName)
+}
+
+func writeHostState(st hostState) {
+	content, err := json.Marshal(st)
+	if err == nil {
+		err = config.SafeWrite(hostStateFile(), content, 0600)
+	}
+	if err != nil {
+		log.Debugf("The state of the meeting couldn't be written: %s", err)
+	}
+}
+
+func removeHostState() {
+	_ = os.Remove(hostStateFile())
+}
+
+func readHostState() (*hostState, error) {
+	content, err := os.ReadFile(hostStateFile())
+	if err != nil {
+		return nil, err
+	}
+
+	var st hostState
+	if err := json.Unmarshal(content, &st); err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+// serverAnswers returns true when the Mumble server of the
+// meeting accepts connections on this computer
+func (st hostState) serverAnswers() bool {
+	conn, err := dialTCP("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(st.ServerPort)), time.Second)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+	return true
+}
+
+// Status writes whether Tor can be used and whether the meeting
+// hosted by Wahay, if there is one, accepts connections
+func Status(conf *config.ApplicationConfig, w io.Writer) error {
+	torOK := torStatus(conf, w)
+	meetingOK := meetingStatus(w)
+
+	if !torOK || !meetingOK {
+		return ErrUnhealthy
+	}
+	return nil
+}
+
+var queryNetworkStatus = tor.QueryNetworkStatus
+
+// torStatus only asks the Tor already running, the one of the hosted
+// meeting first. Checking must not start or reconfigure a Tor
+func torStatus(conf *config.ApplicationConfig, w io.Writer) bool {
+	control := ""
+	if st, err := readHostState(); err == nil {
+		control = st.TorControl
+	}
+
+	ns, where, err := queryNetworkStatus(context.Background(), conf, control)
+	if err != nil {
+		fmt.Fprintln(w, "Tor: not running")
+		return false
+	}
+
+	fmt.Fprintf(w, "Tor: running, with its control port on %s\n", where)
+
+	if ns.Stale(time.Now()) {
+		fmt.Fprintln(w, "Tor network: not reachable")
+		return false
+	}
+
+	fmt.Fprintln(w, "Tor network: reachable")
+	return true
+}
+
+func meetingStatus(w io.Writer) bool {
+	st, err := readHostState()
+	if err != nil {
+		fmt.Fprintln(w, "Meeting: none is hosted")
+		return true
+	}
+
+	if !st.serverAnswers() {
+		fmt.Fprintf(w, "Meeting: %s isn't answering (process %d)\n", st.MeetingID, st.PID)
+		return false
+	}
+
+	fmt.Fprintf(w, "Meeting: %s:%d, hosted since %s\n", st.MeetingID, st.ServicePort, st.Started.Format(time.RFC3339))
+	return true
+}
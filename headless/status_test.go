@@ -0,0 +1,85 @@
+package headless
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/tor"
+	"github.com/prashantv/gostub"
+	. "gopkg.in/check.v1"
+)
+
+func (s *HeadlessSuite) Test_meetingStatus_isFineWithoutAMeeting(c *C) {
+	defer gostub.New().Stub(&hostStateFile, func() string {
+		return filepath.Join(c.MkDir(), hostStateFileName)
+	}).Reset()
+
+	var out bytes.Buffer
+	c.Assert(meetingStatus(&out), Equals, true)
+	c.Assert(out.String(), Equals, "Meeting: none is hosted\n")
+}
+
+func (s *HeadlessSuite) Test_meetingStatus_checksTheServerOfTheHostedMeeting(c *C) {
+	file := filepath.Join(c.MkDir(), hostStateFileName)
+	defer gostub.New().Stub(&hostStateFile, func() string { return file }).Reset()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	defer l.Close()
+
+	started := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	writeHostState(hostState{MeetingID: "a.onion", ServicePort: 64738, ServerPort: l.Addr().(*net.TCPAddr).Port, Started: started})
+
+	var out bytes.Buffer
+	c.Assert(meetingStatus(&out), Equals, true)
+	c.Assert(out.String(), Equals, "Meeting: a.onion:64738, hosted since 2026-10-17T09:00:00Z\n")
+
+	l.Close()
+	out.Reset()
+	c.Assert(meetingStatus(&out), Equals, false)
+	c.Assert(out.String(), Matches, "Meeting: a.onion isn't answering.*\n")
+}
+
+func (s *HeadlessSuite) Test_removeHostState_forgetsTheMeeting(c *C) {
+	file := filepath.Join(c.MkDir(), hostStateFileName)
+	defer gostub.New().Stub(&hostStateFile, func() string { return file }).Reset()
+
+	writeHostState(hostState{MeetingID: "a.onion"})
+	removeHostState()
+
+	_, err := readHostState()
+	c.Assert(err, NotNil)
+}
+
+func (s *HeadlessSuite) Test_torStatus_asksTheTorOfTheHostedMeetingFirst(c *C) {
+	file := filepath.Join(c.MkDir(), hostStateFileName)
+	var asked string
+	defer gostub.New().Stub(&hostStateFile, func() string { return file }).
+		Stub(&queryNetworkStatus, func(_ context.Context, _ *config.ApplicationConfig, control string) (tor.NetworkStatus, string, error) {
+			asked = control
+			now := time.Now()
+			return tor.NetworkStatus{Live: true, ValidAfter: now.Add(-time.Hour), ValidUntil: now.Add(time.Hour)}, control, nil
+		}).Reset()
+	writeHostState(hostState{MeetingID: "a.onion", TorControl: "127.0.0.1:4321"})
+
+	var out bytes.Buffer
+	c.Assert(torStatus(&config.ApplicationConfig{}, &out), Equals, true)
+	c.Assert(asked, Equals, "127.0.0.1:4321")
+	c.Assert(out.String(), Equals, "Tor: running, with its control port on 127.0.0.1:4321\nTor network: reachable\n")
+}
+
+func (s *HeadlessSuite) Test_torStatus_saysWhenTorIsNotRunning(c *C) {
+	defer gostub.New().Stub(&hostStateFile, func() string {
+		return filepath.Join(c.MkDir(), hostStateFileName)
+	}).Stub(&queryNetworkStatus, func(context.Context, *config.ApplicationConfig, string) (tor.NetworkStatus, string, error) {
+		return tor.NetworkStatus{}, "", tor.ErrTorNotRunning
+	}).Reset()
+
+	var out bytes.Buffer
+	c.Assert(torStatus(&config.ApplicationConfig{}, &out), Equals, false)
+	c.Assert(out.String(), Equals, "Tor: not running\n")
+}
@@ -0,0 +1,13 @@
+//go:build !windows
+// +build !windows
+
+package hosting
+
+import (
+	"errors"
+	"syscall"
+)
+
+func isAddressInUse(err error) bool {
+	return errors.Is(err, syscall.EADDRINUSE)
+}
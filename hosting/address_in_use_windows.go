@@ -0,0 +1,11 @@
+package hosting
+
+import (
+	"errors"
+
+	"golang.org/x/sys/windows"
+)
+
+func isAddressInUse(err error) bool {
+	return errors.Is(err, windows.WSAEADDRINUSE)
+}
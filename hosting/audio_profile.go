@@ -0,0 +1,58 @@
+package hosting
+
+// AudioProfile is the quality of the audio in a hosted meeting. Lower
+// qualities use less bandwidth, which Tor circuits often need to avoid
+// choppy audio
+type AudioProfile string
+
+const (
+	// AudioProfileLow works on slow or congested circuits
+	AudioProfileLow AudioProfile = "low"
+	// AudioProfileMedium is a compromise that works for most meetings
+	AudioProfileMedium AudioProfile = "medium"
+	// AudioProfileHigh keeps the grumble defaults
+	AudioProfileHigh AudioProfile = "high"
+)
+
+// DefaultAudioProfile is used when no other profile is chosen
+const DefaultAudioProfile = AudioProfileMedium
+
+// AudioProfiles returns all the audio profiles, from the lowest quality to the highest
+func AudioProfiles() []AudioProfile {
+	return []AudioProfile{AudioProfileLow, AudioProfileMedium, AudioProfileHigh}
+}
+
+// ParseAudioProfile returns the profile with the given name,
+// or the default one if there is none
+func ParseAudioProfile(name string) AudioProfile {
+	for _, p := range AudioProfiles() {
+		if string(p) == name {
+			return p
+		}
+	}
+	return DefaultAudioProfile
+}
+
+// maxBandwidth is the number of bits per second a participant can send
+func (p AudioProfile) maxBandwidth() int {
+	switch p {
+	case AudioProfileLow:
+		return 24000
+	case AudioProfileMedium:
+		return 48000
+	default:
+		return defaultMaxBandwidth
+	}
+}
+
+// opusOnly is true for the profiles with a bandwidth that
+// only sounds good with the Opus codec
+func (p AudioProfile) opusOnly() bool {
+	return p != AudioProfileHigh
+}
+
+// apply sets the bandwidth and the codec of the profile to the options
+func (p AudioProfile) apply(o *ServerOptions) {
+	o.MaxBandwidth = p.maxBandwidth()
+	o.OpusOnly = p.opusOnly()
+}
@@ -0,0 +1,34 @@
+package hosting
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_ParseAudioProfile_usesTheDefaultForUnknownNames(c *C) {
+	c.Assert(ParseAudioProfile("low"), Equals, AudioProfileLow)
+	c.Assert(ParseAudioProfile("high"), Equals, AudioProfileHigh)
+	c.Assert(ParseAudioProfile(""), Equals, DefaultAudioProfile)
+	c.Assert(ParseAudioProfile("studio"), Equals, DefaultAudioProfile)
+}
+
+func (h *hostingSuite) Test_AudioProfile_lowersTheBandwidthAndUsesOpus(c *C) {
+	low := ServerOptions{}
+	AudioProfileLow.apply(&low)
+	c.Assert(low.MaxBandwidth, Equals, 24000)
+	c.Assert(low.OpusOnly, Equals, true)
+
+	high := ServerOptions{}
+	AudioProfileHigh.apply(&high)
+	c.Assert(high.MaxBandwidth, Equals, defaultMaxBandwidth)
+	c.Assert(high.OpusOnly, Equals, false)
+}
+
+func (h *hostingSuite) Test_SetAudioProfile_ignoresUnknownProfiles(c *C) {
+	s := &service{}
+
+	s.SetAudioProfile(AudioProfileLow)
+	c.Assert(s.audioProfile, Equals, AudioProfileLow)
+
+	s.SetAudioProfile("studio")
+	c.Assert(s.audioProfile, Equals, DefaultAudioProfile)
+}
@@ -0,0 +1,38 @@
+package hosting
+
+import (
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// BanList keeps the certificates banned from our meetings, so a disruptive
+// participant banned from a meeting stays banned from the next ones.
+// Grumble doesn't check the usernames of the clients against its bans,
+// so participants can only be banned by their certificate
+type BanList interface {
+	BannedCertificates() []string
+	BanCertificate(certHash string)
+	UnbanCertificate(certHash string)
+}
+
+// WithBanList keeps the participants in the ban list out of the meeting,
+// and adds to the list the participants banned without a duration
+func WithBanList(l BanList) ServiceOption {
+	return func(o *serviceOptions) {
+		o.banList = l
+	}
+}
+
+func setBannedCertificates(hashes []string) serverModifier {
+	return func(serv *grumbleServer.Server) {
+		// The server has not started, so nothing else uses its bans yet
+		for _, h := range hashes {
+			certHash, err := normalizeCertHash(h)
+			if err != nil {
+				log.WithField("certHash", h).Warn("Ignoring an invalid banned certificate")
+				continue
+			}
+			serv.Bans = withBan(serv.Bans, certificateBan(certHash, 0))
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package hosting
+
+import (
+	"time"
+
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	. "gopkg.in/check.v1"
+)
+
+type banListMock struct {
+	banned []string
+}
+
+func (l *banListMock) BannedCertificates() []string {
+	return l.banned
+}
+
+func (l *banListMock) BanCertificate(certHash string) {
+	l.banned = append(l.banned, certHash)
+}
+
+func (l *banListMock) UnbanCertificate(certHash string) {
+	result := []string{}
+	for _, h := range l.banned {
+		if h != certHash {
+			result = append(result, h)
+		}
+	}
+	l.banned = result
+}
+
+func (h *hostingSuite) Test_ServerOptions_bansTheGivenCertificatesFromTheStart(c *C) {
+	serv, err := grumbleServer.NewServer(1)
+	c.Assert(err, IsNil)
+
+	opts := ServerOptions{BannedCertificates: []string{testCertHash, "not a hash"}}
+	for _, m := range opts.modifiers() {
+		m(serv)
+	}
+
+	c.Assert(serv.Bans, HasLen, 1)
+	c.Assert(serv.IsCertHashBanned(testCertHash), Equals, true)
+	c.Assert(serv.Bans[0].Duration, Equals, uint32(0))
+}
+
+func (h *hostingSuite) Test_Ban_addsBansWithoutADurationToTheBanList(c *C) {
+	s := newTestServer(c)
+	l := &banListMock{}
+	s.banList = l
+
+	c.Assert(s.Ban(testCertHash, time.Hour), IsNil)
+	c.Assert(l.banned, HasLen, 0)
+
+	c.Assert(s.Ban(testCertHash, 0), IsNil)
+	c.Assert(l.banned, DeepEquals, []string{testCertHash})
+}
+
+func (h *hostingSuite) Test_Unban_removesTheCertificateFromTheBanList(c *C) {
+	s := newTestServer(c)
+	l := &banListMock{banned: []string{testCertHash}}
+	s.banList = l
+
+	c.Assert(s.Unban(testCertHash), IsNil)
+	c.Assert(l.banned, HasLen, 0)
+}
+
+func (h *hostingSuite) Test_WithBanList_setsTheBanListOfTheService(c *C) {
+	l := &banListMock{}
+	o := newServiceOptions([]ServiceOption{WithBanList(l)})
+
+	c.Assert(o.banList, Equals, l)
+}
@@ -31,7 +31,7 @@ const certServerPort = 8181
 
 var ioutilReadFile = ioutil.ReadFile
 
-func newCertificateServer(dir string) (*webserver, error) {
+func newCertificateServer(dir, host string) (*webserver, error) {
 	certFile := filepath.Join(dir, "cert.pem")
 	if !fileExists(certFile) {
 		return nil, errors.New("the certificate file do not exists")
@@ -43,7 +43,7 @@ func newCertificateServer(dir string) (*webserver, error) {
 	}
 
 	port := config.GetRandomPort()
-	address := net.JoinHostPort(defaultHost(), strconv.Itoa(port))
+	address := net.JoinHostPort(host, strconv.Itoa(port))
 
 	s := &webserver{
 		port:    port,
@@ -40,7 +40,7 @@ func (s *hostingSuite) Test_newCertificateServer_generatesNewCertificatedWebServ
 		c.Fatalf("Failed to create file: %v", e)
 	}
 
-	httpServer, err := newCertificateServer(path)
+	httpServer, err := newCertificateServer(path, defaultHost())
 
 	c.Assert(httpServer, NotNil)
 	c.Assert(httpServer.address, Matches, `127.0.0.1:.*`)
@@ -59,7 +59,7 @@ func (s *hostingSuite) Test_newCertificateServer_returnsAnErrorWhenNoCertificate
 	}
 	defer os.RemoveAll(path)
 
-	httpServer, err := newCertificateServer(path)
+	httpServer, err := newCertificateServer(path, defaultHost())
 	expectedErr := "the certificate file do not exists"
 
 	c.Assert(err, NotNil)
@@ -71,7 +71,7 @@ func (s *hostingSuite) Test_newCertificate_returnsAnErrorWhenDirectoryDoNotExist
 	path := "fake/dir"
 	expectedErr := "the certificate file do not exists"
 
-	httpServer, err := newCertificateServer(path)
+	httpServer, err := newCertificateServer(path, defaultHost())
 
 	c.Assert(httpServer, IsNil)
 	c.Assert(err, NotNil)
@@ -112,7 +112,7 @@ func (s *hostingSuite) Test_newCertificate_returnsAnErrorWhenCertificateContentC
 	expectedErr := errors.New("open " + fp + ": no such file or directory")
 	mi.On("ReadFile", fp).Return(ea, expectedErr)
 
-	httpServer, err := newCertificateServer(path)
+	httpServer, err := newCertificateServer(path, defaultHost())
 
 	c.Assert(err, NotNil)
 	c.Assert(err.Error(), Equals, expectedErr.Error())
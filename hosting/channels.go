@@ -0,0 +1,228 @@
+package hosting
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/grumble/pkg/acl"
+	"github.com/digitalautonomy/grumble/pkg/blobstore"
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+)
+
+// Channel describes one of the channels of a meeting. Channels
+// let the host run breakout rooms inside one meeting
+type Channel struct {
+	ID          int
+	Name        string
+	Description string
+	// Protected is true when a password is needed to enter the channel
+	Protected bool
+}
+
+var (
+	// ErrChannelNotFound is returned when the meeting has no channel with the given ID
+	ErrChannelNotFound = errors.New("the channel doesn't exist")
+	// ErrInvalidChannelName is returned when creating a channel without a name
+	ErrInvalidChannelName = errors.New("the channel needs a name")
+
+	errRootChannel = errors.New("the root channel of the meeting can't be removed")
+	errNoBlobStore = errors.New("the server has no place to keep channel descriptions")
+)
+
+// Channels returns the channels of the meeting, sorted by ID.
+// The first one is the root channel
+func (s *server) Channels() []Channel {
+	ids := []int{}
+	for id := range s.gs.Channels {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	result := []Channel{}
+	for _, id := range ids {
+		result = append(result, s.channelFrom(s.gs.Channels[id]))
+	}
+
+	return result
+}
+
+func (s *server) channelFrom(ch *grumbleServer.Channel) Channel {
+	return Channel{
+		ID:          ch.Id,
+		Name:        ch.Name,
+		Description: s.description(ch),
+		Protected:   isPasswordProtected(s.channelACLs(ch)),
+	}
+}
+
+// CreateChannel adds a channel, under the root channel, with the given
+// name and returns its ID. Participants already connected see the new
+// channel the next time they connect
+func (s *server) CreateChannel(name string) (int, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return 0, ErrInvalidChannelName
+	}
+
+	ch := s.gs.AddChannel(name)
+	s.gs.RootChannel().AddChild(ch)
+
+	return ch.Id, nil
+}
+
+// RemoveChannel removes the channel with the given ID and its
+// subchannels. The participants in them are moved to the parent channel
+func (s *server) RemoveChannel(id int) error {
+	ch, err := s.channel(id)
+	if err != nil {
+		return err
+	}
+
+	if ch == s.gs.RootChannel() {
+		return errRootChannel
+	}
+
+	s.gs.RemoveChannel(ch)
+
+	return nil
+}
+
+// SetChannelPassword makes the given password necessary to enter the
+// channel. Participants add it as an access token in their Mumble
+// client. An empty password makes the channel open again
+func (s *server) SetChannelPassword(id int, password string) error {
+	ch, err := s.channel(id)
+	if err != nil {
+		return err
+	}
+
+	return s.changeACLs(ch, func(acls []acl.ACL) []acl.ACL {
+		result := []acl.ACL{}
+		for _, a := range acls {
+			if !isPasswordACL(a) {
+				result = append(result, a)
+			}
+		}
+
+		if password != "" {
+			result = append(result, passwordACLs(password)...)
+		}
+
+		return result
+	})
+}
+
+// SetChannelDescription changes the text shown to the participants
+// about the channel. An empty description removes it
+func (s *server) SetChannelDescription(id int, description string) error {
+	ch, err := s.channel(id)
+	if err != nil {
+		return err
+	}
+
+	if description == "" {
+		ch.DescriptionBlob = ""
+		return nil
+	}
+
+	blobs, err := s.blobStore()
+	if err != nil {
+		return err
+	}
+
+	key, err := blobs.Put([]byte(description))
+	if err != nil {
+		return err
+	}
+
+	ch.DescriptionBlob = key
+
+	return nil
+}
+
+func (s *server) channel(id int) (*grumbleServer.Channel, error) {
+	ch, ok := s.gs.Channels[id]
+	if !ok {
+		return nil, ErrChannelNotFound
+	}
+	return ch, nil
+}
+
+func (s *server) description(ch *grumbleServer.Channel) string {
+	if !ch.HasDescription() {
+		return ""
+	}
+
+	blobs, err := s.blobStore()
+	if err != nil {
+		return ""
+	}
+
+	content, err := blobs.Get(ch.DescriptionBlob)
+	if err != nil {
+		log.Errorf("server.description(): %s", err)
+		return ""
+	}
+
+	return string(content)
+}
+
+func (s *server) blobStore() (blobstore.BlobStore, error) {
+	if s.serverCollection == nil || s.serverCollection.dataDir == "" {
+		return blobstore.BlobStore{}, errNoBlobStore
+	}
+
+	dir := s.serverCollection.blobDir()
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return blobstore.BlobStore{}, err
+	}
+
+	return blobstore.Open(dir), nil
+}
+
+// blobDir is the directory where grumble keeps the descriptions
+// of channels and other big pieces of data
+func (s *servers) blobDir() string {
+	return filepath.Join(s.dataDir, "blob")
+}
+
+// passwordACLs keeps everybody out of the channel, except the clients
+// that have the password among their access tokens
+func passwordACLs(password string) []acl.ACL {
+	return []acl.ACL{
+		{
+			UserId:    -1,
+			Group:     "all",
+			ApplyHere: true,
+			ApplySubs: true,
+			Deny:      acl.EnterPermission,
+		},
+		{
+			UserId:    -1,
+			Group:     "#" + password,
+			ApplyHere: true,
+			ApplySubs: true,
+			Allow:     acl.EnterPermission,
+		},
+	}
+}
+
+func isPasswordACL(a acl.ACL) bool {
+	isDenyAll := a.Group == "all" && a.Deny == acl.EnterPermission && a.Allow == 0
+	isToken := strings.HasPrefix(a.Group, "#") && a.Allow == acl.EnterPermission && a.Deny == 0
+	return a.UserId == -1 && (isDenyAll || isToken)
+}
+
+func isPasswordProtected(acls []acl.ACL) bool {
+	for _, a := range acls {
+		if isPasswordACL(a) && strings.HasPrefix(a.Group, "#") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package hosting
+
+import (
+	"github.com/digitalautonomy/grumble/pkg/acl"
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	. "gopkg.in/check.v1"
+)
+
+func newTestServer(c *C) *server {
+	gs, err := grumbleServer.NewServer(1)
+	c.Assert(err, IsNil)
+
+	return &server{serverCollection: &servers{dataDir: c.MkDir()}, gs: gs}
+}
+
+func (h *hostingSuite) Test_CreateChannel_addsAChannelUnderTheRootChannel(c *C) {
+	s := newTestServer(c)
+
+	id, err := s.CreateChannel(" Breakout room ")
+	c.Assert(err, IsNil)
+
+	c.Assert(s.Channels(), DeepEquals, []Channel{
+		{ID: 0, Name: "Root"},
+		{ID: id, Name: "Breakout room"},
+	})
+	c.Assert(s.gs.RootChannel().AllSubChannels(), HasLen, 1)
+}
+
+func (h *hostingSuite) Test_CreateChannel_needsAName(c *C) {
+	s := newTestServer(c)
+
+	_, err := s.CreateChannel("  ")
+	c.Assert(err, Equals, ErrInvalidChannelName)
+}
+
+func (h *hostingSuite) Test_RemoveChannel_removesTheChannelButNotTheRootOne(c *C) {
+	s := newTestServer(c)
+	id, _ := s.CreateChannel("Breakout room")
+
+	c.Assert(s.RemoveChannel(id), IsNil)
+	c.Assert(s.Channels(), HasLen, 1)
+
+	c.Assert(s.RemoveChannel(id), Equals, ErrChannelNotFound)
+	c.Assert(s.RemoveChannel(0), Equals, errRootChannel)
+}
+
+func (h *hostingSuite) Test_SetChannelPassword_onlyLetsInTheClientsWithThePassword(c *C) {
+	s := newTestServer(c)
+	id, _ := s.CreateChannel("Breakout room")
+
+	c.Assert(s.SetChannelPassword(id, "secret"), IsNil)
+	c.Assert(s.SetChannelPassword(id, "other secret"), IsNil)
+
+	c.Assert(s.gs.Channels[id].ACL.ACLs, DeepEquals, passwordACLs("other secret"))
+	c.Assert(s.Channels()[1].Protected, Equals, true)
+}
+
+func (h *hostingSuite) Test_SetChannelPassword_keepsTheOtherPermissions(c *C) {
+	s := newTestServer(c)
+	id, _ := s.CreateChannel("Breakout room")
+	moderation := moderationACL("abcdef")
+	s.gs.Channels[id].ACL.ACLs = []acl.ACL{moderation}
+
+	c.Assert(s.SetChannelPassword(id, "secret"), IsNil)
+	c.Assert(s.SetChannelPassword(id, ""), IsNil)
+
+	c.Assert(s.gs.Channels[id].ACL.ACLs, DeepEquals, []acl.ACL{moderation})
+	c.Assert(s.Channels()[1].Protected, Equals, false)
+}
+
+func (h *hostingSuite) Test_SetChannelDescription_keepsTheDescriptionInTheBlobStore(c *C) {
+	s := newTestServer(c)
+	id, _ := s.CreateChannel("Breakout room")
+
+	c.Assert(s.SetChannelDescription(id, "Discussion about <b>Tor</b>"), IsNil)
+	c.Assert(s.Channels()[1].Description, Equals, "Discussion about <b>Tor</b>")
+
+	c.Assert(s.SetChannelDescription(id, ""), IsNil)
+	c.Assert(s.Channels()[1].Description, Equals, "")
+}
+
+func (h *hostingSuite) Test_SetChannelDescription_failsForUnknownChannels(c *C) {
+	s := newTestServer(c)
+
+	c.Assert(s.SetChannelDescription(42, "nothing"), Equals, ErrChannelNotFound)
+	c.Assert(s.SetChannelPassword(42, "nothing"), Equals, ErrChannelNotFound)
+}
@@ -0,0 +1,196 @@
+package hosting
+
+import (
+	"errors"
+	"html"
+	"strings"
+	"sync"
+	"time"
+
+	xhtml "golang.org/x/net/html"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/golang/protobuf/proto"
+)
+
+// Message is a text message sent in the Mumble server of a meeting
+type Message struct {
+	// From is who sent the message
+	From User
+	// Text is the message as plain text. The Mumble clients send
+	// HTML, so the formatting is dropped and links keep only their text
+	Text     string
+	Received time.Time
+}
+
+// ErrEmptyMessage is returned when sending a message without text
+var ErrEmptyMessage = errors.New("the message has no text")
+
+// chatMessages keeps the subscribers to the messages of a meeting. It
+// works like participantEvents, so a burst of messages can't take the
+// place of the participant events of a subscriber
+type chatMessages struct {
+	sync.Mutex
+	subscribers map[chan Message]bool
+}
+
+func newChatMessages() *chatMessages {
+	return &chatMessages{
+		subscribers: make(map[chan Message]bool),
+	}
+}
+
+func (m *chatMessages) subscribe() (<-chan Message, func()) {
+	m.Lock()
+	defer m.Unlock()
+
+	ch := make(chan Message, subscriberBuffer)
+	m.subscribers[ch] = true
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			m.Lock()
+			defer m.Unlock()
+			delete(m.subscribers, ch)
+			close(ch)
+		})
+	}
+}
+
+func (m *chatMessages) publish(msg Message) {
+	if m == nil {
+		return
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	for ch := range m.subscribers {
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
+}
+
+// Messages returns a channel with the text messages Wahay receives in the
+// meeting, and a function to call when they are no longer needed. Those
+// are the messages sent to the root channel, to the whole server and to
+// Wahay itself, but not the ones sent inside other channels
+func (s *server) Messages() (<-chan Message, func()) {
+	if s.messages == nil {
+		s.messages = newChatMessages()
+	}
+	return s.messages.subscribe()
+}
+
+// SendMessage shows the text to everybody in the meeting,
+// whatever channel they are in
+func (s *server) SendMessage(text string) error {
+	ids := []uint32{}
+	for _, ch := range s.Channels() {
+		ids = append(ids, uint32(ch.ID))
+	}
+	return s.sendMessage(ids, text)
+}
+
+// SendChannelMessage shows the text to the participants in the channel
+func (s *server) SendChannelMessage(channel int, text string) error {
+	if _, ok := s.gs.Channels[channel]; !ok {
+		return ErrChannelNotFound
+	}
+	return s.sendMessage([]uint32{uint32(channel)}, text)
+}
+
+func (s *server) sendMessage(channels []uint32, text string) error {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return ErrEmptyMessage
+	}
+
+	c, err := s.moderator()
+	if err != nil {
+		return err
+	}
+
+	return c.sendText(channels, text)
+}
+
+// sendText sends the plain text, with the links in it made clickable,
+// since the Mumble clients show messages as HTML
+func (s *session) sendText(channels []uint32, text string) error {
+	return s.command(func() error {
+		return s.send(&mumbleproto.TextMessage{
+			ChannelId: channels,
+			Message:   proto.String(messageHTML(text)),
+		})
+	})
+}
+
+func (s *session) textMessage(msg *mumbleproto.TextMessage) {
+	s.Lock()
+	from, known := s.users[msg.GetActor()]
+	s.Unlock()
+
+	if !known {
+		return
+	}
+
+	s.messages.publish(Message{
+		From:     from,
+		Text:     plainText(msg.GetMessage()),
+		Received: time.Now(),
+	})
+}
+
+// messageHTML escapes the text and turns the words that are
+// links into anchors, keeping the lines the text has
+func messageHTML(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		words := strings.Split(line, " ")
+		for j, w := range words {
+			escaped := html.EscapeString(w)
+			if isLink(w) {
+				escaped = `<a href="` + escaped + `">` + escaped + `</a>`
+			}
+			words[j] = escaped
+		}
+		lines[i] = strings.Join(words, " ")
+	}
+	return strings.Join(lines, "<br />")
+}
+
+func isLink(word string) bool {
+	for _, scheme := range []string{"http://", "https://"} {
+		if strings.HasPrefix(strings.ToLower(word), scheme) && len(word) > len(scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// plainText returns the text of the HTML a Mumble client sent,
+// with the line breaks and paragraphs as new lines
+func plainText(message string) string {
+	result := &strings.Builder{}
+
+	z := xhtml.NewTokenizer(strings.NewReader(message))
+	for {
+		switch z.Next() {
+		case xhtml.ErrorToken:
+			return strings.TrimSpace(result.String())
+		case xhtml.TextToken:
+			result.Write(z.Text())
+		case xhtml.StartTagToken, xhtml.SelfClosingTagToken:
+			if name, _ := z.TagName(); string(name) == "br" {
+				result.WriteString("\n")
+			}
+		case xhtml.EndTagToken:
+			if name, _ := z.TagName(); string(name) == "p" {
+				result.WriteString("\n")
+			}
+		}
+	}
+}
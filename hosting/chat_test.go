@@ -0,0 +1,83 @@
+package hosting
+
+import (
+	"time"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+func nextMessage(c *C, messages <-chan Message) Message {
+	select {
+	case msg := <-messages:
+		return msg
+	case <-time.After(5 * time.Second):
+		c.Fatal("no message arrived")
+	}
+	return Message{}
+}
+
+func (h *hostingSuite) Test_server_SendMessage_showsTheTextToTheParticipants(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	cert, _, err := newSessionCertificate()
+	c.Assert(err, IsNil)
+	serverCert, err := serverCertificate()
+	c.Assert(err, IsNil)
+
+	received := newChatMessages()
+	messages, stopMessages := received.subscribe()
+	defer stopMessages()
+
+	guest, err := dialSession(serv.localAddress(), cert, serverCert, "", nil, received, nil)
+	c.Assert(err, IsNil)
+	defer guest.close()
+
+	c.Assert(serv.SendMessage("The notes are at https://example.org/pad <here>"), IsNil)
+
+	msg := nextMessage(c, messages)
+	c.Assert(msg.From.Name, Equals, sessionUsername)
+	c.Assert(msg.Text, Equals, "The notes are at https://example.org/pad <here>")
+}
+
+func (h *hostingSuite) Test_server_Messages_returnsWhatTheParticipantsSay(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	messages, stopMessages := serv.Messages()
+	defer stopMessages()
+
+	guest, hash := joinTestServer(c, serv, "")
+	defer guest.close()
+	userWithCertificate(c, serv, hash)
+
+	c.Assert(guest.send(&mumbleproto.TextMessage{
+		ChannelId: []uint32{0},
+		Message:   proto.String("<p>Hello<br />everybody &amp; you</p>"),
+	}), IsNil)
+
+	msg := nextMessage(c, messages)
+	c.Assert(msg.From.CertHash, Equals, hash)
+	c.Assert(msg.Text, Equals, "Hello\neverybody & you")
+}
+
+func (h *hostingSuite) Test_server_SendChannelMessage_failsForAnUnknownChannel(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	c.Assert(serv.SendChannelMessage(42, "hello"), Equals, ErrChannelNotFound)
+	c.Assert(serv.SendMessage("  "), Equals, ErrEmptyMessage)
+}
+
+func (h *hostingSuite) Test_messageHTML_escapesTheTextAndLinksTheURLs(c *C) {
+	c.Assert(messageHTML("see https://example.org/?a=1&b=2\n<b>now</b>"), Equals,
+		`see <a href="https://example.org/?a=1&amp;b=2">https://example.org/?a=1&amp;b=2</a><br />&lt;b&gt;now&lt;/b&gt;`)
+	c.Assert(messageHTML("https:// alone"), Equals, "https:// alone")
+}
+
+func (h *hostingSuite) Test_plainText_dropsTheFormatting(c *C) {
+	c.Assert(plainText(`<b>bold</b> and <a href="https://example.org">a link</a>`), Equals, "bold and a link")
+	c.Assert(plainText("no markup"), Equals, "no markup")
+}
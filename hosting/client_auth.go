@@ -0,0 +1,184 @@
+package hosting
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+// clientAuthParameter is the name of the invitation parameter that
+// carries the client authorization key of the invitee
+const clientAuthParameter = "auth"
+
+// WithClientAuthorization makes the onion service of the meeting reachable
+// only for the host and the given number of invitees, each one of them
+// with their own client authorization key, which can be revoked alone
+func WithClientAuthorization(invitees int) ServiceOption {
+	return func(o *serviceOptions) {
+		o.invitees = invitees
+	}
+}
+
+type clientAuthKeys struct {
+	host     *tor.ClientAuthKey
+	invitees []*tor.ClientAuthKey
+	// names are the usernames in the invitations, in
+	// the same order as the invitees. It can be shorter
+	names []string
+}
+
+var newClientAuthKey = tor.NewClientAuthKey
+
+func generateClientAuthKeys(invitees int) (*clientAuthKeys, error) {
+	host, err := newClientAuthKey()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := &clientAuthKeys{host: host}
+	for i := 0; i < invitees; i++ {
+		k, err := newClientAuthKey()
+		if err != nil {
+			return nil, err
+		}
+		keys.invitees = append(keys.invitees, k)
+	}
+
+	return keys, nil
+}
+
+func (k *clientAuthKeys) publicKeys() []string {
+	result := []string{k.host.PublicKey}
+	for _, i := range k.invitees {
+		result = append(result, i.PublicKey)
+	}
+	return result
+}
+
+// ErrUnknownInvitation is returned when revoking an invitation
+// that is not one of the meeting
+var ErrUnknownInvitation = errors.New("the invitation is not one of this meeting")
+
+// RevokeInvitation stops the invitee with the given invitation from
+// joining the meeting, while the other invitations keep working. The onion
+// service is published again without their key, so the participants
+// connected at the moment are disconnected and their Mumble clients
+// reconnect on their own
+func (s *service) RevokeInvitation(invitation string) error {
+	if s.clientAuth == nil || s.tor == nil {
+		return ErrUnknownInvitation
+	}
+
+	_, key := ParseInvitation(invitation)
+	if !s.clientAuth.revoke(key) {
+		return ErrUnknownInvitation
+	}
+
+	log.WithField("invitees", len(s.clientAuth.invitees)).Info("Revoking an invitation to the meeting")
+
+	return s.republishOnion()
+}
+
+func (s *service) republishOnion() error {
+	if s.onion != nil {
+		if err := s.onion.Delete(); err != nil {
+			log.Errorf("republishOnion(): %s", err)
+			return ErrServerOnionDelete
+		}
+	}
+
+	opts := []tor.OnionOption{tor.WithClientAuthorization(s.clientAuth.publicKeys()...)}
+	if s.onionKey != nil {
+		opts = append(opts, tor.WithPrivateKey(s.onionKey))
+	}
+
+	onion, err := s.tor.NewOnionServiceWithMultiplePorts(s.onionPorts, opts...)
+	if err != nil {
+		log.Errorf("republishOnion(): %s", err)
+		s.onion = nil
+		return err
+	}
+
+	s.onion = onion
+	if serv, ok := s.Server().(*server); ok && serv != nil {
+		serv.onion = onion
+	}
+
+	return nil
+}
+
+// revoke forgets the invitee with the given private key. It returns
+// false when there is no such invitee
+func (k *clientAuthKeys) revoke(privateKey string) bool {
+	for i, inv := range k.invitees {
+		if privateKey != "" && inv.PrivateKey == privateKey {
+			k.invitees = append(k.invitees[:i], k.invitees[i+1:]...)
+			if i < len(k.names) {
+				k.names = append(k.names[:i], k.names[i+1:]...)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// name returns the username in the invitation of the
+// invitee at the given position, if it has one
+func (k *clientAuthKeys) name(i int) string {
+	if i < len(k.names) {
+		return k.names[i]
+	}
+	return ""
+}
+
+// InvitationURL returns the meeting address to share with an invitee,
+// including their client authorization key if there is one
+func InvitationURL(meetingURL, clientAuthKey string) string {
+	return withParameters(meetingURL, invitationParameters(clientAuthKey, ""))
+}
+
+// withParameters returns the meeting address with the
+// given parameters, when there are any
+func withParameters(meetingURL string, params url.Values) string {
+	if len(params) == 0 {
+		return meetingURL
+	}
+	return fmt.Sprintf("%s?%s", meetingURL, params.Encode())
+}
+
+// invitationParameters returns the parameters of an invitation with the
+// given client authorization key and signature, which can be empty
+func invitationParameters(clientAuthKey, token string) url.Values {
+	params := url.Values{}
+	if clientAuthKey != "" {
+		params.Set(clientAuthParameter, clientAuthKey)
+	}
+	if token != "" {
+		params.Set(invitationTokenParameter, token)
+	}
+	return params
+}
+
+// ParseInvitation splits an invitation into the meeting address and the
+// client authorization key, which will be empty if the invitation
+// doesn't contain one
+func ParseInvitation(invitation string) (meetingURL, clientAuthKey string) {
+	invitation = strings.TrimSpace(invitation)
+
+	parts := strings.SplitN(invitation, "?", 2)
+	if len(parts) < 2 {
+		return invitation, ""
+	}
+
+	params, err := url.ParseQuery(parts[1])
+	if err != nil || params.Get(clientAuthParameter) == "" {
+		return invitation, ""
+	}
+
+	return parts[0], params.Get(clientAuthParameter)
+}
@@ -0,0 +1,128 @@
+package hosting
+
+import (
+	"errors"
+
+	"github.com/digitalautonomy/wahay/tor"
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_InvitationURL_includesTheClientAuthorizationKey(c *C) {
+	c.Assert(InvitationURL("abcdef.onion:1234", "QWERTY"), Equals, "abcdef.onion:1234?auth=QWERTY")
+	c.Assert(InvitationURL("abcdef.onion:1234", ""), Equals, "abcdef.onion:1234")
+}
+
+func (h *hostingSuite) Test_ParseInvitation_splitsTheMeetingAddressAndTheKey(c *C) {
+	meetingURL, key := ParseInvitation(" abcdef.onion:1234?auth=QWERTY ")
+	c.Assert(meetingURL, Equals, "abcdef.onion:1234")
+	c.Assert(key, Equals, "QWERTY")
+
+	meetingURL, key = ParseInvitation("abcdef.onion")
+	c.Assert(meetingURL, Equals, "abcdef.onion")
+	c.Assert(key, Equals, "")
+
+	meetingURL, key = ParseInvitation("abcdef.onion?other=QWERTY")
+	c.Assert(meetingURL, Equals, "abcdef.onion?other=QWERTY")
+	c.Assert(key, Equals, "")
+}
+
+func (h *hostingSuite) Test_generateClientAuthKeys_createsOneKeyForTheHostAndEachInvitee(c *C) {
+	keys, err := generateClientAuthKeys(3)
+
+	c.Assert(err, IsNil)
+	c.Assert(keys.invitees, HasLen, 3)
+	c.Assert(keys.publicKeys(), HasLen, 4)
+	c.Assert(keys.publicKeys()[0], Equals, keys.host.PublicKey)
+}
+
+func (h *hostingSuite) Test_generateClientAuthKeys_returnsAnErrorWhenAKeyCantBeGenerated(c *C) {
+	orgNewClientAuthKey := newClientAuthKey
+	defer func() {
+		newClientAuthKey = orgNewClientAuthKey
+	}()
+
+	newClientAuthKey = func() (*tor.ClientAuthKey, error) {
+		return nil, errors.New("no randomness")
+	}
+
+	keys, err := generateClientAuthKeys(3)
+
+	c.Assert(keys, IsNil)
+	c.Assert(err, ErrorMatches, "no randomness")
+}
+
+func (h *hostingSuite) Test_service_Invitations_returnsOneAddressPerInvitee(c *C) {
+	keys := &clientAuthKeys{
+		host:     &tor.ClientAuthKey{PrivateKey: "HOST"},
+		invitees: []*tor.ClientAuthKey{{PrivateKey: "ONE"}, {PrivateKey: "TWO"}},
+	}
+	s := &service{mumblePort: DefaultPort, onion: &onionMock{id: "abcdef.onion"}, clientAuth: keys}
+
+	c.Assert(s.ClientAuthKey(), Equals, "HOST")
+	c.Assert(s.Invitations(), DeepEquals, []string{"abcdef.onion?auth=ONE", "abcdef.onion?auth=TWO"})
+}
+
+func (h *hostingSuite) Test_service_Invitations_returnsTheMeetingAddressWithoutClientAuthorization(c *C) {
+	s := &service{mumblePort: DefaultPort, onion: &onionMock{id: "abcdef.onion"}}
+
+	c.Assert(s.ClientAuthKey(), Equals, "")
+	c.Assert(s.Invitations(), DeepEquals, []string{"abcdef.onion"})
+}
+
+type onionMock struct {
+	id        string
+	deleted   bool
+	deleteErr error
+}
+
+func (o *onionMock) ID() string {
+	return o.id
+}
+
+func (o *onionMock) Delete() error {
+	o.deleted = true
+	return o.deleteErr
+}
+
+type onionPublisherMock struct {
+	tor.Instance
+	published []tor.OnionPort
+	err       error
+}
+
+func (t *onionPublisherMock) NewOnionServiceWithMultiplePorts(ports []tor.OnionPort, opts ...tor.OnionOption) (tor.Onion, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	t.published = ports
+	return &onionMock{id: "abcdef.onion"}, nil
+}
+
+func (h *hostingSuite) Test_service_RevokeInvitation_publishesTheMeetingAgainWithoutTheInvitee(c *C) {
+	keys := &clientAuthKeys{
+		host:     &tor.ClientAuthKey{PrivateKey: "HOST"},
+		invitees: []*tor.ClientAuthKey{{PrivateKey: "ONE"}, {PrivateKey: "TWO"}},
+	}
+	old := &onionMock{id: "abcdef.onion"}
+	ports := []tor.OnionPort{{DestinationPort: 1234, ServicePort: DefaultPort}}
+	t := &onionPublisherMock{}
+	s := &service{mumblePort: DefaultPort, onion: old, clientAuth: keys, tor: t, onionPorts: ports}
+
+	c.Assert(s.RevokeInvitation("abcdef.onion?auth=ONE"), IsNil)
+
+	c.Assert(old.deleted, Equals, true)
+	c.Assert(t.published, DeepEquals, ports)
+	c.Assert(s.Invitations(), DeepEquals, []string{"abcdef.onion?auth=TWO"})
+}
+
+func (h *hostingSuite) Test_service_RevokeInvitation_failsForUnknownInvitations(c *C) {
+	keys := &clientAuthKeys{
+		host:     &tor.ClientAuthKey{PrivateKey: "HOST"},
+		invitees: []*tor.ClientAuthKey{{PrivateKey: "ONE"}},
+	}
+	s := &service{onion: &onionMock{id: "abcdef.onion"}, clientAuth: keys, tor: &onionPublisherMock{}}
+
+	c.Assert(s.RevokeInvitation("abcdef.onion?auth=HOST"), Equals, ErrUnknownInvitation)
+	c.Assert(s.RevokeInvitation("abcdef.onion"), Equals, ErrUnknownInvitation)
+	c.Assert((&service{}).RevokeInvitation("abcdef.onion?auth=ONE"), Equals, ErrUnknownInvitation)
+}
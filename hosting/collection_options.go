@@ -0,0 +1,52 @@
+package hosting
+
+import (
+	"crypto/tls"
+	"os"
+	"path/filepath"
+)
+
+// CollectionOption modifies the way the server collection is created
+type CollectionOption func(*servers)
+
+// WithCertificate makes the servers use the given PEM encoded certificate
+// and private key instead of generating new ones, so the Mumble clients of
+// returning participants recognize the server
+func WithCertificate(cert, key []byte) CollectionOption {
+	return func(s *servers) {
+		s.certificate = cert
+		s.privateKey = key
+	}
+}
+
+// useCertificate writes the certificate given with WithCertificate
+// where grumble looks for it
+func (s *servers) useCertificate() error {
+	if _, err := tls.X509KeyPair(s.certificate, s.privateKey); err != nil {
+		s.log.Debug(err.Error())
+		return ErrInvalidCertificate
+	}
+
+	err := os.WriteFile(filepath.Join(s.dataDir, "cert.pem"), s.certificate, 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(s.dataDir, "key.pem"), s.privateKey, 0600)
+}
+
+// Certificate returns the PEM encoded certificate and private key
+// used by the servers, so they can be used again later
+func (s *servers) Certificate() (cert, key []byte, err error) {
+	cert, err = os.ReadFile(filepath.Join(s.dataDir, "cert.pem"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err = os.ReadFile(filepath.Join(s.dataDir, "key.pem"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, key, nil
+}
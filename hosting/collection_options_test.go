@@ -0,0 +1,51 @@
+package hosting
+
+import (
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	. "gopkg.in/check.v1"
+)
+
+func generateTestCertificate(c *C) (cert, key []byte) {
+	dir := c.MkDir()
+	origDataDir := grumbleServer.Args.DataDir
+	defer func() { grumbleServer.Args.DataDir = origDataDir }()
+	grumbleServer.Args.DataDir = dir
+
+	c.Assert(grumbleServer.GenerateSelfSignedCert("", ""), IsNil)
+
+	cert, _ = os.ReadFile(filepath.Join(dir, "cert.pem"))
+	key, _ = os.ReadFile(filepath.Join(dir, "key.pem"))
+	return cert, key
+}
+
+func (h *hostingSuite) Test_initializeCertificates_usesTheGivenCertificate(c *C) {
+	cert, key := generateTestCertificate(c)
+	servers := &servers{log: log.New(), dataDir: c.MkDir()}
+	WithCertificate(cert, key)(servers)
+
+	c.Assert(servers.initializeCertificates(), IsNil)
+
+	usedCert, usedKey, err := servers.Certificate()
+	c.Assert(err, IsNil)
+	c.Assert(usedCert, DeepEquals, cert)
+	c.Assert(usedKey, DeepEquals, key)
+}
+
+func (h *hostingSuite) Test_initializeCertificates_rejectsAnInvalidCertificate(c *C) {
+	servers := &servers{log: log.New(), dataDir: c.MkDir()}
+	WithCertificate([]byte("not a certificate"), []byte("not a key"))(servers)
+
+	c.Assert(servers.initializeCertificates(), Equals, ErrInvalidCertificate)
+}
+
+func (h *hostingSuite) Test_Certificate_failsWhenThereIsNoCertificateYet(c *C) {
+	servers := &servers{dataDir: c.MkDir()}
+
+	_, _, err := servers.Certificate()
+	c.Assert(err, NotNil)
+}
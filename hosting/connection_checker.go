@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strconv"
 
 	"github.com/digitalautonomy/wahay/config"
 	log "github.com/sirupsen/logrus"
@@ -18,9 +19,11 @@ type checkService struct {
 
 const checkConnectionPort = 12321
 
-func newCheckConnectionService() (*checkService, error) {
+// newCheckConnectionService listens on the given host, or on all the
+// interfaces when it's empty
+func newCheckConnectionService(host string) (*checkService, error) {
 	checkPort := config.GetRandomPort()
-	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", checkPort))
+	listener, err := net.Listen("tcp", net.JoinHostPort(host, strconv.Itoa(checkPort)))
 	if err != nil {
 		log.Errorf("Failed to start server on port %v: %v\n", checkPort, err)
 		return nil, err
@@ -36,6 +39,11 @@ func newCheckConnectionService() (*checkService, error) {
 	return cs, nil
 }
 
+// stop closes the listener of the service before it's started
+func (cs *checkService) stop() error {
+	return cs.l.Close()
+}
+
 func (cs *checkService) start() {
 	go func() {
 		for {
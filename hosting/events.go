@@ -0,0 +1,112 @@
+package hosting
+
+import "sync"
+
+// ParticipantEventType is the kind of change in the participants of a meeting
+type ParticipantEventType int
+
+const (
+	// ParticipantConnected is sent when a guest joins the meeting
+	ParticipantConnected ParticipantEventType = iota
+	// ParticipantDisconnected is sent when a guest leaves the meeting
+	ParticipantDisconnected
+	// ParticipantWaiting is sent when a guest arrives to the waiting
+	// room, where they wait until the host admits or rejects them
+	ParticipantWaiting
+	// ParticipantStoppedWaiting is sent when a guest leaves the waiting
+	// room without being admitted, because they gave up or were rejected
+	ParticipantStoppedWaiting
+	// UserConnected is sent when somebody joins the Mumble server
+	// of the meeting, which is when their name is known
+	UserConnected
+	// UserDisconnected is sent when somebody leaves the Mumble server
+	UserDisconnected
+	// UserChanged is sent when somebody on the Mumble server changes,
+	// for example when they are muted
+	UserChanged
+	// UserRenamed is sent when somebody on the Mumble server changes
+	// their name, instead of UserChanged
+	UserRenamed
+	// UserStartedTalking is sent when Wahay starts hearing somebody
+	// on the Mumble server, which is in the channel it's in
+	UserStartedTalking
+	// UserStoppedTalking is sent when somebody stops talking
+	UserStoppedTalking
+)
+
+// ParticipantEvent tells about a guest joining or leaving a meeting.
+// The host doesn't go through the onion service, so it's not counted
+type ParticipantEvent struct {
+	Type ParticipantEventType
+	// Participants is the number of guests in the meeting after the change.
+	// It's only given for the guests going through the connection gate
+	Participants int
+	// ID identifies the guest in the waiting room, to admit or reject them
+	ID int
+	// User is who the User events are about, the host included
+	User User
+	// PreviousName is the name the user had before, for UserRenamed
+	PreviousName string
+}
+
+// subscriberBuffer is the number of events kept for a subscriber that
+// is not reading them. Events arriving after that are dropped
+const subscriberBuffer = 16
+
+type participantEvents struct {
+	sync.Mutex
+	subscribers map[chan ParticipantEvent]bool
+}
+
+func newParticipantEvents() *participantEvents {
+	return &participantEvents{
+		subscribers: make(map[chan ParticipantEvent]bool),
+	}
+}
+
+// subscribe returns the channel where the events will arrive and a
+// function to stop receiving them, which closes the channel
+func (e *participantEvents) subscribe() (<-chan ParticipantEvent, func()) {
+	e.Lock()
+	defer e.Unlock()
+
+	ch := make(chan ParticipantEvent, subscriberBuffer)
+	e.subscribers[ch] = true
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			e.Lock()
+			defer e.Unlock()
+			delete(e.subscribers, ch)
+			close(ch)
+		})
+	}
+}
+
+// publish never blocks, so a slow subscriber can't hold
+// back the participants of the meeting
+func (e *participantEvents) publish(ev ParticipantEvent) {
+	if e == nil {
+		return
+	}
+
+	e.Lock()
+	defer e.Unlock()
+
+	for ch := range e.subscribers {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel with the participants joining and leaving
+// the meeting, and a function to call when the events are no longer needed
+func (s *server) Subscribe() (<-chan ParticipantEvent, func()) {
+	if s.events == nil {
+		s.events = newParticipantEvents()
+	}
+	return s.events.subscribe()
+}
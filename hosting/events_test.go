@@ -0,0 +1,67 @@
+package hosting
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func nextEvent(c *C, events <-chan ParticipantEvent) ParticipantEvent {
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(5 * time.Second):
+		c.Fatal("no participant event arrived")
+		return ParticipantEvent{}
+	}
+}
+
+func (h *hostingSuite) Test_participantEvents_sendsTheEventsToEverySubscriber(c *C) {
+	e := newParticipantEvents()
+	first, stopFirst := e.subscribe()
+	defer stopFirst()
+	second, stopSecond := e.subscribe()
+	defer stopSecond()
+
+	e.publish(ParticipantEvent{Type: ParticipantConnected, Participants: 1})
+
+	expected := ParticipantEvent{Type: ParticipantConnected, Participants: 1}
+	c.Assert(nextEvent(c, first), Equals, expected)
+	c.Assert(nextEvent(c, second), Equals, expected)
+}
+
+func (h *hostingSuite) Test_participantEvents_doesntBlockOnSubscribersThatDontRead(c *C) {
+	e := newParticipantEvents()
+	events, stop := e.subscribe()
+
+	for i := 0; i < subscriberBuffer*2; i++ {
+		e.publish(ParticipantEvent{Type: ParticipantConnected, Participants: i})
+	}
+
+	c.Assert(events, HasLen, subscriberBuffer)
+
+	stop()
+	stop()
+	c.Assert(e.subscribers, HasLen, 0)
+}
+
+func (h *hostingSuite) Test_connectionGate_tellsWhenGuestsJoinAndLeave(c *C) {
+	target := startEchoServer(c)
+	defer target.Close()
+
+	g, err := newConnectionGate(listenAddress{}, target.Addr().String(), 0)
+	c.Assert(err, IsNil)
+	g.start()
+	defer g.stop()
+
+	s := &server{events: g.events}
+	events, stop := s.Subscribe()
+	defer stop()
+
+	conn := connectToGate(c, g)
+	c.Assert(echo(c, conn, "hello"), Equals, "hello\n")
+	c.Assert(nextEvent(c, events), Equals, ParticipantEvent{Type: ParticipantConnected, Participants: 1})
+
+	conn.Close()
+	c.Assert(nextEvent(c, events), Equals, ParticipantEvent{Type: ParticipantDisconnected, Participants: 0})
+}
@@ -0,0 +1,434 @@
+package hosting
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// connectionGate sits between the onion service and the Mumble server.
+// Every participant joining through Tor goes through it, which makes it the
+// place where we decide if and when a connection reaches the server. The host
+// connects to the Mumble server directly, so it never goes through the gate.
+type connectionGate struct {
+	sync.Mutex
+
+	port     int
+	target   string
+	listener net.Listener
+
+	// capacity is the maximum number of participants connected at the
+	// same time through the gate. Zero means there is no limit
+	capacity int
+	active   int
+	queue    []*queuedConnection
+	// reservations are the slots kept for the guests admitted from
+	// the lobby, until their Mumble client connects again
+	reservations []*time.Timer
+
+	// certificate is the one of the Mumble server, for the lobby of the
+	// guests in the queue. Without it, they wait without knowing why
+	certificate *tls.Certificate
+	texts       QueueTexts
+
+	// keepAlive is the period of the TCP keepalive probes on both sides
+	// of every connection. Zero leaves the system defaults
+	keepAlive time.Duration
+
+	// waitingRoom keeps every participant in the queue
+	// until the host admits them
+	waitingRoom bool
+	nextID      int
+
+	// participants are the guests that went through the gate, by ID
+	participants map[int]*connectedParticipant
+
+	events *participantEvents
+
+	// received and sent count the bytes going through the
+	// gate. They are only accessed atomically
+	received uint64
+	sent     uint64
+}
+
+type queuedConnection struct {
+	id int
+	// admitted receives true when the participant is let in,
+	// and false when the host rejects them
+	admitted chan bool
+	// approved is set when the host admits the participant
+	// but the meeting is full
+	approved bool
+	rejected bool
+	// moved is notified when the queue changes
+	moved chan struct{}
+}
+
+func newConnectionGate(listen listenAddress, target string, capacity int) (*connectionGate, error) {
+	l, port, err := listen.listen()
+	if err != nil {
+		return nil, err
+	}
+
+	return &connectionGate{
+		port:     port,
+		target:   target,
+		listener: l,
+		capacity: capacity,
+		texts:    defaultQueueTexts(),
+		events:   newParticipantEvents(),
+	}, nil
+}
+
+func (g *connectionGate) start() {
+	go func() {
+		for {
+			conn, err := g.listener.Accept()
+			if err != nil {
+				log.Debugf("connectionGate: stopped accepting connections: %v", err)
+				return
+			}
+			setKeepAlive(conn, g.keepAlive)
+			go g.handle(conn)
+		}
+	}()
+}
+
+func (g *connectionGate) stop() error {
+	return g.listener.Close()
+}
+
+// waiting returns the number of connections in the queue
+func (g *connectionGate) waiting() int {
+	g.Lock()
+	defer g.Unlock()
+	return len(g.queue)
+}
+
+// enter returns nil if the connection can go through right away,
+// otherwise the returned connection will be notified when admitted
+func (g *connectionGate) enter() *queuedConnection {
+	g.Lock()
+	defer g.Unlock()
+
+	// The gate can't tell who connects before the server does, so the
+	// slot kept for a guest goes to the next connection. That is only
+	// about the room in the meeting: in the waiting room, nobody is
+	// admitted from the lobby and every connection waits for the host
+	if !g.waitingRoom && len(g.reservations) > 0 {
+		g.reservations[0].Stop()
+		g.reservations = g.reservations[1:]
+		return nil
+	}
+
+	if !g.waitingRoom && g.hasRoom() {
+		g.active++
+		g.participantsChanged(ParticipantConnected)
+		return nil
+	}
+
+	g.nextID++
+	q := &queuedConnection{id: g.nextID, admitted: make(chan bool, 1), moved: make(chan struct{}, 1)}
+	g.queue = append(g.queue, q)
+	g.queueChanged()
+
+	if g.waitingRoom {
+		g.waitingChanged(ParticipantWaiting, q)
+	}
+
+	return q
+}
+
+// hasRoom must be called with the lock held
+func (g *connectionGate) hasRoom() bool {
+	return g.capacity <= 0 || g.active < g.capacity
+}
+
+// leave is called when a connection that went through the gate is
+// closed. The first connection in the queue takes the freed slot
+func (g *connectionGate) leave() {
+	g.Lock()
+	defer g.Unlock()
+
+	g.active--
+	g.participantsChanged(ParticipantDisconnected)
+
+	g.admitNext()
+}
+
+// admitNext lets in the first connection in the queue that can go
+// through. In the waiting room, only the ones the host admitted can.
+// It must be called with the lock held
+func (g *connectionGate) admitNext() {
+	if !g.hasRoom() {
+		return
+	}
+
+	for i, q := range g.queue {
+		if g.waitingRoom && !q.approved {
+			continue
+		}
+
+		g.queue = append(g.queue[:i], g.queue[i+1:]...)
+		g.active++
+		q.admitted <- true
+		g.queueChanged()
+		g.participantsChanged(ParticipantConnected)
+		return
+	}
+}
+
+// giveUp removes a connection from the queue when the participant
+// disconnects before being admitted. It returns false if the connection was
+// admitted in the meantime
+func (g *connectionGate) giveUp(q *queuedConnection) bool {
+	g.Lock()
+	defer g.Unlock()
+
+	for i, c := range g.queue {
+		if c == q {
+			g.queue = append(g.queue[:i], g.queue[i+1:]...)
+			g.queueChanged()
+			if g.waitingRoom {
+				g.waitingChanged(ParticipantStoppedWaiting, q)
+			}
+			return true
+		}
+	}
+
+	return false
+}
+
+// wasRejected returns true if the host rejected the connection
+func (g *connectionGate) wasRejected(q *queuedConnection) bool {
+	g.Lock()
+	defer g.Unlock()
+	return q.rejected
+}
+
+// participantsChanged must be called with the lock held
+func (g *connectionGate) participantsChanged(t ParticipantEventType) {
+	g.events.publish(ParticipantEvent{Type: t, Participants: g.active})
+}
+
+// waitingChanged must be called with the lock held
+func (g *connectionGate) waitingChanged(t ParticipantEventType, q *queuedConnection) {
+	g.events.publish(ParticipantEvent{Type: t, Participants: g.active, ID: q.id})
+}
+
+// queueChanged must be called with the lock held
+func (g *connectionGate) queueChanged() {
+	log.WithFields(log.Fields{
+		"active":  g.active,
+		"waiting": len(g.queue),
+	}).Info("connectionGate: the queue of participants changed")
+
+	for _, q := range g.queue {
+		select {
+		case q.moved <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// place returns the text telling the guest their place in the queue
+func (g *connectionGate) place(q *queuedConnection) string {
+	g.Lock()
+	defer g.Unlock()
+
+	place := 1
+	for _, c := range g.queue {
+		if c == q {
+			break
+		}
+		place++
+	}
+	return g.texts.Place(place)
+}
+
+// reserve keeps the slot of the guest admitted from the
+// lobby for a while, so they can connect again
+func (g *connectionGate) reserve() {
+	g.Lock()
+	defer g.Unlock()
+
+	var t *time.Timer
+	t = time.AfterFunc(lobbyReconnectGrace, func() {
+		g.Lock()
+		defer g.Unlock()
+
+		for i, r := range g.reservations {
+			if r == t {
+				g.reservations = append(g.reservations[:i], g.reservations[i+1:]...)
+				g.active--
+				g.participantsChanged(ParticipantDisconnected)
+				g.admitNext()
+				return
+			}
+		}
+	})
+	g.reservations = append(g.reservations, t)
+}
+
+func (g *connectionGate) handle(conn net.Conn) {
+	q := g.enter()
+	// The guests in the waiting room keep their connection, so the
+	// one the host admits is the one reaching the server
+	if q != nil && g.certificate != nil && !g.waitingRoom {
+		defer conn.Close()
+		g.waitInLobby(conn, q)
+		return
+	}
+
+	incoming := readInBackground(conn)
+	defer discard(incoming)
+	defer conn.Close()
+	var pending [][]byte
+	id := 0
+
+	if q != nil {
+		var ok bool
+		pending, ok = waitForAdmission(q, incoming)
+		if !ok && (g.giveUp(q) || g.wasRejected(q)) {
+			return
+		}
+		id = q.id
+	}
+
+	defer g.leave()
+
+	p := g.connect(id, conn)
+	defer g.disconnected(p)
+
+	server, err := net.Dial("tcp", g.target)
+	if err != nil {
+		log.Errorf("connectionGate: can't connect to the Mumble server: %v", err)
+		return
+	}
+	defer server.Close()
+	setKeepAlive(server, g.keepAlive)
+
+	go func() {
+		_, _ = io.Copy(countingWriter{conn, &g.sent}, server)
+		_ = conn.Close()
+	}()
+
+	toServer := countingWriter{countingWriter{server, &g.received}, &p.received}
+
+	for _, data := range pending {
+		if _, err := toServer.Write(data); err != nil {
+			return
+		}
+	}
+
+	for data := range incoming {
+		if _, err := toServer.Write(data); err != nil {
+			return
+		}
+	}
+}
+
+// waitInLobby keeps the guest in the lobby, telling them their place
+// in the queue, until they are admitted or give up
+func (g *connectionGate) waitInLobby(conn net.Conn, q *queuedConnection) {
+	last := g.place(q)
+	l, err := openLobby(conn, *g.certificate, last)
+	if err != nil {
+		log.Debugf("connectionGate: the guest didn't get into the lobby: %v", err)
+		if !g.giveUp(q) && !g.wasRejected(q) {
+			g.leave()
+		}
+		return
+	}
+	defer l.close()
+
+	for {
+		select {
+		case <-q.admitted:
+			g.reserve()
+			l.tell(g.texts.Admitted)
+			return
+		case <-l.done:
+			// The slot of a guest admitted while
+			// leaving is free for the next one
+			if !g.giveUp(q) && !g.wasRejected(q) {
+				g.leave()
+			}
+			return
+		case <-q.moved:
+			if text := g.place(q); text != last {
+				l.tell(text)
+				last = text
+			}
+		}
+	}
+}
+
+func setKeepAlive(conn net.Conn, period time.Duration) {
+	tc, ok := conn.(*net.TCPConn)
+	if !ok || period <= 0 {
+		return
+	}
+
+	if err := tc.SetKeepAlive(true); err != nil {
+		log.Debugf("connectionGate: can't enable keepalive: %v", err)
+		return
+	}
+
+	if err := tc.SetKeepAlivePeriod(period); err != nil {
+		log.Debugf("connectionGate: can't set the keepalive period: %v", err)
+	}
+}
+
+// readInBackground reads everything the participant sends, even while
+// waiting in the queue, so we notice when they give up. The channel is closed
+// when the connection is closed
+func readInBackground(conn net.Conn) chan []byte {
+	result := make(chan []byte, 16)
+
+	go func() {
+		defer close(result)
+		for {
+			buf := make([]byte, 4096)
+			n, err := conn.Read(buf)
+			if n > 0 {
+				result <- buf[:n]
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return result
+}
+
+// discard makes sure the reading goroutine can finish
+// once the connection has been closed
+func discard(incoming chan []byte) {
+	for range incoming {
+	}
+}
+
+// waitForAdmission blocks until the connection is admitted, returning what
+// the participant sent in the meantime. It returns false if the participant
+// disconnected or was rejected before being admitted
+func waitForAdmission(q *queuedConnection, incoming chan []byte) ([][]byte, bool) {
+	var pending [][]byte
+
+	for {
+		select {
+		case admitted := <-q.admitted:
+			return pending, admitted
+		case data, ok := <-incoming:
+			if !ok {
+				return pending, false
+			}
+			pending = append(pending, data)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package hosting
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func startEchoServer(c *C) net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	return l
+}
+
+func connectToGate(c *C, g *connectionGate) net.Conn {
+	conn, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(g.port)))
+	c.Assert(err, IsNil)
+	return conn
+}
+
+func echo(c *C, conn net.Conn, msg string) string {
+	_, err := conn.Write([]byte(msg + "\n"))
+	c.Assert(err, IsNil)
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	answer, _ := bufio.NewReader(conn).ReadString('\n')
+	return answer
+}
+
+func waitUntil(f func() bool) bool {
+	for i := 0; i < 100; i++ {
+		if f() {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
+
+func (h *hostingSuite) Test_connectionGate_forwardsConnectionsWhenThereIsNoLimit(c *C) {
+	target := startEchoServer(c)
+	defer target.Close()
+
+	g, err := newConnectionGate(listenAddress{}, target.Addr().String(), 0)
+	c.Assert(err, IsNil)
+	g.start()
+	defer g.stop()
+
+	conn := connectToGate(c, g)
+	defer conn.Close()
+
+	c.Assert(echo(c, conn, "hello"), Equals, "hello\n")
+}
+
+func (h *hostingSuite) Test_connectionGate_queuesParticipantsWhenTheMeetingIsFull(c *C) {
+	target := startEchoServer(c)
+	defer target.Close()
+
+	g, err := newConnectionGate(listenAddress{}, target.Addr().String(), 1)
+	c.Assert(err, IsNil)
+	g.start()
+	defer g.stop()
+
+	first := connectToGate(c, g)
+	c.Assert(echo(c, first, "first"), Equals, "first\n")
+
+	second := connectToGate(c, g)
+	defer second.Close()
+
+	_, err = second.Write([]byte("second\n"))
+	c.Assert(err, IsNil)
+	c.Assert(waitUntil(func() bool { return g.waiting() == 1 }), Equals, true)
+
+	first.Close()
+
+	_ = second.SetReadDeadline(time.Now().Add(5 * time.Second))
+	answer, _ := bufio.NewReader(second).ReadString('\n')
+	c.Assert(answer, Equals, "second\n")
+	c.Assert(g.waiting(), Equals, 0)
+}
+
+func (h *hostingSuite) Test_connectionGate_removesFromTheQueueParticipantsThatGiveUp(c *C) {
+	target := startEchoServer(c)
+	defer target.Close()
+
+	g, err := newConnectionGate(listenAddress{}, target.Addr().String(), 1)
+	c.Assert(err, IsNil)
+	g.start()
+	defer g.stop()
+
+	first := connectToGate(c, g)
+	defer first.Close()
+	c.Assert(echo(c, first, "first"), Equals, "first\n")
+
+	second := connectToGate(c, g)
+	c.Assert(waitUntil(func() bool { return g.waiting() == 1 }), Equals, true)
+
+	second.Close()
+
+	c.Assert(waitUntil(func() bool { return g.waiting() == 0 }), Equals, true)
+	c.Assert(echo(c, first, "still here"), Equals, "still here\n")
+}
+
+func (h *hostingSuite) Test_connectionGate_forwardsConnectionsWithKeepAliveEnabled(c *C) {
+	target := startEchoServer(c)
+	defer target.Close()
+
+	g, err := newConnectionGate(listenAddress{}, target.Addr().String(), 0)
+	c.Assert(err, IsNil)
+	g.keepAlive = time.Second
+	g.start()
+	defer g.stop()
+
+	conn := connectToGate(c, g)
+	defer conn.Close()
+
+	c.Assert(echo(c, conn, "hello"), Equals, "hello\n")
+}
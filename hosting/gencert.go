@@ -0,0 +1,135 @@
+package hosting
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+)
+
+// CertificateAlgorithm is the kind of key of the self-signed
+// certificate generated for the servers
+type CertificateAlgorithm string
+
+const (
+	// CertificateECDSA uses a P-256 key, which every Mumble client supports
+	CertificateECDSA CertificateAlgorithm = "ecdsa"
+	// CertificateEd25519 uses an Ed25519 key, which needs a recent Mumble client
+	CertificateEd25519 CertificateAlgorithm = "ed25519"
+	// CertificateRSA uses a 4096-bit RSA key, for very old Mumble clients.
+	// It can take several seconds to generate on slow computers
+	CertificateRSA CertificateAlgorithm = "rsa"
+)
+
+// DefaultCertificateAlgorithm is used when no other algorithm is chosen
+const DefaultCertificateAlgorithm = CertificateECDSA
+
+// ParseCertificateAlgorithm returns the algorithm with the given
+// name, or the default one if there is none
+func ParseCertificateAlgorithm(name string) CertificateAlgorithm {
+	switch a := CertificateAlgorithm(name); a {
+	case CertificateECDSA, CertificateEd25519, CertificateRSA:
+		return a
+	}
+	return DefaultCertificateAlgorithm
+}
+
+// WithCertificateAlgorithm chooses the kind of key of the self-signed
+// certificate generated when no certificate is given
+func WithCertificateAlgorithm(a CertificateAlgorithm) CollectionOption {
+	return func(s *servers) {
+		s.certAlgorithm = a
+	}
+}
+
+// certificateValidity is long because the certificate
+// can be kept across restarts
+const certificateValidity = 10 * 365 * 24 * time.Hour
+
+// generateCertificate writes a new self-signed certificate and its key
+// to the data directory of grumble, where it looks for them
+func generateCertificate(a CertificateAlgorithm) error {
+	certFn := filepath.Join(grumbleServer.Args.DataDir, "cert.pem")
+	keyFn := filepath.Join(grumbleServer.Args.DataDir, "key.pem")
+
+	if a == CertificateRSA {
+		return grumbleServer.GenerateSelfSignedCert(certFn, keyFn)
+	}
+
+	cert, priv, err := selfSignedCertificate(a, "Wahay Autogenerated Certificate", x509.ExtKeyUsageServerAuth)
+	if err != nil {
+		return err
+	}
+
+	key, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return err
+	}
+
+	err = writePEM(certFn, "CERTIFICATE", cert)
+	if err != nil {
+		return err
+	}
+
+	return writePEM(keyFn, "PRIVATE KEY", key)
+}
+
+// selfSignedCertificate returns a new DER encoded certificate signed by
+// its own key, which is returned with it
+func selfSignedCertificate(a CertificateAlgorithm, name string, usage x509.ExtKeyUsage) ([]byte, crypto.Signer, error) {
+	pub, priv, err := generateKey(a)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName: name,
+		},
+		NotBefore:   now.Add(-300 * time.Second),
+		NotAfter:    now.Add(certificateValidity),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{usage},
+	}
+
+	cert, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, pub, priv)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, priv, nil
+}
+
+func generateKey(a CertificateAlgorithm) (crypto.PublicKey, crypto.Signer, error) {
+	if a == CertificateEd25519 {
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		return pub, priv, err
+	}
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &priv.PublicKey, priv, nil
+}
+
+func writePEM(fn, blockType string, content []byte) error {
+	return os.WriteFile(fn, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: content}), 0600)
+}
@@ -0,0 +1,50 @@
+package hosting
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"path/filepath"
+
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	. "gopkg.in/check.v1"
+)
+
+func generatedPublicKey(c *C, a CertificateAlgorithm) interface{} {
+	dir := c.MkDir()
+	origDataDir := grumbleServer.Args.DataDir
+	defer func() { grumbleServer.Args.DataDir = origDataDir }()
+	grumbleServer.Args.DataDir = dir
+
+	c.Assert(generateCertificate(a), IsNil)
+
+	pair, err := tls.LoadX509KeyPair(filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem"))
+	c.Assert(err, IsNil)
+
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	c.Assert(err, IsNil)
+
+	return cert.PublicKey
+}
+
+func (h *hostingSuite) Test_generateCertificate_usesTheChosenAlgorithm(c *C) {
+	_, isECDSA := generatedPublicKey(c, CertificateECDSA).(*ecdsa.PublicKey)
+	c.Assert(isECDSA, Equals, true)
+
+	_, isEd25519 := generatedPublicKey(c, CertificateEd25519).(ed25519.PublicKey)
+	c.Assert(isEd25519, Equals, true)
+}
+
+func (h *hostingSuite) Test_generateCertificate_fallsBackToRSA(c *C) {
+	_, isRSA := generatedPublicKey(c, CertificateRSA).(*rsa.PublicKey)
+	c.Assert(isRSA, Equals, true)
+}
+
+func (h *hostingSuite) Test_ParseCertificateAlgorithm_returnsTheDefaultForUnknownNames(c *C) {
+	c.Assert(ParseCertificateAlgorithm("ed25519"), Equals, CertificateEd25519)
+	c.Assert(ParseCertificateAlgorithm("rsa"), Equals, CertificateRSA)
+	c.Assert(ParseCertificateAlgorithm(""), Equals, DefaultCertificateAlgorithm)
+	c.Assert(ParseCertificateAlgorithm("dsa"), Equals, DefaultCertificateAlgorithm)
+}
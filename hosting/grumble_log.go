@@ -0,0 +1,79 @@
+package hosting
+
+import (
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+)
+
+// grumbleLog receives what grumble logs for a server and turns every line
+// into a logrus entry, so it follows the log level and output of Wahay
+type grumbleLog struct {
+	entry *log.Entry
+}
+
+func newGrumbleLog(id int64) *grumbleLog {
+	return &grumbleLog{
+		entry: log.WithFields(log.Fields{
+			"component": "grumble",
+			"server":    id,
+		}),
+	}
+}
+
+// logToWahay makes the server log through Wahay instead of the grumble log file
+func logToWahay(serv *grumbleServer.Server) {
+	serv.Logger.SetOutput(newGrumbleLog(serv.Id))
+	serv.Logger.SetPrefix("")
+	serv.Logger.SetFlags(0)
+}
+
+func (g *grumbleLog) Write(p []byte) (int, error) {
+	for _, line := range strings.Split(strings.TrimRight(string(p), "\n"), "\n") {
+		g.log(line)
+	}
+	return len(p), nil
+}
+
+// clientPrefix is added by grumble to the lines about a participant:
+// their session, their name and their user ID
+var clientPrefix = regexp.MustCompile(`^<(\d+):.*\((-?\d+)\)> `)
+
+func (g *grumbleLog) log(line string) {
+	entry := g.entry
+	if m := clientPrefix.FindStringSubmatch(line); m != nil {
+		entry = entry.WithField("session", m[1])
+		line = line[len(m[0]):]
+	}
+
+	entry.Log(grumbleLogLevel(line), line)
+}
+
+// grumbleLogLevel guesses the level of a line, since grumble logs
+// everything the same way
+func grumbleLogLevel(line string) log.Level {
+	l := strings.ToLower(line)
+
+	switch {
+	case containsAny(l, "error", "unable", "fatal"):
+		return log.ErrorLevel
+	case containsAny(l, "failed", "rejected", "banned", "kick"):
+		return log.WarnLevel
+	case containsAny(l, "started", "stopped", "new connection", "disconnected"):
+		return log.InfoLevel
+	default:
+		return log.DebugLevel
+	}
+}
+
+func containsAny(s string, words ...string) bool {
+	for _, w := range words {
+		if strings.Contains(s, w) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package hosting
+
+import (
+	"io"
+
+	log "github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_grumbleLog_turnsLinesIntoEntriesWithLevels(c *C) {
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+	log.SetOutput(io.Discard)
+	origLevel := log.GetLevel()
+	defer log.SetLevel(origLevel)
+	log.SetLevel(log.DebugLevel)
+
+	g := newGrumbleLog(3)
+	_, err := g.Write([]byte("Started: listening on 127.0.0.1:1234\n"))
+	c.Assert(err, IsNil)
+	_, _ = g.Write([]byte("<2:alice(-1)> TLS handshake failed: EOF\n"))
+
+	c.Assert(hook.Entries, HasLen, 2)
+
+	c.Assert(hook.Entries[0].Level, Equals, log.InfoLevel)
+	c.Assert(hook.Entries[0].Message, Equals, "Started: listening on 127.0.0.1:1234")
+	c.Assert(hook.Entries[0].Data["component"], Equals, "grumble")
+	c.Assert(hook.Entries[0].Data["server"], Equals, int64(3))
+
+	c.Assert(hook.Entries[1].Level, Equals, log.WarnLevel)
+	c.Assert(hook.Entries[1].Message, Equals, "TLS handshake failed: EOF")
+	c.Assert(hook.Entries[1].Data["session"], Equals, "2")
+}
+
+func (h *hostingSuite) Test_grumbleLogLevel_usesDebugForUnknownLines(c *C) {
+	c.Assert(grumbleLogLevel("Unable to broadcast."), Equals, log.ErrorLevel)
+	c.Assert(grumbleLogLevel("Rejected client 127.0.0.1:5555: Banned"), Equals, log.WarnLevel)
+	c.Assert(grumbleLogLevel("Crypt re-sync successful"), Equals, log.DebugLevel)
+}
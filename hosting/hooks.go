@@ -0,0 +1,83 @@
+package hosting
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// String returns the name of the event type, as given to hook scripts
+func (t ParticipantEventType) String() string {
+	switch t {
+	case ParticipantConnected:
+		return "connected"
+	case ParticipantDisconnected:
+		return "disconnected"
+	case ParticipantWaiting:
+		return "waiting"
+	case ParticipantStoppedWaiting:
+		return "stopped-waiting"
+	case UserConnected:
+		return "user-connected"
+	case UserDisconnected:
+		return "user-disconnected"
+	case UserChanged:
+		return "user-changed"
+	case UserRenamed:
+		return "user-renamed"
+	case UserStartedTalking:
+		return "user-started-talking"
+	case UserStoppedTalking:
+		return "user-stopped-talking"
+	}
+	return "unknown"
+}
+
+// OnParticipantEvent calls the hook with every guest joining or leaving the
+// meeting. The hook runs in its own goroutine, one event after the other.
+// It returns a function to call when the hook is no longer needed
+func (s *server) OnParticipantEvent(hook func(ParticipantEvent)) func() {
+	events, stop := s.Subscribe()
+
+	go func() {
+		for ev := range events {
+			hook(ev)
+		}
+	}()
+
+	return stop
+}
+
+// scriptHookTimeout is how long a hook script can run
+// before it's killed, so it doesn't hold back the next events
+const scriptHookTimeout = 30 * time.Second
+
+// ScriptHook returns a hook for OnParticipantEvent that runs the given
+// executable for every event. The event is described in the environment
+// variables WAHAY_EVENT, WAHAY_PARTICIPANTS and WAHAY_PARTICIPANT_ID, so
+// the script can, for example, tell the host when the first guest arrives
+func ScriptHook(path string) func(ParticipantEvent) {
+	return func(ev ParticipantEvent) {
+		ctx, cancel := context.WithTimeout(context.Background(), scriptHookTimeout)
+		defer cancel()
+
+		/* #nosec G204 */
+		cmd := exec.CommandContext(ctx, path)
+		cmd.Env = append(os.Environ(),
+			"WAHAY_EVENT="+ev.Type.String(),
+			"WAHAY_PARTICIPANTS="+strconv.Itoa(ev.Participants),
+			"WAHAY_PARTICIPANT_ID="+strconv.Itoa(ev.ID),
+		)
+
+		if out, err := cmd.CombinedOutput(); err != nil {
+			log.WithFields(log.Fields{
+				"script": path,
+				"output": string(out),
+			}).Errorf("ScriptHook(): %s", err)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package hosting
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_OnParticipantEvent_callsTheHookWithEveryEvent(c *C) {
+	s := &server{events: newParticipantEvents()}
+	received := make(chan ParticipantEvent, 1)
+
+	stop := s.OnParticipantEvent(func(ev ParticipantEvent) {
+		received <- ev
+	})
+	defer stop()
+
+	ev := ParticipantEvent{Type: ParticipantConnected, Participants: 1}
+	s.events.publish(ev)
+
+	c.Assert(nextEvent(c, received), Equals, ev)
+}
+
+func (h *hostingSuite) Test_ScriptHook_describesTheEventToTheScript(c *C) {
+	if runtime.GOOS == "windows" {
+		c.Skip("the hook script is a shell script")
+	}
+
+	dir := c.MkDir()
+	output := filepath.Join(dir, "event")
+	script := filepath.Join(dir, "hook.sh")
+	content := "#!/bin/sh\necho \"$WAHAY_EVENT $WAHAY_PARTICIPANTS $WAHAY_PARTICIPANT_ID\" > " + output + "\n"
+	c.Assert(os.WriteFile(script, []byte(content), 0700), IsNil)
+
+	ScriptHook(script)(ParticipantEvent{Type: ParticipantDisconnected, Participants: 2})
+
+	result, err := os.ReadFile(output)
+	c.Assert(err, IsNil)
+	c.Assert(string(result), Equals, "disconnected 2 0\n")
+}
+
+func (h *hostingSuite) Test_ParticipantEventType_namesTheEvents(c *C) {
+	c.Assert(ParticipantConnected.String(), Equals, "connected")
+	c.Assert(ParticipantDisconnected.String(), Equals, "disconnected")
+	c.Assert(ParticipantWaiting.String(), Equals, "waiting")
+	c.Assert(ParticipantStoppedWaiting.String(), Equals, "stopped-waiting")
+	c.Assert(UserConnected.String(), Equals, "user-connected")
+	c.Assert(UserDisconnected.String(), Equals, "user-disconnected")
+	c.Assert(UserChanged.String(), Equals, "user-changed")
+	c.Assert(UserRenamed.String(), Equals, "user-renamed")
+	c.Assert(UserStartedTalking.String(), Equals, "user-started-talking")
+	c.Assert(UserStoppedTalking.String(), Equals, "user-stopped-talking")
+}
@@ -0,0 +1,42 @@
+package hosting
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// WithIdleShutdown closes the meeting once it has had no guests connected
+// for the given time, so a meeting left running doesn't keep its onion
+// service and server reachable indefinitely. The host connects directly,
+// so they don't keep the meeting open. closed is called with the error
+// of closing the meeting, if any, after it's closed this way
+func WithIdleShutdown(timeout time.Duration, closed func(error)) ServiceOption {
+	return func(o *serviceOptions) {
+		o.idleTimeout = timeout
+		o.onIdleShutdown = closed
+	}
+}
+
+// watchIdleShutdown starts counting the time the meeting has no guests,
+// when an idle shutdown was configured
+func (s *service) watchIdleShutdown(serv Server) {
+	if s.idleTimeout <= 0 {
+		return
+	}
+
+	s.closeLock.Lock()
+	defer s.closeLock.Unlock()
+
+	count := func() int { return serv.Stats().Participants }
+	s.stopIdle = watchIdle(count, s.idleTimeout, s.closeWhenIdle)
+}
+
+func (s *service) closeWhenIdle() {
+	log.WithField("timeout", s.idleTimeout).Info("Closing a meeting that had nobody connected")
+
+	err := s.Close()
+	if s.onIdleShutdown != nil {
+		s.onIdleShutdown(err)
+	}
+}
@@ -0,0 +1,57 @@
+package hosting
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_WithIdleShutdown_closesTheMeetingWithoutGuests(c *C) {
+	defer fastIdleChecks()()
+
+	closed := make(chan error, 1)
+	o := newServiceOptions([]ServiceOption{WithIdleShutdown(10*time.Millisecond, func(err error) {
+		closed <- err
+	})})
+	srvc := &service{idleTimeout: o.idleTimeout, onIdleShutdown: o.onIdleShutdown}
+
+	srvc.watchIdleShutdown(&server{gate: &connectionGate{}})
+
+	select {
+	case err := <-closed:
+		c.Assert(err, IsNil)
+	case <-time.After(5 * time.Second):
+		c.Fatal("the meeting wasn't closed")
+	}
+	srvc.closeLock.Lock()
+	defer srvc.closeLock.Unlock()
+	c.Assert(srvc.closed, Equals, true)
+}
+
+func (h *hostingSuite) Test_WithIdleShutdown_keepsTheMeetingWhileGuestsAreConnected(c *C) {
+	defer fastIdleChecks()()
+
+	closed := make(chan error, 1)
+	srvc := &service{idleTimeout: 10 * time.Millisecond, onIdleShutdown: func(err error) {
+		closed <- err
+	}}
+
+	srvc.watchIdleShutdown(&server{gate: &connectionGate{active: 1}})
+	defer srvc.stopIdle()
+
+	select {
+	case <-closed:
+		c.Fatal("the meeting was closed with a guest connected")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func (h *hostingSuite) Test_Close_doesNothingOnAClosedMeeting(c *C) {
+	srvc := &service{
+		room: &conferenceRoom{server: &server{}},
+	}
+	srvc.closed = true
+
+	c.Assert(srvc.Close(), IsNil)
+	c.Assert(srvc.room, NotNil)
+}
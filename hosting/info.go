@@ -0,0 +1,116 @@
+package hosting
+
+import (
+	"runtime/debug"
+	"strconv"
+	"strings"
+)
+
+const grumbleModule = "github.com/digitalautonomy/grumble"
+
+// Info describes the Mumble servers Wahay hosts, so the participants
+// can be told which Mumble clients are able to join
+type Info struct {
+	// GrumbleVersion is the version of the embedded grumble, or
+	// "unknown" when Wahay was built without module information
+	GrumbleVersion string
+	// ProtocolVersion is the version of the Mumble protocol
+	// the servers announce to the clients
+	ProtocolVersion string
+	// Codecs are the voice codecs the servers can switch to
+	Codecs []string
+	// MinimumClientVersion is the oldest Mumble client that works
+	// with the servers. The audio profiles need the Opus codec
+	MinimumClientVersion string
+}
+
+const (
+	// grumbleProtocolVersion is the version grumble sends to the clients.
+	// It's not exported by grumble, so it must be kept up to date by hand
+	grumbleProtocolVersion = "1.2.5"
+	// opusClientVersion is the first Mumble client with Opus support
+	opusClientVersion = "1.2.4"
+)
+
+// Info returns the versions and the capabilities of the servers
+func (s *servers) Info() Info {
+	return Info{
+		GrumbleVersion:       grumbleVersion(),
+		ProtocolVersion:      grumbleProtocolVersion,
+		Codecs:               []string{"Opus", "CELT 0.7.0"},
+		MinimumClientVersion: opusClientVersion,
+	}
+}
+
+var readBuildInfo = debug.ReadBuildInfo
+
+func grumbleVersion() string {
+	info, ok := readBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path == grumbleModule {
+			if dep.Replace != nil {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+
+	return "unknown"
+}
+
+// SupportsClient returns true if the Mumble client with the given
+// version, like "1.3.4", can join the meetings
+func (i Info) SupportsClient(version string) bool {
+	return compareVersions(version, i.MinimumClientVersion) >= 0
+}
+
+// compareVersions compares two dotted versions number by number. Anything
+// after the numbers, like "1.4.0-rc1", is ignored
+func compareVersions(a, b string) int {
+	as, bs := versionNumbers(a), versionNumbers(b)
+	for len(as) < len(bs) {
+		as = append(as, 0)
+	}
+	for len(bs) < len(as) {
+		bs = append(bs, 0)
+	}
+
+	for i := range as {
+		switch {
+		case as[i] < bs[i]:
+			return -1
+		case as[i] > bs[i]:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func versionNumbers(v string) []int {
+	result := []int{}
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(v), "v"), ".") {
+		end := strings.IndexFunc(part, func(r rune) bool { return r < '0' || r > '9' })
+		if end == 0 {
+			break
+		}
+		if end > 0 {
+			part = part[:end]
+		}
+
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			break
+		}
+		result = append(result, n)
+
+		if end > 0 {
+			break
+		}
+	}
+	return result
+}
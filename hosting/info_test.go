@@ -0,0 +1,52 @@
+package hosting
+
+import (
+	"runtime/debug"
+
+	"github.com/prashantv/gostub"
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_Info_returnsTheVersionOfTheEmbeddedGrumble(c *C) {
+	defer gostub.Stub(&readBuildInfo, func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Deps: []*debug.Module{
+			{Path: "github.com/sirupsen/logrus", Version: "v1.9.0"},
+			{Path: grumbleModule, Version: "v0.1.1"},
+		}}, true
+	}).Reset()
+
+	info := (&servers{}).Info()
+
+	c.Assert(info.GrumbleVersion, Equals, "v0.1.1")
+	c.Assert(info.ProtocolVersion, Equals, "1.2.5")
+	c.Assert(info.Codecs, DeepEquals, []string{"Opus", "CELT 0.7.0"})
+}
+
+func (h *hostingSuite) Test_Info_usesTheReplacedGrumble(c *C) {
+	defer gostub.Stub(&readBuildInfo, func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Deps: []*debug.Module{
+			{Path: grumbleModule, Version: "v0.1.1", Replace: &debug.Module{Version: "v0.1.2-fork"}},
+		}}, true
+	}).Reset()
+
+	c.Assert(grumbleVersion(), Equals, "v0.1.2-fork")
+}
+
+func (h *hostingSuite) Test_Info_doesntKnowTheVersionWithoutBuildInformation(c *C) {
+	defer gostub.Stub(&readBuildInfo, func() (*debug.BuildInfo, bool) {
+		return nil, false
+	}).Reset()
+
+	c.Assert(grumbleVersion(), Equals, "unknown")
+}
+
+func (h *hostingSuite) Test_SupportsClient_needsAClientWithOpus(c *C) {
+	info := Info{MinimumClientVersion: "1.2.4"}
+
+	c.Assert(info.SupportsClient("1.2.3"), Equals, false)
+	c.Assert(info.SupportsClient("1.2.4"), Equals, true)
+	c.Assert(info.SupportsClient("1.3"), Equals, true)
+	c.Assert(info.SupportsClient("v1.4.0-rc1"), Equals, true)
+	c.Assert(info.SupportsClient("1.1.8"), Equals, false)
+	c.Assert(info.SupportsClient(""), Equals, false)
+}
@@ -0,0 +1,152 @@
+package hosting
+
+import (
+	// #nosec
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// InvitationFileExtension is the extension of the invitation files,
+// which can be sent as attachments and opened to join the meeting
+const InvitationFileExtension = ".wahay"
+
+const invitationFileVersion = 1
+
+var (
+	// ErrInvalidInvitationFile is returned when reading a file
+	// that is not an invitation, or is from a newer version of Wahay
+	ErrInvalidInvitationFile = errors.New("the file is not a valid invitation")
+
+	// ErrInvitationPassphrase is returned when reading an encrypted
+	// invitation without the passphrase, or with the wrong one
+	ErrInvitationPassphrase = errors.New("the invitation is encrypted and the passphrase is wrong")
+
+	errInvalidCertificatePEM = errors.New("the certificate is not PEM encoded")
+)
+
+// Invitation is the content of an invitation file
+type Invitation struct {
+	Meeting MeetingData
+	// CertificateFingerprint is the hash of the certificate of the server,
+	// in the format Mumble uses, so the guests can check they reach it
+	CertificateFingerprint string
+}
+
+// invitationFile is the format of the invitation files, which is
+// kept apart from MeetingData so it doesn't change along with it
+type invitationFile struct {
+	Version                int    `json:"version"`
+	MeetingID              string `json:"meetingID"`
+	Port                   int    `json:"port,omitempty"`
+	Username               string `json:"username,omitempty"`
+	Password               string `json:"password,omitempty"`
+	ClientAuthKey          string `json:"clientAuthKey,omitempty"`
+	InvitationToken        string `json:"invitationToken,omitempty"`
+	CertificateFingerprint string `json:"certificateFingerprint,omitempty"`
+	Title                  string `json:"title,omitempty"`
+	Description            string `json:"agenda,omitempty"`
+	HostName               string `json:"host,omitempty"`
+}
+
+// WriteInvitation writes the invitation file. With a passphrase, the file
+// is encrypted with it, and the guests need it to read the invitation
+func WriteInvitation(w io.Writer, inv Invitation, passphrase string) error {
+	content, err := json.MarshalIndent(invitationFile{
+		Version:                invitationFileVersion,
+		MeetingID:              inv.Meeting.MeetingID,
+		Port:                   inv.Meeting.Port,
+		Username:               inv.Meeting.Username,
+		Password:               inv.Meeting.Password,
+		ClientAuthKey:          inv.Meeting.ClientAuthKey,
+		InvitationToken:        inv.Meeting.InvitationToken,
+		CertificateFingerprint: inv.CertificateFingerprint,
+		Title:                  inv.Meeting.Title,
+		Description:            inv.Meeting.Description,
+		HostName:               inv.Meeting.HostName,
+	}, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	if passphrase != "" {
+		content, err = config.EncryptWithPassword(content, passphrase)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = w.Write(content)
+	return err
+}
+
+// IsInvitationEncrypted returns true if the content of the invitation
+// file is encrypted, so the passphrase has to be asked before reading it
+func IsInvitationEncrypted(content []byte) bool {
+	return config.IsEncrypted(content)
+}
+
+// ReadInvitation reads an invitation file, decrypting it with the
+// passphrase if it's encrypted. The port is the default one when
+// the file doesn't have it
+func ReadInvitation(r io.Reader, passphrase string) (*Invitation, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if IsInvitationEncrypted(content) {
+		content, err = config.DecryptWithPassword(content, passphrase)
+		if err != nil {
+			return nil, ErrInvitationPassphrase
+		}
+	}
+
+	var f invitationFile
+	if err := json.Unmarshal(content, &f); err != nil {
+		return nil, ErrInvalidInvitationFile
+	}
+
+	if f.Version < 1 || f.Version > invitationFileVersion || f.MeetingID == "" {
+		return nil, ErrInvalidInvitationFile
+	}
+
+	if f.Port == 0 {
+		f.Port = DefaultPort
+	}
+
+	return &Invitation{
+		Meeting: MeetingData{
+			MeetingID:       f.MeetingID,
+			Port:            f.Port,
+			Username:        f.Username,
+			Password:        f.Password,
+			ClientAuthKey:   f.ClientAuthKey,
+			InvitationToken: f.InvitationToken,
+			MeetingInfo: MeetingInfo{
+				Title:       f.Title,
+				Description: f.Description,
+				HostName:    f.HostName,
+			}.clean(),
+		},
+		CertificateFingerprint: f.CertificateFingerprint,
+	}, nil
+}
+
+// CertificateFingerprint returns the hash Mumble uses to identify
+// the PEM encoded certificate
+func CertificateFingerprint(certPEM []byte) (string, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return "", errInvalidCertificatePEM
+	}
+
+	// #nosec
+	h := sha1.Sum(block.Bytes)
+	return hex.EncodeToString(h[:]), nil
+}
@@ -0,0 +1,82 @@
+package hosting
+
+import (
+	"bytes"
+	// #nosec
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/pem"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_ReadInvitation_readsWhatWriteInvitationWrote(c *C) {
+	inv := Invitation{
+		Meeting: MeetingData{
+			MeetingID:       "abcdef.onion",
+			Port:            1234,
+			Username:        "guest",
+			ClientAuthKey:   "QWERTY",
+			InvitationToken: "TOKEN",
+		},
+		CertificateFingerprint: "0123456789abcdef0123456789abcdef01234567",
+	}
+
+	var b bytes.Buffer
+	c.Assert(WriteInvitation(&b, inv, ""), IsNil)
+	c.Assert(IsInvitationEncrypted(b.Bytes()), Equals, false)
+
+	read, err := ReadInvitation(&b, "")
+	c.Assert(err, IsNil)
+	c.Assert(*read, DeepEquals, inv)
+}
+
+func (h *hostingSuite) Test_ReadInvitation_needsThePassphraseOfEncryptedInvitations(c *C) {
+	inv := Invitation{Meeting: MeetingData{MeetingID: "abcdef.onion", Port: DefaultPort, Password: "secret"}}
+
+	var b bytes.Buffer
+	c.Assert(WriteInvitation(&b, inv, "passphrase"), IsNil)
+	c.Assert(IsInvitationEncrypted(b.Bytes()), Equals, true)
+	c.Assert(strings.Contains(b.String(), "abcdef"), Equals, false)
+
+	_, err := ReadInvitation(bytes.NewReader(b.Bytes()), "wrong")
+	c.Assert(err, Equals, ErrInvitationPassphrase)
+
+	read, err := ReadInvitation(bytes.NewReader(b.Bytes()), "passphrase")
+	c.Assert(err, IsNil)
+	c.Assert(*read, DeepEquals, inv)
+}
+
+func (h *hostingSuite) Test_ReadInvitation_usesTheDefaultPort(c *C) {
+	read, err := ReadInvitation(strings.NewReader(`{"version": 1, "meetingID": "abcdef.onion"}`), "")
+
+	c.Assert(err, IsNil)
+	c.Assert(read.Meeting.Port, Equals, DefaultPort)
+}
+
+func (h *hostingSuite) Test_ReadInvitation_rejectsFilesThatAreNotInvitations(c *C) {
+	for _, content := range []string{
+		"not json",
+		`{"meetingID": "abcdef.onion"}`,
+		`{"version": 2, "meetingID": "abcdef.onion"}`,
+		`{"version": 1}`,
+	} {
+		_, err := ReadInvitation(strings.NewReader(content), "")
+		c.Assert(err, Equals, ErrInvalidInvitationFile, Commentf("%s", content))
+	}
+}
+
+func (h *hostingSuite) Test_CertificateFingerprint_isTheHashMumbleUses(c *C) {
+	cert, _ := generateTestCertificate(c)
+	block, _ := pem.Decode(cert)
+	// #nosec
+	expected := sha1.Sum(block.Bytes)
+
+	fingerprint, err := CertificateFingerprint(cert)
+	c.Assert(err, IsNil)
+	c.Assert(fingerprint, Equals, hex.EncodeToString(expected[:]))
+
+	_, err = CertificateFingerprint([]byte("not a certificate"))
+	c.Assert(err, NotNil)
+}
@@ -0,0 +1,84 @@
+package hosting
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// defaultInviteeNames are the names given to the invitees when the host
+// doesn't choose them, one for each letter so they are easy to tell apart
+var defaultInviteeNames = []string{
+	"Alice", "Bob", "Carol", "Dave", "Erin", "Frank", "Grace", "Heidi",
+	"Ivan", "Judy", "Kim", "Leo", "Mallory", "Niaj", "Olivia", "Peggy",
+	"Quinn", "Rupert", "Sybil", "Trent", "Uma", "Victor", "Wendy", "Xavier",
+	"Yara", "Zoe",
+}
+
+// DefaultInviteeNames returns n different names for the invitees of
+// a meeting. After running out of names, they start again with a number
+func DefaultInviteeNames(n int) []string {
+	result := []string{}
+	for i := 0; i < n; i++ {
+		name := defaultInviteeNames[i%len(defaultInviteeNames)]
+		if round := i / len(defaultInviteeNames); round > 0 {
+			name = fmt.Sprintf("%s %d", name, round+1)
+		}
+		result = append(result, name)
+	}
+	return result
+}
+
+// WithInviteeNames puts a different username in each invitation, so the
+// invitees show up in the meeting with a name the others recognize. With
+// client authorization the names go to the invitations in order and the
+// ones left over are ignored, without it there is one invitation per name
+func WithInviteeNames(names ...string) ServiceOption {
+	return func(o *serviceOptions) {
+		o.inviteeNames = distinctNames(names)
+	}
+}
+
+// distinctNames returns the given names without the empty ones and with
+// a number after the repeated ones, since the Mumble server doesn't let
+// two users in with the same name
+func distinctNames(names []string) []string {
+	result := []string{}
+	seen := map[string]bool{}
+	for _, n := range names {
+		n = strings.TrimSpace(n)
+		if n == "" {
+			continue
+		}
+
+		name := n
+		for i := 2; seen[strings.ToLower(name)]; i++ {
+			name = fmt.Sprintf("%s (%d)", n, i)
+		}
+
+		seen[strings.ToLower(name)] = true
+		result = append(result, name)
+	}
+	return result
+}
+
+// GenerateURLs returns the URL to join the meeting once for each of the
+// given usernames, so every participant joins with their own name. The
+// repeated and empty usernames are handled like in WithInviteeNames
+func (d MeetingData) GenerateURLs(usernames []string) []string {
+	result := []string{}
+	for _, name := range distinctNames(usernames) {
+		d.Username = name
+		result = append(result, d.GenerateURL())
+	}
+	return result
+}
+
+// withUsername returns the meeting address with the given username in it,
+// or the address as it is when there's no username
+func withUsername(meetingURL, username string) string {
+	if username == "" {
+		return meetingURL
+	}
+	return url.User(username).String() + "@" + meetingURL
+}
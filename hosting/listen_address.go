@@ -0,0 +1,73 @@
+package hosting
+
+import (
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+// listenAddress is where the listeners Tor forwards a meeting to
+// are bound. The zero value binds them to the default host
+type listenAddress struct {
+	host string
+	// socket is the path of the unix socket the guests
+	// arrive through, instead of a TCP port
+	socket string
+}
+
+// WithListenAddress binds the listeners Tor forwards the meeting to, the
+// one the guests go through, the certificate server and the connection
+// checker, to the given address instead of the localhost interface. It's
+// for setups where Tor runs in another network namespace or container
+func WithListenAddress(address string) ServiceOption {
+	return func(o *serviceOptions) {
+		o.listen.host = address
+	}
+}
+
+// WithUnixSocket makes the guests arrive through a unix socket at the given
+// path, instead of a TCP port, so Tor can reach the meeting from another
+// container sharing the socket. The socket is removed when the meeting closes
+func WithUnixSocket(path string) ServiceOption {
+	return func(o *serviceOptions) {
+		o.listen.socket = path
+	}
+}
+
+func (a listenAddress) hostOrDefault() string {
+	if a.host != "" {
+		return a.host
+	}
+	return defaultHost()
+}
+
+// listen returns the listener the guests go through and its TCP port,
+// which is zero for a unix socket
+func (a listenAddress) listen() (net.Listener, int, error) {
+	if a.socket != "" {
+		// A socket left by a meeting that didn't close properly would
+		// keep us from listening, and nobody else should be using it
+		if err := os.Remove(a.socket); err != nil && !os.IsNotExist(err) {
+			return nil, 0, err
+		}
+		l, err := net.Listen("unix", a.socket)
+		return l, 0, err
+	}
+
+	port := config.GetRandomPort()
+	l, err := net.Listen("tcp", net.JoinHostPort(a.hostOrDefault(), strconv.Itoa(port)))
+	return l, port, err
+}
+
+// onionPort returns how Tor reaches the listener of the guests
+func (a listenAddress) onionPort(port, servicePort int) tor.OnionPort {
+	return tor.OnionPort{
+		DestinationHost:   a.hostOrDefault(),
+		DestinationPort:   port,
+		DestinationSocket: a.socket,
+		ServicePort:       servicePort,
+	}
+}
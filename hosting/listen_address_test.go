@@ -0,0 +1,58 @@
+package hosting
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_WithListenAddress_bindsTheListenersOfTheMeeting(c *C) {
+	o := newServiceOptions([]ServiceOption{WithListenAddress("10.0.0.2")})
+
+	c.Assert(o.listen.hostOrDefault(), Equals, "10.0.0.2")
+	p := o.listen.onionPort(1234, 80)
+	c.Assert(p.DestinationHost, Equals, "10.0.0.2")
+	c.Assert(p.DestinationPort, Equals, 1234)
+	c.Assert(p.ServicePort, Equals, 80)
+}
+
+func (h *hostingSuite) Test_listenAddress_usesTheDefaultHostWithoutAnAddress(c *C) {
+	c.Assert(listenAddress{}.hostOrDefault(), Equals, defaultHost())
+}
+
+func (h *hostingSuite) Test_WithUnixSocket_letsTheGuestsInThroughTheSocket(c *C) {
+	target := startEchoServer(c)
+	defer target.Close()
+
+	socket := filepath.Join(c.MkDir(), "meeting.sock")
+	o := newServiceOptions([]ServiceOption{WithUnixSocket(socket)})
+
+	g, err := newConnectionGate(o.listen, target.Addr().String(), 0)
+	c.Assert(err, IsNil)
+	g.start()
+	c.Assert(g.port, Equals, 0)
+	c.Assert(o.listen.onionPort(g.port, 80).DestinationSocket, Equals, socket)
+
+	conn, err := net.Dial("unix", socket)
+	c.Assert(err, IsNil)
+	c.Assert(echo(c, conn, "hello"), Equals, "hello\n")
+	conn.Close()
+
+	c.Assert(g.stop(), IsNil)
+	_, err = os.Stat(socket)
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (h *hostingSuite) Test_WithUnixSocket_replacesAStaleSocket(c *C) {
+	socket := filepath.Join(c.MkDir(), "meeting.sock")
+	c.Assert(os.WriteFile(socket, nil, 0600), IsNil)
+
+	l, port, err := listenAddress{socket: socket}.listen()
+	c.Assert(err, IsNil)
+	defer l.Close()
+
+	c.Assert(port, Equals, 0)
+	c.Assert(l.Addr().Network(), Equals, "unix")
+}
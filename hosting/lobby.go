@@ -0,0 +1,177 @@
+package hosting
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	"github.com/golang/protobuf/proto"
+)
+
+// lobbySession is the session the guests have in the lobby,
+// where they are the only user
+const lobbySession = 1
+
+// lobbyReconnectGrace is how long the slot of a guest admitted from the
+// lobby is kept for them. Mumble connects again ten seconds after losing
+// the connection, and going through Tor again takes a while
+const lobbyReconnectGrace = time.Minute
+
+// lobby is the Mumble server the gate plays for a guest waiting in the
+// queue, so their Mumble client can tell them their place in it. What
+// the guest sent can't be handed to the real server afterwards, so the
+// lobby closes the connection when they are admitted and their client
+// connects again, which mumble-web in Tor Browser doesn't do on its own
+type lobby struct {
+	conn   *tls.Conn
+	writes sync.Mutex
+	done   chan struct{}
+}
+
+// serverTLSCertificate returns the certificate and
+// key grumble serves with, from its data directory
+func serverTLSCertificate() (tls.Certificate, error) {
+	return tls.LoadX509KeyPair(
+		filepath.Join(grumbleServer.Args.DataDir, "cert.pem"),
+		filepath.Join(grumbleServer.Args.DataDir, "key.pem"))
+}
+
+// openLobby lets the Mumble client of the guest log in, with the
+// welcome text as the first thing they see
+func openLobby(conn net.Conn, cert tls.Certificate, welcome string) (*lobby, error) {
+	tc := tls.Server(conn, &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequestClientCert,
+	})
+
+	_ = tc.SetDeadline(time.Now().Add(sessionTimeout))
+	username, err := lobbyLogin(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &lobby{conn: tc, done: make(chan struct{})}
+	err = l.send(
+		&mumbleproto.Version{Version: proto.Uint32(mumbleVersion), Release: proto.String("Wahay")},
+		&mumbleproto.ChannelState{ChannelId: proto.Uint32(0), Name: proto.String("Root")},
+		&mumbleproto.UserState{Session: proto.Uint32(lobbySession), Name: proto.String(username), ChannelId: proto.Uint32(0)},
+		&mumbleproto.ServerSync{Session: proto.Uint32(lobbySession), WelcomeText: proto.String(welcome)},
+	)
+	if err != nil {
+		return nil, err
+	}
+	_ = tc.SetDeadline(time.Time{})
+
+	go l.read()
+
+	return l, nil
+}
+
+// lobbyLogin returns the name the guest logs in with
+func lobbyLogin(conn net.Conn) (string, error) {
+	for {
+		kind, data, err := readMessage(conn)
+		if err != nil {
+			return "", err
+		}
+
+		if kind == mumbleproto.MessageAuthenticate {
+			auth := &mumbleproto.Authenticate{}
+			if err := proto.Unmarshal(data, auth); err != nil {
+				return "", err
+			}
+			return auth.GetUsername(), nil
+		}
+	}
+}
+
+// read answers the pings of the client, which drops the connection
+// without them, and ignores everything else, voice included
+func (l *lobby) read() {
+	defer close(l.done)
+
+	for {
+		kind, data, err := readMessage(l.conn)
+		if err != nil {
+			return
+		}
+
+		if kind == mumbleproto.MessagePing {
+			ping := &mumbleproto.Ping{}
+			if unmarshal(data, ping) {
+				_ = l.send(&mumbleproto.Ping{Timestamp: ping.Timestamp})
+			}
+		}
+	}
+}
+
+func (l *lobby) send(msgs ...proto.Message) error {
+	l.writes.Lock()
+	defer l.writes.Unlock()
+
+	for _, msg := range msgs {
+		if err := writeMessage(l.conn, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tell shows the text to the guest, as a message from the server
+func (l *lobby) tell(text string) {
+	err := l.send(&mumbleproto.TextMessage{
+		Session: []uint32{lobbySession},
+		Message: proto.String(text),
+	})
+	if err != nil {
+		log.Debugf("lobby: can't send a message to the guest: %v", err)
+	}
+}
+
+func (l *lobby) close() {
+	_ = l.conn.Close()
+}
+
+// QueueTexts are what the guests waiting for a full meeting read in
+// their Mumble client. They come from the host, in their language
+type QueueTexts struct {
+	// Place tells the guest their place in the queue
+	Place func(place int) string
+	// Admitted tells the guest Mumble will take them into the meeting
+	Admitted string
+}
+
+// WithQueueTexts replaces the English texts the guests in the queue
+// read. The ones left empty stay in English
+func WithQueueTexts(t QueueTexts) ServiceOption {
+	return func(o *serviceOptions) {
+		o.queueTexts = t
+	}
+}
+
+func defaultQueueTexts() QueueTexts {
+	return QueueTexts{
+		Place: func(place int) string {
+			return fmt.Sprintf("The meeting is full. You are number %d in the queue, and will join it as soon as somebody leaves.", place)
+		},
+		Admitted: "It's your turn. Mumble will take you into the meeting in a few seconds.",
+	}
+}
+
+// or returns the texts, with the ones that are missing from the others
+func (t QueueTexts) or(others QueueTexts) QueueTexts {
+	if t.Place == nil {
+		t.Place = others.Place
+	}
+	if t.Admitted == "" {
+		t.Admitted = others.Admitted
+	}
+	return t
+}
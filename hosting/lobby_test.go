@@ -0,0 +1,164 @@
+package hosting
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+func newLobbyTestGate(c *C, capacity int) (*connectionGate, func()) {
+	target := startEchoServer(c)
+
+	g, err := newConnectionGate(listenAddress{}, target.Addr().String(), capacity)
+	c.Assert(err, IsNil)
+
+	der, key, err := selfSignedCertificate(CertificateECDSA, "test", x509.ExtKeyUsageServerAuth)
+	c.Assert(err, IsNil)
+	g.certificate = &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+
+	g.start()
+	return g, func() {
+		_ = g.stop()
+		_ = target.Close()
+	}
+}
+
+// joinLobby logs in like a Mumble client and returns the welcome text
+func joinLobby(c *C, g *connectionGate) (net.Conn, string) {
+	/* #nosec G402 */
+	conn, err := tls.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(g.port)), &tls.Config{InsecureSkipVerify: true})
+	c.Assert(err, IsNil)
+
+	c.Assert(writeMessage(conn, &mumbleproto.Version{Version: proto.Uint32(mumbleVersion)}), IsNil)
+	c.Assert(writeMessage(conn, &mumbleproto.Authenticate{Username: proto.String("guest")}), IsNil)
+
+	sync := &mumbleproto.ServerSync{}
+	c.Assert(proto.Unmarshal(nextLobbyMessage(c, conn, mumbleproto.MessageServerSync), sync), IsNil)
+	return conn, sync.GetWelcomeText()
+}
+
+func nextLobbyMessage(c *C, conn net.Conn, kind uint16) []byte {
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		k, data, err := readMessage(conn)
+		c.Assert(err, IsNil)
+		if k == kind {
+			return data
+		}
+	}
+}
+
+func nextLobbyText(c *C, conn net.Conn) string {
+	msg := &mumbleproto.TextMessage{}
+	c.Assert(proto.Unmarshal(nextLobbyMessage(c, conn, mumbleproto.MessageTextMessage), msg), IsNil)
+	return msg.GetMessage()
+}
+
+func (h *hostingSuite) Test_connectionGate_tellsTheGuestsInTheQueueTheirPlace(c *C) {
+	g, stop := newLobbyTestGate(c, 1)
+	defer stop()
+
+	inside := connectToGate(c, g)
+	defer inside.Close()
+	c.Assert(echo(c, inside, "hello"), Equals, "hello\n")
+
+	first, welcome := joinLobby(c, g)
+	defer first.Close()
+	c.Assert(welcome, Equals, g.texts.Place(1))
+
+	second, welcome := joinLobby(c, g)
+	defer second.Close()
+	c.Assert(welcome, Equals, g.texts.Place(2))
+
+	first.Close()
+	c.Assert(nextLobbyText(c, second), Equals, g.texts.Place(1))
+
+	inside.Close()
+	c.Assert(nextLobbyText(c, second), Equals, g.texts.Admitted)
+	_, _, err := readMessage(second)
+	c.Assert(err, NotNil)
+
+	again := connectToGate(c, g)
+	defer again.Close()
+	c.Assert(echo(c, again, "hello again"), Equals, "hello again\n")
+}
+
+func (h *hostingSuite) Test_connectionGate_answersThePingsOfTheGuestsInTheLobby(c *C) {
+	g, stop := newLobbyTestGate(c, 1)
+	defer stop()
+
+	inside := connectToGate(c, g)
+	defer inside.Close()
+	c.Assert(echo(c, inside, "hello"), Equals, "hello\n")
+
+	guest, _ := joinLobby(c, g)
+	defer guest.Close()
+
+	c.Assert(writeMessage(guest, &mumbleproto.Ping{Timestamp: proto.Uint64(42)}), IsNil)
+	ping := &mumbleproto.Ping{}
+	c.Assert(proto.Unmarshal(nextLobbyMessage(c, guest, mumbleproto.MessagePing), ping), IsNil)
+	c.Assert(ping.GetTimestamp(), Equals, uint64(42))
+}
+
+func (h *hostingSuite) Test_connectionGate_tellsTheGuestsInTheQueueTheTextsOfTheHost(c *C) {
+	g, stop := newLobbyTestGate(c, 1)
+	defer stop()
+	g.texts = QueueTexts{Place: func(place int) string { return fmt.Sprintf("Número %d", place) }}.or(g.texts)
+
+	inside := connectToGate(c, g)
+	defer inside.Close()
+	c.Assert(echo(c, inside, "hello"), Equals, "hello\n")
+
+	guest, welcome := joinLobby(c, g)
+	defer guest.Close()
+	c.Assert(welcome, Equals, "Número 1")
+	c.Assert(g.texts.Admitted, Equals, defaultQueueTexts().Admitted)
+}
+
+func (h *hostingSuite) Test_connectionGate_keepsTheGuestsOfTheWaitingRoomOutOfTheLobby(c *C) {
+	g, stop := newLobbyTestGate(c, 0)
+	defer stop()
+	g.waitingRoom = true
+
+	guest := connectToGate(c, g)
+	defer guest.Close()
+	c.Assert(waitUntil(func() bool { return len(g.waitingForHost()) == 1 }), Equals, true)
+	c.Assert(g.admit(g.waitingForHost()[0]), IsNil)
+	c.Assert(echo(c, guest, "hello"), Equals, "hello\n")
+
+	g.Lock()
+	c.Assert(g.reservations, HasLen, 0)
+	g.Unlock()
+
+	other := connectToGate(c, g)
+	defer other.Close()
+	c.Assert(waitUntil(func() bool { return len(g.waitingForHost()) == 1 }), Equals, true)
+}
+
+func (h *hostingSuite) Test_connectionGate_freesTheSlotNobodyCameBackFor(c *C) {
+	g, stop := newLobbyTestGate(c, 1)
+	defer stop()
+
+	g.Lock()
+	g.active = 1
+	g.Unlock()
+	g.reserve()
+
+	g.Lock()
+	t := g.reservations[0]
+	g.Unlock()
+	c.Assert(t.Reset(time.Millisecond), Equals, true)
+
+	c.Assert(waitUntil(func() bool {
+		g.Lock()
+		defer g.Unlock()
+		return g.active == 0 && len(g.reservations) == 0
+	}), Equals, true)
+}
@@ -0,0 +1,83 @@
+package hosting
+
+import (
+	"net/url"
+	"strings"
+)
+
+// The names of the invitation parameters that
+// carry the details of the meeting
+const (
+	titleParameter       = "title"
+	descriptionParameter = "agenda"
+	hostNameParameter    = "host"
+)
+
+const (
+	// maxTitleLength and maxDescriptionLength, in characters, keep the
+	// details of an invitation from taking over the join confirmation
+	maxTitleLength       = 100
+	maxDescriptionLength = 1000
+)
+
+// MeetingInfo are the optional details of a meeting that go in its
+// invitations, so the guests can tell which of several invitations
+// is which before joining
+type MeetingInfo struct {
+	Title string
+	// Description is the agenda of the meeting
+	Description string
+	// HostName is the name the host shows to the participants
+	HostName string
+}
+
+// SetMeetingInfo puts the given details in the invitations to the
+// meeting. The title is the name of the server the Mumble clients
+// show, so it must be set before the meeting starts
+func (s *service) SetMeetingInfo(info MeetingInfo) {
+	s.info = info.clean()
+}
+
+// IsEmpty returns true when the meeting has no details
+func (i MeetingInfo) IsEmpty() bool {
+	return i.Title == "" && i.Description == "" && i.HostName == ""
+}
+
+// clean returns the details without surrounding spaces
+// and cut down to the size shown to the guests
+func (i MeetingInfo) clean() MeetingInfo {
+	return MeetingInfo{
+		Title:       truncate(strings.TrimSpace(i.Title), maxTitleLength),
+		Description: truncate(strings.TrimSpace(i.Description), maxDescriptionLength),
+		HostName:    truncate(strings.TrimSpace(i.HostName), maxTitleLength),
+	}
+}
+
+func truncate(s string, length int) string {
+	r := []rune(s)
+	if len(r) <= length {
+		return s
+	}
+	return string(r[:length])
+}
+
+// addTo sets the parameters of the details the meeting has
+func (i MeetingInfo) addTo(params url.Values) {
+	for name, value := range map[string]string{
+		titleParameter:       i.Title,
+		descriptionParameter: i.Description,
+		hostNameParameter:    i.HostName,
+	} {
+		if value != "" {
+			params.Set(name, value)
+		}
+	}
+}
+
+func meetingInfoFrom(params url.Values) MeetingInfo {
+	return MeetingInfo{
+		Title:       params.Get(titleParameter),
+		Description: params.Get(descriptionParameter),
+		HostName:    params.Get(hostNameParameter),
+	}.clean()
+}
@@ -0,0 +1,55 @@
+package hosting
+
+import (
+	"bytes"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_MeetingData_GenerateURL_includesTheMeetingInfo(c *C) {
+	d := MeetingData{
+		MeetingID: "abcdef.onion",
+		Port:      DefaultPort,
+		MeetingInfo: MeetingInfo{
+			Title:       "Weekly sync",
+			Description: "Budget & hiring",
+			HostName:    "Ana",
+		},
+	}
+
+	u := d.GenerateURL()
+	c.Assert(u, Equals, "mumble://abcdef.onion?agenda=Budget+%26+hiring&host=Ana&title=Weekly+sync")
+
+	parsed, err := ParseURL(u)
+	c.Assert(err, IsNil)
+	c.Assert(parsed.MeetingInfo, DeepEquals, d.MeetingInfo)
+}
+
+func (h *hostingSuite) Test_ParseURL_cutsDownTheMeetingInfo(c *C) {
+	parsed, err := ParseURL("abcdef.onion?title=" + strings.Repeat("a", 150) + "&host=+Ana+")
+
+	c.Assert(err, IsNil)
+	c.Assert(parsed.Title, HasLen, maxTitleLength)
+	c.Assert(parsed.HostName, Equals, "Ana")
+	c.Assert(parsed.MeetingInfo.IsEmpty(), Equals, false)
+}
+
+func (h *hostingSuite) Test_service_Invitations_includeTheMeetingInfo(c *C) {
+	s := &service{mumblePort: DefaultPort, onion: &onionMock{id: "abcdef.onion"}}
+	s.SetMeetingInfo(MeetingInfo{Title: " Weekly sync "})
+
+	c.Assert(s.Invitations(), DeepEquals, []string{"abcdef.onion?title=Weekly+sync"})
+}
+
+func (h *hostingSuite) Test_ReadInvitation_keepsTheMeetingInfo(c *C) {
+	info := MeetingInfo{Title: "Weekly sync", Description: "Budget", HostName: "Ana"}
+	inv := Invitation{Meeting: MeetingData{MeetingID: "abcdef.onion", Port: DefaultPort, MeetingInfo: info}}
+
+	var b bytes.Buffer
+	c.Assert(WriteInvitation(&b, inv, ""), IsNil)
+
+	read, err := ReadInvitation(&b, "")
+	c.Assert(err, IsNil)
+	c.Assert(read.Meeting.MeetingInfo, DeepEquals, info)
+}
@@ -0,0 +1,133 @@
+package hosting
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// DefaultQRSize is the width and height, in pixels, of the invitation
+// QR codes, big enough to be scanned from the screen by a phone
+const DefaultQRSize = 256
+
+// ErrInvalidMeetingURL is returned when parsing a meeting URL that is
+// malformed or not a Mumble URL. The error says what is wrong with it
+var ErrInvalidMeetingURL = errors.New("invalid meeting URL")
+
+const (
+	meetingURLScheme = "mumble"
+	// wahayURLScheme is accepted like mumble:// so the links to meetings
+	// open in Wahay even when another Mumble client handles mumble://
+	wahayURLScheme = "wahay"
+)
+
+// GenerateURL returns the mumble:// URL to join the meeting, which Mumble
+// clients open directly. The port is left out when it's the default one,
+// and the client authorization key, the signature and the details of the
+// meeting go in the same parameters as the invitations, so ParseURL gets
+// back the same meeting data
+func (d MeetingData) GenerateURL() string {
+	u := url.URL{
+		Scheme: meetingURLScheme,
+		Host:   d.host(),
+	}
+
+	params := invitationParameters(d.ClientAuthKey, d.InvitationToken)
+	d.MeetingInfo.addTo(params)
+	u.RawQuery = params.Encode()
+
+	if d.Password != "" {
+		u.User = url.UserPassword(d.Username, d.Password)
+	} else if d.Username != "" {
+		u.User = url.User(d.Username)
+	}
+
+	return u.String()
+}
+
+func (d MeetingData) host() string {
+	if d.Port != 0 && d.Port != DefaultPort {
+		return net.JoinHostPort(d.MeetingID, strconv.Itoa(d.Port))
+	}
+
+	if strings.Contains(d.MeetingID, ":") {
+		return "[" + d.MeetingID + "]"
+	}
+
+	return d.MeetingID
+}
+
+// ParseURL returns the meeting data of a mumble:// or wahay:// URL, or of
+// a bare address like the ones in the invitations, with the user, password,
+// port, client authorization key, signature and meeting details it has. The
+// port is the default one when the URL doesn't have it. The path and other
+// parameters of the URL, like the channel or the version of Mumble, are ignored
+func ParseURL(meetingURL string) (*MeetingData, error) {
+	meetingURL = strings.TrimSpace(meetingURL)
+	if meetingURL == "" {
+		return nil, fmt.Errorf("%w: it's empty", ErrInvalidMeetingURL)
+	}
+
+	if !strings.Contains(meetingURL, "://") {
+		meetingURL = meetingURLScheme + "://" + meetingURL
+	}
+
+	u, err := url.Parse(meetingURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMeetingURL, errors.Unwrap(err))
+	}
+
+	if u.Scheme != meetingURLScheme && u.Scheme != wahayURLScheme {
+		return nil, fmt.Errorf("%w: the scheme must be %s, not %s", ErrInvalidMeetingURL, meetingURLScheme, u.Scheme)
+	}
+
+	d := &MeetingData{
+		MeetingID: u.Hostname(),
+		Port:      DefaultPort,
+	}
+
+	if d.MeetingID == "" {
+		return nil, fmt.Errorf("%w: it has no meeting ID", ErrInvalidMeetingURL)
+	}
+
+	if p := u.Port(); p != "" {
+		port, err := strconv.Atoi(p)
+		if err != nil || port < 1 || port > 65535 {
+			return nil, fmt.Errorf("%w: the port %s is not valid", ErrInvalidMeetingURL, p)
+		}
+		d.Port = port
+	}
+
+	if u.User != nil {
+		d.Username = u.User.Username()
+		d.Password, _ = u.User.Password()
+	}
+
+	q, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMeetingURL, err)
+	}
+	d.ClientAuthKey = q.Get(clientAuthParameter)
+	d.InvitationToken = q.Get(invitationTokenParameter)
+	d.MeetingInfo = meetingInfoFrom(q)
+
+	return d, nil
+}
+
+// GenerateQR returns a QR code of size pixels with the URL to join the
+// meeting, so the guests using Mumble on a phone can scan it instead of
+// typing the onion address
+func (d MeetingData) GenerateQR(size int) (image.Image, error) {
+	q, err := qrcode.New(d.GenerateURL(), qrcode.Medium)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Image(size), nil
+}
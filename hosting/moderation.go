@@ -0,0 +1,344 @@
+package hosting
+
+import (
+	"encoding/hex"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/grumble/pkg/acl"
+	"github.com/digitalautonomy/grumble/pkg/ban"
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+)
+
+// WithCoHost designates the participant using the Mumble certificate with
+// the given SHA1 hash as co-host. The co-host takes over the moderation of
+// the meeting while the host is disconnected
+func WithCoHost(certHash string) ServiceOption {
+	return func(o *serviceOptions) {
+		o.coHost = strings.ToLower(strings.TrimSpace(certHash))
+	}
+}
+
+var errNoConferenceRoom = errors.New("the meeting has not started")
+
+// HandOffModeration gives the co-host full moderation rights, tells the
+// notice to the participants in the meeting and changes the welcome text
+// to it, which is what participants see when they connect again. It does
+// nothing if there is no co-host
+func (s *service) HandOffModeration(notice string) error {
+	if s.coHost == "" {
+		return nil
+	}
+
+	if s.room == nil {
+		return errNoConferenceRoom
+	}
+
+	log.WithField("cohost", s.coHost).Info("Handing off the moderation of the meeting")
+
+	if err := s.room.server.GrantModeration(s.coHost); err != nil {
+		return err
+	}
+	s.room.server.SetWelcomeText(notice)
+
+	// The co-host moderates already, even if nobody was told
+	if err := s.room.server.SendMessage(notice); err != nil {
+		log.Errorf("The participants couldn't be told the co-host moderates the meeting: %s", err)
+	}
+
+	return nil
+}
+
+// RestoreModeration takes back the rights given to the co-host by
+// HandOffModeration, once the host is connected again
+func (s *service) RestoreModeration() error {
+	if s.coHost == "" {
+		return nil
+	}
+
+	if s.room == nil {
+		return errNoConferenceRoom
+	}
+
+	log.WithField("cohost", s.coHost).Info("Restoring the moderation of the meeting")
+
+	if err := s.room.server.RevokeModeration(s.coHost); err != nil {
+		return err
+	}
+	s.room.server.SetWelcomeText(s.welcomeText)
+
+	return nil
+}
+
+// moderationACL gives all permissions, in every channel, to the client
+// with the given certificate hash, even if they are not registered
+func moderationACL(certHash string) acl.ACL {
+	return acl.ACL{
+		UserId:    -1,
+		Group:     "$" + certHash,
+		ApplyHere: true,
+		ApplySubs: true,
+		Allow:     acl.AllPermissions,
+	}
+}
+
+// GrantModeration gives full permissions to the client using the
+// certificate with the given hash
+func (s *server) GrantModeration(certHash string) error {
+	entry := moderationACL(certHash)
+
+	return s.changeACLs(s.gs.RootChannel(), func(acls []acl.ACL) []acl.ACL {
+		for _, a := range acls {
+			if a == entry {
+				return acls
+			}
+		}
+		return append(acls, entry)
+	})
+}
+
+// RevokeModeration removes the permissions given by GrantModeration
+func (s *server) RevokeModeration(certHash string) error {
+	entry := moderationACL(certHash)
+
+	return s.changeACLs(s.gs.RootChannel(), func(acls []acl.ACL) []acl.ACL {
+		result := []acl.ACL{}
+		for _, a := range acls {
+			if a != entry {
+				result = append(result, a)
+			}
+		}
+		return result
+	})
+}
+
+// changeACLs replaces the ACLs of the channel with the result of change.
+// Grumble checks the permissions from its own goroutines while it runs, so
+// a running server gets them through its own handling of ACLs, like the bans
+func (s *server) changeACLs(ch *grumbleServer.Channel, change func([]acl.ACL) []acl.ACL) error {
+	s.aclChanges.Lock()
+	defer s.aclChanges.Unlock()
+
+	s.Lock()
+	if !s.running {
+		defer s.Unlock()
+		ch.ACL.ACLs = change(ch.ACL.ACLs)
+		s.gs.ClearCaches()
+		return nil
+	}
+	// A channel created while the server runs has
+	// no ACLs, unless they were set from here
+	acls := change(append([]acl.ACL{}, s.acls[ch.Id]...))
+	s.Unlock()
+
+	c, err := s.moderator()
+	if err != nil {
+		return err
+	}
+
+	if err := c.setACLs(uint32(ch.Id), acls); err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if s.acls != nil {
+		s.acls[ch.Id] = acls
+	}
+	return nil
+}
+
+// channelACLs returns the ACLs of the channel as Wahay set them
+func (s *server) channelACLs(ch *grumbleServer.Channel) []acl.ACL {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.running {
+		return s.acls[ch.Id]
+	}
+	return ch.ACL.ACLs
+}
+
+// ErrInvalidCertificateHash is returned when a certificate hash is not
+// the hex encoded SHA1 hash Mumble uses to identify participants
+var ErrInvalidCertificateHash = errors.New("the certificate hash is not valid")
+
+var errInvalidBanDuration = errors.New("the duration of a ban can't be negative")
+
+func normalizeCertHash(certHash string) (string, error) {
+	certHash = strings.ToLower(strings.TrimSpace(certHash))
+
+	decoded, err := hex.DecodeString(certHash)
+	if err != nil || len(decoded) != 20 {
+		return "", ErrInvalidCertificateHash
+	}
+
+	return certHash, nil
+}
+
+func certificateBan(certHash string, duration time.Duration) ban.Ban {
+	return ban.Ban{
+		// Grumble also matches every ban against the address of the
+		// connections, and a ban without an address matches all of them
+		IP:       net.IPv6unspecified,
+		Mask:     128,
+		CertHash: certHash,
+		Reason:   "Banned by the host of the meeting",
+		Start:    time.Now().Unix(),
+		// Grumble counts in seconds and takes zero as forever
+		Duration: uint32((duration + time.Second - 1) / time.Second),
+	}
+}
+
+// Ban keeps the client using the certificate with the given hash out of
+// the meeting for the given duration, or until the meeting ends if the
+// duration is zero. With a ban list, bans without a duration also apply
+// to the next meetings. Grumble checks the bans when a client connects,
+// so the users already in the meeting with that certificate are kicked
+func (s *server) Ban(certHash string, duration time.Duration) error {
+	certHash, err := normalizeCertHash(certHash)
+	if err != nil {
+		return err
+	}
+
+	if duration < 0 {
+		return errInvalidBanDuration
+	}
+
+	b := certificateBan(certHash, duration)
+	err = s.changeBans(func(bans []ban.Ban) []ban.Ban {
+		return withBan(bans, b)
+	})
+	if err != nil {
+		return err
+	}
+
+	if duration == 0 && s.banList != nil {
+		s.banList.BanCertificate(certHash)
+	}
+
+	log.WithField("certHash", certHash).Info("A participant has been banned from the meeting")
+
+	for _, u := range s.Users() {
+		if u.CertHash == certHash {
+			if err := s.Kick(u.Session, b.Reason); err != nil {
+				log.Errorf("Ban(): %s", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// withBan adds the ban, replacing the one of the
+// same certificate, if there is one
+func withBan(bans []ban.Ban, b ban.Ban) []ban.Ban {
+	return append([]ban.Ban{b}, withoutCertificate(bans, b.CertHash)...)
+}
+
+// withoutCertificate returns the bans that are not for the given certificate
+func withoutCertificate(bans []ban.Ban, certHash string) []ban.Ban {
+	result := []ban.Ban{}
+	for _, existing := range bans {
+		if existing.CertHash != certHash {
+			result = append(result, existing)
+		}
+	}
+	return result
+}
+
+// changeBans replaces the bans of the server with the result of change.
+// Grumble checks the bans from its own goroutines while it runs, so a
+// running server gets them through its own handling of ban lists, the
+// same a Mumble client with the permission to ban uses
+func (s *server) changeBans(change func([]ban.Ban) []ban.Ban) error {
+	s.Lock()
+	if !s.running {
+		defer s.Unlock()
+		s.gs.Bans = change(s.gs.Bans)
+		return nil
+	}
+	s.Unlock()
+
+	c, err := s.moderator()
+	if err != nil {
+		return err
+	}
+	return c.changeBans(change)
+}
+
+// Unban lets the client using the certificate with the given hash
+// join the meeting again
+func (s *server) Unban(certHash string) error {
+	certHash, err := normalizeCertHash(certHash)
+	if err != nil {
+		return err
+	}
+
+	err = s.changeBans(func(bans []ban.Ban) []ban.Ban {
+		return withoutCertificate(bans, certHash)
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.banList != nil {
+		s.banList.UnbanCertificate(certHash)
+	}
+
+	return nil
+}
+
+// Users returns everybody connected to the Mumble server of the meeting,
+// the host included, or nothing if the server is not running
+func (s *server) Users() []User {
+	c, err := s.moderator()
+	if err != nil {
+		return nil
+	}
+	return c.connectedUsers()
+}
+
+// Kick disconnects the user with the given session from the meeting, with
+// the reason their Mumble client shows. Their client can connect again,
+// so a user that must not come back has to be banned instead
+func (s *server) Kick(session uint32, reason string) error {
+	c, err := s.moderator()
+	if err != nil {
+		return err
+	}
+
+	err = c.kick(session, reason)
+	if err == nil {
+		log.WithField("session", session).Info("A user has been kicked from the meeting")
+	}
+	return err
+}
+
+// Mute keeps the user with the given session from talking in the meeting
+// until they are unmuted. Unlike muting themselves, they can't undo it
+func (s *server) Mute(session uint32) error {
+	c, err := s.moderator()
+	if err != nil {
+		return err
+	}
+	return c.mute(session, true)
+}
+
+// Unmute lets the user with the given session talk again
+func (s *server) Unmute(session uint32) error {
+	c, err := s.moderator()
+	if err != nil {
+		return err
+	}
+	return c.mute(session, false)
+}
+
+// SetWelcomeText changes the text shown to the clients when they connect
+func (s *server) SetWelcomeText(t string) {
+	s.gs.Set("WelcomeText", t)
+}
@@ -0,0 +1,167 @@
+package hosting
+
+import (
+	"net"
+	"time"
+
+	"github.com/digitalautonomy/grumble/pkg/acl"
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	. "gopkg.in/check.v1"
+)
+
+func newTestConferenceRoom(c *C) (*conferenceRoom, *grumbleServer.Server) {
+	gs, err := grumbleServer.NewServer(1)
+	c.Assert(err, IsNil)
+
+	return &conferenceRoom{server: &server{gs: gs}}, gs
+}
+
+func (h *hostingSuite) Test_HandOffModeration_givesAllPermissionsToTheCoHost(c *C) {
+	room, gs := newTestConferenceRoom(c)
+	srvc := &service{room: room, coHost: "abcdef"}
+
+	err := srvc.HandOffModeration("the host is away")
+	c.Assert(err, IsNil)
+
+	c.Assert(gs.RootChannel().ACL.ACLs, DeepEquals, []acl.ACL{
+		{
+			UserId:    -1,
+			Group:     "$abcdef",
+			ApplyHere: true,
+			ApplySubs: true,
+			Allow:     acl.AllPermissions,
+		},
+	})
+}
+
+func (h *hostingSuite) Test_HandOffModeration_tellsTheNoticeToTheParticipants(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	cert, _, err := newSessionCertificate()
+	c.Assert(err, IsNil)
+	serverCert, err := serverCertificate()
+	c.Assert(err, IsNil)
+
+	received := newChatMessages()
+	messages, stopMessages := received.subscribe()
+	defer stopMessages()
+
+	guest, err := dialSession(serv.localAddress(), cert, serverCert, "", nil, received, nil)
+	c.Assert(err, IsNil)
+	defer guest.close()
+
+	srvc := &service{room: &conferenceRoom{server: serv}, coHost: "abcdef"}
+	c.Assert(srvc.HandOffModeration("the host is away"), IsNil)
+
+	msg := nextMessage(c, messages)
+	c.Assert(msg.From.Name, Equals, sessionUsername)
+	c.Assert(msg.Text, Equals, "the host is away")
+}
+
+func (h *hostingSuite) Test_HandOffModeration_doesntGrantThePermissionsTwice(c *C) {
+	room, gs := newTestConferenceRoom(c)
+	srvc := &service{room: room, coHost: "abcdef"}
+
+	c.Assert(srvc.HandOffModeration("the host is away"), IsNil)
+	c.Assert(srvc.HandOffModeration("the host is away"), IsNil)
+
+	c.Assert(gs.RootChannel().ACL.ACLs, HasLen, 1)
+}
+
+func (h *hostingSuite) Test_RestoreModeration_removesThePermissionsOfTheCoHost(c *C) {
+	room, gs := newTestConferenceRoom(c)
+	other := acl.ACL{UserId: 3, ApplyHere: true, Allow: acl.SpeakPermission}
+	gs.RootChannel().ACL.ACLs = []acl.ACL{other}
+	srvc := &service{room: room, coHost: "abcdef"}
+
+	c.Assert(srvc.HandOffModeration("the host is away"), IsNil)
+	c.Assert(srvc.RestoreModeration(), IsNil)
+
+	c.Assert(gs.RootChannel().ACL.ACLs, DeepEquals, []acl.ACL{other})
+}
+
+func (h *hostingSuite) Test_HandOffModeration_doesNothingWithoutCoHost(c *C) {
+	srvc := &service{}
+
+	c.Assert(srvc.HandOffModeration("the host is away"), IsNil)
+	c.Assert(srvc.RestoreModeration(), IsNil)
+}
+
+func (h *hostingSuite) Test_HandOffModeration_failsBeforeTheMeetingStarts(c *C) {
+	srvc := &service{coHost: "abcdef"}
+
+	c.Assert(srvc.HandOffModeration("the host is away"), Equals, errNoConferenceRoom)
+}
+
+func (h *hostingSuite) Test_WithCoHost_normalizesTheCertificateHash(c *C) {
+	o := newServiceOptions([]ServiceOption{WithCoHost(" ABCDEF\n")})
+
+	c.Assert(o.coHost, Equals, "abcdef")
+}
+
+const testCertHash = "0123456789abcdef0123456789abcdef01234567"
+
+func (h *hostingSuite) Test_Ban_keepsTheClientOutOfTheMeeting(c *C) {
+	s := newTestServer(c)
+
+	c.Assert(s.Ban(" 0123456789ABCDEF0123456789ABCDEF01234567 ", time.Hour), IsNil)
+
+	c.Assert(s.gs.IsCertHashBanned(testCertHash), Equals, true)
+	c.Assert(s.gs.Bans, HasLen, 1)
+	c.Assert(s.gs.Bans[0].Duration, Equals, uint32(3600))
+}
+
+type connFromAddress struct {
+	net.Conn
+	addr net.Addr
+}
+
+func (c connFromAddress) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+func (h *hostingSuite) Test_Ban_doesntKeepOutTheOtherClients(c *C) {
+	s := newTestServer(c)
+
+	c.Assert(s.Ban(testCertHash, time.Hour), IsNil)
+
+	for _, ip := range []net.IP{{127, 0, 0, 1}, net.ParseIP("127.0.0.1"), net.IPv6loopback} {
+		conn := connFromAddress{addr: &net.TCPAddr{IP: ip, Port: 1234}}
+		c.Assert(s.gs.IsConnectionBanned(conn), Equals, false)
+	}
+}
+
+func (h *hostingSuite) Test_Ban_replacesThePreviousBanOfTheSameClient(c *C) {
+	s := newTestServer(c)
+
+	c.Assert(s.Ban(testCertHash, time.Hour), IsNil)
+	c.Assert(s.Ban(testCertHash, 0), IsNil)
+
+	c.Assert(s.gs.Bans, HasLen, 1)
+	c.Assert(s.gs.Bans[0].Duration, Equals, uint32(0))
+}
+
+func (h *hostingSuite) Test_Ban_doesntTurnShortBansIntoPermanentOnes(c *C) {
+	s := newTestServer(c)
+
+	c.Assert(s.Ban(testCertHash, 500*time.Millisecond), IsNil)
+	c.Assert(s.gs.Bans[0].Duration, Equals, uint32(1))
+}
+
+func (h *hostingSuite) Test_Ban_rejectsInvalidArguments(c *C) {
+	s := newTestServer(c)
+
+	c.Assert(s.Ban("not a hash", time.Hour), Equals, ErrInvalidCertificateHash)
+	c.Assert(s.Ban(testCertHash, -time.Hour), Equals, errInvalidBanDuration)
+	c.Assert(s.gs.Bans, HasLen, 0)
+}
+
+func (h *hostingSuite) Test_Unban_letsTheClientJoinAgain(c *C) {
+	s := newTestServer(c)
+	c.Assert(s.Ban(testCertHash, 0), IsNil)
+
+	c.Assert(s.Unban(testCertHash), IsNil)
+
+	c.Assert(s.gs.IsCertHashBanned(testCertHash), Equals, false)
+}
@@ -0,0 +1,56 @@
+package hosting
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/golang/protobuf/proto"
+)
+
+// mumbleVersion is the version of the Mumble protocol Wahay speaks, 1.2.4
+const mumbleVersion = 1<<16 | 2<<8 | 4
+
+// maxMessageLength is the longest message of the Mumble
+// protocol read, which is what the Mumble clients accept
+const maxMessageLength = 8 * 1024 * 1024
+
+var errMessageTooLong = errors.New("the Mumble message is too long")
+
+// writeMessage sends a message of the Mumble protocol, which goes
+// as its type and length followed by its protobuf encoding
+func writeMessage(w io.Writer, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, 6, 6+len(data))
+	binary.BigEndian.PutUint16(buf, mumbleproto.MessageType(msg))
+	binary.BigEndian.PutUint32(buf[2:], uint32(len(data)))
+
+	_, err = w.Write(append(buf, data...))
+	return err
+}
+
+// readMessage returns the type and the protobuf encoding
+// of the next message of the Mumble protocol
+func readMessage(r io.Reader) (uint16, []byte, error) {
+	header := make([]byte, 6)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, err
+	}
+
+	length := binary.BigEndian.Uint32(header[2:])
+	if length > maxMessageLength {
+		return 0, nil, errMessageTooLong
+	}
+
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return 0, nil, err
+	}
+
+	return binary.BigEndian.Uint16(header), data, nil
+}
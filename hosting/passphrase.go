@@ -0,0 +1,91 @@
+package hosting
+
+import (
+	"crypto/rand"
+	"embed"
+	"math"
+	"math/big"
+	"path"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	// DefaultPassphraseEntropy is the strength, in bits, of the generated
+	// passphrases when no other was chosen. Guessing the password of a
+	// meeting goes through Tor, which makes every attempt slow
+	DefaultPassphraseEntropy = 40
+
+	// maxPassphraseEntropy keeps a mistaken setting from
+	// generating passphrases nobody can read out
+	maxPassphraseEntropy = 128
+
+	passphraseSeparator = "-"
+	wordlistsDirectory  = "files/wordlists"
+)
+
+//go:embed files/wordlists
+var wordlistFiles embed.FS
+
+// wordlistLanguages are the languages with a wordlist, the first
+// one is used for any other language
+var wordlistLanguages = []language.Tag{
+	language.English,
+	language.Spanish,
+	language.French,
+	language.Swedish,
+	language.Arabic,
+}
+
+var wordlistMatcher = language.NewMatcher(wordlistLanguages)
+
+func wordlistFor(lang language.Tag) ([]string, error) {
+	_, i, _ := wordlistMatcher.Match(lang)
+	base, _ := wordlistLanguages[i].Base()
+
+	content, err := wordlistFiles.ReadFile(path.Join(wordlistsDirectory, base.String()+".txt"))
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Fields(string(content)), nil
+}
+
+// passphraseWords returns how many words from a list of the given
+// size are needed to reach the entropy
+func passphraseWords(entropy, listSize int) int {
+	if entropy <= 0 {
+		entropy = DefaultPassphraseEntropy
+	}
+	if entropy > maxPassphraseEntropy {
+		entropy = maxPassphraseEntropy
+	}
+
+	return int(math.Ceil(float64(entropy) / math.Log2(float64(listSize))))
+}
+
+// GeneratePassphrase returns a passphrase of common words in the given
+// language, like diceware ones, with at least the given entropy in bits.
+// They are easier to read out over a phone call than random characters.
+// An entropy of 0 or less uses DefaultPassphraseEntropy
+func GeneratePassphrase(lang language.Tag, entropy int) (string, error) {
+	words, err := wordlistFor(lang)
+	if err != nil {
+		return "", err
+	}
+
+	n := passphraseWords(entropy, len(words))
+	size := big.NewInt(int64(len(words)))
+
+	result := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		w, err := rand.Int(rand.Reader, size)
+		if err != nil {
+			return "", err
+		}
+		result = append(result, words[w.Int64()])
+	}
+
+	return strings.Join(result, passphraseSeparator), nil
+}
@@ -0,0 +1,58 @@
+package hosting
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_GeneratePassphrase_usesEnoughWordsForTheEntropy(c *C) {
+	p, err := GeneratePassphrase(language.English, 40)
+	c.Assert(err, IsNil)
+
+	words, _ := wordlistFor(language.English)
+	c.Assert(strings.Split(p, passphraseSeparator), HasLen, passphraseWords(40, len(words)))
+	c.Assert(passphraseWords(40, 256), Equals, 5)
+	c.Assert(passphraseWords(41, 256), Equals, 6)
+	c.Assert(passphraseWords(0, 256), Equals, 5)
+	c.Assert(passphraseWords(1000, 256), Equals, 16)
+}
+
+func (h *hostingSuite) Test_GeneratePassphrase_usesTheWordsOfTheLanguage(c *C) {
+	p, err := GeneratePassphrase(language.MustParse("es-AR"), 0)
+	c.Assert(err, IsNil)
+
+	words, _ := wordlistFor(language.Spanish)
+	spanish := map[string]bool{}
+	for _, w := range words {
+		spanish[w] = true
+	}
+
+	for _, w := range strings.Split(p, passphraseSeparator) {
+		c.Assert(spanish[w], Equals, true, Commentf("%s", w))
+	}
+}
+
+func (h *hostingSuite) Test_wordlistFor_fallsBackToEnglish(c *C) {
+	words, err := wordlistFor(language.Japanese)
+	c.Assert(err, IsNil)
+
+	english, _ := wordlistFor(language.English)
+	c.Assert(words, DeepEquals, english)
+}
+
+func (h *hostingSuite) Test_wordlists_haveEnoughDifferentWords(c *C) {
+	for _, lang := range wordlistLanguages {
+		words, err := wordlistFor(lang)
+		c.Assert(err, IsNil)
+		c.Assert(len(words) >= 250, Equals, true, Commentf("%s", lang))
+
+		seen := map[string]bool{}
+		for _, w := range words {
+			c.Assert(seen[w], Equals, false, Commentf("%s: %s", lang, w))
+			c.Assert(strings.Contains(w, passphraseSeparator), Equals, false)
+			seen[w] = true
+		}
+	}
+}
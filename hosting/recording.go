@@ -0,0 +1,270 @@
+package hosting
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/digitalautonomy/grumble/pkg/packetdata"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	recordingStartedText = "The host started recording this meeting. Everything said in it from now on is recorded."
+	recordingOngoingText = "The host is recording this meeting. Everything said in it is recorded."
+	recordingStoppedText = "The host stopped recording this meeting."
+)
+
+var (
+	// ErrAlreadyRecording is returned when starting to record
+	// a meeting that is being recorded already
+	ErrAlreadyRecording = errors.New("the meeting is already being recorded")
+	// ErrNotRecording is returned when stopping the recording
+	// of a meeting that is not being recorded
+	ErrNotRecording = errors.New("the meeting is not being recorded")
+)
+
+// recorder writes the voice Wahay hears in the meeting to a recording
+type recorder struct {
+	sync.Mutex
+	f        *os.File
+	w        *recordingWriter
+	start    time.Time
+	speakers map[uint32]string
+	failed   error
+	closed   bool
+}
+
+// newRecorder creates the recording, which must not exist yet
+func newRecorder(path, password string, now time.Time) (*recorder, error) {
+	if password == "" {
+		return nil, errRecordingPassword
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := newRecordingWriter(f, password)
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		return nil, err
+	}
+
+	r := &recorder{f: f, w: w, start: now, speakers: make(map[uint32]string)}
+
+	started := make([]byte, 8)
+	binary.BigEndian.PutUint64(started, uint64(now.UnixNano()))
+	r.write(record{kind: recordStart, data: started})
+	if r.failed != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		return nil, r.failed
+	}
+
+	return r, nil
+}
+
+// voice writes a packet of voice of the speaker, and their
+// name the first time they speak or when it changed
+func (r *recorder) voice(session uint32, name string, opus []byte) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.closed {
+		return
+	}
+
+	at := time.Since(r.start)
+	if known, ok := r.speakers[session]; !ok || known != name {
+		r.speakers[session] = name
+		r.write(record{kind: recordSpeaker, at: at, session: session, data: []byte(name)})
+	}
+	r.write(record{kind: recordVoice, at: at, session: session, data: opus})
+}
+
+// write must be called with the lock held. After a failure nothing else
+// is written, since the chunks after a missing one can't be read
+func (r *recorder) write(rec record) {
+	if r.failed != nil {
+		return
+	}
+
+	if err := r.w.write(rec); err != nil {
+		log.Errorf("The recording of the meeting failed: %s", err)
+		r.failed = err
+	}
+}
+
+// close marks the end of the recording, so it's known not to be cut off
+func (r *recorder) close() error {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+
+	r.write(record{kind: recordEnd, at: time.Since(r.start)})
+	err := r.f.Close()
+	if r.failed != nil {
+		return r.failed
+	}
+	return err
+}
+
+// voicePacket returns who spoke and the Opus packet of a voice packet
+// the server tunnels to a client, which is the type and target, the
+// session of the speaker, the sequence number, and the Opus header and data
+func voicePacket(data []byte) (uint32, []byte, bool) {
+	if len(data) < 2 || data[0]>>5 != mumbleproto.UDPMessageVoiceOpus {
+		return 0, nil, false
+	}
+
+	pd := packetdata.New(data[1:])
+	session := pd.GetUint32()
+	_ = pd.GetUint64()
+	size := int(pd.GetUint64() & 0x1fff)
+	if !pd.IsValid() || size == 0 || pd.Left() < size {
+		return 0, nil, false
+	}
+
+	start := 1 + pd.Size()
+	return session, data[start : start+size], true
+}
+
+// recordVoice is called with every voice packet the session hears
+func (s *session) recordVoice(data []byte) {
+	s.Lock()
+	r := s.recorder
+	s.Unlock()
+
+	if r == nil {
+		return
+	}
+
+	id, opus, ok := voicePacket(data)
+	if !ok {
+		return
+	}
+
+	s.Lock()
+	name := s.users[id].Name
+	s.Unlock()
+
+	r.voice(id, name, opus)
+}
+
+// record makes the session record what it hears, or stop when r is nil.
+// The Mumble clients show the participants that the session is recording
+func (s *session) record(r *recorder) error {
+	s.Lock()
+	s.recorder = r
+	own := s.own
+	s.Unlock()
+
+	return s.command(func() error {
+		return s.send(&mumbleproto.UserState{
+			Session:   proto.Uint32(own),
+			Recording: proto.Bool(r != nil),
+		})
+	})
+}
+
+// tellRecording lets somebody who joined know the meeting is recorded
+func (s *session) tellRecording(id uint32) {
+	err := s.send(&mumbleproto.TextMessage{
+		Session: []uint32{id},
+		Message: proto.String(recordingOngoingText),
+	})
+	if err != nil {
+		log.Debugf("session: can't tell a user about the recording: %s", err)
+	}
+}
+
+// StartRecording records the voice in the main channel of the meeting to
+// a new file, encrypted with the password. Everybody in the meeting is
+// told, and so is everybody who joins while it's recorded. Only the
+// Opus packets are kept, one stream for every speaker, since Wahay
+// doesn't decode audio; ExportRecording turns them into audio files
+func (s *server) StartRecording(path, password string) error {
+	s.recordingChanges.Lock()
+	defer s.recordingChanges.Unlock()
+
+	if s.IsRecording() {
+		return ErrAlreadyRecording
+	}
+
+	c, err := s.moderator()
+	if err != nil {
+		return err
+	}
+
+	r, err := newRecorder(path, password, time.Now())
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	s.recorder = r
+	s.Unlock()
+
+	if err := c.record(r); err != nil {
+		s.Lock()
+		s.recorder = nil
+		s.Unlock()
+		_ = r.close()
+		_ = os.Remove(path)
+		return err
+	}
+
+	if err := s.SendMessage(recordingStartedText); err != nil {
+		log.Errorf("StartRecording(): the participants were not told about the recording: %s", err)
+	}
+
+	log.WithField("path", path).Info("Recording the meeting")
+	return nil
+}
+
+// StopRecording closes the recording and tells the participants
+func (s *server) StopRecording() error {
+	s.recordingChanges.Lock()
+	defer s.recordingChanges.Unlock()
+
+	s.Lock()
+	r := s.recorder
+	s.recorder = nil
+	s.Unlock()
+
+	if r == nil {
+		return ErrNotRecording
+	}
+
+	if c, err := s.moderator(); err == nil {
+		if err := c.record(nil); err != nil {
+			log.Debugf("StopRecording(): %s", err)
+		}
+		if err := s.SendMessage(recordingStoppedText); err != nil {
+			log.Debugf("StopRecording(): %s", err)
+		}
+	}
+
+	log.Info("Stopped recording the meeting")
+	return r.close()
+}
+
+// IsRecording returns true while the meeting is being recorded
+func (s *server) IsRecording() bool {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.recorder != nil
+}
@@ -0,0 +1,278 @@
+package hosting
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// opusSampleRate is the rate the granule positions
+// of Ogg Opus streams are counted in
+const opusSampleRate = 48000
+
+// opusSilence is a packet of 20 milliseconds of silence,
+// which fills the time a speaker doesn't talk
+var opusSilence = []byte{0xf8, 0xff, 0xfe}
+
+const opusSilenceSamples = opusSampleRate / 50
+
+// exportTolerance is how far behind its time a packet can arrive before
+// the time in between is filled with silence. Voice comes through Tor, so
+// the packets of a speaker arrive in bursts, not every 10 or 20 milliseconds
+const exportTolerance = 200 * time.Millisecond
+
+// opusPacketSamples returns how long the Opus packet is, in samples
+// at 48 kHz, from its table of contents byte as RFC 6716 describes it
+func opusPacketSamples(packet []byte) int {
+	if len(packet) == 0 {
+		return 0
+	}
+
+	toc := packet[0]
+	config := toc >> 3
+
+	var frame int
+	switch {
+	case config < 12:
+		frame = []int{480, 960, 1920, 2880}[config%4]
+	case config < 16:
+		frame = []int{480, 960}[config%2]
+	default:
+		frame = []int{120, 240, 480, 960}[config%4]
+	}
+
+	switch toc & 0x03 {
+	case 0:
+		return frame
+	case 1, 2:
+		return 2 * frame
+	default:
+		if len(packet) < 2 {
+			return 0
+		}
+		return int(packet[1]&0x3f) * frame
+	}
+}
+
+// oggStream writes the packets of one logical Ogg stream,
+// every packet in a page of its own
+type oggStream struct {
+	w        io.Writer
+	serial   uint32
+	sequence uint32
+}
+
+var oggCRCTable = func() [256]uint32 {
+	var table [256]uint32
+	for i := range table {
+		r := uint32(i) << 24
+		for j := 0; j < 8; j++ {
+			if r&0x80000000 != 0 {
+				r = r<<1 ^ 0x04c11db7
+			} else {
+				r <<= 1
+			}
+		}
+		table[i] = r
+	}
+	return table
+}()
+
+func oggCRC(page []byte) uint32 {
+	var crc uint32
+	for _, b := range page {
+		crc = crc<<8 ^ oggCRCTable[byte(crc>>24)^b]
+	}
+	return crc
+}
+
+const (
+	oggFirstPage byte = 0x02
+	oggLastPage  byte = 0x04
+)
+
+func (o *oggStream) writePacket(packet []byte, granule int64, flags byte) error {
+	lacing := []byte{}
+	for n := len(packet); ; n -= 255 {
+		if n < 255 {
+			lacing = append(lacing, byte(n))
+			break
+		}
+		lacing = append(lacing, 255)
+	}
+
+	page := make([]byte, 27, 27+len(lacing)+len(packet))
+	copy(page, "OggS")
+	page[5] = flags
+	binary.LittleEndian.PutUint64(page[6:], uint64(granule))
+	binary.LittleEndian.PutUint32(page[14:], o.serial)
+	binary.LittleEndian.PutUint32(page[18:], o.sequence)
+	page[26] = byte(len(lacing))
+	page = append(page, lacing...)
+	page = append(page, packet...)
+	binary.LittleEndian.PutUint32(page[22:], oggCRC(page))
+
+	o.sequence++
+	_, err := o.w.Write(page)
+	return err
+}
+
+// opusFile writes the packets of one speaker as an Ogg Opus file
+// that starts at the start of the recording
+type opusFile struct {
+	f       *os.File
+	ogg     *oggStream
+	samples int64
+	last    []byte
+}
+
+func newOpusFile(path, speaker string, serial uint32) (*opusFile, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &opusFile{f: f, ogg: &oggStream{w: f, serial: serial}}
+
+	head := []byte("OpusHead")
+	head = append(head, 1, 1, 0, 0)
+	head = binary.LittleEndian.AppendUint32(head, opusSampleRate)
+	head = append(head, 0, 0, 0)
+
+	vendor := "Wahay"
+	tags := []byte("OpusTags")
+	tags = binary.LittleEndian.AppendUint32(tags, uint32(len(vendor)))
+	tags = append(tags, vendor...)
+	comment := "ARTIST=" + speaker
+	tags = binary.LittleEndian.AppendUint32(tags, 1)
+	tags = binary.LittleEndian.AppendUint32(tags, uint32(len(comment)))
+	tags = append(tags, comment...)
+
+	if err := o.ogg.writePacket(head, 0, oggFirstPage); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if err := o.ogg.writePacket(tags, 0, 0); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// add writes the packet where it belongs in time, after the silence
+// since the packet before. The last packet is held back, since the
+// last page of the stream must say it's the last one
+func (o *opusFile) add(at time.Duration, packet []byte) error {
+	if err := o.flush(0); err != nil {
+		return err
+	}
+
+	target := samplesIn(at)
+	if o.samples+samplesIn(exportTolerance) < target {
+		for o.samples+opusSilenceSamples <= target {
+			if err := o.writeAudio(opusSilence, 0); err != nil {
+				return err
+			}
+		}
+	}
+
+	o.last = packet
+	return nil
+}
+
+func samplesIn(d time.Duration) int64 {
+	return int64(d) * opusSampleRate / int64(time.Second)
+}
+
+func (o *opusFile) flush(flags byte) error {
+	if o.last == nil {
+		return nil
+	}
+	packet := o.last
+	o.last = nil
+	return o.writeAudio(packet, flags)
+}
+
+func (o *opusFile) writeAudio(packet []byte, flags byte) error {
+	o.samples += int64(opusPacketSamples(packet))
+	return o.ogg.writePacket(packet, o.samples, flags)
+}
+
+func (o *opusFile) close() error {
+	if o.last == nil {
+		o.last = opusSilence
+	}
+	err := o.flush(oggLastPage)
+	if cerr := o.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+var unsafeFileNameCharacters = regexp.MustCompile(`[^\p{L}\p{N}_-]+`)
+
+// speakerFileName returns the name of the file with the voice of the
+// speaker, which is the same for a speaker who left and joined again
+func speakerFileName(speaker string, session uint32) string {
+	name := strings.Trim(unsafeFileNameCharacters.ReplaceAllString(speaker, "_"), "_")
+	if name == "" {
+		name = fmt.Sprintf("speaker-%d", session)
+	}
+	return name + ".opus"
+}
+
+// ExportRecording writes the voice of every speaker of the recording to
+// an Ogg Opus file of its own in the directory. All the files start at
+// the start of the recording, so they can be played or mixed together.
+// It returns the files written, even if the recording was cut off
+func ExportRecording(r *RecordingReader, dir string) ([]string, error) {
+	files := map[string]*opusFile{}
+	var result []string
+
+	closeAll := func() error {
+		var err error
+		for _, f := range files {
+			if cerr := f.close(); err == nil {
+				err = cerr
+			}
+		}
+		sort.Strings(result)
+		return err
+	}
+
+	for {
+		v, err := r.Next()
+		if err == io.EOF {
+			return result, closeAll()
+		}
+		if err != nil {
+			_ = closeAll()
+			return result, err
+		}
+
+		name := speakerFileName(v.Speaker, v.Session)
+		f, ok := files[name]
+		if !ok {
+			path := filepath.Join(dir, name)
+			f, err = newOpusFile(path, v.Speaker, uint32(len(files)+1))
+			if err != nil {
+				_ = closeAll()
+				return result, err
+			}
+			files[name] = f
+			result = append(result, path)
+		}
+
+		if err := f.add(v.At, v.Opus); err != nil {
+			_ = closeAll()
+			return result, err
+		}
+	}
+}
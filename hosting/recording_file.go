@@ -0,0 +1,282 @@
+package hosting
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// A recording is a header followed by chunks, each one a record sealed
+// with AES-GCM. The key comes from the password of the recording through
+// scrypt, with the parameters and the salt in the header. The number of
+// the chunk is its nonce and the header is authenticated with every
+// chunk, so chunks can't be moved around or taken from another recording
+
+var recordingMagic = []byte("WAHAYREC")
+
+// RecordingFileExtension is the extension of the recordings of meetings
+const RecordingFileExtension = ".wahayrec"
+
+const (
+	recordingVersion = 1
+	recordingLogN    = 15
+	recordingR       = 8
+	recordingP       = 1
+	recordingSaltLen = 16
+	recordingKeyLen  = 32
+	// recordingMaxChunk is more than any voice packet takes, and
+	// keeps a damaged length from making the reader allocate gigabytes
+	recordingMaxChunk = 64 * 1024
+)
+
+const (
+	recordStart byte = iota + 1
+	recordSpeaker
+	recordVoice
+	recordEnd
+)
+
+var (
+	// ErrNotARecording is returned when opening a file that
+	// is not a recording of a meeting
+	ErrNotARecording = errors.New("the file is not a recording of a meeting")
+	// ErrWrongRecordingPassword is returned when the recording
+	// can't be decrypted with the password given
+	ErrWrongRecordingPassword = errors.New("the password of the recording is not correct")
+	// ErrRecordingTruncated is returned when the recording ends before
+	// it was closed, for example because Wahay was killed. Everything
+	// read before is fine
+	ErrRecordingTruncated = errors.New("the recording was cut off")
+
+	errRecordingPassword = errors.New("a recording needs a password")
+	errDamagedRecording  = errors.New("the recording is damaged")
+)
+
+// record is an entry of a recording. At is how long after the start of
+// the recording it happened, and Session is the user it's about
+type record struct {
+	kind    byte
+	at      time.Duration
+	session uint32
+	data    []byte
+}
+
+func (r record) marshal() []byte {
+	buf := make([]byte, 9, 9+len(r.data))
+	buf[0] = r.kind
+	binary.BigEndian.PutUint32(buf[1:], uint32(r.at/time.Millisecond))
+	binary.BigEndian.PutUint32(buf[5:], r.session)
+	return append(buf, r.data...)
+}
+
+func unmarshalRecord(buf []byte) (record, error) {
+	if len(buf) < 9 {
+		return record{}, errDamagedRecording
+	}
+	return record{
+		kind:    buf[0],
+		at:      time.Duration(binary.BigEndian.Uint32(buf[1:])) * time.Millisecond,
+		session: binary.BigEndian.Uint32(buf[5:]),
+		data:    buf[9:],
+	}, nil
+}
+
+func recordingHeader(salt []byte, logN, r, p byte) []byte {
+	header := append([]byte{}, recordingMagic...)
+	header = append(header, recordingVersion, logN, r, p)
+	return append(header, salt...)
+}
+
+func recordingCipher(password string, salt []byte, logN, r, p byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(password), salt, 1<<logN, int(r), int(p), recordingKeyLen)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(aead cipher.AEAD, n uint64) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], n)
+	return nonce
+}
+
+// recordingWriter seals the records as they are written
+type recordingWriter struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	header []byte
+	chunks uint64
+}
+
+func newRecordingWriter(w io.Writer, password string) (*recordingWriter, error) {
+	if password == "" {
+		return nil, errRecordingPassword
+	}
+
+	salt := make([]byte, recordingSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	aead, err := recordingCipher(password, salt, recordingLogN, recordingR, recordingP)
+	if err != nil {
+		return nil, err
+	}
+
+	header := recordingHeader(salt, recordingLogN, recordingR, recordingP)
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	return &recordingWriter{w: w, aead: aead, header: header}, nil
+}
+
+func (rw *recordingWriter) write(r record) error {
+	sealed := rw.aead.Seal(nil, chunkNonce(rw.aead, rw.chunks), r.marshal(), rw.header)
+	rw.chunks++
+
+	buf := make([]byte, 4, 4+len(sealed))
+	binary.BigEndian.PutUint32(buf, uint32(len(sealed)))
+	_, err := rw.w.Write(append(buf, sealed...))
+	return err
+}
+
+// RecordedVoice is a packet of voice of a recording, as
+// the Mumble client of the speaker encoded it with Opus
+type RecordedVoice struct {
+	// At is how long after the start of the recording it was received
+	At      time.Duration
+	Session uint32
+	Speaker string
+	Opus    []byte
+}
+
+// RecordingReader reads the voice of a recording
+type RecordingReader struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	header  []byte
+	chunks  uint64
+	started time.Time
+	names   map[uint32]string
+	ended   bool
+}
+
+// OpenRecording checks the password of the recording
+// and reads when it started
+func OpenRecording(r io.Reader, password string) (*RecordingReader, error) {
+	header := make([]byte, len(recordingMagic)+4+recordingSaltLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, ErrNotARecording
+	}
+
+	params := header[len(recordingMagic):]
+	if !bytes.Equal(header[:len(recordingMagic)], recordingMagic) || params[0] != recordingVersion {
+		return nil, ErrNotARecording
+	}
+
+	// The parameters come from the file, so they are kept
+	// to what doesn't take more than a few seconds to open
+	if params[1] > 20 || params[2] == 0 || params[2] > 16 || params[3] == 0 || params[3] > 4 {
+		return nil, ErrNotARecording
+	}
+
+	aead, err := recordingCipher(password, params[4:], params[1], params[2], params[3])
+	if err != nil {
+		return nil, ErrNotARecording
+	}
+
+	rr := &RecordingReader{r: r, aead: aead, header: header, names: make(map[uint32]string)}
+
+	first, err := rr.next()
+	if err != nil {
+		return nil, err
+	}
+	if first.kind != recordStart || len(first.data) != 8 {
+		return nil, errDamagedRecording
+	}
+	rr.started = time.Unix(0, int64(binary.BigEndian.Uint64(first.data)))
+
+	return rr, nil
+}
+
+// Started returns when the recording started
+func (rr *RecordingReader) Started() time.Time {
+	return rr.started
+}
+
+// Next returns the next packet of voice. It returns io.EOF at the end of
+// the recording, and ErrRecordingTruncated if the recording was not closed
+func (rr *RecordingReader) Next() (RecordedVoice, error) {
+	for {
+		if rr.ended {
+			return RecordedVoice{}, io.EOF
+		}
+
+		r, err := rr.next()
+		if err != nil {
+			return RecordedVoice{}, err
+		}
+
+		switch r.kind {
+		case recordSpeaker:
+			rr.names[r.session] = string(r.data)
+		case recordVoice:
+			return RecordedVoice{
+				At:      r.at,
+				Session: r.session,
+				Speaker: rr.names[r.session],
+				Opus:    r.data,
+			}, nil
+		case recordEnd:
+			rr.ended = true
+		}
+	}
+}
+
+func (rr *RecordingReader) next() (record, error) {
+	length := make([]byte, 4)
+	if _, err := io.ReadFull(rr.r, length); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return record{}, ErrRecordingTruncated
+		}
+		return record{}, err
+	}
+
+	size := binary.BigEndian.Uint32(length)
+	if size > recordingMaxChunk {
+		return record{}, errDamagedRecording
+	}
+
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(rr.r, sealed); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return record{}, ErrRecordingTruncated
+		}
+		return record{}, err
+	}
+
+	plain, err := rr.aead.Open(nil, chunkNonce(rr.aead, rr.chunks), sealed, rr.header)
+	if err != nil {
+		if rr.chunks == 0 {
+			return record{}, ErrWrongRecordingPassword
+		}
+		return record{}, errDamagedRecording
+	}
+	rr.chunks++
+
+	return unmarshalRecord(plain)
+}
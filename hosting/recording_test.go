@@ -0,0 +1,290 @@
+package hosting
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/digitalautonomy/grumble/pkg/packetdata"
+	. "gopkg.in/check.v1"
+)
+
+// clientVoicePacket is a packet of voice as a Mumble client sends it
+func clientVoicePacket(sequence uint64, opus []byte) []byte {
+	buf := make([]byte, 1024)
+	pd := packetdata.New(buf[1:])
+	pd.PutUint64(sequence)
+	pd.PutUint64(uint64(len(opus)))
+	pd.PutBytes(opus)
+	buf[0] = mumbleproto.UDPMessageVoiceOpus << 5
+	return buf[:1+pd.Size()]
+}
+
+// serverVoicePacket is the same packet as the server
+// sends it to the others, with the session of the speaker
+func serverVoicePacket(session uint32, sequence uint64, opus []byte) []byte {
+	buf := make([]byte, 1024)
+	pd := packetdata.New(buf[1:])
+	pd.PutUint32(session)
+	pd.PutUint64(sequence)
+	pd.PutUint64(uint64(len(opus)))
+	pd.PutBytes(opus)
+	buf[0] = mumbleproto.UDPMessageVoiceOpus << 5
+	return buf[:1+pd.Size()]
+}
+
+func tunnelVoice(s *session, packet []byte) error {
+	s.writes.Lock()
+	defer s.writes.Unlock()
+
+	header := make([]byte, 6)
+	binary.BigEndian.PutUint16(header, mumbleproto.MessageUDPTunnel)
+	binary.BigEndian.PutUint32(header[2:], uint32(len(packet)))
+	_, err := s.conn.Write(append(header, packet...))
+	return err
+}
+
+func readAllVoice(c *C, path, password string) ([]RecordedVoice, error) {
+	f, err := os.Open(path)
+	c.Assert(err, IsNil)
+	defer f.Close()
+
+	r, err := OpenRecording(f, password)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []RecordedVoice{}
+	for {
+		v, err := r.Next()
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return result, err
+		}
+		result = append(result, v)
+	}
+}
+
+func (h *hostingSuite) Test_recorder_writesARecordingThatCanBeReadWithThePassword(c *C) {
+	path := filepath.Join(c.MkDir(), "meeting.wahayrec")
+
+	r, err := newRecorder(path, "secret", time.Now())
+	c.Assert(err, IsNil)
+	r.voice(3, "Ana", []byte{0xf8, 1, 2})
+	r.voice(4, "Bo", []byte{0xf8, 3})
+	r.voice(3, "Ana", []byte{0xf8, 4})
+	c.Assert(r.close(), IsNil)
+
+	voice, err := readAllVoice(c, path, "secret")
+	c.Assert(err, IsNil)
+	c.Assert(voice, HasLen, 3)
+	c.Assert(voice[0].Speaker, Equals, "Ana")
+	c.Assert(voice[0].Opus, DeepEquals, []byte{0xf8, 1, 2})
+	c.Assert(voice[1].Speaker, Equals, "Bo")
+	c.Assert(voice[2].Session, Equals, uint32(3))
+
+	_, err = readAllVoice(c, path, "wrong")
+	c.Assert(err, Equals, ErrWrongRecordingPassword)
+}
+
+func (h *hostingSuite) Test_recorder_refusesAnEmptyPasswordAndExistingFiles(c *C) {
+	path := filepath.Join(c.MkDir(), "meeting.wahayrec")
+
+	_, err := newRecorder(path, "", time.Now())
+	c.Assert(err, Equals, errRecordingPassword)
+
+	c.Assert(os.WriteFile(path, []byte("keep me"), 0600), IsNil)
+	_, err = newRecorder(path, "secret", time.Now())
+	c.Assert(err, NotNil)
+
+	content, _ := os.ReadFile(path)
+	c.Assert(string(content), Equals, "keep me")
+}
+
+func (h *hostingSuite) Test_OpenRecording_tellsWhatIsWrongWithTheFile(c *C) {
+	_, err := OpenRecording(bytes.NewReader([]byte("not a recording at all, really")), "secret")
+	c.Assert(err, Equals, ErrNotARecording)
+
+	path := filepath.Join(c.MkDir(), "meeting.wahayrec")
+	r, err := newRecorder(path, "secret", time.Now())
+	c.Assert(err, IsNil)
+	r.voice(3, "Ana", []byte{0xf8, 1})
+	c.Assert(r.f.Close(), IsNil)
+
+	voice, err := readAllVoice(c, path, "secret")
+	c.Assert(err, Equals, ErrRecordingTruncated)
+	c.Assert(voice, HasLen, 1)
+}
+
+func (h *hostingSuite) Test_voicePacket_returnsTheSpeakerAndTheOpusPacket(c *C) {
+	session, opus, ok := voicePacket(serverVoicePacket(7, 1234, []byte{0xf8, 0xff, 0xfe}))
+	c.Assert(ok, Equals, true)
+	c.Assert(session, Equals, uint32(7))
+	c.Assert(opus, DeepEquals, []byte{0xf8, 0xff, 0xfe})
+
+	_, _, ok = voicePacket([]byte{mumbleproto.UDPMessagePing << 5, 1})
+	c.Assert(ok, Equals, false)
+
+	_, _, ok = voicePacket(serverVoicePacket(7, 1, []byte{0xf8, 0xff, 0xfe})[:5])
+	c.Assert(ok, Equals, false)
+}
+
+func (h *hostingSuite) Test_opusPacketSamples_readsTheDurationFromTheTableOfContents(c *C) {
+	// CELT, 20 ms, one frame
+	c.Assert(opusPacketSamples([]byte{0xf8}), Equals, 960)
+	// SILK, 10 ms, two frames
+	c.Assert(opusPacketSamples([]byte{0x01}), Equals, 960)
+	// Hybrid, 20 ms, three frames as the count byte says
+	c.Assert(opusPacketSamples([]byte{0x6b, 0x03}), Equals, 2880)
+	c.Assert(opusPacketSamples(nil), Equals, 0)
+}
+
+type oggPage struct {
+	flags   byte
+	granule int64
+	packet  []byte
+}
+
+func readOggPages(c *C, path string) []oggPage {
+	content, err := os.ReadFile(path)
+	c.Assert(err, IsNil)
+
+	pages := []oggPage{}
+	for len(content) > 0 {
+		c.Assert(string(content[:4]), Equals, "OggS")
+		segments := int(content[26])
+		size := 0
+		for _, l := range content[27 : 27+segments] {
+			size += int(l)
+		}
+		end := 27 + segments + size
+
+		page := append([]byte{}, content[:end]...)
+		crc := binary.LittleEndian.Uint32(page[22:])
+		binary.LittleEndian.PutUint32(page[22:], 0)
+		c.Assert(oggCRC(page), Equals, crc)
+
+		pages = append(pages, oggPage{
+			flags:   content[5],
+			granule: int64(binary.LittleEndian.Uint64(content[6:])),
+			packet:  content[27+segments : end],
+		})
+		content = content[end:]
+	}
+	return pages
+}
+
+func (h *hostingSuite) Test_ExportRecording_writesAnOggOpusFileForEverySpeaker(c *C) {
+	dir := c.MkDir()
+	path := filepath.Join(dir, "meeting.wahayrec")
+
+	r, err := newRecorder(path, "secret", time.Now())
+	c.Assert(err, IsNil)
+	c.Assert(r.w.write(record{kind: recordSpeaker, session: 3, data: []byte("Ana María")}), IsNil)
+	c.Assert(r.w.write(record{kind: recordVoice, at: time.Second, session: 3, data: []byte{0xf8, 1}}), IsNil)
+	c.Assert(r.w.write(record{kind: recordVoice, at: time.Second + 20*time.Millisecond, session: 3, data: []byte{0xf8, 2}}), IsNil)
+	c.Assert(r.w.write(record{kind: recordSpeaker, session: 4, data: []byte("../Bo")}), IsNil)
+	c.Assert(r.w.write(record{kind: recordVoice, session: 4, data: []byte{0xf8, 3}}), IsNil)
+	c.Assert(r.close(), IsNil)
+
+	f, err := os.Open(path)
+	c.Assert(err, IsNil)
+	defer f.Close()
+	rr, err := OpenRecording(f, "secret")
+	c.Assert(err, IsNil)
+
+	out := c.MkDir()
+	files, err := ExportRecording(rr, out)
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{filepath.Join(out, "Ana_María.opus"), filepath.Join(out, "Bo.opus")})
+
+	pages := readOggPages(c, files[0])
+	c.Assert(pages[0].flags, Equals, oggFirstPage)
+	c.Assert(string(pages[0].packet[:8]), Equals, "OpusHead")
+	c.Assert(string(pages[1].packet[:8]), Equals, "OpusTags")
+	c.Assert(bytes.Contains(pages[1].packet, []byte("ARTIST=Ana María")), Equals, true)
+
+	// A second of silence comes before the voice
+	audio := pages[2:]
+	c.Assert(audio, HasLen, 52)
+	c.Assert(audio[49].packet, DeepEquals, opusSilence)
+	c.Assert(audio[49].granule, Equals, int64(48000))
+	c.Assert(audio[50].packet, DeepEquals, []byte{0xf8, 1})
+	c.Assert(audio[51].packet, DeepEquals, []byte{0xf8, 2})
+	c.Assert(audio[51].flags, Equals, oggLastPage)
+	c.Assert(audio[51].granule, Equals, int64(49920))
+}
+
+func (h *hostingSuite) Test_server_StartRecording_recordsTheVoiceOfTheParticipants(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	guest, hash := joinTestServer(c, serv, "")
+	defer guest.close()
+	userWithCertificate(c, serv, hash)
+
+	path := filepath.Join(c.MkDir(), "meeting.wahayrec")
+	c.Assert(serv.StartRecording(path, "secret"), IsNil)
+	c.Assert(serv.IsRecording(), Equals, true)
+	c.Assert(serv.StartRecording(path, "secret"), Equals, ErrAlreadyRecording)
+
+	c.Assert(tunnelVoice(guest, clientVoicePacket(1, []byte{0xf8, 0xff, 0xfe})), IsNil)
+	c.Assert(waitUntil(func() bool {
+		serv.Lock()
+		defer serv.Unlock()
+		serv.recorder.Lock()
+		defer serv.recorder.Unlock()
+		return len(serv.recorder.speakers) == 1
+	}), Equals, true)
+
+	c.Assert(serv.StopRecording(), IsNil)
+	c.Assert(serv.IsRecording(), Equals, false)
+	c.Assert(serv.StopRecording(), Equals, ErrNotRecording)
+
+	voice, err := readAllVoice(c, path, "secret")
+	c.Assert(err, IsNil)
+	c.Assert(voice, HasLen, 1)
+	c.Assert(voice[0].Speaker, Equals, sessionUsername)
+	c.Assert(voice[0].Opus, DeepEquals, []byte{0xf8, 0xff, 0xfe})
+}
+
+func (h *hostingSuite) Test_server_StartRecording_tellsTheParticipants(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	cert, _, err := newSessionCertificate()
+	c.Assert(err, IsNil)
+	serverCert, err := serverCertificate()
+	c.Assert(err, IsNil)
+
+	received := newChatMessages()
+	messages, stopMessages := received.subscribe()
+	defer stopMessages()
+
+	guest, err := dialSession(serv.localAddress(), cert, serverCert, "", nil, received, nil)
+	c.Assert(err, IsNil)
+
+	c.Assert(serv.StartRecording(filepath.Join(c.MkDir(), "meeting.wahayrec"), "secret"), IsNil)
+	c.Assert(nextMessage(c, messages).Text, Equals, recordingStartedText)
+
+	lateCert, _, err := newSessionCertificate()
+	c.Assert(err, IsNil)
+	late, err := dialSession(serv.localAddress(), lateCert, serverCert, "", nil, received, nil)
+	c.Assert(err, IsNil)
+	c.Assert(nextMessage(c, messages).Text, Equals, recordingOngoingText)
+
+	c.Assert(serv.StopRecording(), IsNil)
+	c.Assert(nextMessage(c, messages).Text, Equals, recordingStoppedText)
+
+	// The server must be done with both of them before it stops,
+	// or it fails telling one of them the other one left
+	guest.close()
+	late.close()
+	c.Assert(waitUntil(func() bool { return len(serv.Users()) == 0 }), Equals, true)
+}
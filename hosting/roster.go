@@ -0,0 +1,113 @@
+package hosting
+
+import (
+	"errors"
+	"net"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrParticipantNotConnected is returned when disconnecting
+// a guest that is not in the meeting anymore
+var ErrParticipantNotConnected = errors.New("the participant is not in the meeting")
+
+// Participant is a guest in the meeting, as seen by the connection gate.
+// The connection is encrypted from the Mumble client to the server, so
+// the gate doesn't know the name of the guest. Voice is most of what a
+// guest sends, so BytesReceived grows quickly while they talk
+type Participant struct {
+	// ID identifies the guest. It's the same they had in the waiting room
+	ID            int
+	Joined        time.Time
+	BytesReceived uint64
+}
+
+type connectedParticipant struct {
+	id     int
+	joined time.Time
+	conn   net.Conn
+	// received is only accessed atomically
+	received uint64
+}
+
+// Participants returns the guests in the meeting, in the order they joined
+func (s *server) Participants() []Participant {
+	if s.gate == nil {
+		return nil
+	}
+	return s.gate.participantList()
+}
+
+// Disconnect closes the connection of the guest with the given ID. The
+// Mumble client of the guest can connect again, so a guest that must not
+// come back has to be banned from Mumble instead
+func (s *server) Disconnect(id int) error {
+	if s.gate == nil {
+		return ErrParticipantNotConnected
+	}
+	return s.gate.disconnect(id)
+}
+
+// connect adds the connection to the participants, with a new ID when
+// it didn't get one in the waiting room
+func (g *connectionGate) connect(id int, conn net.Conn) *connectedParticipant {
+	g.Lock()
+	defer g.Unlock()
+
+	if id == 0 {
+		g.nextID++
+		id = g.nextID
+	}
+
+	p := &connectedParticipant{id: id, joined: time.Now(), conn: conn}
+	if g.participants == nil {
+		g.participants = make(map[int]*connectedParticipant)
+	}
+	g.participants[id] = p
+
+	return p
+}
+
+func (g *connectionGate) disconnected(p *connectedParticipant) {
+	g.Lock()
+	defer g.Unlock()
+
+	delete(g.participants, p.id)
+}
+
+func (g *connectionGate) participantList() []Participant {
+	g.Lock()
+	defer g.Unlock()
+
+	result := make([]Participant, 0, len(g.participants))
+	for _, p := range g.participants {
+		result = append(result, Participant{
+			ID:            p.id,
+			Joined:        p.joined,
+			BytesReceived: atomic.LoadUint64(&p.received),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+func (g *connectionGate) disconnect(id int) error {
+	g.Lock()
+	p, ok := g.participants[id]
+	g.Unlock()
+
+	if !ok {
+		return ErrParticipantNotConnected
+	}
+
+	log.WithField("participant", id).Info("connectionGate: the host disconnected a participant")
+
+	return p.conn.Close()
+}
@@ -0,0 +1,60 @@
+package hosting
+
+import (
+	"io"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_Participants_listsTheGuestsWithWhatTheySent(c *C) {
+	target := startEchoServer(c)
+	defer target.Close()
+
+	g, err := newConnectionGate(listenAddress{}, target.Addr().String(), 0)
+	c.Assert(err, IsNil)
+	g.start()
+	defer g.stop()
+	s := &server{gate: g}
+
+	before := time.Now()
+	conn := connectToGate(c, g)
+	defer conn.Close()
+	c.Assert(echo(c, conn, "hello"), Equals, "hello\n")
+
+	participants := s.Participants()
+	c.Assert(participants, HasLen, 1)
+	c.Assert(participants[0].ID, Equals, 1)
+	c.Assert(participants[0].BytesReceived, Equals, uint64(len("hello\n")))
+	c.Assert(participants[0].Joined.Before(before), Equals, false)
+}
+
+func (h *hostingSuite) Test_Disconnect_closesTheConnectionOfTheGuest(c *C) {
+	target := startEchoServer(c)
+	defer target.Close()
+
+	g, err := newConnectionGate(listenAddress{}, target.Addr().String(), 0)
+	c.Assert(err, IsNil)
+	g.start()
+	defer g.stop()
+	s := &server{gate: g}
+
+	conn := connectToGate(c, g)
+	defer conn.Close()
+	c.Assert(echo(c, conn, "hello"), Equals, "hello\n")
+
+	c.Assert(s.Disconnect(1), IsNil)
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = conn.Read(make([]byte, 1))
+	c.Assert(err, Equals, io.EOF)
+	c.Assert(waitUntil(func() bool { return len(s.Participants()) == 0 }), Equals, true)
+	c.Assert(s.Disconnect(1), Equals, ErrParticipantNotConnected)
+}
+
+func (h *hostingSuite) Test_Participants_isEmptyWithoutAGate(c *C) {
+	s := &server{}
+
+	c.Assert(s.Participants(), HasLen, 0)
+	c.Assert(s.Disconnect(1), Equals, ErrParticipantNotConnected)
+}
@@ -0,0 +1,179 @@
+package hosting
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Schedule is when a meeting takes place
+type Schedule struct {
+	Start    time.Time
+	Duration time.Duration
+	// Lead is how long before the start the meeting is set up, since
+	// Tor needs a while to make a new onion service reachable
+	Lead time.Duration
+	// IdleTimeout is how long the meeting is kept after its end while
+	// nobody is connected. Participants still talking keep it open
+	IdleTimeout time.Duration
+}
+
+// ScheduledMeeting is a meeting that starts and ends on its own
+type ScheduledMeeting struct {
+	sync.Mutex
+	schedule Schedule
+	start    func() (Service, error)
+	ended    func(Service, error)
+
+	service  Service
+	timer    *time.Timer
+	stopIdle func()
+	done     bool
+}
+
+// ScheduleMeeting calls start when the meeting must be set up, and
+// closes the service it returns after the meeting, once there has been
+// nobody connected for the idle timeout. start is expected to create the
+// service and its conference room, and to hand out the invitations.
+// ended is called with the error, if any, when the meeting is over
+func ScheduleMeeting(sch Schedule, start func() (Service, error), ended func(Service, error)) *ScheduledMeeting {
+	m := &ScheduledMeeting{
+		schedule: sch,
+		start:    start,
+		ended:    ended,
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	m.timer = time.AfterFunc(time.Until(sch.Start.Add(-sch.Lead)), m.begin)
+
+	return m
+}
+
+func (m *ScheduledMeeting) begin() {
+	m.Lock()
+	if m.done {
+		m.Unlock()
+		return
+	}
+	m.Unlock()
+
+	log.WithField("start", m.schedule.Start).Info("Starting a scheduled meeting")
+	s, err := m.start()
+
+	m.Lock()
+	defer m.Unlock()
+
+	if err != nil {
+		m.finish(nil, err)
+		return
+	}
+
+	m.service = s
+	if m.done {
+		// It was canceled while starting
+		go m.ended(s, s.Close())
+		return
+	}
+
+	end := m.schedule.Start.Add(m.schedule.Duration)
+	m.timer = time.AfterFunc(time.Until(end), m.waitForIdle)
+}
+
+func (m *ScheduledMeeting) waitForIdle() {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.done {
+		return
+	}
+
+	count := func() int { return participants(m.service) }
+	m.stopIdle = watchIdle(count, m.schedule.IdleTimeout, func() {
+		m.Lock()
+		defer m.Unlock()
+
+		if m.done {
+			return
+		}
+
+		log.Info("Closing a scheduled meeting that is over")
+		m.finish(m.service, m.service.Close())
+	})
+}
+
+// finish must be called with the lock held
+func (m *ScheduledMeeting) finish(s Service, err error) {
+	m.done = true
+	if m.stopIdle != nil {
+		m.stopIdle()
+	}
+	go m.ended(s, err)
+}
+
+// Cancel stops the meeting from starting, or closes it if it already
+// started. The ended function is only called for meetings that started
+func (m *ScheduledMeeting) Cancel() {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.done {
+		return
+	}
+
+	m.timer.Stop()
+
+	if m.service == nil {
+		// A start in progress closes the service itself
+		m.done = true
+		return
+	}
+
+	m.finish(m.service, m.service.Close())
+}
+
+// idleCheckPeriod is how often the participants of
+// a meeting are counted to notice it's empty
+var idleCheckPeriod = 30 * time.Second
+
+// watchIdle calls onIdle once, when the meeting has had no guests
+// connected for the given time. count returns the guests connected.
+// It returns a function to stop watching
+func watchIdle(count func() int, timeout time.Duration, onIdle func()) func() {
+	stop := make(chan bool)
+	var once sync.Once
+
+	go func() {
+		ticker := time.NewTicker(idleCheckPeriod)
+		defer ticker.Stop()
+
+		idleSince := time.Now()
+		for {
+			if count() > 0 {
+				idleSince = time.Now()
+			} else if time.Since(idleSince) >= timeout {
+				onIdle()
+				return
+			}
+
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return func() {
+		once.Do(func() { close(stop) })
+	}
+}
+
+func participants(s Service) int {
+	serv := s.Server()
+	if serv == nil {
+		return 0
+	}
+	return serv.Stats().Participants
+}
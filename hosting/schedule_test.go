@@ -0,0 +1,122 @@
+package hosting
+
+import (
+	"errors"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type scheduledServiceMock struct {
+	Service
+	serv   Server
+	closed bool
+}
+
+func (s *scheduledServiceMock) Server() Server {
+	return s.serv
+}
+
+func (s *scheduledServiceMock) Close() error {
+	s.closed = true
+	return nil
+}
+
+type meetingEnd struct {
+	service Service
+	err     error
+}
+
+func waitForEnd(c *C, ended chan meetingEnd) meetingEnd {
+	select {
+	case e := <-ended:
+		return e
+	case <-time.After(5 * time.Second):
+		c.Fatal("the scheduled meeting didn't end")
+		return meetingEnd{}
+	}
+}
+
+func fastIdleChecks() func() {
+	orig := idleCheckPeriod
+	idleCheckPeriod = 5 * time.Millisecond
+	return func() { idleCheckPeriod = orig }
+}
+
+func (h *hostingSuite) Test_ScheduleMeeting_startsAndClosesTheMeeting(c *C) {
+	defer fastIdleChecks()()
+
+	srvc := &scheduledServiceMock{}
+	started := make(chan bool, 1)
+	ended := make(chan meetingEnd, 1)
+
+	sch := Schedule{
+		Start:       time.Now().Add(20 * time.Millisecond),
+		Duration:    20 * time.Millisecond,
+		IdleTimeout: 10 * time.Millisecond,
+	}
+	ScheduleMeeting(sch, func() (Service, error) {
+		started <- true
+		return srvc, nil
+	}, func(s Service, err error) {
+		ended <- meetingEnd{s, err}
+	})
+
+	end := waitForEnd(c, ended)
+	c.Assert(<-started, Equals, true)
+	c.Assert(end.err, IsNil)
+	c.Assert(end.service, Equals, srvc)
+	c.Assert(srvc.closed, Equals, true)
+}
+
+func (h *hostingSuite) Test_ScheduleMeeting_keepsTheMeetingWhileThereAreParticipants(c *C) {
+	defer fastIdleChecks()()
+
+	gate := &connectionGate{active: 1}
+	srvc := &scheduledServiceMock{serv: &server{gate: gate}}
+	ended := make(chan meetingEnd, 1)
+
+	sch := Schedule{Start: time.Now(), IdleTimeout: 10 * time.Millisecond}
+	ScheduleMeeting(sch, func() (Service, error) {
+		return srvc, nil
+	}, func(s Service, err error) {
+		ended <- meetingEnd{s, err}
+	})
+
+	time.Sleep(50 * time.Millisecond)
+	select {
+	case <-ended:
+		c.Fatal("the meeting ended with a participant connected")
+	default:
+	}
+
+	gate.Lock()
+	gate.active = 0
+	gate.Unlock()
+
+	c.Assert(waitForEnd(c, ended).service, Equals, srvc)
+}
+
+func (h *hostingSuite) Test_ScheduleMeeting_reportsWhenTheMeetingCantStart(c *C) {
+	ended := make(chan meetingEnd, 1)
+
+	ScheduleMeeting(Schedule{Start: time.Now()}, func() (Service, error) {
+		return nil, errors.New("tor is not running")
+	}, func(s Service, err error) {
+		ended <- meetingEnd{s, err}
+	})
+
+	c.Assert(waitForEnd(c, ended).err, ErrorMatches, "tor is not running")
+}
+
+func (h *hostingSuite) Test_ScheduledMeeting_Cancel_preventsTheMeetingFromStarting(c *C) {
+	started := false
+
+	m := ScheduleMeeting(Schedule{Start: time.Now().Add(time.Hour)}, func() (Service, error) {
+		started = true
+		return &scheduledServiceMock{}, nil
+	}, func(Service, error) {})
+
+	m.Cancel()
+	c.Assert(started, Equals, false)
+}
@@ -1,29 +1,238 @@
 package hosting
 
-import grumbleServer "github.com/digitalautonomy/grumble/server"
+import (
+	"crypto/tls"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/grumble/pkg/acl"
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+
+	"github.com/digitalautonomy/wahay/tor"
+)
 
 // Server serves
 type Server interface {
 	Start() error
 	Stop() error
+	GrantModeration(certHash string) error
+	RevokeModeration(certHash string) error
+	Ban(certHash string, duration time.Duration) error
+	Unban(certHash string) error
+	Users() []User
+	Kick(session uint32, reason string) error
+	Mute(session uint32) error
+	Unmute(session uint32) error
+	Subscribe() (<-chan ParticipantEvent, func())
+	Messages() (<-chan Message, func())
+	SendMessage(text string) error
+	SendChannelMessage(channel int, text string) error
+	StartRecording(path, password string) error
+	StopRecording() error
+	IsRecording() bool
+	OnParticipantEvent(hook func(ParticipantEvent)) func()
+	Stats() Stats
+	Admit(id int) error
+	Reject(id int) error
+	Waiting() []int
+	Participants() []Participant
+	Disconnect(id int) error
+	SetWelcomeText(string)
+	SetSuperUserPassword(password string) error
+	RotateSuperUserPassword() (string, error)
+	Channels() []Channel
+	CreateChannel(name string) (int, error)
+	RemoveChannel(id int) error
+	SetChannelPassword(id int, password string) error
+	SetChannelDescription(id int, description string) error
 }
 
 type server struct {
+	// the lock protects running, control and acls, since
+	// moderating happens while the meeting starts and ends
+	sync.Mutex
+	// aclChanges is held while the ACLs of the running
+	// server change, which takes a round trip to it
+	aclChanges sync.Mutex
+	// recordingChanges is held while the recording starts or stops
+	recordingChanges sync.Mutex
+
 	serverCollection *servers
 	gs               *grumbleServer.Server
+	running          bool
+	// password is what Wahay needs to join the server
+	password string
+	// control is the session of Wahay on the running server, which
+	// moderates the meeting with the certificate in controlCert
+	control     *session
+	controlCert tls.Certificate
+	// acls are the ACLs of the channels while the server runs. Grumble
+	// reports the ACLs for groups it got from a client as ACLs for the
+	// superuser, so Wahay keeps the ones it sets instead of asking
+	acls map[int][]acl.ACL
+	// recorder is where the voice of the meeting goes while it's recorded
+	recorder *recorder
+	// tcpOnly is true when the voice of the participants must
+	// not arrive over UDP, as ServerOptions.TCPOnly says
+	tcpOnly bool
+	// onion is the onion service of the meeting, which
+	// is deleted together with the server
+	onion    tor.Onion
+	events   *participantEvents
+	messages *chatMessages
+	// gate is the connection gate the guests go through,
+	// or nil when the server is not used for a meeting
+	gate *connectionGate
+	// banList keeps the bans for the next meetings
+	banList BanList
 }
 
+// Start makes the server accept participants.
+// Starting a running server does nothing
 func (s *server) Start() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.running {
+		return nil
+	}
+
+	acls := make(map[int][]acl.ACL)
+	for id, ch := range s.gs.Channels {
+		acls[id] = append([]acl.ACL{}, ch.ACL.ACLs...)
+	}
+
 	err := s.gs.Start()
 	if err != nil {
 		return err
 	}
 
+	s.running = true
+	s.acls = acls
 	s.serverCollection.startListener()
 
+	// The meeting works without the session, it's
+	// connected again when the host moderates
+	if err := s.connectControl(); err != nil {
+		log.Errorf("Start(): Wahay can't join the Mumble server to moderate the meeting: %s", err)
+	}
+
 	return nil
 }
 
+// Stop disconnects the participants and keeps the data of the server,
+// so it can be started again. Stopping a stopped server does nothing
 func (s *server) Stop() error {
-	return s.gs.Stop()
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.running {
+		return nil
+	}
+
+	if s.recorder != nil {
+		if err := s.recorder.close(); err != nil {
+			log.Errorf("Stop(): the recording of the meeting failed: %s", err)
+		}
+		s.recorder = nil
+	}
+
+	if s.control != nil {
+		s.control.close()
+		s.control = nil
+	}
+
+	err := s.gs.Stop()
+	if err != nil {
+		return err
+	}
+
+	s.running = false
+
+	// What grumble has now loses the groups next time it starts
+	for id, acls := range s.acls {
+		if ch, ok := s.gs.Channels[id]; ok {
+			ch.ACL.ACLs = acls
+		}
+	}
+	s.acls = nil
+	s.gs.ClearCaches()
+
+	return nil
+}
+
+// sessionReconnectDelay keeps Wahay from joining again and again
+// when somebody with the permissions keeps kicking it out
+const sessionReconnectDelay = 5 * time.Second
+
+// moderator returns the session of Wahay on the running server,
+// connecting it again if the connection was lost
+func (s *server) moderator() (*session, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.running {
+		return nil, errServerNotRunning
+	}
+
+	if s.control == nil || s.control.isClosed() {
+		if err := s.connectControl(); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.control, nil
+}
+
+// connectControl must be called with the lock held
+func (s *server) connectControl() error {
+	cert, err := serverCertificate()
+	if err != nil {
+		return err
+	}
+
+	c, err := dialSession(s.localAddress(), s.controlCert, cert, s.password, s.events, s.messages, s.reconnectControl)
+	if err != nil {
+		return err
+	}
+
+	s.control = c
+
+	c.Lock()
+	c.tcpOnly = s.tcpOnly
+	c.Unlock()
+
+	// The recording goes on with the new session
+	// when the one before was lost
+	if s.recorder != nil {
+		if err := c.record(s.recorder); err != nil {
+			log.Errorf("connectControl(): the meeting can't be recorded: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// reconnectControl joins the server again when the session was
+// dropped while the meeting goes on, so the users keep being followed
+func (s *server) reconnectControl() {
+	go func() {
+		time.Sleep(sessionReconnectDelay)
+		if _, err := s.moderator(); err != nil && err != errServerNotRunning {
+			log.Errorf("reconnectControl(): %s", err)
+		}
+	}()
+}
+
+// localAddress is where Wahay reaches the running server
+func (s *server) localAddress() string {
+	host := s.gs.HostAddress()
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		host = "127.0.0.1"
+	}
+	return net.JoinHostPort(host, strconv.Itoa(s.gs.CurrentPort()))
 }
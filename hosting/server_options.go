@@ -0,0 +1,263 @@
+package hosting
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+)
+
+// ServerOptions are the settings of a new Mumble server. The zero value
+// of every field keeps the default grumble behavior
+type ServerOptions struct {
+	// Port is the port the server listens on
+	Port int
+	// Address is the address the server binds to. By default it's the
+	// localhost interface, or all of them in Whonix-like environments
+	Address string
+	// Password is the password participants need to join
+	Password string
+	// SuperUser is the account with all the permissions on the server
+	SuperUser SuperUserData
+	// WelcomeText is shown to the participants when they join
+	WelcomeText string
+	// Name is the name of the server the Mumble clients show,
+	// which is the name of its root channel
+	Name string
+	// MaxUsers is the number of users the server announces as its
+	// capacity. When it's zero, DefaultMaxUsers decides it
+	MaxUsers int
+	// MaxBandwidth is the maximum number of bits per second a
+	// participant is allowed to send
+	MaxBandwidth int
+	// OpusOnly makes the server use the Opus codec from the start
+	// instead of negotiating one with the first participants
+	OpusOnly bool
+	// Certificate is where the TLS certificate of the server comes from.
+	// Grumble only knows about one certificate, so it can't be given while
+	// another server exists. The servers of a service get theirs with
+	// WithServerCertificate instead
+	Certificate CertificateSource
+	// BannedCertificates are the hashes of the certificates of the
+	// clients kept out of the server from the start
+	BannedCertificates []string
+	// TCPOnly keeps the voice of the participants in their TCP
+	// connection, the only one an onion service carries. Grumble
+	// opens its UDP socket anyway, so the server listens on the
+	// localhost interface unless Address says otherwise, and Wahay
+	// disconnects the participants whose voice arrives over UDP
+	TCPOnly bool
+}
+
+// CertificateSource is a TLS certificate and its private key, given either
+// as PEM files or as their PEM encoded content. It lets organizations use
+// their own long-lived certificate for standing meetings. When it's empty
+// the self-signed certificate generated for the server collection is used
+type CertificateSource struct {
+	CertificateFile string
+	KeyFile         string
+	// CertificatePEM is used when there is no CertificateFile
+	CertificatePEM []byte
+	// PrivateKeyPEM is used when there is no KeyFile
+	PrivateKeyPEM []byte
+}
+
+// ErrInvalidCertificate is returned when the certificate given
+// for a server can't be loaded
+var ErrInvalidCertificate = errors.New("the certificate of the server can't be loaded")
+
+// ErrCertificateInUse is returned when a certificate is given for a server
+// while another one exists, which serves with the certificate there is
+var ErrCertificateInUse = errors.New("the certificate can't change while another server exists")
+
+// WithServerCertificate makes the Mumble server of the meeting serve with
+// the given certificate. It's installed before anything of the service
+// loads the certificate, and refused while another server exists
+func WithServerCertificate(c CertificateSource) ServiceOption {
+	return func(o *serviceOptions) {
+		o.certificate = c
+	}
+}
+
+func (c CertificateSource) isEmpty() bool {
+	return c.CertificateFile == "" && c.KeyFile == "" &&
+		len(c.CertificatePEM) == 0 && len(c.PrivateKeyPEM) == 0
+}
+
+// pemOrFile returns the content of the file, if there is
+// one, or the given PEM content otherwise
+func pemOrFile(content []byte, file string) ([]byte, error) {
+	if file == "" {
+		return content, nil
+	}
+	return os.ReadFile(filepath.Clean(file))
+}
+
+func (c CertificateSource) load() (cert, key []byte, err error) {
+	cert, err = pemOrFile(c.CertificatePEM, c.CertificateFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err = pemOrFile(c.PrivateKeyPEM, c.KeyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, key, nil
+}
+
+// installCertificate installs the certificate, unless there is another
+// server serving with a different one. It must be called with the lock
+// of the collection held
+func (s *servers) installCertificate(c CertificateSource) error {
+	if c.isEmpty() {
+		return nil
+	}
+
+	if len(s.servers) > 0 {
+		cert, _, err := c.load()
+		if err != nil {
+			return err
+		}
+
+		installed, err := os.ReadFile(filepath.Join(s.dataDir, "cert.pem"))
+		if err != nil || !bytes.Equal(installed, cert) {
+			return ErrCertificateInUse
+		}
+		return nil
+	}
+
+	return c.install(s.dataDir)
+}
+
+// install copies the certificate to the data directory, where grumble
+// looks for it when a server starts. Grumble only knows about one
+// certificate, so it's shared with the servers started afterwards
+func (c CertificateSource) install(dataDir string) error {
+	if c.isEmpty() {
+		return nil
+	}
+
+	cert, key, err := c.load()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tls.X509KeyPair(cert, key); err != nil {
+		log.Errorf("CertificateSource.install(): %s", err)
+		return ErrInvalidCertificate
+	}
+
+	err = os.WriteFile(filepath.Join(dataDir, "cert.pem"), cert, 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dataDir, "key.pem"), key, 0600)
+}
+
+func (o ServerOptions) modifiers() []serverModifier {
+	result := []serverModifier{
+		setDefaultOptions,
+		setWelcomeText(o.WelcomeText),
+		setPassword(o.Password),
+		setSuperUser(o.SuperUser.Username, o.SuperUser.Password),
+	}
+
+	if o.Port != 0 {
+		result = append(result, setPort(strconv.Itoa(o.Port)))
+	}
+
+	switch {
+	case o.Address != "":
+		result = append(result, setAddress(o.Address))
+	case o.TCPOnly:
+		result = append(result, setAddress(tcpOnlyAddress))
+	}
+
+	if o.Name != "" {
+		result = append(result, setName(o.Name))
+	}
+
+	maxUsers := o.MaxUsers
+	if maxUsers <= 0 {
+		maxUsers = DefaultMaxUsers(o.MaxBandwidth)
+	}
+	result = append(result, setMaxUsers(maxUsers))
+
+	if o.MaxBandwidth > 0 {
+		result = append(result, setMaxBandwidth(o.MaxBandwidth))
+	}
+
+	if o.OpusOnly {
+		result = append(result, setOpusOnly)
+	}
+
+	if len(o.BannedCertificates) > 0 {
+		result = append(result, setBannedCertificates(o.BannedCertificates))
+	}
+
+	return result
+}
+
+const (
+	// onionServiceBandwidth is the number of bits per second an onion
+	// service comfortably carries for the audio of a meeting
+	onionServiceBandwidth = 5000000
+	// defaultMaxBandwidth is the grumble default for the bits per
+	// second a participant can send
+	defaultMaxBandwidth = 72000
+)
+
+// DefaultMaxUsers returns how many users, the host included, fit in a
+// meeting when each one can send up to maxBandwidth bits per second, or
+// the grumble default if it's zero. The server sends the audio of every
+// user to all the others, so the traffic grows with the square of the
+// users and an onion service gets choppy way before grumble's own limit
+func DefaultMaxUsers(maxBandwidth int) int {
+	if maxBandwidth <= 0 {
+		maxBandwidth = defaultMaxBandwidth
+	}
+
+	n := 2
+	for (n+1)*n*maxBandwidth <= onionServiceBandwidth {
+		n++
+	}
+
+	return n
+}
+
+func setAddress(address string) serverModifier {
+	return func(serv *grumbleServer.Server) {
+		serv.Set("Address", address)
+	}
+}
+
+func setName(name string) serverModifier {
+	return func(serv *grumbleServer.Server) {
+		serv.RootChannel().Name = name
+	}
+}
+
+func setMaxUsers(n int) serverModifier {
+	return func(serv *grumbleServer.Server) {
+		serv.Set("MaxUsers", strconv.Itoa(n))
+	}
+}
+
+func setMaxBandwidth(bps int) serverModifier {
+	return func(serv *grumbleServer.Server) {
+		serv.Set("MaxBandwidth", strconv.Itoa(bps))
+	}
+}
+
+func setOpusOnly(serv *grumbleServer.Server) {
+	serv.Opus = true
+}
@@ -0,0 +1,168 @@
+package hosting
+
+import (
+	"os"
+	"path/filepath"
+
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_ServerOptions_configuresTheGrumbleServer(c *C) {
+	serv, err := grumbleServer.NewServer(1)
+	c.Assert(err, IsNil)
+
+	opts := ServerOptions{
+		Port:     1234,
+		Address:  "127.0.0.2",
+		OpusOnly: true,
+	}
+	for _, m := range opts.modifiers() {
+		m(serv)
+	}
+
+	c.Assert(serv.Port(), Equals, 1234)
+	c.Assert(serv.HostAddress(), Equals, "127.0.0.2")
+	c.Assert(serv.Opus, Equals, true)
+}
+
+func (h *hostingSuite) Test_ServerOptions_usesTheDefaultAddressWhenNoneIsGiven(c *C) {
+	serv, err := grumbleServer.NewServer(1)
+	c.Assert(err, IsNil)
+
+	for _, m := range (ServerOptions{}).modifiers() {
+		m(serv)
+	}
+
+	c.Assert(serv.HostAddress(), Equals, defaultHost())
+	c.Assert(serv.Opus, Equals, false)
+}
+
+func (h *hostingSuite) Test_ServerOptions_namesTheRootChannelAfterTheMeeting(c *C) {
+	serv, err := grumbleServer.NewServer(1)
+	c.Assert(err, IsNil)
+
+	for _, m := range (ServerOptions{Name: "Weekly sync"}).modifiers() {
+		m(serv)
+	}
+
+	c.Assert(serv.RootChannel().Name, Equals, "Weekly sync")
+}
+
+func (h *hostingSuite) Test_CertificateSource_copiesTheCertificateToTheDataDirectory(c *C) {
+	source := c.MkDir()
+	origDataDir := grumbleServer.Args.DataDir
+	defer func() { grumbleServer.Args.DataDir = origDataDir }()
+	grumbleServer.Args.DataDir = source
+	certFile := filepath.Join(source, "cert.pem")
+	keyFile := filepath.Join(source, "key.pem")
+	c.Assert(grumbleServer.GenerateSelfSignedCert(certFile, keyFile), IsNil)
+
+	dataDir := c.MkDir()
+	err := CertificateSource{CertificateFile: certFile, KeyFile: keyFile}.install(dataDir)
+	c.Assert(err, IsNil)
+
+	expected, _ := os.ReadFile(certFile)
+	installed, _ := os.ReadFile(filepath.Join(dataDir, "cert.pem"))
+	c.Assert(installed, DeepEquals, expected)
+}
+
+func (h *hostingSuite) Test_CertificateSource_installsTheGivenPEMContent(c *C) {
+	cert, key := generateTestCertificate(c)
+
+	dataDir := c.MkDir()
+	err := CertificateSource{CertificatePEM: cert, PrivateKeyPEM: key}.install(dataDir)
+	c.Assert(err, IsNil)
+
+	installedCert, _ := os.ReadFile(filepath.Join(dataDir, "cert.pem"))
+	installedKey, _ := os.ReadFile(filepath.Join(dataDir, "key.pem"))
+	c.Assert(installedCert, DeepEquals, cert)
+	c.Assert(installedKey, DeepEquals, key)
+}
+
+func (h *hostingSuite) Test_CertificateSource_rejectsAKeyThatDoesntMatchTheCertificate(c *C) {
+	cert, _ := generateTestCertificate(c)
+	_, otherKey := generateTestCertificate(c)
+
+	err := CertificateSource{CertificatePEM: cert, PrivateKeyPEM: otherKey}.install(c.MkDir())
+	c.Assert(err, Equals, ErrInvalidCertificate)
+}
+
+func (h *hostingSuite) Test_CertificateSource_rejectsFilesThatArentACertificate(c *C) {
+	source := c.MkDir()
+	certFile := filepath.Join(source, "server.crt")
+	c.Assert(os.WriteFile(certFile, []byte("not a certificate"), 0600), IsNil)
+
+	err := CertificateSource{CertificateFile: certFile, KeyFile: certFile}.install(c.MkDir())
+	c.Assert(err, Equals, ErrInvalidCertificate)
+}
+
+func (h *hostingSuite) Test_CertificateSource_doesNothingWhenEmpty(c *C) {
+	dataDir := c.MkDir()
+
+	c.Assert(CertificateSource{}.install(dataDir), IsNil)
+
+	entries, _ := os.ReadDir(dataDir)
+	c.Assert(entries, HasLen, 0)
+}
+
+func (h *hostingSuite) Test_servers_installCertificate_refusesItWhileAnotherServerExists(c *C) {
+	cert, key := generateTestCertificate(c)
+	other, err := grumbleServer.NewServer(1)
+	c.Assert(err, IsNil)
+
+	s := &servers{dataDir: c.MkDir(), servers: map[int64]*grumbleServer.Server{1: other}}
+	err = s.installCertificate(CertificateSource{CertificatePEM: cert, PrivateKeyPEM: key})
+	c.Assert(err, Equals, ErrCertificateInUse)
+	c.Assert(s.installCertificate(CertificateSource{}), IsNil)
+
+	delete(s.servers, 1)
+	c.Assert(s.installCertificate(CertificateSource{CertificatePEM: cert, PrivateKeyPEM: key}), IsNil)
+	installed, _ := os.ReadFile(filepath.Join(s.dataDir, "cert.pem"))
+	c.Assert(installed, DeepEquals, cert)
+
+	// The servers already serve with it
+	s.servers[1] = other
+	c.Assert(s.installCertificate(CertificateSource{CertificatePEM: cert, PrivateKeyPEM: key}), IsNil)
+}
+
+func (h *hostingSuite) Test_WithServerCertificate_keepsTheCertificateForTheService(c *C) {
+	source := CertificateSource{CertificateFile: "cert.pem", KeyFile: "key.pem"}
+	c.Assert(newServiceOptions([]ServiceOption{WithServerCertificate(source)}).certificate, DeepEquals, source)
+}
+
+func (h *hostingSuite) Test_DefaultMaxUsers_fitsTheAudioInAnOnionService(c *C) {
+	c.Assert(DefaultMaxUsers(0), Equals, 8)
+	c.Assert(DefaultMaxUsers(defaultMaxBandwidth), Equals, 8)
+	c.Assert(DefaultMaxUsers(32000), Equals, 13)
+	c.Assert(DefaultMaxUsers(10000000), Equals, 2)
+}
+
+func (h *hostingSuite) Test_serviceOptions_limits_leavesARoomForTheHost(c *C) {
+	maxUsers, guests := newServiceOptions(nil).limits()
+	c.Assert(maxUsers, Equals, 8)
+	c.Assert(guests, Equals, 7)
+
+	maxUsers, guests = newServiceOptions([]ServiceOption{WithMaxUsers(4)}).limits()
+	c.Assert(maxUsers, Equals, 4)
+	c.Assert(guests, Equals, 3)
+
+	maxUsers, guests = newServiceOptions([]ServiceOption{WithMaxParticipants(4)}).limits()
+	c.Assert(maxUsers, Equals, 5)
+	c.Assert(guests, Equals, 4)
+
+	maxUsers, guests = newServiceOptions([]ServiceOption{WithMaxUsers(1)}).limits()
+	c.Assert(maxUsers, Equals, 1)
+	c.Assert(guests, Equals, 1)
+}
+
+func (h *hostingSuite) Test_ServerOptions_TCPOnly_keepsTheAddressGiven(c *C) {
+	serv, err := grumbleServer.NewServer(1)
+	c.Assert(err, IsNil)
+
+	for _, m := range (ServerOptions{TCPOnly: true, Address: "127.0.0.2"}).modifiers() {
+		m(serv)
+	}
+
+	c.Assert(serv.HostAddress(), Equals, "127.0.0.2")
+}
@@ -1,26 +1,34 @@
 package hosting
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/digitalautonomy/grumble/pkg/blobstore"
 	"github.com/digitalautonomy/grumble/pkg/logtarget"
 	grumbleServer "github.com/digitalautonomy/grumble/server"
 	"github.com/digitalautonomy/wahay/tor"
 )
 
-// Servers serves
+// Servers serves. Several meetings can be hosted at the same time, each
+// one with its own service and server
 type Servers interface {
-	CreateServer(...serverModifier) (Server, error)
+	CreateServer(ServerOptions) (Server, error)
 	DestroyServer(Server) error
 	DataDir() string
+	Info() Info
+	Certificate() (cert, key []byte, err error)
+	Close() error
 	Cleanup()
-	NewService(port string, t tor.Instance) (Service, error)
+	NewService(port string, t tor.Instance, opts ...ServiceOption) (Service, error)
+	Services() []Service
 }
 
 // MeetingData is a representation of the data used to create a Mumble url
@@ -31,21 +39,68 @@ type MeetingData struct {
 	Password  string
 	Username  string
 	IsHost    bool
+	// ClientAuthKey is the private key used to connect to meetings
+	// that require client authorization
+	ClientAuthKey string
+	// InvitationToken is the signature of the host in the invitation,
+	// for meetings with signed invitations
+	InvitationToken string
+	// StartMuted and StartDeafened are how the microphone and the
+	// sound of the meeting are when joining it. They aren't part
+	// of the invitation, but a choice of the one joining
+	StartMuted    bool
+	StartDeafened bool
+	MeetingInfo
 }
 
-func create() (Servers, error) {
+func create(opts ...CollectionOption) (Servers, error) {
 	s := &servers{}
+	for _, o := range opts {
+		o(s)
+	}
 	e := s.create()
 
 	return s, e
 }
 
+// collectionState is the stage of the life of a server collection.
+// A closed collection has removed its data, so it can't be used again
+type collectionState int
+
+const (
+	collectionOpen collectionState = iota
+	collectionClosed
+)
+
+// ErrCollectionClosed is returned when using a server collection after closing it
+var ErrCollectionClosed = errors.New("the servers have already been closed")
+
 type servers struct {
-	dataDir string
-	started bool
-	nextID  int
-	servers map[int64]*grumbleServer.Server
-	log     *log.Logger
+	sync.Mutex
+	dataDir  string
+	started  bool
+	state    collectionState
+	nextID   int
+	servers  map[int64]*grumbleServer.Server
+	services map[*service]bool
+	log      *log.Logger
+
+	// created are the servers not destroyed yet, so
+	// they can be stopped before removing their data
+	created map[*server]bool
+
+	// certificate and privateKey are used instead of
+	// a new self-signed certificate when they're given
+	certificate []byte
+	privateKey  []byte
+	// certAlgorithm is the kind of key of the certificate
+	// generated when none is given
+	certAlgorithm CertificateAlgorithm
+
+	// dataParent is where the data directory is created, or
+	// the temporary directory of the system when it's empty
+	dataParent    string
+	memoryStorage bool
 }
 
 func (s *servers) initializeSharedObjects() {
@@ -57,8 +112,12 @@ var ioutilTempDir = ioutil.TempDir
 var osMkdirAll = os.MkdirAll
 
 func (s *servers) initializeDataDirectory() error {
-	var e error
-	s.dataDir, e = ioutilTempDir("", "wahay")
+	parent, e := s.dataDirectoryParent()
+	if e != nil {
+		return e
+	}
+
+	s.dataDir, e = ioutilTempDir(parent, "wahay")
 	if e != nil {
 		s.log.Debug(e.Error())
 		return e
@@ -72,6 +131,13 @@ func (s *servers) initializeDataDirectory() error {
 		return e
 	}
 
+	e = osMkdirAll(s.blobDir(), 0700)
+	if e != nil {
+		s.log.Debug(e.Error())
+		return e
+	}
+	grumbleServer.SetBlobStore(blobstore.Open(s.blobDir()))
+
 	return nil
 }
 
@@ -94,17 +160,21 @@ func (s *servers) initializeLogging() error {
 }
 
 func (s *servers) initializeCertificates() error {
-	s.log.Debug("Generating 4096-bit RSA keypair for self-signed certificate...")
+	if len(s.certificate) != 0 {
+		s.log.Debug("Using the certificate given for the servers")
+		return s.useCertificate()
+	}
 
-	certFn := filepath.Join(s.dataDir, "cert.pem")
-	keyFn := filepath.Join(s.dataDir, "key.pem")
-	err := grumbleServer.GenerateSelfSignedCert(certFn, keyFn)
+	algorithm := ParseCertificateAlgorithm(string(s.certAlgorithm))
+	s.log.Debugf("Generating %s keypair for self-signed certificate...", algorithm)
+
+	err := generateCertificate(algorithm)
 	if err != nil {
 		return err
 	}
 
-	s.log.Debugf("Certificate output to %v", certFn)
-	s.log.Debugf("Private key output to %v", keyFn)
+	s.log.Debugf("Certificate output to %v", filepath.Join(grumbleServer.Args.DataDir, "cert.pem"))
+	s.log.Debugf("Private key output to %v", filepath.Join(grumbleServer.Args.DataDir, "key.pem"))
 	return nil
 }
 
@@ -132,6 +202,9 @@ func (s *servers) create() error {
 }
 
 func (s *servers) startListener() {
+	s.Lock()
+	defer s.Unlock()
+
 	if !s.started {
 		go grumbleServer.SignalHandler()
 		s.started = true
@@ -176,39 +249,204 @@ func setSuperUser(username, password string) serverModifier {
 	}
 }
 
-func (s *servers) CreateServer(modifiers ...serverModifier) (Server, error) {
+// CreateServer creates a new Mumble server with the given options.
+// The server must be started before participants can join
+func (s *servers) CreateServer(opts ServerOptions) (Server, error) {
+	return s.createServer(opts)
+}
+
+func (s *servers) createServer(opts ServerOptions) (*server, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.state == collectionClosed {
+		return nil, ErrCollectionClosed
+	}
+
+	err := s.installCertificate(opts.Certificate)
+	if err != nil {
+		return nil, err
+	}
+
 	s.nextID++
 	serv, err := grumbleServer.NewServer(int64(s.nextID))
 	if err != nil {
 		return nil, err
 	}
+	logToWahay(serv)
 
-	s.servers[serv.Id] = serv
+	err = os.Mkdir(s.serverDir(serv.Id), 0750)
+	if err != nil {
+		return nil, err
+	}
 
-	err = os.Mkdir(filepath.Join(s.dataDir, "servers", fmt.Sprintf("%v", serv.Id)), 0750)
+	controlCert, controlHash, err := newSessionCertificate()
 	if err != nil {
 		return nil, err
 	}
 
-	for _, m := range modifiers {
+	s.servers[serv.Id] = serv
+
+	for _, m := range opts.modifiers() {
 		m(serv)
 	}
 
-	return &server{s, serv}, nil
+	// Wahay moderates the meeting from a session of its own, which
+	// has all the permissions through the certificate it uses
+	root := serv.RootChannel()
+	root.ACL.ACLs = append(root.ACL.ACLs, moderationACL(controlHash))
+
+	result := &server{
+		serverCollection: s,
+		gs:               serv,
+		password:         opts.Password,
+		tcpOnly:          opts.TCPOnly,
+		controlCert:      controlCert,
+		events:           newParticipantEvents(),
+		messages:         newChatMessages(),
+	}
+	if s.created == nil {
+		s.created = make(map[*server]bool)
+	}
+	s.created[result] = true
+
+	return result, nil
 }
 
-func (s *servers) DestroyServer(Server) error {
-	// For now, this function will do nothing. We will still call it,
-	// in case we need it in the server
-	return nil
+var errUnknownServer = errors.New("the server was not created by this collection")
+
+// DestroyServer stops the server if it's still running, removes its data
+// and deletes the onion service of its meeting. The other meetings hosted
+// keep working. A server that can't be stopped keeps its data, so it
+// can be destroyed again later
+func (s *servers) DestroyServer(serv Server) error {
+	ss, ok := serv.(*server)
+	if !ok || ss == nil || ss.gs == nil {
+		return errUnknownServer
+	}
+
+	if err := ss.Stop(); err != nil {
+		log.Errorf("DestroyServer(): %s", err)
+		return ErrServerNoClosed
+	}
+
+	var result error
+
+	s.Lock()
+	delete(s.servers, ss.gs.Id)
+	delete(s.created, ss)
+	s.Unlock()
+
+	if err := os.RemoveAll(s.serverDir(ss.gs.Id)); err != nil {
+		log.Errorf("DestroyServer(): %s", err)
+	}
+
+	if ss.onion != nil {
+		if err := ss.onion.Delete(); err != nil {
+			log.Errorf("DestroyServer(): %s", err)
+			if result == nil {
+				result = ErrServerOnionDelete
+			}
+		}
+		ss.onion = nil
+	}
+
+	return result
+}
+
+// serverDir is the directory where grumble keeps the data of a server
+func (s *servers) serverDir(id int64) string {
+	return filepath.Join(s.dataDir, "servers", fmt.Sprintf("%v", id))
 }
 
 func (s *servers) DataDir() string {
 	return s.dataDir
 }
 
+func (s *servers) register(ss *service) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.services == nil {
+		s.services = make(map[*service]bool)
+	}
+	s.services[ss] = true
+}
+
+func (s *servers) unregister(ss *service) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.services, ss)
+}
+
+// Services returns the meetings hosted at the moment
+func (s *servers) Services() []Service {
+	s.Lock()
+	defer s.Unlock()
+
+	result := []Service{}
+	for ss := range s.services {
+		result = append(result, ss)
+	}
+	return result
+}
+
+func (s *servers) createdServers() []*server {
+	s.Lock()
+	defer s.Unlock()
+
+	result := []*server{}
+	for ss := range s.created {
+		result = append(result, ss)
+	}
+	return result
+}
+
+// Close closes the meetings that are still hosted, destroys the servers
+// left and removes the data of all of them. The data is kept if any
+// server can't be stopped, and Close can be called again to retry.
+// Closing an already closed collection does nothing
+func (s *servers) Close() error {
+	s.Lock()
+	closed := s.state == collectionClosed
+	s.Unlock()
+
+	if closed {
+		return nil
+	}
+
+	var result error
+
+	for _, ss := range s.Services() {
+		if err := ss.Close(); err != nil {
+			log.Errorf("Close(): %s", err)
+			result = err
+		}
+	}
+
+	for _, ss := range s.createdServers() {
+		if err := s.DestroyServer(ss); err != nil {
+			log.Errorf("Close(): %s", err)
+			result = err
+		}
+	}
+
+	if result != nil {
+		return result
+	}
+
+	s.Lock()
+	s.state = collectionClosed
+	s.Unlock()
+
+	return os.RemoveAll(s.dataDir)
+}
+
+// Cleanup closes the collection when Wahay exits, where
+// there is nobody left to handle the error
 func (s *servers) Cleanup() {
-	err := os.RemoveAll(s.dataDir)
+	err := s.Close()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "WARNING: Error cleaning up temporaries: "+err.Error())
 	}
@@ -190,7 +190,7 @@ func (s *hostingSuite) Test_CreateServer_setDefaultOptionsOnlyReturnsAServerInst
 		dataDir: path,
 	}
 
-	serv, err := servers.CreateServer(setDefaultOptions)
+	serv, err := servers.CreateServer(ServerOptions{})
 	c.Assert(err, IsNil)
 	c.Assert(reflect.TypeOf(serv), DeepEquals, reflect.TypeOf(&server{}))
 }
@@ -211,7 +211,7 @@ func (s *hostingSuite) Test_CreateServer_setWelcomeTextOnlyReturnsAServerInstanc
 		dataDir: path,
 	}
 
-	serv, err := servers.CreateServer(setWelcomeText("hello wahay"))
+	serv, err := servers.CreateServer(ServerOptions{WelcomeText: "hello wahay"})
 	c.Assert(err, IsNil)
 	c.Assert(reflect.TypeOf(serv), DeepEquals, reflect.TypeOf(&server{}))
 }
@@ -232,7 +232,7 @@ func (s *hostingSuite) Test_CreateServer_setPortOnlyReturnsAServerInstance(c *C)
 		dataDir: path,
 	}
 
-	serv, err := servers.CreateServer(setPort("1234"))
+	serv, err := servers.CreateServer(ServerOptions{Port: 1234})
 	c.Assert(err, IsNil)
 	c.Assert(reflect.TypeOf(serv), DeepEquals, reflect.TypeOf(&server{}))
 }
@@ -253,7 +253,7 @@ func (s *hostingSuite) Test_CreateServer_setPasswordOnlyReturnsAServerInstance(c
 		dataDir: path,
 	}
 
-	serv, err := servers.CreateServer(setPassword("pAwd12!@"))
+	serv, err := servers.CreateServer(ServerOptions{Password: "pAwd12!@"})
 	c.Assert(err, IsNil)
 	c.Assert(reflect.TypeOf(serv), DeepEquals, reflect.TypeOf(&server{}))
 }
@@ -274,12 +274,12 @@ func (s *hostingSuite) Test_CreateServer_setSuperUserOnlyReturnsAServerInstance(
 		dataDir: path,
 	}
 
-	serv, err := servers.CreateServer(setSuperUser("root", "pAwd12!@"))
+	serv, err := servers.CreateServer(ServerOptions{SuperUser: SuperUserData{Username: "root", Password: "pAwd12!@"}})
 	c.Assert(err, IsNil)
 	c.Assert(reflect.TypeOf(serv), DeepEquals, reflect.TypeOf(&server{}))
 }
 
-func (s *hostingSuite) Test_CreateServer_sendSeveralServerModifiersReturnsAServerInstanceWithNoErrors(c *C) {
+func (s *hostingSuite) Test_CreateServer_sendSeveralServerOptionsReturnsAServerInstanceWithNoErrors(c *C) {
 	path := "/tmp/wahay/"
 	var perm fs.FileMode = 0700
 	e := os.MkdirAll(filepath.Join(path, "servers"), perm)
@@ -295,13 +295,12 @@ func (s *hostingSuite) Test_CreateServer_sendSeveralServerModifiersReturnsAServe
 		dataDir: path,
 	}
 
-	serv, err := servers.CreateServer(
-		setDefaultOptions,
-		setWelcomeText("hello wahay"),
-		setPort("1234"),
-		setPassword("pAwd12!@"),
-		setSuperUser("root", "pAwd12!@"),
-	)
+	serv, err := servers.CreateServer(ServerOptions{
+		WelcomeText: "hello wahay",
+		Port:        1234,
+		Password:    "pAwd12!@",
+		SuperUser:   SuperUserData{Username: "root", Password: "pAwd12!@"},
+	})
 
 	c.Assert(err, IsNil)
 	c.Assert(reflect.TypeOf(serv), DeepEquals, reflect.TypeOf(&server{}))
@@ -315,7 +314,7 @@ func (s *hostingSuite) Test_CreateServer_returnsAnErrorWhenServersDirectoryHasNo
 
 	expectedError := `mkdir servers[/\\]2: (no such file or directory|The system cannot find the path specified.)$`
 
-	_, err := servers.CreateServer()
+	_, err := servers.CreateServer(ServerOptions{})
 	c.Assert(err, NotNil)
 	c.Assert(err, ErrorMatches, expectedError)
 }
@@ -358,3 +357,211 @@ func (s *hostingSuite) Test_Cleanup_deleteCurrentServerDataDirSuccessfully(c *C)
 	c.Assert(e, NotNil)
 	c.Assert(e, ErrorMatches, expectedErr)
 }
+
+func (s *hostingSuite) Test_CreateServer_givesEveryServerItsOwnDirectory(c *C) {
+	path := c.MkDir()
+	e := os.MkdirAll(filepath.Join(path, "servers"), 0700)
+	c.Assert(e, IsNil)
+
+	servers := &servers{
+		servers: make(map[int64]*grumbleServer.Server),
+		dataDir: path,
+	}
+
+	_, err := servers.CreateServer(ServerOptions{Port: 1234})
+	c.Assert(err, IsNil)
+	_, err = servers.CreateServer(ServerOptions{Port: 1235})
+	c.Assert(err, IsNil)
+
+	c.Assert(servers.servers, HasLen, 2)
+	c.Assert(servers.serverDir(1), Not(Equals), servers.serverDir(2))
+
+	entries, _ := os.ReadDir(filepath.Join(path, "servers"))
+	c.Assert(entries, HasLen, 2)
+}
+
+func (s *hostingSuite) Test_Services_returnsTheMeetingsHostedAtTheMoment(c *C) {
+	servers := &servers{}
+	first := &service{collection: servers}
+	second := &service{collection: servers}
+
+	servers.register(first)
+	servers.register(second)
+	c.Assert(servers.Services(), HasLen, 2)
+
+	c.Assert(first.Close(), IsNil)
+	c.Assert(servers.Services(), DeepEquals, []Service{second})
+}
+
+func (s *hostingSuite) Test_Close_keepsTheDataOfTheOtherMeetings(c *C) {
+	path := c.MkDir()
+	servers := &servers{dataDir: path}
+	srvc := &service{collection: servers}
+	servers.register(srvc)
+
+	c.Assert(srvc.Close(), IsNil)
+
+	_, e := os.ReadDir(path)
+	c.Assert(e, IsNil)
+}
+
+func (s *hostingSuite) Test_Cleanup_closesTheMeetingsStillHosted(c *C) {
+	path := c.MkDir()
+	servers := &servers{dataDir: path}
+	servers.register(&service{collection: servers})
+
+	servers.Cleanup()
+
+	c.Assert(servers.Services(), HasLen, 0)
+	_, e := os.ReadDir(path)
+	c.Assert(e, NotNil)
+}
+
+func (s *hostingSuite) Test_DestroyServer_removesOnlyTheDataOfThatServer(c *C) {
+	path := c.MkDir()
+	e := os.MkdirAll(filepath.Join(path, "servers"), 0700)
+	c.Assert(e, IsNil)
+
+	servers := &servers{
+		servers: make(map[int64]*grumbleServer.Server),
+		dataDir: path,
+	}
+
+	first, err := servers.CreateServer(ServerOptions{Port: 1234})
+	c.Assert(err, IsNil)
+	_, err = servers.CreateServer(ServerOptions{Port: 1235})
+	c.Assert(err, IsNil)
+
+	c.Assert(servers.DestroyServer(first), IsNil)
+
+	c.Assert(servers.servers, HasLen, 1)
+	_, e = os.Stat(servers.serverDir(1))
+	c.Assert(os.IsNotExist(e), Equals, true)
+	_, e = os.Stat(servers.serverDir(2))
+	c.Assert(e, IsNil)
+}
+
+func (s *hostingSuite) Test_DestroyServer_deletesTheOnionServiceOfTheMeeting(c *C) {
+	path := c.MkDir()
+	e := os.MkdirAll(filepath.Join(path, "servers"), 0700)
+	c.Assert(e, IsNil)
+
+	servers := &servers{
+		servers: make(map[int64]*grumbleServer.Server),
+		dataDir: path,
+	}
+
+	serv, err := servers.createServer(ServerOptions{Port: 1234})
+	c.Assert(err, IsNil)
+	onion := &onionMock{id: "meeting"}
+	serv.onion = onion
+
+	c.Assert(servers.DestroyServer(serv), IsNil)
+	c.Assert(onion.deleted, Equals, true)
+	c.Assert(serv.onion, IsNil)
+}
+
+func (s *hostingSuite) Test_DestroyServer_returnsAnErrorWhenTheOnionServiceCantBeDeleted(c *C) {
+	path := c.MkDir()
+	e := os.MkdirAll(filepath.Join(path, "servers"), 0700)
+	c.Assert(e, IsNil)
+
+	servers := &servers{
+		servers: make(map[int64]*grumbleServer.Server),
+		dataDir: path,
+	}
+
+	serv, err := servers.createServer(ServerOptions{Port: 1234})
+	c.Assert(err, IsNil)
+	serv.onion = &onionMock{id: "meeting", deleteErr: errors.New("no control port")}
+
+	c.Assert(servers.DestroyServer(serv), Equals, ErrServerOnionDelete)
+	c.Assert(servers.servers, HasLen, 0)
+}
+
+func (s *hostingSuite) Test_DestroyServer_returnsAnErrorForServersOfOtherCollections(c *C) {
+	servers := &servers{}
+
+	c.Assert(servers.DestroyServer(nil), Equals, errUnknownServer)
+}
+
+func (s *hostingSuite) Test_DestroyServer_keepsTheDataOfAServerThatCantBeStopped(c *C) {
+	path := c.MkDir()
+	e := os.MkdirAll(filepath.Join(path, "servers"), 0700)
+	c.Assert(e, IsNil)
+
+	servers := &servers{
+		servers: make(map[int64]*grumbleServer.Server),
+		dataDir: path,
+	}
+
+	serv, err := servers.createServer(ServerOptions{Port: 1234})
+	c.Assert(err, IsNil)
+	// Grumble fails to stop a server it never started
+	serv.running = true
+
+	c.Assert(servers.DestroyServer(serv), Equals, ErrServerNoClosed)
+	c.Assert(servers.servers, HasLen, 1)
+	_, e = os.Stat(servers.serverDir(1))
+	c.Assert(e, IsNil)
+}
+
+func (s *hostingSuite) Test_Close_keepsTheDataWhileAMeetingIsStillLive(c *C) {
+	path := c.MkDir()
+	e := os.MkdirAll(filepath.Join(path, "servers"), 0700)
+	c.Assert(e, IsNil)
+
+	servers := &servers{
+		servers: make(map[int64]*grumbleServer.Server),
+		dataDir: path,
+	}
+
+	serv, err := servers.createServer(ServerOptions{Port: 1234})
+	c.Assert(err, IsNil)
+	serv.running = true
+
+	c.Assert(servers.Close(), Equals, ErrServerNoClosed)
+	_, e = os.ReadDir(path)
+	c.Assert(e, IsNil)
+
+	_, err = servers.CreateServer(ServerOptions{Port: 1235})
+	c.Assert(err, IsNil)
+}
+
+func (s *hostingSuite) Test_Close_canBeCalledMoreThanOnce(c *C) {
+	path := c.MkDir()
+	e := os.MkdirAll(filepath.Join(path, "servers"), 0700)
+	c.Assert(e, IsNil)
+
+	servers := &servers{
+		servers: make(map[int64]*grumbleServer.Server),
+		dataDir: path,
+	}
+
+	_, err := servers.CreateServer(ServerOptions{Port: 1234})
+	c.Assert(err, IsNil)
+
+	c.Assert(servers.Close(), IsNil)
+	c.Assert(servers.servers, HasLen, 0)
+	_, e = os.ReadDir(path)
+	c.Assert(e, NotNil)
+
+	c.Assert(servers.Close(), IsNil)
+}
+
+func (s *hostingSuite) Test_CreateServer_failsOnAClosedCollection(c *C) {
+	servers := &servers{dataDir: c.MkDir()}
+	c.Assert(servers.Close(), IsNil)
+
+	_, err := servers.CreateServer(ServerOptions{})
+	c.Assert(err, Equals, ErrCollectionClosed)
+
+	_, err = servers.NewService("", nil)
+	c.Assert(err, Equals, ErrCollectionClosed)
+}
+
+func (s *hostingSuite) Test_Stop_doesNothingOnAStoppedServer(c *C) {
+	serv := &server{gs: &grumbleServer.Server{}}
+
+	c.Assert(serv.Stop(), IsNil)
+}
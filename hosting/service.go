@@ -5,6 +5,8 @@ import (
 	"net"
 	"os"
 	"strconv"
+	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -13,8 +15,8 @@ import (
 )
 
 // CreateServerCollection creates the hosting server
-func CreateServerCollection() (Servers, error) {
-	return create()
+func CreateServerCollection(opts ...CollectionOption) (Servers, error) {
+	return create(opts...)
 }
 
 const (
@@ -63,20 +65,70 @@ type Service interface {
 	URL() string
 	Port() int
 	ServicePort() int
+	WebGatewayURL() string
 	SetWelcomeText(string)
+	SetWelcome(Welcome) error
+	SetAudioProfile(AudioProfile)
+	SetMeetingInfo(MeetingInfo)
+	ClientAuthKey() string
+	Invitations() []string
+	RevokeInvitation(invitation string) error
+	InvitationExpiry() time.Time
+	RenewInvitations(validFor time.Duration) error
+	WaitingParticipants() int
+	HandOffModeration(notice string) error
+	RestoreModeration() error
 	NewConferenceRoom(password string, u SuperUserData) error
+	Server() Server
 	Close() error
 }
 
 type service struct {
-	port        int
-	mumblePort  int
-	welcomeText string
-	onion       tor.Onion
-	room        *conferenceRoom
-	httpServer  *webserver
-	collection  Servers
-	checkServer *checkService
+	port         int
+	mumblePort   int
+	welcomeText  string
+	onion        tor.Onion
+	room         *conferenceRoom
+	httpServer   *webserver
+	collection   *servers
+	checkServer  *checkService
+	clientAuth   *clientAuthKeys
+	gate         *connectionGate
+	webGateway   *webGateway
+	coHost       string
+	maxUsers     int
+	audioProfile AudioProfile
+
+	// tor, onionPorts and onionKey publish the onion
+	// service again when an invitation is revoked
+	tor        tor.Instance
+	onionPorts []tor.OnionPort
+	onionKey   *tor.OnionKey
+
+	// invitationToken is the signature of the host
+	// in the invitations, when they are signed, and
+	// invitationExpiry is when they can't be used anymore
+	invitationToken  string
+	invitationExpiry time.Time
+
+	// inviteeNames are the usernames in the invitations
+	// when the meeting doesn't use client authorization
+	inviteeNames []string
+
+	// info are the details of the meeting in the invitations
+	info MeetingInfo
+
+	// idleTimeout is how long the meeting is kept without guests
+	idleTimeout    time.Duration
+	onIdleShutdown func(error)
+	stopIdle       func()
+
+	banList BanList
+
+	// closeLock keeps the meeting from being closed twice at the
+	// same time, by the host and by the idle shutdown
+	closeLock sync.Mutex
+	closed    bool
 }
 
 func (s *service) ID() string {
@@ -90,6 +142,9 @@ func (s *service) URL() string {
 	return s.ID()
 }
 
+// Port returns the local port of the Mumble server. It can change when
+// the meeting starts, if the port was taken in the meantime, so the
+// meeting data for the host must be built afterwards
 func (s *service) Port() int {
 	return s.port
 }
@@ -102,23 +157,104 @@ func (s *service) SetWelcomeText(t string) {
 	s.welcomeText = t
 }
 
+// SetAudioProfile chooses the audio quality of the meeting.
+// It must be called before the meeting starts
+func (s *service) SetAudioProfile(p AudioProfile) {
+	s.audioProfile = ParseAudioProfile(string(p))
+}
+
+// ClientAuthKey returns the key the host needs to join their own
+// meeting, or an empty string if the meeting doesn't use client authorization
+func (s *service) ClientAuthKey() string {
+	if s.clientAuth == nil {
+		return ""
+	}
+	return s.clientAuth.host.PrivateKey
+}
+
+// Invitations returns one meeting address per invitee. Without client
+// authorization all the invitees share the same address, unless they
+// were given names, which makes one address per name
+func (s *service) Invitations() []string {
+	if s.clientAuth == nil {
+		if len(s.inviteeNames) == 0 {
+			return []string{s.invitationURL("", "")}
+		}
+
+		result := []string{}
+		for _, name := range s.inviteeNames {
+			result = append(result, s.invitationURL(name, ""))
+		}
+		return result
+	}
+
+	result := []string{}
+	for i, k := range s.clientAuth.invitees {
+		result = append(result, s.invitationURL(s.clientAuth.name(i), k.PrivateKey))
+	}
+
+	return result
+}
+
+// invitationURL returns the address of an invitation to the meeting,
+// with the signature of the host and the details of the meeting
+func (s *service) invitationURL(username, clientAuthKey string) string {
+	params := invitationParameters(clientAuthKey, s.invitationToken)
+	s.info.addTo(params)
+	return withParameters(withUsername(s.URL(), username), params)
+}
+
+// WaitingParticipants returns the number of participants waiting
+// for somebody to leave a full meeting
+func (s *service) WaitingParticipants() int {
+	if s.gate == nil {
+		return 0
+	}
+	return s.gate.waiting()
+}
+
+// Server returns the Mumble server of the meeting, or nil
+// if the meeting has not started
+func (s *service) Server() Server {
+	if s.room == nil {
+		return nil
+	}
+	return s.room.server
+}
+
 type conferenceRoom struct {
 	server Server
 }
 
 func (s *service) NewConferenceRoom(password string, u SuperUserData) error {
-	serv, err := s.collection.CreateServer(
-		setDefaultOptions,
-		setWelcomeText(s.welcomeText),
-		setPort(strconv.Itoa(s.port)),
-		setPassword(password),
-		setSuperUser(u.Username, u.Password),
-	)
+	opts := ServerOptions{
+		Port:        s.port,
+		Password:    password,
+		SuperUser:   u,
+		WelcomeText: s.welcomeText,
+		MaxUsers:    s.maxUsers,
+		Name:        s.info.Title,
+		// Everybody arrives through Tor and the connection
+		// gate, neither of which carries UDP
+		TCPOnly: true,
+	}
+	ParseAudioProfile(string(s.audioProfile)).apply(&opts)
+	if s.banList != nil {
+		opts.BannedCertificates = s.banList.BannedCertificates()
+	}
+
+	serv, err := s.collection.createServer(opts)
 	if err != nil {
 		return err
 	}
+	serv.onion = s.onion
+	serv.banList = s.banList
+	if s.gate != nil {
+		serv.events = s.gate.events
+		serv.gate = s.gate
+	}
 
-	err = serv.Start()
+	err = s.startOnFreePort(serv)
 	if err != nil {
 		return err
 	}
@@ -135,35 +271,104 @@ func (s *service) NewConferenceRoom(password string, u SuperUserData) error {
 
 	s.checkServer.start()
 
+	if s.gate != nil {
+		s.gate.start()
+	}
+
+	if s.webGateway != nil {
+		s.webGateway.start()
+	}
+
+	s.watchIdleShutdown(serv)
+
 	return nil
 }
 
+// portAttempts is the number of ports tried before giving
+// up on starting the Mumble server of a meeting
+const portAttempts = 10
+
+// startOnFreePort starts the server, moving it to another port when
+// something else took its port between choosing it and starting the server
+func (s *service) startOnFreePort(serv *server) error {
+	for attempt := 1; ; attempt++ {
+		err := serv.Start()
+		if err == nil || !isAddressInUse(err) || attempt == portAttempts {
+			return err
+		}
+
+		port := config.GetRandomPort()
+		log.WithFields(log.Fields{
+			"taken": s.port,
+			"port":  port,
+		}).Debug("startOnFreePort(): the port of the Mumble server is in use, trying another one")
+
+		serv.gs.Set("Port", strconv.Itoa(port))
+		s.port = port
+		if s.gate != nil {
+			s.gate.target = net.JoinHostPort("127.0.0.1", strconv.Itoa(port))
+		}
+	}
+}
+
 func (r *conferenceRoom) close() error {
 	return r.server.Stop()
 }
 
 // NewService creates a new hosting service
-func (s *servers) NewService(port string, t tor.Instance) (Service, error) {
+func (s *servers) NewService(port string, t tor.Instance, opts ...ServiceOption) (Service, error) {
+	options := newServiceOptions(opts)
+
+	s.Lock()
+	closed := s.state == collectionClosed
+	// The certificate server, the lobby and the session
+	// of Wahay load the certificate installed here
+	var err error
+	if !closed {
+		err = s.installCertificate(options.certificate)
+	}
+	s.Unlock()
+
+	if closed {
+		return nil, ErrCollectionClosed
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	var onionPorts []tor.OnionPort
+	var onionOptions []tor.OnionOption
+	var clientAuth *clientAuthKeys
+
+	// What was started is stopped again when the
+	// meeting can't be created, and kept otherwise
+	var cleanup []func()
+	defer func() {
+		for _, stop := range cleanup {
+			stop()
+		}
+	}()
 
-	httpServer, err := newCertificateServer(s.DataDir())
+	httpServer, err := newCertificateServer(s.DataDir(), options.listen.hostOrDefault())
 	if err != nil {
 		return nil, err
 	}
+	cleanup = append(cleanup, func() { _ = httpServer.stop() })
 
 	onionPorts = append(onionPorts, tor.OnionPort{
-		DestinationHost: defaultHost(),
+		DestinationHost: options.listen.hostOrDefault(),
 		DestinationPort: httpServer.port,
 		ServicePort:     certServerPort,
 	})
 
-	checkService, err := newCheckConnectionService()
+	checkService, err := newCheckConnectionService(options.listen.hostOrDefault())
 	if err != nil {
 		return nil, err
 	}
+	cleanup = append(cleanup, func() { _ = checkService.stop() })
 
 	onionPorts = append(onionPorts, tor.OnionPort{
-		DestinationHost: defaultHost(),
+		DestinationHost: options.listen.hostOrDefault(),
 		DestinationPort: checkService.port,
 		ServicePort:     checkConnectionPort,
 	})
@@ -176,27 +381,109 @@ func (s *servers) NewService(port string, t tor.Instance) (Service, error) {
 		}
 	}
 
+	if options.invitees > 0 {
+		clientAuth, err = generateClientAuthKeys(options.invitees)
+		if err != nil {
+			return nil, err
+		}
+		onionOptions = append(onionOptions, tor.WithClientAuthorization(clientAuth.publicKeys()...))
+		clientAuth.names = options.inviteeNames
+	}
+
+	// Revoking an invitation publishes the service again, which needs
+	// the key to keep the same meeting ID, and signing the invitations
+	// needs it too
+	if options.onionKey == nil && (options.invitees > 0 || options.invitationLifetime > 0) {
+		options.onionKey, err = tor.GenerateOnionKey()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if options.onionKey != nil {
+		onionOptions = append(onionOptions, tor.WithPrivateKey(options.onionKey))
+	}
+
+	var invitationToken string
+	var invitationExpiry time.Time
+	if options.invitationLifetime > 0 {
+		invitationExpiry = time.Now().Add(options.invitationLifetime)
+		invitationToken, err = signInvitation(options.onionKey, invitationExpiry)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	serverPort := config.GetRandomPort()
 
-	onionPorts = append(onionPorts, tor.OnionPort{
-		DestinationHost: defaultHost(),
-		DestinationPort: serverPort,
-		ServicePort:     p,
-	})
+	maxUsers, guests := options.limits()
+	gate, err := newConnectionGate(options.listen, net.JoinHostPort("127.0.0.1", strconv.Itoa(serverPort)), guests)
+	if err != nil {
+		return nil, err
+	}
+	cleanup = append(cleanup, func() { _ = gate.stop() })
+	gate.keepAlive = options.keepAlive
+	gate.waitingRoom = options.waitingRoom
+	gate.texts = options.queueTexts.or(gate.texts)
+	if cert, err := serverTLSCertificate(); err == nil {
+		gate.certificate = &cert
+	} else {
+		log.Errorf("The guests in the queue won't know their place in it: %v", err)
+	}
+
+	onionPorts = append(onionPorts, options.listen.onionPort(gate.port, p))
+
+	// The meeting works without the web gateway, so it's
+	// only left out when it can't be created
+	var web *webGateway
+	if options.webGateway {
+		web, err = newWebGateway(options.listen, s.DataDir(), options.mumbleWebDir, dialGate(gate))
+		if err != nil {
+			log.Errorf("The meeting can't be joined from Tor Browser: %v", err)
+		} else {
+			cleanup = append(cleanup, func() { _ = web.listener.Close() })
+			onionPorts = append(onionPorts, tor.OnionPort{
+				DestinationHost: options.listen.hostOrDefault(),
+				DestinationPort: web.port,
+				ServicePort:     webGatewayPort,
+			})
+		}
+	}
 
-	onion, err := t.NewOnionServiceWithMultiplePorts(onionPorts)
+	onion, err := t.NewOnionServiceWithMultiplePorts(onionPorts, onionOptions...)
 	if err != nil {
 		return nil, err
 	}
+	cleanup = nil
 
 	ss := &service{
 		port:        serverPort,
 		mumblePort:  p,
 		onion:       onion,
+		tor:         t,
+		onionPorts:  onionPorts,
+		onionKey:    options.onionKey,
 		httpServer:  httpServer,
 		collection:  s,
 		checkServer: checkService,
+		clientAuth:  clientAuth,
+		gate:        gate,
+		webGateway:  web,
+		coHost:      options.coHost,
+		maxUsers:    maxUsers,
+
+		idleTimeout:    options.idleTimeout,
+		onIdleShutdown: options.onIdleShutdown,
+		banList:        options.banList,
+
+		invitationToken:  invitationToken,
+		invitationExpiry: invitationExpiry,
 	}
+	if clientAuth == nil {
+		ss.inviteeNames = options.inviteeNames
+	}
+
+	s.register(ss)
 
 	return ss, nil
 }
@@ -208,7 +495,20 @@ var (
 	ErrServerOnionDelete = errors.New("the hidden service can't be deleted")
 )
 
+// Close stops the meeting and deletes its onion service. Closing
+// a meeting that is already closed does nothing
 func (s *service) Close() error {
+	s.closeLock.Lock()
+	defer s.closeLock.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	if s.stopIdle != nil {
+		s.stopIdle()
+	}
+
 	var err error
 
 	if s.httpServer != nil {
@@ -218,12 +518,38 @@ func (s *service) Close() error {
 		}
 	}
 
+	if s.gate != nil {
+		err = s.gate.stop()
+		if err != nil {
+			log.Errorf("hosting stop connection gate: Close(): %s", err)
+		}
+	}
+
+	if s.webGateway != nil {
+		err = s.webGateway.stop()
+		if err != nil {
+			log.Errorf("hosting stop web gateway: Close(): %s", err)
+		}
+	}
+
 	if s.room != nil {
 		err = s.room.close()
 		if err != nil {
 			log.Errorf("hosting stop server: Close(): %s", err)
 			return ErrServerNoClosed
 		}
+
+		if s.collection != nil {
+			err = s.collection.DestroyServer(s.room.server)
+			if err != nil {
+				log.Errorf("hosting destroy server: Close(): %s", err)
+				return err
+			}
+			// The onion service was deleted together with the server
+			s.onion = nil
+		}
+
+		s.room = nil
 	}
 
 	if s.onion != nil {
@@ -234,7 +560,12 @@ func (s *service) Close() error {
 		}
 	}
 
-	s.collection.Cleanup()
+	// The other meetings keep running, so only this one is forgotten
+	if s.collection != nil {
+		s.collection.unregister(s)
+	}
+
+	s.closed = true
 
 	return nil
 }
@@ -0,0 +1,98 @@
+package hosting
+
+import (
+	"time"
+
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+// ServiceOption modifies the way a new hosting service is created
+type ServiceOption func(*serviceOptions)
+
+type serviceOptions struct {
+	invitees        int
+	maxParticipants int
+	maxUsers        int
+	keepAlive       time.Duration
+	coHost          string
+	onionKey        *tor.OnionKey
+	waitingRoom     bool
+	idleTimeout     time.Duration
+	onIdleShutdown  func(error)
+	listen          listenAddress
+	banList         BanList
+	inviteeNames    []string
+	webGateway      bool
+	mumbleWebDir    string
+	queueTexts      QueueTexts
+	certificate     CertificateSource
+
+	invitationLifetime time.Duration
+}
+
+// WithMaxParticipants limits the number of participants connected to the
+// meeting at the same time. Participants arriving when the meeting is full
+// wait in a queue and are let in automatically as soon as somebody leaves
+func WithMaxParticipants(n int) ServiceOption {
+	return func(o *serviceOptions) {
+		o.maxParticipants = n
+	}
+}
+
+// WithMaxUsers limits the number of users in the meeting, the host
+// included. The guests arriving when the meeting is full wait in a queue,
+// like with WithMaxParticipants
+func WithMaxUsers(n int) ServiceOption {
+	return func(o *serviceOptions) {
+		o.maxUsers = n
+	}
+}
+
+// limits returns the maximum number of users in the meeting and the
+// number of guests that can be connected through the onion service at the
+// same time. The host doesn't go through it, so there is one guest less
+// than users. Without limits configured, DefaultMaxUsers is used
+func (o *serviceOptions) limits() (maxUsers, guests int) {
+	maxUsers, guests = o.maxUsers, o.maxParticipants
+
+	switch {
+	case maxUsers > 0 && guests <= 0:
+		guests = maxUsers - 1
+	case maxUsers <= 0 && guests > 0:
+		maxUsers = guests + 1
+	case maxUsers <= 0 && guests <= 0:
+		maxUsers = DefaultMaxUsers(0)
+		guests = maxUsers - 1
+	}
+
+	if guests < 1 {
+		guests = 1
+	}
+
+	return maxUsers, guests
+}
+
+// WithKeepAlive enables TCP keepalive probes with the given period on the
+// connections of the participants, so connections that died silently
+// behind a NAT or on an idle circuit are noticed and cleaned up
+func WithKeepAlive(period time.Duration) ServiceOption {
+	return func(o *serviceOptions) {
+		o.keepAlive = period
+	}
+}
+
+// WithOnionKey publishes the meeting with the given onion key, so its
+// address is known in advance
+func WithOnionKey(key *tor.OnionKey) ServiceOption {
+	return func(o *serviceOptions) {
+		o.onionKey = key
+	}
+}
+
+func newServiceOptions(opts []ServiceOption) *serviceOptions {
+	o := &serviceOptions{}
+	for _, f := range opts {
+		f(o)
+	}
+	return o
+}
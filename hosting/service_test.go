@@ -4,10 +4,12 @@ import (
 	"errors"
 	"io"
 	"io/fs"
+	"net"
 	"net/http"
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 
 	"github.com/digitalautonomy/grumble/pkg/logtarget"
 	grumbleServer "github.com/digitalautonomy/grumble/server"
@@ -117,6 +119,15 @@ func (h *hostingSuite) Test_NewService_returnsAnErrorWhenWrongPortIsGiven(c *C)
 	c.Assert(srvc, IsNil)
 }
 
+func (h *hostingSuite) Test_checkService_stop_closesTheListenerOfTheService(c *C) {
+	cs, err := newCheckConnectionService("127.0.0.1")
+	c.Assert(err, IsNil)
+	c.Assert(cs.stop(), IsNil)
+
+	_, err = net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(cs.port)))
+	c.Assert(err, NotNil)
+}
+
 func (h *hostingSuite) Test_NewConferenceRoom_returnsAnErrorWhenFailsCreatingServer(c *C) {
 	path := "/tmp/wahay/"
 	sID := 2
@@ -207,7 +218,8 @@ func (h *hostingSuite) Test_Close_returnsAnErrorWhenFailsClosingRoom(c *C) {
 	srvc := &service{
 		room: &conferenceRoom{
 			server: &server{
-				gs: &grumbleServer.Server{},
+				gs:      &grumbleServer.Server{},
+				running: true,
 			},
 		},
 	}
@@ -215,3 +227,34 @@ func (h *hostingSuite) Test_Close_returnsAnErrorWhenFailsClosingRoom(c *C) {
 	c.Assert(err, NotNil)
 	c.Assert(err, Equals, ErrServerNoClosed)
 }
+
+func (h *hostingSuite) Test_startOnFreePort_movesTheServerWhenItsPortIsTaken(c *C) {
+	dir := c.MkDir()
+	c.Assert(os.MkdirAll(filepath.Join(dir, "servers"), 0700), IsNil)
+	origDataDir := grumbleServer.Args.DataDir
+	defer func() { grumbleServer.Args.DataDir = origDataDir }()
+	grumbleServer.Args.DataDir = dir
+	c.Assert(generateCertificate(CertificateECDSA), IsNil)
+
+	taken, err := net.ListenPacket("udp", net.JoinHostPort(defaultHost(), "0"))
+	c.Assert(err, IsNil)
+	defer taken.Close()
+	takenPort := taken.LocalAddr().(*net.UDPAddr).Port
+
+	servers := &servers{
+		servers: make(map[int64]*grumbleServer.Server),
+		dataDir: dir,
+	}
+	gate := &connectionGate{}
+	srvc := &service{collection: servers, port: takenPort, gate: gate}
+
+	serv, err := servers.createServer(ServerOptions{Port: takenPort})
+	c.Assert(err, IsNil)
+
+	c.Assert(srvc.startOnFreePort(serv), IsNil)
+	defer serv.Stop()
+
+	c.Assert(srvc.Port(), Not(Equals), takenPort)
+	c.Assert(serv.gs.CurrentPort(), Equals, srvc.Port())
+	c.Assert(gate.target, Equals, net.JoinHostPort("127.0.0.1", strconv.Itoa(srvc.Port())))
+}
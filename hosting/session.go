@@ -0,0 +1,674 @@
+package hosting
+
+import (
+	"bytes"
+	"crypto/sha1" // #nosec G505
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/grumble/pkg/acl"
+	"github.com/digitalautonomy/grumble/pkg/ban"
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	"github.com/golang/protobuf/proto"
+)
+
+// User is somebody connected to the Mumble server of a meeting, the host
+// included. Unlike the guests the connection gate sees, users are known by
+// the name and the certificate of their Mumble client
+type User struct {
+	// Session identifies the user while they are connected
+	Session uint32
+	Name    string
+	// CertHash is the SHA1 hash of the certificate of the user, which is
+	// what bans are about. It's empty if the user has no certificate
+	CertHash string
+	// Muted is true while the user is muted on the server
+	Muted bool
+}
+
+// sessionUsername is the name Wahay has in the meetings it hosts
+const sessionUsername = "Wahay"
+
+// sessionTimeout is how long the Mumble server has to answer
+// the session before it's given up on
+const sessionTimeout = 10 * time.Second
+
+// sessionPingInterval is how often the session pings the server
+// while nothing else happens, like the Mumble clients do
+const sessionPingInterval = 15 * time.Second
+
+// sessionAnswers is the number of answers of the
+// server kept until the command waiting for them reads them
+const sessionAnswers = 16
+
+// ErrPermissionDenied is returned when the Mumble server refuses to
+// make a change, like muting the superuser, which nobody can
+var ErrPermissionDenied = errors.New("the Mumble server refused the change")
+
+var (
+	errSessionClosed                = errors.New("the connection to the Mumble server was closed")
+	errSessionTimeout               = errors.New("the Mumble server took too long to answer")
+	errUnexpectedServerCertificate  = errors.New("the Mumble server has an unexpected certificate")
+	errServerNotRunning             = errors.New("the Mumble server is not running")
+	errNoServerCertificateInPEMFile = errors.New("there is no certificate in the PEM file of the server")
+)
+
+// session is the connection Wahay keeps to the Mumble server of a meeting.
+// Grumble only changes the state of a running server from its own handler
+// loop, so the moderation of the meeting goes through the same messages a
+// Mumble client with all the permissions would send
+type session struct {
+	conn net.Conn
+	// events is where the users joining, leaving and
+	// changing are announced
+	events *participantEvents
+	// messages is where the text messages the session receives go
+	messages *chatMessages
+	// recorder gets the voice the session hears, while the meeting
+	// is recorded. It's protected by the lock
+	recorder *recorder
+	// tcpOnly makes the session disconnect the users whose voice
+	// arrives over UDP. It's protected by the lock
+	tcpOnly bool
+	// lost is called when the connection is closed by
+	// the server or fails, but not when it's closed by Wahay
+	lost func()
+
+	// commands is held while a command waits for the answers
+	// of the server, so the answers arriving belong to it
+	commands sync.Mutex
+	// writes keeps the messages sent from mixing
+	writes sync.Mutex
+
+	// answers are the pings, ban lists, ACLs and denials the server
+	// sends back. They are either a proto.Message or an error
+	answers chan interface{}
+	done    chan struct{}
+
+	sync.Mutex
+	ready    bool
+	closing  bool
+	own      uint32
+	users    map[uint32]User
+	nextPing uint64
+	// talking are the users Wahay hears, with the timer
+	// that takes them as silent if nothing else arrives
+	talking map[uint32]*time.Timer
+	// stats are the last statistics of every user
+	stats map[uint32]*mumbleproto.UserStats
+}
+
+// newSessionCertificate returns a new client certificate
+// for the session and the hash Mumble identifies it by
+func newSessionCertificate() (tls.Certificate, string, error) {
+	cert, key, err := selfSignedCertificate(CertificateECDSA, sessionUsername, x509.ExtKeyUsageClientAuth)
+	if err != nil {
+		return tls.Certificate{}, "", err
+	}
+
+	sum := sha1.Sum(cert) // #nosec G401
+	return tls.Certificate{Certificate: [][]byte{cert}, PrivateKey: key}, hex.EncodeToString(sum[:]), nil
+}
+
+// serverCertificate returns the DER encoded certificate
+// grumble serves with, from its data directory
+func serverCertificate() ([]byte, error) {
+	content, err := os.ReadFile(filepath.Join(grumbleServer.Args.DataDir, "cert.pem"))
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, errNoServerCertificateInPEMFile
+	}
+	return block.Bytes, nil
+}
+
+// dialSession connects to the Mumble server at the given address with the
+// client certificate and waits until the server tells it who is there. The
+// lost function is called if the server closes the connection later
+func dialSession(address string, cert tls.Certificate, serverCert []byte, password string,
+	events *participantEvents, messages *chatMessages, lost func()) (*session, error) {
+	/* #nosec G402 */
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		// The certificate of the server is self-signed, so it's
+		// compared with the one the server was started with instead
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(raw [][]byte, _ [][]*x509.Certificate) error {
+			if len(raw) == 0 || !bytes.Equal(raw[0], serverCert) {
+				return errUnexpectedServerCertificate
+			}
+			return nil
+		},
+	}
+
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: sessionTimeout}, "tcp", address, config)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &session{
+		conn:     conn,
+		events:   events,
+		messages: messages,
+		lost:     lost,
+		answers:  make(chan interface{}, sessionAnswers),
+		done:     make(chan struct{}),
+		users:    make(map[uint32]User),
+		talking:  make(map[uint32]*time.Timer),
+		stats:    make(map[uint32]*mumbleproto.UserStats),
+	}
+
+	_ = conn.SetDeadline(time.Now().Add(sessionTimeout))
+	err = s.authenticate(password)
+	_ = conn.SetDeadline(time.Time{})
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	go s.read()
+	go s.keepAlive()
+
+	return s, nil
+}
+
+// authenticate logs in and reads the users already connected,
+// which the server sends before saying the session is ready
+func (s *session) authenticate(password string) error {
+	err := s.send(&mumbleproto.Version{
+		Version: proto.Uint32(mumbleVersion),
+		Release: proto.String("Wahay"),
+		Os:      proto.String(runtime.GOOS),
+	})
+	if err != nil {
+		return err
+	}
+
+	auth := &mumbleproto.Authenticate{
+		Username: proto.String(sessionUsername),
+		Opus:     proto.Bool(true),
+	}
+	if password != "" {
+		auth.Password = proto.String(password)
+	}
+	if err := s.send(auth); err != nil {
+		return err
+	}
+
+	for {
+		kind, data, err := readMessage(s.conn)
+		if err != nil {
+			return err
+		}
+
+		switch kind {
+		case mumbleproto.MessageReject:
+			reject := &mumbleproto.Reject{}
+			_ = proto.Unmarshal(data, reject)
+			return fmt.Errorf("the Mumble server rejected the connection: %s", reject.GetReason())
+		case mumbleproto.MessageServerSync:
+			serverSync := &mumbleproto.ServerSync{}
+			if err := proto.Unmarshal(data, serverSync); err != nil {
+				return err
+			}
+			s.Lock()
+			s.own = serverSync.GetSession()
+			s.ready = true
+			s.Unlock()
+			return nil
+		default:
+			s.handle(kind, data)
+		}
+	}
+}
+
+func (s *session) send(msg proto.Message) error {
+	s.writes.Lock()
+	defer s.writes.Unlock()
+
+	_ = s.conn.SetWriteDeadline(time.Now().Add(sessionTimeout))
+	return writeMessage(s.conn, msg)
+}
+
+// read handles everything the server sends. Grumble writes to its clients
+// from its handler loop, voice included, so nothing here can block
+func (s *session) read() {
+	for {
+		kind, data, err := readMessage(s.conn)
+		if err != nil {
+			s.Lock()
+			closing := s.closing
+			s.Unlock()
+
+			if !closing {
+				log.Debugf("session: the connection to the Mumble server was lost: %s", err)
+			}
+			s.close()
+			if !closing && s.lost != nil {
+				s.lost()
+			}
+			return
+		}
+
+		s.handle(kind, data)
+	}
+}
+
+func (s *session) handle(kind uint16, data []byte) {
+	switch kind {
+	case mumbleproto.MessageUserState:
+		state := &mumbleproto.UserState{}
+		if unmarshal(data, state) {
+			s.userState(state)
+		}
+	case mumbleproto.MessageUserRemove:
+		remove := &mumbleproto.UserRemove{}
+		if unmarshal(data, remove) {
+			s.userRemoved(remove.GetSession())
+		}
+	case mumbleproto.MessageUDPTunnel:
+		s.heardVoice(data)
+		s.recordVoice(data)
+	case mumbleproto.MessageTextMessage:
+		msg := &mumbleproto.TextMessage{}
+		if unmarshal(data, msg) {
+			s.textMessage(msg)
+		}
+	case mumbleproto.MessageUserStats:
+		stats := &mumbleproto.UserStats{}
+		if unmarshal(data, stats) {
+			s.userStats(stats)
+		}
+	case mumbleproto.MessagePing:
+		ping := &mumbleproto.Ping{}
+		if unmarshal(data, ping) && ping.GetTimestamp() != 0 {
+			s.answer(ping)
+		}
+	case mumbleproto.MessageBanList:
+		list := &mumbleproto.BanList{}
+		if unmarshal(data, list) {
+			s.answer(list)
+		}
+	case mumbleproto.MessageACL:
+		acls := &mumbleproto.ACL{}
+		if unmarshal(data, acls) {
+			s.answer(acls)
+		}
+	case mumbleproto.MessagePermissionDenied:
+		denied := &mumbleproto.PermissionDenied{}
+		if unmarshal(data, denied) {
+			s.answer(deniedError(denied))
+		}
+	}
+}
+
+func unmarshal(data []byte, msg proto.Message) bool {
+	if err := proto.Unmarshal(data, msg); err != nil {
+		log.Debugf("session: invalid message from the Mumble server: %s", err)
+		return false
+	}
+	return true
+}
+
+func deniedError(denied *mumbleproto.PermissionDenied) error {
+	if reason := denied.GetReason(); reason != "" {
+		return fmt.Errorf("%w: %s", ErrPermissionDenied, reason)
+	}
+	return fmt.Errorf("%w: %s", ErrPermissionDenied, denied.GetType())
+}
+
+// answer never blocks. Only a command waits for answers,
+// so the ones that arrive when nobody waits are dropped
+func (s *session) answer(a interface{}) {
+	select {
+	case s.answers <- a:
+	default:
+	}
+}
+
+func (s *session) userState(state *mumbleproto.UserState) {
+	if state.Session == nil {
+		return
+	}
+
+	s.Lock()
+	u, known := s.users[state.GetSession()]
+	previousName := u.Name
+	u.Session = state.GetSession()
+	if state.Name != nil {
+		u.Name = state.GetName()
+	}
+	if state.Hash != nil {
+		u.CertHash = state.GetHash()
+	}
+	if state.Mute != nil {
+		u.Muted = state.GetMute()
+	}
+	s.users[u.Session] = u
+	announce := s.ready && u.Session != s.own
+	recording := s.recorder != nil
+	s.Unlock()
+
+	if !announce {
+		return
+	}
+
+	if !known && recording {
+		go s.tellRecording(u.Session)
+	}
+
+	switch {
+	case !known:
+		s.events.publish(ParticipantEvent{Type: UserConnected, User: u})
+	case previousName != "" && u.Name != previousName:
+		s.events.publish(ParticipantEvent{Type: UserRenamed, User: u, PreviousName: previousName})
+	default:
+		s.events.publish(ParticipantEvent{Type: UserChanged, User: u})
+	}
+}
+
+func (s *session) userRemoved(id uint32) {
+	s.Lock()
+	u, known := s.users[id]
+	delete(s.users, id)
+	delete(s.stats, id)
+	s.forgetTalking(id)
+	announce := known && s.ready && id != s.own
+	s.Unlock()
+
+	if announce {
+		s.events.publish(ParticipantEvent{Type: UserDisconnected, User: u})
+	}
+}
+
+func (s *session) keepAlive() {
+	t := time.NewTicker(sessionPingInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-t.C:
+			if err := s.send(&mumbleproto.Ping{Timestamp: proto.Uint64(0)}); err != nil {
+				log.Debugf("session: can't ping the Mumble server: %s", err)
+			}
+			s.askUserStats()
+		}
+	}
+}
+
+// close disconnects the session. It doesn't count as lost
+func (s *session) close() {
+	s.Lock()
+	defer s.Unlock()
+
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+
+	s.closing = true
+	for id := range s.talking {
+		s.forgetTalking(id)
+	}
+	close(s.done)
+	_ = s.conn.Close()
+}
+
+func (s *session) isClosed() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// connectedUsers returns everybody on the server but the session itself
+func (s *session) connectedUsers() []User {
+	s.Lock()
+	defer s.Unlock()
+
+	result := []User{}
+	for id, u := range s.users {
+		if id != s.own {
+			result = append(result, u)
+		}
+	}
+	return result
+}
+
+func (s *session) isConnected(id uint32) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	_, ok := s.users[id]
+	return ok && id != s.own
+}
+
+// command runs f, which sends messages to the server, and waits until the
+// server handled them. It returns the denial the server sent, if any
+func (s *session) command(f func() error) error {
+	s.commands.Lock()
+	defer s.commands.Unlock()
+
+	for len(s.answers) > 0 {
+		<-s.answers
+	}
+
+	if err := f(); err != nil {
+		return err
+	}
+
+	return s.sync()
+}
+
+// sync waits until the server handled everything sent before. Grumble
+// handles the messages of a client one after the other, pings included,
+// so the answer to a ping comes after the answers to what was sent before
+func (s *session) sync() error {
+	s.Lock()
+	s.nextPing++
+	token := s.nextPing
+	s.Unlock()
+
+	if err := s.send(&mumbleproto.Ping{Timestamp: proto.Uint64(token)}); err != nil {
+		return err
+	}
+
+	_, err := s.await(func(msg proto.Message) bool {
+		ping, ok := msg.(*mumbleproto.Ping)
+		return ok && ping.GetTimestamp() == token
+	})
+	return err
+}
+
+// await returns the first answer of the server that matches,
+// unless the server refuses something before
+func (s *session) await(matches func(proto.Message) bool) (proto.Message, error) {
+	timeout := time.NewTimer(sessionTimeout)
+	defer timeout.Stop()
+
+	for {
+		select {
+		case a := <-s.answers:
+			if err, ok := a.(error); ok {
+				return nil, err
+			}
+			if msg := a.(proto.Message); matches(msg) {
+				return msg, nil
+			}
+		case <-s.done:
+			return nil, errSessionClosed
+		case <-timeout.C:
+			return nil, errSessionTimeout
+		}
+	}
+}
+
+// kick disconnects the user. Grumble drops the session when the user
+// is gone by the time it handles the kick, so that is checked first
+func (s *session) kick(id uint32, reason string) error {
+	return s.command(func() error {
+		if !s.isConnected(id) {
+			return ErrParticipantNotConnected
+		}
+
+		remove := &mumbleproto.UserRemove{Session: proto.Uint32(id)}
+		if reason != "" {
+			remove.Reason = proto.String(reason)
+		}
+		return s.send(remove)
+	})
+}
+
+func (s *session) mute(id uint32, muted bool) error {
+	return s.command(func() error {
+		if !s.isConnected(id) {
+			return ErrParticipantNotConnected
+		}
+
+		return s.send(&mumbleproto.UserState{
+			Session: proto.Uint32(id),
+			Mute:    proto.Bool(muted),
+		})
+	})
+}
+
+// changeBans replaces the bans of the server with the result of change,
+// which gets the bans the server has now
+func (s *session) changeBans(change func([]ban.Ban) []ban.Ban) error {
+	return s.command(func() error {
+		if err := s.send(&mumbleproto.BanList{Query: proto.Bool(true)}); err != nil {
+			return err
+		}
+
+		msg, err := s.await(func(msg proto.Message) bool {
+			_, ok := msg.(*mumbleproto.BanList)
+			return ok
+		})
+		if err != nil {
+			return err
+		}
+
+		bans := []ban.Ban{}
+		for _, e := range msg.(*mumbleproto.BanList).Bans {
+			bans = append(bans, banFromEntry(e))
+		}
+
+		entries := []*mumbleproto.BanList_BanEntry{}
+		for _, b := range change(bans) {
+			entries = append(entries, banEntry(b))
+		}
+
+		return s.send(&mumbleproto.BanList{Bans: entries})
+	})
+}
+
+func banEntry(b ban.Ban) *mumbleproto.BanList_BanEntry {
+	address := b.IP
+	if address == nil {
+		address = net.IPv6unspecified
+	}
+
+	return &mumbleproto.BanList_BanEntry{
+		Address:  address,
+		Mask:     proto.Uint32(uint32(b.Mask)),
+		Name:     proto.String(b.Username),
+		Hash:     proto.String(b.CertHash),
+		Reason:   proto.String(b.Reason),
+		Start:    proto.String(b.ISOStartDate()),
+		Duration: proto.Uint32(b.Duration),
+	}
+}
+
+// banFromEntry does what grumble does with the entries it receives
+func banFromEntry(e *mumbleproto.BanList_BanEntry) ban.Ban {
+	b := ban.Ban{
+		IP:       e.Address,
+		Mask:     int(e.GetMask()),
+		Username: e.GetName(),
+		CertHash: e.GetHash(),
+		Reason:   e.GetReason(),
+		Duration: e.GetDuration(),
+	}
+	if e.Start != nil {
+		b.SetISOStartDate(e.GetStart())
+	}
+	return b
+}
+
+// setACLs replaces the ACLs of the channel. Setting them also replaces
+// the groups of the channel, so the ones it has are queried and sent back
+func (s *session) setACLs(channel uint32, acls []acl.ACL) error {
+	return s.command(func() error {
+		query := &mumbleproto.ACL{ChannelId: proto.Uint32(channel), Query: proto.Bool(true)}
+		if err := s.send(query); err != nil {
+			return err
+		}
+
+		msg, err := s.await(func(msg proto.Message) bool {
+			reply, ok := msg.(*mumbleproto.ACL)
+			return ok && reply.GetChannelId() == channel
+		})
+		if err != nil {
+			return err
+		}
+		current := msg.(*mumbleproto.ACL)
+
+		groups := []*mumbleproto.ACL_ChanGroup{}
+		for _, g := range current.Groups {
+			groups = append(groups, &mumbleproto.ACL_ChanGroup{
+				Name:        g.Name,
+				Inherit:     proto.Bool(g.GetInherit()),
+				Inheritable: proto.Bool(g.GetInheritable()),
+				Add:         g.Add,
+			})
+		}
+
+		entries := []*mumbleproto.ACL_ChanACL{}
+		for _, a := range acls {
+			entries = append(entries, aclEntry(a))
+		}
+
+		return s.send(&mumbleproto.ACL{
+			ChannelId:   proto.Uint32(channel),
+			InheritAcls: proto.Bool(current.GetInheritAcls()),
+			Groups:      groups,
+			Acls:        entries,
+		})
+	})
+}
+
+func aclEntry(a acl.ACL) *mumbleproto.ACL_ChanACL {
+	entry := &mumbleproto.ACL_ChanACL{
+		ApplyHere: proto.Bool(a.ApplyHere),
+		ApplySubs: proto.Bool(a.ApplySubs),
+		Grant:     proto.Uint32(uint32(a.Allow)),
+		Deny:      proto.Uint32(uint32(a.Deny)),
+	}
+	if a.IsUserACL() {
+		entry.UserId = proto.Uint32(uint32(a.UserId))
+	} else {
+		entry.Group = proto.String(a.Group)
+	}
+	return entry
+}
@@ -0,0 +1,246 @@
+package hosting
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/digitalautonomy/grumble/pkg/ban"
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	"github.com/digitalautonomy/wahay/config"
+	. "gopkg.in/check.v1"
+)
+
+// startTestServer starts a Mumble server with its own data directory.
+// The returned function stops it and restores the data directory
+func startTestServer(c *C, opts ServerOptions) (*server, func()) {
+	dir := c.MkDir()
+	c.Assert(os.MkdirAll(filepath.Join(dir, "servers"), 0700), IsNil)
+	origDataDir := grumbleServer.Args.DataDir
+	grumbleServer.Args.DataDir = dir
+	c.Assert(generateCertificate(CertificateECDSA), IsNil)
+
+	servers := &servers{
+		servers: make(map[int64]*grumbleServer.Server),
+		dataDir: dir,
+	}
+
+	opts.Port = config.GetRandomPort()
+	serv, err := servers.createServer(opts)
+	c.Assert(err, IsNil)
+	c.Assert(serv.Start(), IsNil)
+
+	return serv, func() {
+		_ = serv.Stop()
+		grumbleServer.Args.DataDir = origDataDir
+	}
+}
+
+// joinTestServer connects a Mumble client without permissions to the server
+func joinTestServer(c *C, serv *server, password string) (*session, string) {
+	cert, hash, err := newSessionCertificate()
+	c.Assert(err, IsNil)
+
+	serverCert, err := serverCertificate()
+	c.Assert(err, IsNil)
+
+	guest, err := dialSession(serv.localAddress(), cert, serverCert, password, nil, nil, nil)
+	c.Assert(err, IsNil)
+
+	return guest, hash
+}
+
+// userWithCertificate waits for the server to tell Wahay about the user
+func userWithCertificate(c *C, serv *server, certHash string) User {
+	for i := 0; i < 100; i++ {
+		for _, u := range serv.Users() {
+			if u.CertHash == certHash {
+				return u
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	c.Fatalf("the user with the certificate %s never joined", certHash)
+	return User{}
+}
+
+func (h *hostingSuite) Test_server_Start_joinsWahayToTheServer(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{Password: "secret"})
+	defer stop()
+
+	serv.Lock()
+	control := serv.control
+	serv.Unlock()
+	c.Assert(control, NotNil)
+	c.Assert(serv.Users(), HasLen, 0)
+}
+
+func (h *hostingSuite) Test_server_Users_returnsTheUsersWithTheirCertificates(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	guest, hash := joinTestServer(c, serv, "")
+	defer guest.close()
+
+	u := userWithCertificate(c, serv, hash)
+	c.Assert(u.Name, Equals, sessionUsername)
+	c.Assert(u.Muted, Equals, false)
+}
+
+func (h *hostingSuite) Test_server_Subscribe_announcesTheUsersJoiningAndLeaving(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	events, stopEvents := serv.Subscribe()
+	defer stopEvents()
+
+	guest, hash := joinTestServer(c, serv, "")
+	ev := nextEvent(c, events)
+	c.Assert(ev.Type, Equals, UserConnected)
+	c.Assert(ev.User.CertHash, Equals, hash)
+
+	guest.close()
+	for ev.Type != UserDisconnected {
+		ev = nextEvent(c, events)
+	}
+	c.Assert(ev.User.CertHash, Equals, hash)
+}
+
+func (h *hostingSuite) Test_server_Mute_mutesTheUserOnTheServer(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	guest, hash := joinTestServer(c, serv, "")
+	defer guest.close()
+	u := userWithCertificate(c, serv, hash)
+
+	c.Assert(serv.Mute(u.Session), IsNil)
+	c.Assert(userWithCertificate(c, serv, hash).Muted, Equals, true)
+
+	c.Assert(serv.Unmute(u.Session), IsNil)
+	c.Assert(userWithCertificate(c, serv, hash).Muted, Equals, false)
+}
+
+func (h *hostingSuite) Test_server_Kick_disconnectsTheUser(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	guest, hash := joinTestServer(c, serv, "")
+	defer guest.close()
+	u := userWithCertificate(c, serv, hash)
+
+	c.Assert(serv.Kick(u.Session, "too loud"), IsNil)
+
+	select {
+	case <-guest.done:
+	case <-time.After(5 * time.Second):
+		c.Fatal("the user was not disconnected")
+	}
+	c.Assert(serv.Users(), HasLen, 0)
+}
+
+func (h *hostingSuite) Test_server_Kick_returnsAnErrorForUnknownUsers(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	c.Assert(serv.Kick(1234, ""), Equals, ErrParticipantNotConnected)
+
+	control, err := serv.moderator()
+	c.Assert(err, IsNil)
+	c.Assert(control.isClosed(), Equals, false)
+}
+
+func (h *hostingSuite) Test_server_Ban_kicksTheUserAndKeepsThemOut(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	guest, hash := joinTestServer(c, serv, "")
+	defer guest.close()
+	userWithCertificate(c, serv, hash)
+
+	c.Assert(serv.Ban(hash, 0), IsNil)
+
+	select {
+	case <-guest.done:
+	case <-time.After(5 * time.Second):
+		c.Fatal("the banned user was not disconnected")
+	}
+
+	control, err := serv.moderator()
+	c.Assert(err, IsNil)
+
+	var bans []ban.Ban
+	c.Assert(control.changeBans(func(current []ban.Ban) []ban.Ban {
+		bans = current
+		return current
+	}), IsNil)
+	c.Assert(bans, HasLen, 1)
+	c.Assert(bans[0].CertHash, Equals, hash)
+
+	c.Assert(serv.Unban(hash), IsNil)
+	c.Assert(control.changeBans(func(current []ban.Ban) []ban.Ban {
+		bans = current
+		return current
+	}), IsNil)
+	c.Assert(bans, HasLen, 0)
+}
+
+func (h *hostingSuite) Test_session_returnsTheDenialsOfTheServer(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	guest, hash := joinTestServer(c, serv, "")
+	defer guest.close()
+	userWithCertificate(c, serv, hash)
+
+	err := guest.changeBans(func(bans []ban.Ban) []ban.Ban {
+		return bans
+	})
+	c.Assert(err, ErrorMatches, ErrPermissionDenied.Error()+".*")
+}
+
+func (h *hostingSuite) Test_server_moderator_joinsAgainWhenTheSessionWasLost(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	lost, err := serv.moderator()
+	c.Assert(err, IsNil)
+	lost.close()
+
+	c.Assert(serv.Kick(1234, ""), Equals, ErrParticipantNotConnected)
+
+	control, err := serv.moderator()
+	c.Assert(err, IsNil)
+	c.Assert(control == lost, Equals, false)
+}
+
+func (h *hostingSuite) Test_server_GrantModeration_givesThePermissionsWhileTheServerRuns(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	guest, hash := joinTestServer(c, serv, "")
+	defer guest.close()
+	userWithCertificate(c, serv, hash)
+
+	keepBans := func(bans []ban.Ban) []ban.Ban {
+		return bans
+	}
+
+	c.Assert(serv.GrantModeration(hash), IsNil)
+	c.Assert(guest.changeBans(keepBans), IsNil)
+
+	c.Assert(serv.RevokeModeration(hash), IsNil)
+	c.Assert(guest.changeBans(keepBans), ErrorMatches, ErrPermissionDenied.Error()+".*")
+}
+
+func (h *hostingSuite) Test_server_Stop_keepsTheACLsSetWhileTheServerRan(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	c.Assert(serv.GrantModeration(testCertHash), IsNil)
+	c.Assert(serv.Stop(), IsNil)
+
+	c.Assert(serv.gs.RootChannel().ACL.ACLs, HasLen, 2)
+	c.Assert(serv.gs.RootChannel().ACL.ACLs[1], Equals, moderationACL(testCertHash))
+}
@@ -0,0 +1,143 @@
+package hosting
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/digitalautonomy/wahay/tor"
+)
+
+// invitationTokenParameter is the name of the invitation parameter that
+// carries the signature of the host
+const invitationTokenParameter = "token"
+
+const (
+	// invitationTokenContext keeps the signature of an invitation
+	// from being valid as the signature of anything else
+	invitationTokenContext = "wahay invitation v1"
+	invitationNonceSize    = 16
+	invitationExpirySize   = 8
+	invitationSigSize      = 64
+)
+
+var (
+	// ErrInvitationExpired is returned when verifying an invitation
+	// whose time to be used has passed
+	ErrInvitationExpired = errors.New("the invitation has expired")
+
+	// ErrForgedInvitation is returned when verifying an invitation that
+	// was not signed by the host of the meeting, or was modified afterwards
+	ErrForgedInvitation = errors.New("the invitation was not created by the host of the meeting")
+)
+
+var invitationTokenEncoding = base64.RawURLEncoding
+
+// WithSignedInvitations makes the invitations to the meeting carry a
+// signature of the host that expires after the given time. The signature
+// is made with the key of the onion service, so the guests check it with
+// the meeting ID alone, and stale or forged invitations are rejected
+// before connecting
+func WithSignedInvitations(validFor time.Duration) ServiceOption {
+	return func(o *serviceOptions) {
+		o.invitationLifetime = validFor
+	}
+}
+
+// invitationMessage is what the host signs. The nonce makes every
+// invitation different, even with the same expiry
+func invitationMessage(meetingID string, expiry, nonce []byte) []byte {
+	id := strings.TrimSuffix(strings.ToLower(meetingID), ".onion")
+
+	m := []byte(invitationTokenContext)
+	m = append(m, 0)
+	m = append(m, id...)
+	m = append(m, expiry...)
+	return append(m, nonce...)
+}
+
+// signInvitation returns the token of an invitation to the meeting
+// of the key, which can be used until the given time
+func signInvitation(key *tor.OnionKey, expiresAt time.Time) (string, error) {
+	expiry := make([]byte, invitationExpirySize)
+	binary.BigEndian.PutUint64(expiry, uint64(expiresAt.Unix()))
+
+	nonce := make([]byte, invitationNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sig, err := key.Sign(invitationMessage(key.ServiceID, expiry, nonce))
+	if err != nil {
+		return "", err
+	}
+
+	token := append(append(expiry, nonce...), sig...)
+	return invitationTokenEncoding.EncodeToString(token), nil
+}
+
+// VerifyInvitation checks the signature of the host in the invitation, and
+// that it hasn't expired at the given time. Invitations without a
+// signature are accepted, since the host decides whether to sign them
+func (d MeetingData) VerifyInvitation(now time.Time) error {
+	if d.InvitationToken == "" {
+		return nil
+	}
+
+	token, err := invitationTokenEncoding.DecodeString(d.InvitationToken)
+	if err != nil || len(token) != invitationExpirySize+invitationNonceSize+invitationSigSize {
+		return ErrForgedInvitation
+	}
+
+	expiry := token[:invitationExpirySize]
+	nonce := token[invitationExpirySize : invitationExpirySize+invitationNonceSize]
+	sig := token[invitationExpirySize+invitationNonceSize:]
+
+	if !tor.VerifyOnionSignature(d.MeetingID, invitationMessage(d.MeetingID, expiry, nonce), sig) {
+		return ErrForgedInvitation
+	}
+
+	if now.Unix() > int64(binary.BigEndian.Uint64(expiry)) {
+		return ErrInvitationExpired
+	}
+
+	return nil
+}
+
+// ErrCantSignInvitations is returned when signing the invitations
+// of a meeting that was published without a key of its own
+var ErrCantSignInvitations = errors.New("the invitations of this meeting can't be signed")
+
+// InvitationExpiry returns when the invitations to the
+// meeting expire, or the zero time if they aren't signed
+func (s *service) InvitationExpiry() time.Time {
+	return s.invitationExpiry
+}
+
+// RenewInvitations signs the invitations to the meeting again, so they
+// expire after the given time from now. With 0 or less they aren't signed
+// anymore. The invitations given before keep their own expiry
+func (s *service) RenewInvitations(validFor time.Duration) error {
+	if validFor <= 0 {
+		s.invitationToken = ""
+		s.invitationExpiry = time.Time{}
+		return nil
+	}
+
+	if s.onionKey == nil {
+		return ErrCantSignInvitations
+	}
+
+	expiresAt := time.Now().Add(validFor)
+	token, err := signInvitation(s.onionKey, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	s.invitationToken = token
+	s.invitationExpiry = expiresAt
+	return nil
+}
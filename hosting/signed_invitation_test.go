@@ -0,0 +1,120 @@
+package hosting
+
+import (
+	"time"
+
+	"github.com/digitalautonomy/wahay/tor"
+	. "gopkg.in/check.v1"
+)
+
+func signedMeetingData(c *C, expiresAt time.Time) (MeetingData, *tor.OnionKey) {
+	key, err := tor.GenerateOnionKey()
+	c.Assert(err, IsNil)
+
+	token, err := signInvitation(key, expiresAt)
+	c.Assert(err, IsNil)
+
+	return MeetingData{MeetingID: key.ServiceID, Port: DefaultPort, InvitationToken: token}, key
+}
+
+func (h *hostingSuite) Test_VerifyInvitation_acceptsAnInvitationSignedByTheHost(c *C) {
+	d, _ := signedMeetingData(c, time.Now().Add(time.Hour))
+
+	c.Assert(d.VerifyInvitation(time.Now()), IsNil)
+}
+
+func (h *hostingSuite) Test_VerifyInvitation_acceptsInvitationsWithoutSignature(c *C) {
+	d := MeetingData{MeetingID: "abcdef.onion"}
+
+	c.Assert(d.VerifyInvitation(time.Now()), IsNil)
+}
+
+func (h *hostingSuite) Test_VerifyInvitation_rejectsExpiredInvitations(c *C) {
+	d, _ := signedMeetingData(c, time.Now().Add(time.Hour))
+
+	c.Assert(d.VerifyInvitation(time.Now().Add(2*time.Hour)), Equals, ErrInvitationExpired)
+}
+
+func (h *hostingSuite) Test_VerifyInvitation_rejectsInvitationsForAnotherMeeting(c *C) {
+	d, _ := signedMeetingData(c, time.Now().Add(time.Hour))
+	other, _ := signedMeetingData(c, time.Now().Add(time.Hour))
+
+	d.MeetingID = other.MeetingID
+
+	c.Assert(d.VerifyInvitation(time.Now()), Equals, ErrForgedInvitation)
+}
+
+func (h *hostingSuite) Test_VerifyInvitation_rejectsAnExtendedExpiry(c *C) {
+	d, _ := signedMeetingData(c, time.Now().Add(time.Hour))
+
+	token, _ := invitationTokenEncoding.DecodeString(d.InvitationToken)
+	token[0] = 0x7f
+	d.InvitationToken = invitationTokenEncoding.EncodeToString(token)
+
+	c.Assert(d.VerifyInvitation(time.Now()), Equals, ErrForgedInvitation)
+
+	d.InvitationToken = "not a token"
+	c.Assert(d.VerifyInvitation(time.Now()), Equals, ErrForgedInvitation)
+}
+
+func (h *hostingSuite) Test_service_Invitations_includeTheSignatureOfTheHost(c *C) {
+	d, key := signedMeetingData(c, time.Now().Add(time.Hour))
+	s := &service{
+		mumblePort:      DefaultPort,
+		onion:           &onionMock{id: key.ServiceID},
+		clientAuth:      &clientAuthKeys{invitees: []*tor.ClientAuthKey{{PrivateKey: "QWERTY"}}},
+		invitationToken: d.InvitationToken,
+	}
+
+	invitations := s.Invitations()
+	c.Assert(invitations, HasLen, 1)
+
+	parsed, err := ParseURL(invitations[0])
+	c.Assert(err, IsNil)
+	c.Assert(*parsed, DeepEquals, MeetingData{
+		MeetingID:       key.ServiceID,
+		Port:            DefaultPort,
+		ClientAuthKey:   "QWERTY",
+		InvitationToken: d.InvitationToken,
+	})
+	c.Assert(parsed.VerifyInvitation(time.Now()), IsNil)
+
+	_, clientAuthKey := ParseInvitation(invitations[0])
+	c.Assert(clientAuthKey, Equals, "QWERTY")
+}
+
+func (h *hostingSuite) Test_service_RenewInvitations_signsTheInvitationsWithTheNewExpiry(c *C) {
+	key, err := tor.GenerateOnionKey()
+	c.Assert(err, IsNil)
+	s := &service{mumblePort: DefaultPort, onion: &onionMock{id: key.ServiceID}, onionKey: key}
+
+	c.Assert(s.RenewInvitations(time.Hour), IsNil)
+	c.Assert(s.InvitationExpiry().After(time.Now().Add(59*time.Minute)), Equals, true)
+
+	parsed, err := ParseURL(s.Invitations()[0])
+	c.Assert(err, IsNil)
+	c.Assert(parsed.VerifyInvitation(time.Now()), IsNil)
+	c.Assert(parsed.VerifyInvitation(time.Now().Add(2*time.Hour)), Equals, ErrInvitationExpired)
+}
+
+func (h *hostingSuite) Test_service_RenewInvitations_withoutExpiryDropsTheSignature(c *C) {
+	d, key := signedMeetingData(c, time.Now().Add(time.Hour))
+	s := &service{
+		mumblePort:       DefaultPort,
+		onion:            &onionMock{id: key.ServiceID},
+		onionKey:         key,
+		invitationToken:  d.InvitationToken,
+		invitationExpiry: time.Now().Add(time.Hour),
+	}
+
+	c.Assert(s.RenewInvitations(0), IsNil)
+	c.Assert(s.InvitationExpiry().IsZero(), Equals, true)
+	c.Assert(s.Invitations(), DeepEquals, []string{key.ServiceID})
+}
+
+func (h *hostingSuite) Test_service_RenewInvitations_needsTheKeyOfTheMeeting(c *C) {
+	s := &service{mumblePort: DefaultPort, onion: &onionMock{id: "abcdef"}}
+
+	c.Assert(s.RenewInvitations(time.Hour), Equals, ErrCantSignInvitations)
+	c.Assert(s.InvitationExpiry().IsZero(), Equals, true)
+}
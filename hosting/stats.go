@@ -0,0 +1,153 @@
+package hosting
+
+import (
+	"io"
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+)
+
+// Stats describes the traffic of a meeting, as seen by the connection
+// gate, and the voice of every user, as their Mumble clients report it.
+// The host connects directly, so their traffic is not included in the
+// bytes, but their voice is among the users
+type Stats struct {
+	// Participants is the number of guests in the meeting
+	Participants int
+	// Waiting is the number of guests waiting for a free place
+	Waiting int
+	// BytesReceived is the number of bytes the guests sent to the server
+	BytesReceived uint64
+	// BytesSent is the number of bytes the server sent to the guests
+	BytesSent uint64
+	// Users are the statistics of the users the server
+	// reported so far, by their session
+	Users []UserStats
+}
+
+// UserStats are the statistics of the voice of somebody on the Mumble
+// server, which their Mumble client reports. Wahay asks the server for
+// them every sessionPingInterval
+type UserStats struct {
+	User User
+	// Good, Late and Lost are the packets of voice the client got from
+	// the server in time, too late to be played and never. Mumble only
+	// counts the voice that goes over UDP, which Tor doesn't carry
+	Good, Late, Lost uint32
+	// Ping is the average round trip time of the connection the
+	// voice of the user goes through, and Jitter how much it varies
+	Ping, Jitter time.Duration
+}
+
+// Stats returns the current participants and the traffic of the meeting
+// so far. Comparing two of them gives the bandwidth used in between
+func (s *server) Stats() Stats {
+	result := Stats{}
+	if s.gate != nil {
+		result = s.gate.stats()
+	}
+
+	s.Lock()
+	c := s.control
+	s.Unlock()
+
+	if c != nil && !c.isClosed() {
+		result.Users = c.usersStats()
+	}
+	return result
+}
+
+// newUserStats returns the statistics of the user in the message of the
+// server. The ping of UDP is the one of the voice when it goes over UDP
+func newUserStats(u User, stats *mumbleproto.UserStats) UserStats {
+	ping, variance := stats.GetTcpPingAvg(), stats.GetTcpPingVar()
+	if stats.GetUdpPackets() > 0 {
+		ping, variance = stats.GetUdpPingAvg(), stats.GetUdpPingVar()
+	}
+
+	return UserStats{
+		User:   u,
+		Good:   stats.GetFromServer().GetGood(),
+		Late:   stats.GetFromServer().GetLate(),
+		Lost:   stats.GetFromServer().GetLost(),
+		Ping:   milliseconds(float64(ping)),
+		Jitter: milliseconds(math.Sqrt(float64(variance))),
+	}
+}
+
+func milliseconds(ms float64) time.Duration {
+	return time.Duration(ms * float64(time.Millisecond))
+}
+
+// askUserStats asks the server for the statistics of
+// every user, which userStats keeps when they arrive
+func (s *session) askUserStats() {
+	for _, u := range s.connectedUsers() {
+		err := s.send(&mumbleproto.UserStats{
+			Session:   proto.Uint32(u.Session),
+			StatsOnly: proto.Bool(true),
+		})
+		if err != nil {
+			log.Debugf("session: can't ask for the statistics of a user: %s", err)
+			return
+		}
+	}
+}
+
+// userStats keeps the statistics of the user, and checks
+// they don't send their voice over UDP to a TCP-only server
+func (s *session) userStats(stats *mumbleproto.UserStats) {
+	s.Lock()
+	if _, ok := s.users[stats.GetSession()]; ok {
+		s.stats[stats.GetSession()] = stats
+	}
+	s.Unlock()
+
+	s.checkTransport(stats)
+}
+
+// usersStats returns the statistics of the connected
+// users the server reported, by their session
+func (s *session) usersStats() []UserStats {
+	s.Lock()
+	defer s.Unlock()
+
+	result := []UserStats{}
+	for id, stats := range s.stats {
+		if u, ok := s.users[id]; ok && id != s.own {
+			result = append(result, newUserStats(u, stats))
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].User.Session < result[j].User.Session })
+	return result
+}
+
+func (g *connectionGate) stats() Stats {
+	g.Lock()
+	defer g.Unlock()
+
+	return Stats{
+		Participants:  g.active,
+		Waiting:       len(g.queue),
+		BytesReceived: atomic.LoadUint64(&g.received),
+		BytesSent:     atomic.LoadUint64(&g.sent),
+	}
+}
+
+// countingWriter adds the number of bytes written to count
+type countingWriter struct {
+	io.Writer
+	count *uint64
+}
+
+func (w countingWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	atomic.AddUint64(w.count, uint64(n))
+	return n, err
+}
@@ -0,0 +1,86 @@
+package hosting
+
+import (
+	"time"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_Stats_countsTheTrafficThroughTheGate(c *C) {
+	target := startEchoServer(c)
+	defer target.Close()
+
+	g, err := newConnectionGate(listenAddress{}, target.Addr().String(), 0)
+	c.Assert(err, IsNil)
+	g.start()
+	defer g.stop()
+
+	conn := connectToGate(c, g)
+	defer conn.Close()
+
+	c.Assert(echo(c, conn, "hello"), Equals, "hello\n")
+
+	serv := &server{gate: g}
+	c.Assert(waitUntil(func() bool { return serv.Stats().BytesSent == 6 }), Equals, true)
+
+	stats := serv.Stats()
+	c.Assert(stats.Participants, Equals, 1)
+	c.Assert(stats.Waiting, Equals, 0)
+	c.Assert(stats.BytesReceived, Equals, uint64(6))
+}
+
+func (h *hostingSuite) Test_Stats_isEmptyWithoutAGate(c *C) {
+	c.Assert((&server{}).Stats(), DeepEquals, Stats{})
+}
+
+func (h *hostingSuite) Test_Stats_reportsTheUsersTheServerHasStatisticsOf(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	guest, hash := joinTestServer(c, serv, "")
+	defer guest.close()
+	u := userWithCertificate(c, serv, hash)
+
+	control, err := serv.moderator()
+	c.Assert(err, IsNil)
+	control.askUserStats()
+
+	c.Assert(waitUntil(func() bool { return len(serv.Stats().Users) == 1 }), Equals, true)
+	c.Assert(serv.Stats().Users[0].User.Session, Equals, u.Session)
+}
+
+func (h *hostingSuite) Test_newUserStats_takesTheLossAndJitterOfTheVoice(c *C) {
+	u := User{Session: 3, Name: "Alice"}
+	stats := newUserStats(u, &mumbleproto.UserStats{
+		FromServer: &mumbleproto.UserStats_Stats{
+			Good: proto.Uint32(90),
+			Late: proto.Uint32(4),
+			Lost: proto.Uint32(6),
+		},
+		TcpPingAvg: proto.Float32(120),
+		TcpPingVar: proto.Float32(400),
+	})
+
+	c.Assert(stats, Equals, UserStats{
+		User:   u,
+		Good:   90,
+		Late:   4,
+		Lost:   6,
+		Ping:   120 * time.Millisecond,
+		Jitter: 20 * time.Millisecond,
+	})
+}
+
+func (h *hostingSuite) Test_newUserStats_takesThePingOfUDPWhenTheVoiceGoesThroughIt(c *C) {
+	stats := newUserStats(User{}, &mumbleproto.UserStats{
+		UdpPackets: proto.Uint32(10),
+		TcpPingAvg: proto.Float32(120),
+		UdpPingAvg: proto.Float32(40),
+		UdpPingVar: proto.Float32(25),
+	})
+
+	c.Assert(stats.Ping, Equals, 40*time.Millisecond)
+	c.Assert(stats.Jitter, Equals, 5*time.Millisecond)
+}
@@ -0,0 +1,55 @@
+package hosting
+
+import (
+	"errors"
+	"os"
+)
+
+// ErrNoMemoryStorage is returned when the data of the servers must be kept
+// in memory but there is no memory-backed filesystem to keep it in
+var ErrNoMemoryStorage = errors.New("there is no memory-backed filesystem for the data of the servers")
+
+// WithDataDirectory creates the data directory of the servers inside the
+// given directory instead of the temporary directory of the system, for
+// example in an encrypted filesystem that is unmounted after the meetings
+func WithDataDirectory(parent string) CollectionOption {
+	return func(s *servers) {
+		s.dataParent = parent
+	}
+}
+
+// WithMemoryStorage keeps the data of the servers, the certificates and
+// the log included, in a filesystem backed by memory, like tmpfs, so it
+// never touches the disk. Creating the servers fails with
+// ErrNoMemoryStorage when there is no such filesystem
+func WithMemoryStorage() CollectionOption {
+	return func(s *servers) {
+		s.memoryStorage = true
+	}
+}
+
+// dataDirectoryParent returns where the data directory is created.
+// An empty string means the temporary directory of the system
+func (s *servers) dataDirectoryParent() (string, error) {
+	if !s.memoryStorage {
+		return s.dataParent, nil
+	}
+
+	for _, dir := range memoryDirectories() {
+		if dir != "" && isWritableDir(dir) && isMemoryBacked(dir) {
+			return dir, nil
+		}
+	}
+
+	return "", ErrNoMemoryStorage
+}
+
+func isWritableDir(dir string) bool {
+	f, err := os.CreateTemp(dir, "wahay")
+	if err != nil {
+		return false
+	}
+	_ = f.Close()
+	_ = os.Remove(f.Name())
+	return true
+}
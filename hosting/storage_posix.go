@@ -0,0 +1,33 @@
+//go:build !windows
+// +build !windows
+
+package hosting
+
+import (
+	"os"
+	"syscall"
+)
+
+const (
+	tmpfsMagic = 0x01021994
+	ramfsMagic = 0x858458f6
+)
+
+// memoryDirectories are the places where a memory-backed filesystem
+// is usually mounted, in order of preference
+func memoryDirectories() []string {
+	return []string{os.Getenv("XDG_RUNTIME_DIR"), "/dev/shm", "/run/shm"}
+}
+
+// isMemoryBacked returns true if the directory is in a tmpfs or ramfs
+// filesystem. The magic numbers are the Linux ones, so the check fails
+// on other systems
+func isMemoryBacked(dir string) bool {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(dir, &st); err != nil {
+		return false
+	}
+
+	t := uint32(st.Type)
+	return t == tmpfsMagic || t == ramfsMagic
+}
@@ -0,0 +1,37 @@
+package hosting
+
+import (
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_WithDataDirectory_createsTheDataDirectoryInside(c *C) {
+	parent := c.MkDir()
+	servers := &servers{}
+	WithDataDirectory(parent)(servers)
+
+	c.Assert(servers.initializeDataDirectory(), IsNil)
+	c.Assert(filepath.Dir(servers.dataDir), Equals, parent)
+	c.Assert(fileExists(filepath.Join(servers.dataDir, "servers")), Equals, true)
+}
+
+func (h *hostingSuite) Test_WithMemoryStorage_onlyUsesMemoryBackedFilesystems(c *C) {
+	servers := &servers{}
+	WithMemoryStorage()(servers)
+
+	parent, err := servers.dataDirectoryParent()
+	if err != nil {
+		c.Assert(err, Equals, ErrNoMemoryStorage)
+		return
+	}
+
+	c.Assert(isMemoryBacked(parent), Equals, true)
+}
+
+func (h *hostingSuite) Test_dataDirectoryParent_isTheSystemTemporaryDirectoryByDefault(c *C) {
+	parent, err := (&servers{}).dataDirectoryParent()
+
+	c.Assert(err, IsNil)
+	c.Assert(parent, Equals, "")
+}
@@ -0,0 +1,11 @@
+package hosting
+
+// memoryDirectories is empty, since Windows has no
+// memory-backed filesystem mounted by default
+func memoryDirectories() []string {
+	return nil
+}
+
+func isMemoryBacked(string) bool {
+	return false
+}
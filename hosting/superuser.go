@@ -0,0 +1,51 @@
+package hosting
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidSuperUserPassword is returned when setting an empty password
+// for the superuser, which would leave nobody able to log in as the superuser
+var ErrInvalidSuperUserPassword = errors.New("the password of the superuser can't be empty")
+
+// superUserPasswordBytes is the randomness of the generated passwords,
+// which are encoded to 16 characters
+const superUserPasswordBytes = 10
+
+// GenerateSuperUserPassword returns a new random password for the superuser
+// of a meeting. It only has letters and digits, so it's easy to type in the
+// Mumble client when the host needs to reclaim the superuser
+func GenerateSuperUserPassword() (string, error) {
+	b := make([]byte, superUserPasswordBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return strings.ToLower(base32.StdEncoding.EncodeToString(b)), nil
+}
+
+// SetSuperUserPassword changes the password of the superuser of the server.
+// A superuser already connected stays connected, the new password is needed
+// the next time they connect
+func (s *server) SetSuperUserPassword(password string) error {
+	if password == "" {
+		return ErrInvalidSuperUserPassword
+	}
+
+	s.gs.SetSuperUserPassword(password)
+
+	return nil
+}
+
+// RotateSuperUserPassword changes the password of the superuser
+// of the server to a new random one and returns it
+func (s *server) RotateSuperUserPassword() (string, error) {
+	password, err := GenerateSuperUserPassword()
+	if err != nil {
+		return "", err
+	}
+
+	return password, s.SetSuperUserPassword(password)
+}
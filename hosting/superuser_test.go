@@ -0,0 +1,46 @@
+package hosting
+
+import (
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_SetSuperUserPassword_changesThePasswordOfTheSuperUser(c *C) {
+	gs, err := grumbleServer.NewServer(1)
+	c.Assert(err, IsNil)
+	serv := &server{gs: gs}
+
+	c.Assert(serv.SetSuperUserPassword("first"), IsNil)
+	c.Assert(gs.CheckSuperUserPassword("first"), Equals, true)
+
+	c.Assert(serv.SetSuperUserPassword("second"), IsNil)
+	c.Assert(gs.CheckSuperUserPassword("first"), Equals, false)
+	c.Assert(gs.CheckSuperUserPassword("second"), Equals, true)
+}
+
+func (h *hostingSuite) Test_SetSuperUserPassword_rejectsAnEmptyPassword(c *C) {
+	gs, err := grumbleServer.NewServer(1)
+	c.Assert(err, IsNil)
+	serv := &server{gs: gs}
+	c.Assert(serv.SetSuperUserPassword("secret"), IsNil)
+
+	c.Assert(serv.SetSuperUserPassword(""), Equals, ErrInvalidSuperUserPassword)
+	c.Assert(gs.CheckSuperUserPassword("secret"), Equals, true)
+}
+
+func (h *hostingSuite) Test_RotateSuperUserPassword_setsANewRandomPassword(c *C) {
+	gs, err := grumbleServer.NewServer(1)
+	c.Assert(err, IsNil)
+	serv := &server{gs: gs}
+	c.Assert(serv.SetSuperUserPassword("secret"), IsNil)
+
+	password, err := serv.RotateSuperUserPassword()
+	c.Assert(err, IsNil)
+	c.Assert(password, HasLen, 16)
+	c.Assert(gs.CheckSuperUserPassword(password), Equals, true)
+	c.Assert(gs.CheckSuperUserPassword("secret"), Equals, false)
+
+	other, err := serv.RotateSuperUserPassword()
+	c.Assert(err, IsNil)
+	c.Assert(other, Not(Equals), password)
+}
@@ -0,0 +1,102 @@
+package hosting
+
+import (
+	"time"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/digitalautonomy/grumble/pkg/packetdata"
+)
+
+// talkingTimeout is how long after their last packet of voice somebody
+// is taken as silent. Mumble marks the last packet of what is said, but
+// it can get lost with the connection of the speaker
+const talkingTimeout = 500 * time.Millisecond
+
+// opusTerminator is the bit of the Opus header of a voice packet
+// that marks the last packet before the speaker goes silent
+const opusTerminator = 0x2000
+
+// voiceSpeaker returns who spoke in a voice packet the server tunnels
+// to a client, and whether it's the last packet of what they said
+func voiceSpeaker(data []byte) (uint32, bool, bool) {
+	if len(data) < 2 || data[0]>>5 != mumbleproto.UDPMessageVoiceOpus {
+		return 0, false, false
+	}
+
+	pd := packetdata.New(data[1:])
+	session := pd.GetUint32()
+	_ = pd.GetUint64()
+	header := pd.GetUint64()
+	if !pd.IsValid() {
+		return 0, false, false
+	}
+
+	return session, header&opusTerminator != 0, true
+}
+
+// heardVoice announces the users starting and stopping to talk, from the
+// voice packets the session hears. The server only sends the session the
+// voice of the users in its channel who are not muted
+func (s *session) heardVoice(data []byte) {
+	id, last, ok := voiceSpeaker(data)
+	if !ok {
+		return
+	}
+
+	s.Lock()
+	u, known := s.users[id]
+	t, talking := s.talking[id]
+	if !known || !s.ready {
+		s.Unlock()
+		return
+	}
+
+	if last {
+		if talking {
+			s.forgetTalking(id)
+		}
+		s.Unlock()
+		if talking {
+			s.events.publish(ParticipantEvent{Type: UserStoppedTalking, User: u})
+		}
+		return
+	}
+
+	if talking && t.Stop() {
+		t.Reset(talkingTimeout)
+		s.Unlock()
+		return
+	}
+
+	// A timer that fired already finds another one in its place, so
+	// the user that kept talking isn't announced as silent
+	var timer *time.Timer
+	timer = time.AfterFunc(talkingTimeout, func() {
+		s.Lock()
+		current, ok := s.talking[id]
+		silent := ok && current == timer
+		if silent {
+			delete(s.talking, id)
+		}
+		u := s.users[id]
+		s.Unlock()
+
+		if silent {
+			s.events.publish(ParticipantEvent{Type: UserStoppedTalking, User: u})
+		}
+	})
+	s.talking[id] = timer
+	s.Unlock()
+
+	if !talking {
+		s.events.publish(ParticipantEvent{Type: UserStartedTalking, User: u})
+	}
+}
+
+// forgetTalking must be called with the lock held
+func (s *session) forgetTalking(id uint32) {
+	if t, ok := s.talking[id]; ok {
+		t.Stop()
+		delete(s.talking, id)
+	}
+}
@@ -0,0 +1,91 @@
+package hosting
+
+import (
+	"time"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/digitalautonomy/grumble/pkg/packetdata"
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+// lastClientVoicePacket is the packet a Mumble client sends
+// when its user stops talking
+func lastClientVoicePacket(sequence uint64, opus []byte) []byte {
+	buf := make([]byte, 1024)
+	pd := packetdata.New(buf[1:])
+	pd.PutUint64(sequence)
+	pd.PutUint64(uint64(len(opus)) | opusTerminator)
+	pd.PutBytes(opus)
+	buf[0] = mumbleproto.UDPMessageVoiceOpus << 5
+	return buf[:1+pd.Size()]
+}
+
+func newTalkingTestSession() (*session, <-chan ParticipantEvent, func()) {
+	s := &session{
+		events:  newParticipantEvents(),
+		ready:   true,
+		users:   map[uint32]User{7: {Session: 7, Name: "Ana"}},
+		talking: make(map[uint32]*time.Timer),
+	}
+	events, stop := s.events.subscribe()
+	return s, events, stop
+}
+
+func (h *hostingSuite) Test_voiceSpeaker_returnsTheSpeakerAndTheEndOfWhatTheySaid(c *C) {
+	session, last, ok := voiceSpeaker(serverVoicePacket(7, 1, []byte{0xf8, 0xff, 0xfe}))
+	c.Assert(ok, Equals, true)
+	c.Assert(session, Equals, uint32(7))
+	c.Assert(last, Equals, false)
+
+	_, _, ok = voiceSpeaker([]byte{mumbleproto.UDPMessagePing << 5, 1})
+	c.Assert(ok, Equals, false)
+}
+
+func (h *hostingSuite) Test_session_heardVoice_takesTheSilentUsersAsStoppedTalking(c *C) {
+	s, events, stop := newTalkingTestSession()
+	defer stop()
+
+	s.heardVoice(serverVoicePacket(7, 1, []byte{0xf8}))
+	s.heardVoice(serverVoicePacket(7, 2, []byte{0xf8}))
+	c.Assert(nextEvent(c, events), DeepEquals, ParticipantEvent{Type: UserStartedTalking, User: s.users[7]})
+	c.Assert(nextEvent(c, events), DeepEquals, ParticipantEvent{Type: UserStoppedTalking, User: s.users[7]})
+	c.Assert(events, HasLen, 0)
+
+	s.heardVoice(serverVoicePacket(8, 1, []byte{0xf8}))
+	time.Sleep(2 * talkingTimeout)
+	c.Assert(events, HasLen, 0)
+}
+
+func (h *hostingSuite) Test_session_userState_announcesTheUsersChangingTheirName(c *C) {
+	s, events, stop := newTalkingTestSession()
+	defer stop()
+
+	s.userState(&mumbleproto.UserState{Session: proto.Uint32(7), Name: proto.String("Ana María")})
+	c.Assert(nextEvent(c, events), DeepEquals, ParticipantEvent{
+		Type:         UserRenamed,
+		User:         User{Session: 7, Name: "Ana María"},
+		PreviousName: "Ana",
+	})
+
+	s.userState(&mumbleproto.UserState{Session: proto.Uint32(7), Mute: proto.Bool(true)})
+	c.Assert(nextEvent(c, events).Type, Equals, UserChanged)
+}
+
+func (h *hostingSuite) Test_server_Subscribe_announcesTheUsersTalking(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	guest, hash := joinTestServer(c, serv, "")
+	defer guest.close()
+	u := userWithCertificate(c, serv, hash)
+
+	events, stopEvents := serv.Subscribe()
+	defer stopEvents()
+
+	c.Assert(tunnelVoice(guest, clientVoicePacket(1, []byte{0xf8, 0xff, 0xfe})), IsNil)
+	c.Assert(nextEvent(c, events), DeepEquals, ParticipantEvent{Type: UserStartedTalking, User: u})
+
+	c.Assert(tunnelVoice(guest, lastClientVoicePacket(2, []byte{0xf8, 0xff, 0xfe})), IsNil)
+	c.Assert(nextEvent(c, events), DeepEquals, ParticipantEvent{Type: UserStoppedTalking, User: u})
+}
@@ -0,0 +1,47 @@
+package hosting
+
+import (
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	log "github.com/sirupsen/logrus"
+)
+
+// tcpOnlyAddress is where a TCP-only server listens when no address is
+// given. Grumble opens its UDP socket on the address of the server no
+// matter what, so it's kept where nothing from the network reaches it
+const tcpOnlyAddress = "127.0.0.1"
+
+// tcpOnlyKickReason is what the Mumble client of a participant
+// disconnected for sending its voice over UDP shows
+const tcpOnlyKickReason = "This meeting only carries voice through Tor. " +
+	"Turn on Force TCP mode in the network settings of Mumble and join again."
+
+// sendsVoiceOverUDP returns true when the statistics of a user say that
+// the server got packets from them over UDP. Grumble only decrypts the
+// packets that come over UDP, the ones tunneled through TCP go as they are
+func sendsVoiceOverUDP(stats *mumbleproto.UserStats) bool {
+	return stats.GetFromClient().GetGood() > 0 || stats.GetUdpPackets() > 0
+}
+
+// checkTransport disconnects the user the statistics are about if
+// the server is TCP-only and their voice arrives over UDP anyway
+func (s *session) checkTransport(stats *mumbleproto.UserStats) {
+	s.Lock()
+	tcpOnly := s.tcpOnly
+	s.Unlock()
+
+	id := stats.GetSession()
+	if !tcpOnly || !sendsVoiceOverUDP(stats) || !s.isConnected(id) {
+		return
+	}
+
+	// The kick waits for the server, whose answers are read by the
+	// caller, so it can't be done before the caller returns
+	go func() {
+		err := s.kick(id, tcpOnlyKickReason)
+		if err != nil {
+			log.Debugf("session: can't disconnect a user sending voice over UDP: %s", err)
+			return
+		}
+		log.WithField("session", id).Info("A user sending voice over UDP has been disconnected from the meeting")
+	}()
+}
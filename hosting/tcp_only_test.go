@@ -0,0 +1,86 @@
+package hosting
+
+import (
+	"time"
+
+	"github.com/digitalautonomy/grumble/pkg/mumbleproto"
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_sendsVoiceOverUDP_looksAtThePacketsTheServerDecrypted(c *C) {
+	c.Assert(sendsVoiceOverUDP(&mumbleproto.UserStats{}), Equals, false)
+	c.Assert(sendsVoiceOverUDP(&mumbleproto.UserStats{
+		FromClient: &mumbleproto.UserStats_Stats{Good: proto.Uint32(0)},
+		TcpPackets: proto.Uint32(120),
+	}), Equals, false)
+	c.Assert(sendsVoiceOverUDP(&mumbleproto.UserStats{
+		FromClient: &mumbleproto.UserStats_Stats{Good: proto.Uint32(3)},
+	}), Equals, true)
+	c.Assert(sendsVoiceOverUDP(&mumbleproto.UserStats{UdpPackets: proto.Uint32(1)}), Equals, true)
+}
+
+func (h *hostingSuite) Test_ServerOptions_TCPOnly_listensOnLocalhost(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{TCPOnly: true})
+	defer stop()
+
+	c.Assert(serv.gs.HostAddress(), Equals, tcpOnlyAddress)
+}
+
+func (h *hostingSuite) Test_server_TCPOnly_disconnectsTheUsersSendingVoiceOverUDP(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{TCPOnly: true})
+	defer stop()
+
+	guest, hash := joinTestServer(c, serv, "")
+	defer guest.close()
+	u := userWithCertificate(c, serv, hash)
+
+	control, err := serv.moderator()
+	c.Assert(err, IsNil)
+	control.userStats(&mumbleproto.UserStats{
+		Session:    proto.Uint32(u.Session),
+		FromClient: &mumbleproto.UserStats_Stats{Good: proto.Uint32(5)},
+	})
+
+	select {
+	case <-guest.done:
+	case <-time.After(5 * time.Second):
+		c.Fatal("the user sending voice over UDP was not disconnected")
+	}
+}
+
+func (h *hostingSuite) Test_server_TCPOnly_keepsTheUsersSendingVoiceOverTCP(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{TCPOnly: true})
+	defer stop()
+
+	guest, hash := joinTestServer(c, serv, "")
+	defer guest.close()
+	userWithCertificate(c, serv, hash)
+
+	control, err := serv.moderator()
+	c.Assert(err, IsNil)
+	control.askUserStats()
+	c.Assert(control.command(func() error { return nil }), IsNil)
+
+	c.Assert(guest.isClosed(), Equals, false)
+	c.Assert(serv.Users(), HasLen, 1)
+}
+
+func (h *hostingSuite) Test_server_withoutTCPOnly_letsTheUsersSendVoiceOverUDP(c *C) {
+	serv, stop := startTestServer(c, ServerOptions{})
+	defer stop()
+
+	guest, hash := joinTestServer(c, serv, "")
+	defer guest.close()
+	u := userWithCertificate(c, serv, hash)
+
+	control, err := serv.moderator()
+	c.Assert(err, IsNil)
+	control.userStats(&mumbleproto.UserStats{
+		Session:    proto.Uint32(u.Session),
+		FromClient: &mumbleproto.UserStats_Stats{Good: proto.Uint32(5)},
+	})
+	c.Assert(control.command(func() error { return nil }), IsNil)
+
+	c.Assert(guest.isClosed(), Equals, false)
+}
@@ -0,0 +1,55 @@
+package hosting
+
+import (
+	"crypto/sha256"
+	"encoding/pem"
+	"strings"
+)
+
+// VerificationSymbols is how many different symbols there are
+// in a verification code, so every symbol carries six bits
+const VerificationSymbols = 64
+
+// verificationCodeLength is how many symbols a verification code
+// has. Seven symbols are 42 bits, which are enough for people that
+// compare them once, while they are talking to each other
+const verificationCodeLength = 7
+
+// verificationContext keeps the hash of a verification code
+// from being the hash of anything else
+const verificationContext = "wahay meeting verification v1"
+
+// VerificationCode returns the symbols the host and the guests of
+// a meeting compare, to check that the meeting ID and the certificate
+// of the server are the ones of the host. An invitation changed on its
+// way to a guest leads to another onion service, with another
+// certificate, so it shows other symbols
+func VerificationCode(meetingID string, certPEM []byte) ([]int, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errInvalidCertificatePEM
+	}
+
+	id := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(meetingID)), ".onion")
+
+	h := sha256.New()
+	_, _ = h.Write([]byte(verificationContext))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(id))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write(block.Bytes)
+	sum := h.Sum(nil)
+
+	var bits uint64
+	for _, b := range sum[:6] {
+		bits = bits<<8 | uint64(b)
+	}
+
+	code := make([]int, verificationCodeLength)
+	for i := range code {
+		shift := 48 - 6*(i+1)
+		code[i] = int(bits>>shift) % VerificationSymbols
+	}
+
+	return code, nil
+}
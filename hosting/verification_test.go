@@ -0,0 +1,43 @@
+package hosting
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+const verificationTestOnion = "qvdjpoqcg572ibylv673qr76iwashlazh6spm47ly37w65iwwmkbmtid"
+
+func (h *hostingSuite) Test_VerificationCode_isTheSameForTheHostAndTheGuests(c *C) {
+	cert, _ := generateTestCertificate(c)
+
+	host, err := VerificationCode(verificationTestOnion+".onion", cert)
+	c.Assert(err, IsNil)
+	c.Assert(host, HasLen, verificationCodeLength)
+	for _, symbol := range host {
+		c.Assert(symbol >= 0 && symbol < VerificationSymbols, Equals, true)
+	}
+
+	guest, err := VerificationCode(" "+verificationTestOnion+".ONION", cert)
+	c.Assert(err, IsNil)
+	c.Assert(guest, DeepEquals, host)
+
+	guest, err = VerificationCode(verificationTestOnion, cert)
+	c.Assert(err, IsNil)
+	c.Assert(guest, DeepEquals, host)
+}
+
+func (h *hostingSuite) Test_VerificationCode_changesWithTheCertificateOrTheMeeting(c *C) {
+	cert, _ := generateTestCertificate(c)
+	other, _ := generateTestCertificate(c)
+
+	code, _ := VerificationCode(verificationTestOnion, cert)
+	withOtherCert, _ := VerificationCode(verificationTestOnion, other)
+	withOtherMeeting, _ := VerificationCode("abcdef"+verificationTestOnion[6:], cert)
+
+	c.Assert(withOtherCert, Not(DeepEquals), code)
+	c.Assert(withOtherMeeting, Not(DeepEquals), code)
+}
+
+func (h *hostingSuite) Test_VerificationCode_needsACertificate(c *C) {
+	_, err := VerificationCode(verificationTestOnion, []byte("not a certificate"))
+	c.Assert(err, Equals, errInvalidCertificatePEM)
+}
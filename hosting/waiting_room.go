@@ -0,0 +1,114 @@
+package hosting
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrParticipantNotWaiting is returned when admitting or rejecting
+// a guest that is not in the waiting room
+var ErrParticipantNotWaiting = errors.New("the participant is not in the waiting room")
+
+// WithWaitingRoom keeps the guests in a waiting room until the host
+// admits them, so a leaked invitation doesn't let anybody in. The guests
+// arriving are announced with ParticipantWaiting events. They haven't
+// reached the Mumble server yet, so nothing else is known about them
+func WithWaitingRoom() ServiceOption {
+	return func(o *serviceOptions) {
+		o.waitingRoom = true
+	}
+}
+
+// Admit lets the guest with the given ID in, as soon as there is room in
+// the meeting
+func (s *server) Admit(id int) error {
+	if s.gate == nil {
+		return ErrParticipantNotWaiting
+	}
+	return s.gate.admit(id)
+}
+
+// Reject disconnects the guest with the given ID from the waiting room
+func (s *server) Reject(id int) error {
+	if s.gate == nil {
+		return ErrParticipantNotWaiting
+	}
+	return s.gate.reject(id)
+}
+
+// Waiting returns the IDs of the guests in the waiting room that the host
+// hasn't admitted yet, in the order they arrived
+func (s *server) Waiting() []int {
+	if s.gate == nil {
+		return nil
+	}
+	return s.gate.waitingForHost()
+}
+
+func (g *connectionGate) waitingForHost() []int {
+	g.Lock()
+	defer g.Unlock()
+
+	result := []int{}
+	if !g.waitingRoom {
+		return result
+	}
+
+	for _, q := range g.queue {
+		if !q.approved {
+			result = append(result, q.id)
+		}
+	}
+	return result
+}
+
+func (g *connectionGate) admit(id int) error {
+	g.Lock()
+	defer g.Unlock()
+
+	q := g.queued(id)
+	if q == nil {
+		return ErrParticipantNotWaiting
+	}
+
+	log.WithField("participant", id).Info("connectionGate: the host admitted a participant")
+
+	q.approved = true
+	g.admitNext()
+
+	return nil
+}
+
+func (g *connectionGate) reject(id int) error {
+	g.Lock()
+	defer g.Unlock()
+
+	for i, q := range g.queue {
+		if q.id != id {
+			continue
+		}
+
+		log.WithField("participant", id).Info("connectionGate: the host rejected a participant")
+
+		g.queue = append(g.queue[:i], g.queue[i+1:]...)
+		q.rejected = true
+		q.admitted <- false
+		g.queueChanged()
+		g.waitingChanged(ParticipantStoppedWaiting, q)
+
+		return nil
+	}
+
+	return ErrParticipantNotWaiting
+}
+
+// queued must be called with the lock held
+func (g *connectionGate) queued(id int) *queuedConnection {
+	for _, q := range g.queue {
+		if q.id == id {
+			return q
+		}
+	}
+	return nil
+}
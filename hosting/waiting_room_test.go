@@ -0,0 +1,87 @@
+package hosting
+
+import (
+	"bufio"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func startWaitingRoom(c *C) (*connectionGate, *server, <-chan ParticipantEvent, func()) {
+	target := startEchoServer(c)
+
+	g, err := newConnectionGate(listenAddress{}, target.Addr().String(), 0)
+	c.Assert(err, IsNil)
+	g.waitingRoom = true
+	g.start()
+
+	s := &server{events: g.events, gate: g}
+	events, stop := s.Subscribe()
+
+	return g, s, events, func() {
+		stop()
+		_ = g.stop()
+		_ = target.Close()
+	}
+}
+
+func (h *hostingSuite) Test_WithWaitingRoom_letsInOnlyTheParticipantsTheHostAdmits(c *C) {
+	gate, s, events, done := startWaitingRoom(c)
+	defer done()
+
+	conn := connectToGate(c, gate)
+	defer conn.Close()
+
+	ev := nextEvent(c, events)
+	c.Assert(ev.Type, Equals, ParticipantWaiting)
+
+	c.Assert(s.Admit(ev.ID), IsNil)
+	c.Assert(nextEvent(c, events), Equals, ParticipantEvent{Type: ParticipantConnected, Participants: 1})
+	c.Assert(echo(c, conn, "hello"), Equals, "hello\n")
+}
+
+func (h *hostingSuite) Test_WithWaitingRoom_disconnectsTheParticipantsTheHostRejects(c *C) {
+	gate, s, events, done := startWaitingRoom(c)
+	defer done()
+
+	conn := connectToGate(c, gate)
+	defer conn.Close()
+
+	ev := nextEvent(c, events)
+	c.Assert(s.Reject(ev.ID), IsNil)
+	c.Assert(nextEvent(c, events), Equals, ParticipantEvent{Type: ParticipantStoppedWaiting, ID: ev.ID})
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err := bufio.NewReader(conn).ReadString('\n')
+	c.Assert(err, NotNil)
+	c.Assert(s.Stats().Participants, Equals, 0)
+}
+
+func (h *hostingSuite) Test_Admit_failsForParticipantsThatAreNotWaiting(c *C) {
+	_, s, _, done := startWaitingRoom(c)
+	defer done()
+
+	c.Assert(s.Admit(42), Equals, ErrParticipantNotWaiting)
+	c.Assert(s.Reject(42), Equals, ErrParticipantNotWaiting)
+	c.Assert((&server{}).Admit(1), Equals, ErrParticipantNotWaiting)
+}
+
+func (h *hostingSuite) Test_Waiting_returnsTheParticipantsNotAdmittedYet(c *C) {
+	gate, s, events, done := startWaitingRoom(c)
+	defer done()
+
+	first := connectToGate(c, gate)
+	defer first.Close()
+	ev1 := nextEvent(c, events)
+
+	second := connectToGate(c, gate)
+	defer second.Close()
+	ev2 := nextEvent(c, events)
+
+	c.Assert(s.Waiting(), DeepEquals, []int{ev1.ID, ev2.ID})
+
+	c.Assert(s.Admit(ev1.ID), IsNil)
+	c.Assert(s.Waiting(), DeepEquals, []int{ev2.ID})
+
+	c.Assert((&server{}).Waiting(), IsNil)
+}
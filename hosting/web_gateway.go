@@ -0,0 +1,231 @@
+package hosting
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+// webGatewayPort is the port of the onion service where the invitees
+// without a Mumble client open the meeting in Tor Browser. Mumble-web
+// connects to the same address and port it was loaded from by default
+const webGatewayPort = 443
+
+// ErrNoMumbleWeb is returned when the web gateway is enabled
+// but there is no copy of mumble-web to serve
+var ErrNoMumbleWeb = errors.New("mumble-web can't be found")
+
+// mumbleWebLocations are the places mumble-web is installed in by
+// the packages and by npm, in the order they are looked in
+var mumbleWebLocations = []string{
+	"/usr/share/webapps/mumble-web",
+	"/usr/share/mumble-web",
+	"/usr/local/share/mumble-web",
+	"/usr/lib/node_modules/mumble-web/dist",
+	"/usr/local/lib/node_modules/mumble-web/dist",
+}
+
+// WithWebGateway lets the invitees join the meeting from Tor Browser,
+// with mumble-web served from the given directory, or from where it's
+// usually installed when the directory is empty. The connections of the
+// browser go through the same gate as the ones of the Mumble clients, so
+// the waiting room and the limits of the meeting apply to them too
+func WithWebGateway(mumbleWebDir string) ServiceOption {
+	return func(o *serviceOptions) {
+		o.webGateway = true
+		o.mumbleWebDir = mumbleWebDir
+	}
+}
+
+// findMumbleWeb returns the directory with mumble-web
+func findMumbleWeb(dir string) (string, error) {
+	candidates := mumbleWebLocations
+	if dir != "" {
+		candidates = []string{dir}
+	}
+
+	for _, c := range candidates {
+		if fileExists(filepath.Join(c, "index.html")) {
+			return c, nil
+		}
+	}
+
+	return "", ErrNoMumbleWeb
+}
+
+// webGateway serves mumble-web and turns the WebSocket connections
+// it makes into connections to the meeting, like websockify does
+type webGateway struct {
+	port     int
+	listener net.Listener
+	server   *http.Server
+	certFile string
+	keyFile  string
+	upgrader websocket.Upgrader
+
+	// dialMeeting connects to the place the Mumble clients
+	// of the guests connect to through the onion service
+	dialMeeting func() (net.Conn, error)
+}
+
+func newWebGateway(listen listenAddress, dataDir, mumbleWebDir string, dialMeeting func() (net.Conn, error)) (*webGateway, error) {
+	dir, err := findMumbleWeb(mumbleWebDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// The gateway is only reached through Tor, so it never
+	// listens on the unix socket of the guests
+	l, port, err := listenAddress{host: listen.host}.listen()
+	if err != nil {
+		return nil, err
+	}
+
+	g := &webGateway{
+		port:        port,
+		listener:    l,
+		certFile:    filepath.Join(dataDir, "cert.pem"),
+		keyFile:     filepath.Join(dataDir, "key.pem"),
+		dialMeeting: dialMeeting,
+	}
+
+	files := http.FileServer(http.Dir(dir))
+	g.server = &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if websocket.IsWebSocketUpgrade(r) {
+				g.handleWebSocket(w, r)
+				return
+			}
+			files.ServeHTTP(w, r)
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+	}
+
+	log.WithFields(log.Fields{
+		"port":       port,
+		"mumble-web": dir,
+	}).Debug("Creating the web gateway of the meeting")
+
+	return g, nil
+}
+
+// start serves the gateway with the certificate of the Mumble server.
+// It's not signed by anybody, so Tor Browser asks the invitees to accept
+// it, but the onion service already authenticates the meeting
+func (g *webGateway) start() {
+	go func() {
+		err := g.server.ServeTLS(g.listener, g.certFile, g.keyFile)
+		if err != http.ErrServerClosed {
+			log.Errorf("webGateway: %v", err)
+		}
+	}()
+}
+
+func (g *webGateway) stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return g.server.Shutdown(ctx)
+}
+
+func (g *webGateway) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	ws, err := g.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Debugf("webGateway: %v", err)
+		return
+	}
+	defer ws.Close()
+
+	meeting, err := g.dialMeeting()
+	if err != nil {
+		log.Errorf("webGateway: can't connect to the meeting: %v", err)
+		return
+	}
+
+	// Mumble-web speaks the Mumble protocol without TLS, which is what
+	// the WebSocket is for in a browser. The server is our own one on
+	// this computer, so there is no certificate to check
+	/* #nosec G402 */
+	server := tls.Client(meeting, &tls.Config{InsecureSkipVerify: true})
+	defer server.Close()
+
+	go func() {
+		_ = copyToWebSocket(ws, server)
+		_ = ws.Close()
+	}()
+
+	_ = copyFromWebSocket(server, ws)
+}
+
+// copyFromWebSocket writes the content of every message of the
+// WebSocket to w, until the WebSocket is closed
+func copyFromWebSocket(w io.Writer, ws *websocket.Conn) error {
+	for {
+		_, r, err := ws.NextReader()
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(w, r); err != nil {
+			return err
+		}
+	}
+}
+
+// copyToWebSocket sends what is read from r as binary
+// messages of the WebSocket, until r is closed
+func copyToWebSocket(ws *websocket.Conn, r io.Reader) error {
+	buf := make([]byte, 16*1024)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if werr := ws.WriteMessage(websocket.BinaryMessage, buf[:n]); werr != nil {
+				return werr
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// dialGate connects to the listener of the guests, so the connections
+// from the browser go through the gate like the rest
+func dialGate(g *connectionGate) func() (net.Conn, error) {
+	return func() (net.Conn, error) {
+		addr := g.listener.Addr()
+		if addr.Network() == "unix" {
+			return net.Dial("unix", addr.String())
+		}
+		_, port, err := net.SplitHostPort(addr.String())
+		if err != nil {
+			return nil, err
+		}
+		return net.Dial("tcp", net.JoinHostPort(gateDialHost(addr), port))
+	}
+}
+
+// gateDialHost returns the address to connect to the gate, which
+// is localhost when it listens on all the interfaces
+func gateDialHost(addr net.Addr) string {
+	if tcp, ok := addr.(*net.TCPAddr); ok && !tcp.IP.IsUnspecified() {
+		return tcp.IP.String()
+	}
+	return "127.0.0.1"
+}
+
+// WebGatewayURL returns the address of the meeting in Tor Browser,
+// or an empty string when it can't be joined from the browser
+func (s *service) WebGatewayURL() string {
+	if s.webGateway == nil {
+		return ""
+	}
+	return "https://" + s.ID() + "/"
+}
@@ -0,0 +1,105 @@
+package hosting
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	grumbleServer "github.com/digitalautonomy/grumble/server"
+	"github.com/gorilla/websocket"
+	. "gopkg.in/check.v1"
+)
+
+func createMumbleWeb(c *C) string {
+	dir := c.MkDir()
+	c.Assert(os.WriteFile(filepath.Join(dir, "index.html"), []byte("mumble-web"), 0600), IsNil)
+	return dir
+}
+
+func startTLSEchoServer(c *C, dataDir string) net.Listener {
+	cert, err := tls.LoadX509KeyPair(filepath.Join(dataDir, "cert.pem"), filepath.Join(dataDir, "key.pem"))
+	c.Assert(err, IsNil)
+
+	l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	c.Assert(err, IsNil)
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	return l
+}
+
+func (h *hostingSuite) Test_findMumbleWeb_usesTheGivenDirectory(c *C) {
+	dir := createMumbleWeb(c)
+
+	found, err := findMumbleWeb(dir)
+
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, dir)
+}
+
+func (h *hostingSuite) Test_findMumbleWeb_failsWithoutMumbleWeb(c *C) {
+	_, err := findMumbleWeb(c.MkDir())
+
+	c.Assert(err, Equals, ErrNoMumbleWeb)
+}
+
+func (h *hostingSuite) Test_webGateway_servesMumbleWebAndForwardsTheWebSockets(c *C) {
+	dataDir := c.MkDir()
+	origDataDir := grumbleServer.Args.DataDir
+	defer func() { grumbleServer.Args.DataDir = origDataDir }()
+	grumbleServer.Args.DataDir = dataDir
+	c.Assert(generateCertificate(CertificateECDSA), IsNil)
+
+	target := startTLSEchoServer(c, dataDir)
+	defer target.Close()
+
+	g, err := newWebGateway(listenAddress{host: "127.0.0.1"}, dataDir, createMumbleWeb(c), func() (net.Conn, error) {
+		return net.Dial("tcp", target.Addr().String())
+	})
+	c.Assert(err, IsNil)
+	g.start()
+	defer func() { _ = g.stop() }()
+
+	address := net.JoinHostPort("127.0.0.1", strconv.Itoa(g.port))
+	/* #nosec G402 */
+	insecure := &tls.Config{InsecureSkipVerify: true}
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: insecure}, Timeout: 5 * time.Second}
+	res, err := client.Get("https://" + address + "/index.html")
+	c.Assert(err, IsNil)
+	page, _ := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	c.Assert(string(page), Equals, "mumble-web")
+
+	dialer := websocket.Dialer{TLSClientConfig: insecure, HandshakeTimeout: 5 * time.Second}
+	ws, _, err := dialer.Dial("wss://"+address+"/", nil)
+	c.Assert(err, IsNil)
+	defer ws.Close()
+
+	c.Assert(ws.WriteMessage(websocket.BinaryMessage, []byte("hello")), IsNil)
+	_ = ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, answer, err := ws.ReadMessage()
+	c.Assert(err, IsNil)
+	c.Assert(string(answer), Equals, "hello")
+}
+
+func (h *hostingSuite) Test_gateDialHost_usesLocalhostForAllTheInterfaces(c *C) {
+	c.Assert(gateDialHost(&net.TCPAddr{IP: net.IPv4zero, Port: 1234}), Equals, "127.0.0.1")
+	c.Assert(gateDialHost(&net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 1234}), Equals, "10.0.0.2")
+}
@@ -0,0 +1,52 @@
+package hosting
+
+import (
+	"errors"
+	"html/template"
+	"strings"
+)
+
+// Welcome is what the participants are told when they join a meeting.
+// The template is given by the caller, so it can be localized, and the
+// Mumble client shows the result as HTML
+type Welcome struct {
+	// Template can use {{.MeetingName}}, {{.HostName}} and {{.RulesLink}},
+	// which are escaped when the text is rendered
+	Template    string
+	MeetingName string
+	HostName    string
+	// RulesLink is the address of the rules of the meeting
+	RulesLink string
+}
+
+// ErrInvalidWelcomeTemplate is returned when the template
+// of the welcome text can't be rendered
+var ErrInvalidWelcomeTemplate = errors.New("the welcome text template is not valid")
+
+// Text renders the welcome text with the details of the meeting
+func (w Welcome) Text() (string, error) {
+	t, err := template.New("welcome").Option("missingkey=error").Parse(w.Template)
+	if err != nil {
+		return "", ErrInvalidWelcomeTemplate
+	}
+
+	var result strings.Builder
+	err = t.Execute(&result, w)
+	if err != nil {
+		return "", ErrInvalidWelcomeTemplate
+	}
+
+	return result.String(), nil
+}
+
+// SetWelcome renders the welcome text of the meeting. It must be
+// called before the meeting starts to be shown to the participants
+func (s *service) SetWelcome(w Welcome) error {
+	t, err := w.Text()
+	if err != nil {
+		return err
+	}
+
+	s.SetWelcomeText(t)
+	return nil
+}
@@ -0,0 +1,33 @@
+package hosting
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (h *hostingSuite) Test_Welcome_rendersTheDetailsOfTheMeeting(c *C) {
+	w := Welcome{
+		Template:    `Welcome to {{.MeetingName}}, hosted by <b>{{.HostName}}</b>. <a href="{{.RulesLink}}">Rules</a>`,
+		MeetingName: "Weekly sync",
+		HostName:    "<alice>",
+		RulesLink:   "https://example.org/rules",
+	}
+
+	text, err := w.Text()
+	c.Assert(err, IsNil)
+	c.Assert(text, Equals, `Welcome to Weekly sync, hosted by <b>&lt;alice&gt;</b>. <a href="https://example.org/rules">Rules</a>`)
+}
+
+func (h *hostingSuite) Test_Welcome_failsWithAnInvalidTemplate(c *C) {
+	_, err := Welcome{Template: "Welcome {{.Nobody}}"}.Text()
+	c.Assert(err, Equals, ErrInvalidWelcomeTemplate)
+
+	_, err = Welcome{Template: "Welcome {{"}.Text()
+	c.Assert(err, Equals, ErrInvalidWelcomeTemplate)
+}
+
+func (h *hostingSuite) Test_SetWelcome_setsTheWelcomeTextOfTheMeeting(c *C) {
+	s := &service{}
+
+	c.Assert(s.SetWelcome(Welcome{Template: "Hi, I'm {{.HostName}}", HostName: "alice"}), IsNil)
+	c.Assert(s.welcomeText, Equals, "Hi, I'm alice")
+}
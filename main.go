@@ -5,12 +5,10 @@ package main
 
 import (
 	"fmt"
+	"os"
 
-	"github.com/coyim/gotk3adapter/gdka"
-	"github.com/coyim/gotk3adapter/gliba"
-	"github.com/coyim/gotk3adapter/gtka"
 	"github.com/digitalautonomy/wahay/config"
-	"github.com/digitalautonomy/wahay/gui"
+	"github.com/digitalautonomy/wahay/headless"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -36,7 +34,25 @@ func main() {
 
 	initLogging()
 
-	runClient()
+	args := config.Arguments()
+
+	switch {
+	case len(args) > 0 && headless.IsCommand(args[0]):
+		exitOnError(headless.RunCommand(args))
+	case *config.HostMeeting:
+		exitOnError(runHeadlessHost())
+	case *config.Headless:
+		exitOnError(runHeadless())
+	default:
+		exitOnError(runClient())
+	}
+}
+
+func exitOnError(err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Wahay: %s\n", err)
+		os.Exit(1)
+	}
 }
 
 func initLogging() {
@@ -50,7 +66,33 @@ func initLogging() {
 	log.SetReportCaller(*config.DebugFunctionCalls)
 }
 
-func runClient() {
-	g := gui.CreateGraphics(gtka.Real, gliba.Real, gdka.Real)
-	gui.NewGTK(g).Loop()
+// runHeadless joins the meeting in the invitation from the terminal. The
+// password of the meeting can be given in the environment instead of the
+// invitation, so it doesn't show up in the history of the shell
+func runHeadless() error {
+	conf, _, err := headless.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	return headless.Join(conf, headless.Options{
+		MeetingURL:    config.DeepLink(),
+		Username:      *config.Username,
+		Password:      os.Getenv("WAHAY_MEETING_PASSWORD"),
+		ConsoleClient: *config.HeadlessClient,
+	})
+}
+
+// runHeadlessHost hosts a meeting with the settings of Wahay until it's
+// stopped, for a server without a desktop. The password is taken from
+// the environment for the same reason as when joining
+func runHeadlessHost() error {
+	conf, k, err := headless.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	return headless.Host(conf, k, headless.HostOptions{
+		Password: os.Getenv("WAHAY_MEETING_PASSWORD"),
+	})
 }
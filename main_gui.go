@@ -0,0 +1,23 @@
+//go:build binary && !headless
+// +build binary,!headless
+
+package main
+
+import (
+	"github.com/coyim/gotk3adapter/gdka"
+	"github.com/coyim/gotk3adapter/gliba"
+	"github.com/coyim/gotk3adapter/gtka"
+	"github.com/digitalautonomy/wahay/config"
+	"github.com/digitalautonomy/wahay/gui"
+)
+
+func runClient() error {
+	// A link opened while Wahay is running goes to the running Wahay
+	if link := config.DeepLink(); link != "" && gui.SendDeepLink(link) == nil {
+		return nil
+	}
+
+	g := gui.CreateGraphics(gtka.Real, gliba.Real, gdka.Real)
+	gui.NewGTK(g).Loop()
+	return nil
+}
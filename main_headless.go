@@ -0,0 +1,12 @@
+//go:build binary && headless
+// +build binary,headless
+
+package main
+
+import "errors"
+
+// A headless build doesn't link GTK, so it runs on servers
+// without a desktop, where it can only host or join from the terminal
+func runClient() error {
+	return errors.New("this build of Wahay has no graphical interface, use -host-meeting or -headless")
+}
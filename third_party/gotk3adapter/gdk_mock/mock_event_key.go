@@ -0,0 +1,13 @@
+package gdk_mock
+
+type MockEventKey struct {
+	MockEvent
+}
+
+func (*MockEventKey) KeyVal() uint {
+	return 0
+}
+
+func (*MockEventKey) State() uint {
+	return 0
+}
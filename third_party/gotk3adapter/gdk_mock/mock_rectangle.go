@@ -0,0 +1,8 @@
+package gdk_mock
+
+type MockRectangle struct {
+}
+
+func (*MockRectangle) GetY() int {
+	return 0
+}
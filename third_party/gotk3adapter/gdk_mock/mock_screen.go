@@ -0,0 +1,4 @@
+package gdk_mock
+
+type MockScreen struct {
+}
@@ -0,0 +1,628 @@
+package gdka
+
+import (
+	"github.com/coyim/gotk3adapter/gdki"
+	"github.com/gotk3/gotk3/gdk"
+)
+
+func init() {
+	gdki.GDK_SHIFT_MASK = gdki.ModifierType(gdk.SHIFT_MASK)
+	gdki.GDK_LOCK_MASK = gdki.ModifierType(gdk.LOCK_MASK)
+	gdki.GDK_CONTROL_MASK = gdki.ModifierType(gdk.CONTROL_MASK)
+	gdki.GDK_MOD1_MASK = gdki.ModifierType(gdk.MOD1_MASK)
+	gdki.GDK_MOD2_MASK = gdki.ModifierType(gdk.MOD2_MASK)
+	gdki.GDK_MOD3_MASK = gdki.ModifierType(gdk.MOD3_MASK)
+	gdki.GDK_MOD4_MASK = gdki.ModifierType(gdk.MOD4_MASK)
+	gdki.GDK_MOD5_MASK = gdki.ModifierType(gdk.MOD5_MASK)
+	gdki.GDK_BUTTON1_MASK = gdki.ModifierType(gdk.BUTTON1_MASK)
+	gdki.GDK_BUTTON2_MASK = gdki.ModifierType(gdk.BUTTON2_MASK)
+	gdki.GDK_BUTTON3_MASK = gdki.ModifierType(gdk.BUTTON3_MASK)
+	gdki.GDK_BUTTON4_MASK = gdki.ModifierType(gdk.BUTTON4_MASK)
+	gdki.GDK_BUTTON5_MASK = gdki.ModifierType(gdk.BUTTON5_MASK)
+	gdki.GDK_SUPER_MASK = gdki.ModifierType(gdk.SUPER_MASK)
+	gdki.GDK_HYPER_MASK = gdki.ModifierType(gdk.HYPER_MASK)
+	gdki.GDK_META_MASK = gdki.ModifierType(gdk.META_MASK)
+	gdki.GDK_RELEASE_MASK = gdki.ModifierType(gdk.RELEASE_MASK)
+	gdki.GDK_MODIFIER_MASK = gdki.ModifierType(gdk.MODIFIER_MASK)
+
+	gdki.KEY_VoidSymbol = gdk.KEY_VoidSymbol
+	gdki.KEY_BackSpace = gdk.KEY_BackSpace
+	gdki.KEY_Tab = gdk.KEY_Tab
+	gdki.KEY_Linefeed = gdk.KEY_Linefeed
+	gdki.KEY_Clear = gdk.KEY_Clear
+	gdki.KEY_Return = gdk.KEY_Return
+	gdki.KEY_Pause = gdk.KEY_Pause
+	gdki.KEY_Scroll_Lock = gdk.KEY_Scroll_Lock
+	gdki.KEY_Sys_Req = gdk.KEY_Sys_Req
+	gdki.KEY_Escape = gdk.KEY_Escape
+	gdki.KEY_Delete = gdk.KEY_Delete
+	gdki.KEY_Multi_key = gdk.KEY_Multi_key
+	gdki.KEY_Codeinput = gdk.KEY_Codeinput
+	gdki.KEY_SingleCandidate = gdk.KEY_SingleCandidate
+	gdki.KEY_MultipleCandidate = gdk.KEY_MultipleCandidate
+	gdki.KEY_PreviousCandidate = gdk.KEY_PreviousCandidate
+	gdki.KEY_Kanji = gdk.KEY_Kanji
+	gdki.KEY_Muhenkan = gdk.KEY_Muhenkan
+	gdki.KEY_Henkan_Mode = gdk.KEY_Henkan_Mode
+	gdki.KEY_Henkan = gdk.KEY_Henkan
+	gdki.KEY_Romaji = gdk.KEY_Romaji
+	gdki.KEY_Hiragana = gdk.KEY_Hiragana
+	gdki.KEY_Katakana = gdk.KEY_Katakana
+	gdki.KEY_Hiragana_Katakana = gdk.KEY_Hiragana_Katakana
+	gdki.KEY_Zenkaku = gdk.KEY_Zenkaku
+	gdki.KEY_Hankaku = gdk.KEY_Hankaku
+	gdki.KEY_Zenkaku_Hankaku = gdk.KEY_Zenkaku_Hankaku
+	gdki.KEY_Touroku = gdk.KEY_Touroku
+	gdki.KEY_Massyo = gdk.KEY_Massyo
+	gdki.KEY_Kana_Lock = gdk.KEY_Kana_Lock
+	gdki.KEY_Kana_Shift = gdk.KEY_Kana_Shift
+	gdki.KEY_Eisu_Shift = gdk.KEY_Eisu_Shift
+	gdki.KEY_Eisu_toggle = gdk.KEY_Eisu_toggle
+	gdki.KEY_Kanji_Bangou = gdk.KEY_Kanji_Bangou
+	gdki.KEY_Zen_Koho = gdk.KEY_Zen_Koho
+	gdki.KEY_Mae_Koho = gdk.KEY_Mae_Koho
+	gdki.KEY_Home = gdk.KEY_Home
+	gdki.KEY_Left = gdk.KEY_Left
+	gdki.KEY_Up = gdk.KEY_Up
+	gdki.KEY_Right = gdk.KEY_Right
+	gdki.KEY_Down = gdk.KEY_Down
+	gdki.KEY_Prior = gdk.KEY_Prior
+	gdki.KEY_Page_Up = gdk.KEY_Page_Up
+	gdki.KEY_Next = gdk.KEY_Next
+	gdki.KEY_Page_Down = gdk.KEY_Page_Down
+	gdki.KEY_End = gdk.KEY_End
+	gdki.KEY_Begin = gdk.KEY_Begin
+	gdki.KEY_Select = gdk.KEY_Select
+	gdki.KEY_Print = gdk.KEY_Print
+	gdki.KEY_Execute = gdk.KEY_Execute
+	gdki.KEY_Insert = gdk.KEY_Insert
+	gdki.KEY_Undo = gdk.KEY_Undo
+	gdki.KEY_Redo = gdk.KEY_Redo
+	gdki.KEY_Menu = gdk.KEY_Menu
+	gdki.KEY_Find = gdk.KEY_Find
+	gdki.KEY_Cancel = gdk.KEY_Cancel
+	gdki.KEY_Help = gdk.KEY_Help
+	gdki.KEY_Break = gdk.KEY_Break
+	gdki.KEY_Mode_switch = gdk.KEY_Mode_switch
+	gdki.KEY_script_switch = gdk.KEY_script_switch
+	gdki.KEY_Num_Lock = gdk.KEY_Num_Lock
+	gdki.KEY_KP_Space = gdk.KEY_KP_Space
+	gdki.KEY_KP_Tab = gdk.KEY_KP_Tab
+	gdki.KEY_KP_Enter = gdk.KEY_KP_Enter
+	gdki.KEY_KP_F1 = gdk.KEY_KP_F1
+	gdki.KEY_KP_F2 = gdk.KEY_KP_F2
+	gdki.KEY_KP_F3 = gdk.KEY_KP_F3
+	gdki.KEY_KP_F4 = gdk.KEY_KP_F4
+	gdki.KEY_KP_Home = gdk.KEY_KP_Home
+	gdki.KEY_KP_Left = gdk.KEY_KP_Left
+	gdki.KEY_KP_Up = gdk.KEY_KP_Up
+	gdki.KEY_KP_Right = gdk.KEY_KP_Right
+	gdki.KEY_KP_Down = gdk.KEY_KP_Down
+	gdki.KEY_KP_Prior = gdk.KEY_KP_Prior
+	gdki.KEY_KP_Page_Up = gdk.KEY_KP_Page_Up
+	gdki.KEY_KP_Next = gdk.KEY_KP_Next
+	gdki.KEY_KP_Page_Down = gdk.KEY_KP_Page_Down
+	gdki.KEY_KP_End = gdk.KEY_KP_End
+	gdki.KEY_KP_Begin = gdk.KEY_KP_Begin
+	gdki.KEY_KP_Insert = gdk.KEY_KP_Insert
+	gdki.KEY_KP_Delete = gdk.KEY_KP_Delete
+	gdki.KEY_KP_Equal = gdk.KEY_KP_Equal
+	gdki.KEY_KP_Multiply = gdk.KEY_KP_Multiply
+	gdki.KEY_KP_Add = gdk.KEY_KP_Add
+	gdki.KEY_KP_Separator = gdk.KEY_KP_Separator
+	gdki.KEY_KP_Subtract = gdk.KEY_KP_Subtract
+	gdki.KEY_KP_Decimal = gdk.KEY_KP_Decimal
+	gdki.KEY_KP_Divide = gdk.KEY_KP_Divide
+	gdki.KEY_KP_0 = gdk.KEY_KP_0
+	gdki.KEY_KP_1 = gdk.KEY_KP_1
+	gdki.KEY_KP_2 = gdk.KEY_KP_2
+	gdki.KEY_KP_3 = gdk.KEY_KP_3
+	gdki.KEY_KP_4 = gdk.KEY_KP_4
+	gdki.KEY_KP_5 = gdk.KEY_KP_5
+	gdki.KEY_KP_6 = gdk.KEY_KP_6
+	gdki.KEY_KP_7 = gdk.KEY_KP_7
+	gdki.KEY_KP_8 = gdk.KEY_KP_8
+	gdki.KEY_KP_9 = gdk.KEY_KP_9
+	gdki.KEY_F1 = gdk.KEY_F1
+	gdki.KEY_F2 = gdk.KEY_F2
+	gdki.KEY_F3 = gdk.KEY_F3
+	gdki.KEY_F4 = gdk.KEY_F4
+	gdki.KEY_F5 = gdk.KEY_F5
+	gdki.KEY_F6 = gdk.KEY_F6
+	gdki.KEY_F7 = gdk.KEY_F7
+	gdki.KEY_F8 = gdk.KEY_F8
+	gdki.KEY_F9 = gdk.KEY_F9
+	gdki.KEY_F10 = gdk.KEY_F10
+	gdki.KEY_F11 = gdk.KEY_F11
+	gdki.KEY_L1 = gdk.KEY_L1
+	gdki.KEY_F12 = gdk.KEY_F12
+	gdki.KEY_L2 = gdk.KEY_L2
+	gdki.KEY_F13 = gdk.KEY_F13
+	gdki.KEY_L3 = gdk.KEY_L3
+	gdki.KEY_F14 = gdk.KEY_F14
+	gdki.KEY_L4 = gdk.KEY_L4
+	gdki.KEY_F15 = gdk.KEY_F15
+	gdki.KEY_L5 = gdk.KEY_L5
+	gdki.KEY_F16 = gdk.KEY_F16
+	gdki.KEY_L6 = gdk.KEY_L6
+	gdki.KEY_F17 = gdk.KEY_F17
+	gdki.KEY_L7 = gdk.KEY_L7
+	gdki.KEY_F18 = gdk.KEY_F18
+	gdki.KEY_L8 = gdk.KEY_L8
+	gdki.KEY_F19 = gdk.KEY_F19
+	gdki.KEY_L9 = gdk.KEY_L9
+	gdki.KEY_F20 = gdk.KEY_F20
+	gdki.KEY_L10 = gdk.KEY_L10
+	gdki.KEY_F21 = gdk.KEY_F21
+	gdki.KEY_R1 = gdk.KEY_R1
+	gdki.KEY_F22 = gdk.KEY_F22
+	gdki.KEY_R2 = gdk.KEY_R2
+	gdki.KEY_F23 = gdk.KEY_F23
+	gdki.KEY_R3 = gdk.KEY_R3
+	gdki.KEY_F24 = gdk.KEY_F24
+	gdki.KEY_R4 = gdk.KEY_R4
+	gdki.KEY_F25 = gdk.KEY_F25
+	gdki.KEY_R5 = gdk.KEY_R5
+	gdki.KEY_F26 = gdk.KEY_F26
+	gdki.KEY_R6 = gdk.KEY_R6
+	gdki.KEY_F27 = gdk.KEY_F27
+	gdki.KEY_R7 = gdk.KEY_R7
+	gdki.KEY_F28 = gdk.KEY_F28
+	gdki.KEY_R8 = gdk.KEY_R8
+	gdki.KEY_F29 = gdk.KEY_F29
+	gdki.KEY_R9 = gdk.KEY_R9
+	gdki.KEY_F30 = gdk.KEY_F30
+	gdki.KEY_R10 = gdk.KEY_R10
+	gdki.KEY_F31 = gdk.KEY_F31
+	gdki.KEY_R11 = gdk.KEY_R11
+	gdki.KEY_F32 = gdk.KEY_F32
+	gdki.KEY_R12 = gdk.KEY_R12
+	gdki.KEY_F33 = gdk.KEY_F33
+	gdki.KEY_R13 = gdk.KEY_R13
+	gdki.KEY_F34 = gdk.KEY_F34
+	gdki.KEY_R14 = gdk.KEY_R14
+	gdki.KEY_F35 = gdk.KEY_F35
+	gdki.KEY_R15 = gdk.KEY_R15
+	gdki.KEY_Shift_L = gdk.KEY_Shift_L
+	gdki.KEY_Shift_R = gdk.KEY_Shift_R
+	gdki.KEY_Control_L = gdk.KEY_Control_L
+	gdki.KEY_Control_R = gdk.KEY_Control_R
+	gdki.KEY_Caps_Lock = gdk.KEY_Caps_Lock
+	gdki.KEY_Shift_Lock = gdk.KEY_Shift_Lock
+	gdki.KEY_Meta_L = gdk.KEY_Meta_L
+	gdki.KEY_Meta_R = gdk.KEY_Meta_R
+	gdki.KEY_Alt_L = gdk.KEY_Alt_L
+	gdki.KEY_Alt_R = gdk.KEY_Alt_R
+	gdki.KEY_Super_L = gdk.KEY_Super_L
+	gdki.KEY_Super_R = gdk.KEY_Super_R
+	gdki.KEY_Hyper_L = gdk.KEY_Hyper_L
+	gdki.KEY_Hyper_R = gdk.KEY_Hyper_R
+	gdki.KEY_ISO_Lock = gdk.KEY_ISO_Lock
+	gdki.KEY_ISO_Level2_Latch = gdk.KEY_ISO_Level2_Latch
+	gdki.KEY_ISO_Level3_Shift = gdk.KEY_ISO_Level3_Shift
+	gdki.KEY_ISO_Level3_Latch = gdk.KEY_ISO_Level3_Latch
+	gdki.KEY_ISO_Level3_Lock = gdk.KEY_ISO_Level3_Lock
+	gdki.KEY_ISO_Level5_Shift = gdk.KEY_ISO_Level5_Shift
+	gdki.KEY_ISO_Level5_Latch = gdk.KEY_ISO_Level5_Latch
+	gdki.KEY_ISO_Level5_Lock = gdk.KEY_ISO_Level5_Lock
+	gdki.KEY_ISO_Group_Shift = gdk.KEY_ISO_Group_Shift
+	gdki.KEY_ISO_Group_Latch = gdk.KEY_ISO_Group_Latch
+	gdki.KEY_ISO_Group_Lock = gdk.KEY_ISO_Group_Lock
+	gdki.KEY_ISO_Next_Group = gdk.KEY_ISO_Next_Group
+	gdki.KEY_ISO_Next_Group_Lock = gdk.KEY_ISO_Next_Group_Lock
+	gdki.KEY_ISO_Prev_Group = gdk.KEY_ISO_Prev_Group
+	gdki.KEY_ISO_Prev_Group_Lock = gdk.KEY_ISO_Prev_Group_Lock
+	gdki.KEY_ISO_First_Group = gdk.KEY_ISO_First_Group
+	gdki.KEY_ISO_First_Group_Lock = gdk.KEY_ISO_First_Group_Lock
+	gdki.KEY_ISO_Last_Group = gdk.KEY_ISO_Last_Group
+	gdki.KEY_ISO_Last_Group_Lock = gdk.KEY_ISO_Last_Group_Lock
+	gdki.KEY_ISO_Left_Tab = gdk.KEY_ISO_Left_Tab
+	gdki.KEY_ISO_Move_Line_Up = gdk.KEY_ISO_Move_Line_Up
+	gdki.KEY_ISO_Move_Line_Down = gdk.KEY_ISO_Move_Line_Down
+	gdki.KEY_ISO_Partial_Line_Up = gdk.KEY_ISO_Partial_Line_Up
+	gdki.KEY_ISO_Partial_Line_Down = gdk.KEY_ISO_Partial_Line_Down
+	gdki.KEY_ISO_Partial_Space_Left = gdk.KEY_ISO_Partial_Space_Left
+	gdki.KEY_ISO_Partial_Space_Right = gdk.KEY_ISO_Partial_Space_Right
+	gdki.KEY_ISO_Set_Margin_Left = gdk.KEY_ISO_Set_Margin_Left
+	gdki.KEY_ISO_Set_Margin_Right = gdk.KEY_ISO_Set_Margin_Right
+	gdki.KEY_ISO_Release_Margin_Left = gdk.KEY_ISO_Release_Margin_Left
+	gdki.KEY_ISO_Release_Margin_Right = gdk.KEY_ISO_Release_Margin_Right
+	gdki.KEY_ISO_Release_Both_Margins = gdk.KEY_ISO_Release_Both_Margins
+	gdki.KEY_ISO_Fast_Cursor_Left = gdk.KEY_ISO_Fast_Cursor_Left
+	gdki.KEY_ISO_Fast_Cursor_Right = gdk.KEY_ISO_Fast_Cursor_Right
+	gdki.KEY_ISO_Fast_Cursor_Up = gdk.KEY_ISO_Fast_Cursor_Up
+	gdki.KEY_ISO_Fast_Cursor_Down = gdk.KEY_ISO_Fast_Cursor_Down
+	gdki.KEY_ISO_Continuous_Underline = gdk.KEY_ISO_Continuous_Underline
+	gdki.KEY_ISO_Discontinuous_Underline = gdk.KEY_ISO_Discontinuous_Underline
+	gdki.KEY_ISO_Emphasize = gdk.KEY_ISO_Emphasize
+	gdki.KEY_ISO_Center_Object = gdk.KEY_ISO_Center_Object
+	gdki.KEY_ISO_Enter = gdk.KEY_ISO_Enter
+	gdki.KEY_First_Virtual_Screen = gdk.KEY_First_Virtual_Screen
+	gdki.KEY_Prev_Virtual_Screen = gdk.KEY_Prev_Virtual_Screen
+	gdki.KEY_Next_Virtual_Screen = gdk.KEY_Next_Virtual_Screen
+	gdki.KEY_Last_Virtual_Screen = gdk.KEY_Last_Virtual_Screen
+	gdki.KEY_Terminate_Server = gdk.KEY_Terminate_Server
+	gdki.KEY_AccessX_Enable = gdk.KEY_AccessX_Enable
+	gdki.KEY_AccessX_Feedback_Enable = gdk.KEY_AccessX_Feedback_Enable
+	gdki.KEY_RepeatKeys_Enable = gdk.KEY_RepeatKeys_Enable
+	gdki.KEY_SlowKeys_Enable = gdk.KEY_SlowKeys_Enable
+	gdki.KEY_BounceKeys_Enable = gdk.KEY_BounceKeys_Enable
+	gdki.KEY_StickyKeys_Enable = gdk.KEY_StickyKeys_Enable
+	gdki.KEY_MouseKeys_Enable = gdk.KEY_MouseKeys_Enable
+	gdki.KEY_MouseKeys_Accel_Enable = gdk.KEY_MouseKeys_Accel_Enable
+	gdki.KEY_Overlay1_Enable = gdk.KEY_Overlay1_Enable
+	gdki.KEY_Overlay2_Enable = gdk.KEY_Overlay2_Enable
+	gdki.KEY_AudibleBell_Enable = gdk.KEY_AudibleBell_Enable
+	gdki.KEY_Pointer_Left = gdk.KEY_Pointer_Left
+	gdki.KEY_Pointer_Right = gdk.KEY_Pointer_Right
+	gdki.KEY_Pointer_Up = gdk.KEY_Pointer_Up
+	gdki.KEY_Pointer_Down = gdk.KEY_Pointer_Down
+	gdki.KEY_Pointer_UpLeft = gdk.KEY_Pointer_UpLeft
+	gdki.KEY_Pointer_UpRight = gdk.KEY_Pointer_UpRight
+	gdki.KEY_Pointer_DownLeft = gdk.KEY_Pointer_DownLeft
+	gdki.KEY_Pointer_DownRight = gdk.KEY_Pointer_DownRight
+	gdki.KEY_Pointer_Button_Dflt = gdk.KEY_Pointer_Button_Dflt
+	gdki.KEY_Pointer_Button1 = gdk.KEY_Pointer_Button1
+	gdki.KEY_Pointer_Button2 = gdk.KEY_Pointer_Button2
+	gdki.KEY_Pointer_Button3 = gdk.KEY_Pointer_Button3
+	gdki.KEY_Pointer_Button4 = gdk.KEY_Pointer_Button4
+	gdki.KEY_Pointer_Button5 = gdk.KEY_Pointer_Button5
+	gdki.KEY_Pointer_DblClick_Dflt = gdk.KEY_Pointer_DblClick_Dflt
+	gdki.KEY_Pointer_DblClick1 = gdk.KEY_Pointer_DblClick1
+	gdki.KEY_Pointer_DblClick2 = gdk.KEY_Pointer_DblClick2
+	gdki.KEY_Pointer_DblClick3 = gdk.KEY_Pointer_DblClick3
+	gdki.KEY_Pointer_DblClick4 = gdk.KEY_Pointer_DblClick4
+	gdki.KEY_Pointer_DblClick5 = gdk.KEY_Pointer_DblClick5
+	gdki.KEY_Pointer_Drag_Dflt = gdk.KEY_Pointer_Drag_Dflt
+	gdki.KEY_Pointer_Drag1 = gdk.KEY_Pointer_Drag1
+	gdki.KEY_Pointer_Drag2 = gdk.KEY_Pointer_Drag2
+	gdki.KEY_Pointer_Drag3 = gdk.KEY_Pointer_Drag3
+	gdki.KEY_Pointer_Drag4 = gdk.KEY_Pointer_Drag4
+	gdki.KEY_Pointer_Drag5 = gdk.KEY_Pointer_Drag5
+	gdki.KEY_Pointer_EnableKeys = gdk.KEY_Pointer_EnableKeys
+	gdki.KEY_Pointer_Accelerate = gdk.KEY_Pointer_Accelerate
+	gdki.KEY_Pointer_DfltBtnNext = gdk.KEY_Pointer_DfltBtnNext
+	gdki.KEY_Pointer_DfltBtnPrev = gdk.KEY_Pointer_DfltBtnPrev
+	gdki.KEY_space = gdk.KEY_space
+	gdki.KEY_exclam = gdk.KEY_exclam
+	gdki.KEY_quotedbl = gdk.KEY_quotedbl
+	gdki.KEY_numbersign = gdk.KEY_numbersign
+	gdki.KEY_dollar = gdk.KEY_dollar
+	gdki.KEY_percent = gdk.KEY_percent
+	gdki.KEY_ampersand = gdk.KEY_ampersand
+	gdki.KEY_apostrophe = gdk.KEY_apostrophe
+	gdki.KEY_quoteright = gdk.KEY_quoteright
+	gdki.KEY_parenleft = gdk.KEY_parenleft
+	gdki.KEY_parenright = gdk.KEY_parenright
+	gdki.KEY_asterisk = gdk.KEY_asterisk
+	gdki.KEY_plus = gdk.KEY_plus
+	gdki.KEY_comma = gdk.KEY_comma
+	gdki.KEY_minus = gdk.KEY_minus
+	gdki.KEY_period = gdk.KEY_period
+	gdki.KEY_slash = gdk.KEY_slash
+	gdki.KEY_0 = gdk.KEY_0
+	gdki.KEY_1 = gdk.KEY_1
+	gdki.KEY_2 = gdk.KEY_2
+	gdki.KEY_3 = gdk.KEY_3
+	gdki.KEY_4 = gdk.KEY_4
+	gdki.KEY_5 = gdk.KEY_5
+	gdki.KEY_6 = gdk.KEY_6
+	gdki.KEY_7 = gdk.KEY_7
+	gdki.KEY_8 = gdk.KEY_8
+	gdki.KEY_9 = gdk.KEY_9
+	gdki.KEY_colon = gdk.KEY_colon
+	gdki.KEY_semicolon = gdk.KEY_semicolon
+	gdki.KEY_less = gdk.KEY_less
+	gdki.KEY_equal = gdk.KEY_equal
+	gdki.KEY_greater = gdk.KEY_greater
+	gdki.KEY_question = gdk.KEY_question
+	gdki.KEY_at = gdk.KEY_at
+	gdki.KEY_A = gdk.KEY_A
+	gdki.KEY_B = gdk.KEY_B
+	gdki.KEY_C = gdk.KEY_C
+	gdki.KEY_D = gdk.KEY_D
+	gdki.KEY_E = gdk.KEY_E
+	gdki.KEY_F = gdk.KEY_F
+	gdki.KEY_G = gdk.KEY_G
+	gdki.KEY_H = gdk.KEY_H
+	gdki.KEY_I = gdk.KEY_I
+	gdki.KEY_J = gdk.KEY_J
+	gdki.KEY_K = gdk.KEY_K
+	gdki.KEY_L = gdk.KEY_L
+	gdki.KEY_M = gdk.KEY_M
+	gdki.KEY_N = gdk.KEY_N
+	gdki.KEY_O = gdk.KEY_O
+	gdki.KEY_P = gdk.KEY_P
+	gdki.KEY_Q = gdk.KEY_Q
+	gdki.KEY_R = gdk.KEY_R
+	gdki.KEY_S = gdk.KEY_S
+	gdki.KEY_T = gdk.KEY_T
+	gdki.KEY_U = gdk.KEY_U
+	gdki.KEY_V = gdk.KEY_V
+	gdki.KEY_W = gdk.KEY_W
+	gdki.KEY_X = gdk.KEY_X
+	gdki.KEY_Y = gdk.KEY_Y
+	gdki.KEY_Z = gdk.KEY_Z
+	gdki.KEY_bracketleft = gdk.KEY_bracketleft
+	gdki.KEY_backslash = gdk.KEY_backslash
+	gdki.KEY_bracketright = gdk.KEY_bracketright
+	gdki.KEY_asciicircum = gdk.KEY_asciicircum
+	gdki.KEY_underscore = gdk.KEY_underscore
+	gdki.KEY_grave = gdk.KEY_grave
+	gdki.KEY_quoteleft = gdk.KEY_quoteleft
+	gdki.KEY_a = gdk.KEY_a
+	gdki.KEY_b = gdk.KEY_b
+	gdki.KEY_c = gdk.KEY_c
+	gdki.KEY_d = gdk.KEY_d
+	gdki.KEY_e = gdk.KEY_e
+	gdki.KEY_f = gdk.KEY_f
+	gdki.KEY_g = gdk.KEY_g
+	gdki.KEY_h = gdk.KEY_h
+	gdki.KEY_i = gdk.KEY_i
+	gdki.KEY_j = gdk.KEY_j
+	gdki.KEY_k = gdk.KEY_k
+	gdki.KEY_l = gdk.KEY_l
+	gdki.KEY_m = gdk.KEY_m
+	gdki.KEY_n = gdk.KEY_n
+	gdki.KEY_o = gdk.KEY_o
+	gdki.KEY_p = gdk.KEY_p
+	gdki.KEY_q = gdk.KEY_q
+	gdki.KEY_r = gdk.KEY_r
+	gdki.KEY_s = gdk.KEY_s
+	gdki.KEY_t = gdk.KEY_t
+	gdki.KEY_u = gdk.KEY_u
+	gdki.KEY_v = gdk.KEY_v
+	gdki.KEY_w = gdk.KEY_w
+	gdki.KEY_x = gdk.KEY_x
+	gdki.KEY_y = gdk.KEY_y
+	gdki.KEY_z = gdk.KEY_z
+	gdki.KEY_braceleft = gdk.KEY_braceleft
+	gdki.KEY_bar = gdk.KEY_bar
+	gdki.KEY_braceright = gdk.KEY_braceright
+	gdki.KEY_asciitilde = gdk.KEY_asciitilde
+	gdki.KEY_nobreakspace = gdk.KEY_nobreakspace
+	gdki.KEY_exclamdown = gdk.KEY_exclamdown
+	gdki.KEY_cent = gdk.KEY_cent
+	gdki.KEY_sterling = gdk.KEY_sterling
+	gdki.KEY_currency = gdk.KEY_currency
+	gdki.KEY_yen = gdk.KEY_yen
+	gdki.KEY_brokenbar = gdk.KEY_brokenbar
+	gdki.KEY_section = gdk.KEY_section
+	gdki.KEY_diaeresis = gdk.KEY_diaeresis
+	gdki.KEY_copyright = gdk.KEY_copyright
+	gdki.KEY_ordfeminine = gdk.KEY_ordfeminine
+	gdki.KEY_guillemotleft = gdk.KEY_guillemotleft
+	gdki.KEY_notsign = gdk.KEY_notsign
+	gdki.KEY_hyphen = gdk.KEY_hyphen
+	gdki.KEY_registered = gdk.KEY_registered
+	gdki.KEY_macron = gdk.KEY_macron
+	gdki.KEY_degree = gdk.KEY_degree
+	gdki.KEY_plusminus = gdk.KEY_plusminus
+	gdki.KEY_twosuperior = gdk.KEY_twosuperior
+	gdki.KEY_threesuperior = gdk.KEY_threesuperior
+	gdki.KEY_acute = gdk.KEY_acute
+	gdki.KEY_mu = gdk.KEY_mu
+	gdki.KEY_paragraph = gdk.KEY_paragraph
+	gdki.KEY_periodcentered = gdk.KEY_periodcentered
+	gdki.KEY_cedilla = gdk.KEY_cedilla
+	gdki.KEY_onesuperior = gdk.KEY_onesuperior
+	gdki.KEY_masculine = gdk.KEY_masculine
+	gdki.KEY_guillemotright = gdk.KEY_guillemotright
+	gdki.KEY_onequarter = gdk.KEY_onequarter
+	gdki.KEY_onehalf = gdk.KEY_onehalf
+	gdki.KEY_threequarters = gdk.KEY_threequarters
+	gdki.KEY_questiondown = gdk.KEY_questiondown
+	gdki.KEY_Agrave = gdk.KEY_Agrave
+	gdki.KEY_Aacute = gdk.KEY_Aacute
+	gdki.KEY_Acircumflex = gdk.KEY_Acircumflex
+	gdki.KEY_Atilde = gdk.KEY_Atilde
+	gdki.KEY_Adiaeresis = gdk.KEY_Adiaeresis
+	gdki.KEY_Aring = gdk.KEY_Aring
+	gdki.KEY_AE = gdk.KEY_AE
+	gdki.KEY_Ccedilla = gdk.KEY_Ccedilla
+	gdki.KEY_Egrave = gdk.KEY_Egrave
+	gdki.KEY_Eacute = gdk.KEY_Eacute
+	gdki.KEY_Ecircumflex = gdk.KEY_Ecircumflex
+	gdki.KEY_Ediaeresis = gdk.KEY_Ediaeresis
+	gdki.KEY_Igrave = gdk.KEY_Igrave
+	gdki.KEY_Iacute = gdk.KEY_Iacute
+	gdki.KEY_Icircumflex = gdk.KEY_Icircumflex
+	gdki.KEY_Idiaeresis = gdk.KEY_Idiaeresis
+	gdki.KEY_ETH = gdk.KEY_ETH
+	gdki.KEY_Eth = gdk.KEY_Eth
+	gdki.KEY_Ntilde = gdk.KEY_Ntilde
+	gdki.KEY_Ograve = gdk.KEY_Ograve
+	gdki.KEY_Oacute = gdk.KEY_Oacute
+	gdki.KEY_Ocircumflex = gdk.KEY_Ocircumflex
+	gdki.KEY_Otilde = gdk.KEY_Otilde
+	gdki.KEY_Odiaeresis = gdk.KEY_Odiaeresis
+	gdki.KEY_multiply = gdk.KEY_multiply
+	gdki.KEY_Oslash = gdk.KEY_Oslash
+	gdki.KEY_Ooblique = gdk.KEY_Ooblique
+	gdki.KEY_Ugrave = gdk.KEY_Ugrave
+	gdki.KEY_Uacute = gdk.KEY_Uacute
+	gdki.KEY_Ucircumflex = gdk.KEY_Ucircumflex
+	gdki.KEY_Udiaeresis = gdk.KEY_Udiaeresis
+	gdki.KEY_Yacute = gdk.KEY_Yacute
+	gdki.KEY_THORN = gdk.KEY_THORN
+	gdki.KEY_Thorn = gdk.KEY_Thorn
+	gdki.KEY_ssharp = gdk.KEY_ssharp
+	gdki.KEY_agrave = gdk.KEY_agrave
+	gdki.KEY_aacute = gdk.KEY_aacute
+	gdki.KEY_acircumflex = gdk.KEY_acircumflex
+	gdki.KEY_atilde = gdk.KEY_atilde
+	gdki.KEY_adiaeresis = gdk.KEY_adiaeresis
+	gdki.KEY_aring = gdk.KEY_aring
+	gdki.KEY_ae = gdk.KEY_ae
+	gdki.KEY_ccedilla = gdk.KEY_ccedilla
+	gdki.KEY_egrave = gdk.KEY_egrave
+	gdki.KEY_eacute = gdk.KEY_eacute
+	gdki.KEY_ecircumflex = gdk.KEY_ecircumflex
+	gdki.KEY_ediaeresis = gdk.KEY_ediaeresis
+	gdki.KEY_igrave = gdk.KEY_igrave
+	gdki.KEY_iacute = gdk.KEY_iacute
+	gdki.KEY_icircumflex = gdk.KEY_icircumflex
+	gdki.KEY_idiaeresis = gdk.KEY_idiaeresis
+	gdki.KEY_eth = gdk.KEY_eth
+	gdki.KEY_ntilde = gdk.KEY_ntilde
+	gdki.KEY_ograve = gdk.KEY_ograve
+	gdki.KEY_oacute = gdk.KEY_oacute
+	gdki.KEY_ocircumflex = gdk.KEY_ocircumflex
+	gdki.KEY_otilde = gdk.KEY_otilde
+	gdki.KEY_odiaeresis = gdk.KEY_odiaeresis
+	gdki.KEY_division = gdk.KEY_division
+	gdki.KEY_oslash = gdk.KEY_oslash
+	gdki.KEY_ooblique = gdk.KEY_ooblique
+	gdki.KEY_ugrave = gdk.KEY_ugrave
+	gdki.KEY_uacute = gdk.KEY_uacute
+	gdki.KEY_ucircumflex = gdk.KEY_ucircumflex
+	gdki.KEY_udiaeresis = gdk.KEY_udiaeresis
+	gdki.KEY_yacute = gdk.KEY_yacute
+	gdki.KEY_thorn = gdk.KEY_thorn
+	gdki.KEY_ydiaeresis = gdk.KEY_ydiaeresis
+	gdki.KEY_Aogonek = gdk.KEY_Aogonek
+	gdki.KEY_breve = gdk.KEY_breve
+	gdki.KEY_Lstroke = gdk.KEY_Lstroke
+	gdki.KEY_Lcaron = gdk.KEY_Lcaron
+	gdki.KEY_Sacute = gdk.KEY_Sacute
+	gdki.KEY_Scaron = gdk.KEY_Scaron
+	gdki.KEY_Scedilla = gdk.KEY_Scedilla
+	gdki.KEY_Tcaron = gdk.KEY_Tcaron
+	gdki.KEY_Zacute = gdk.KEY_Zacute
+	gdki.KEY_Zcaron = gdk.KEY_Zcaron
+	gdki.KEY_Zabovedot = gdk.KEY_Zabovedot
+	gdki.KEY_aogonek = gdk.KEY_aogonek
+	gdki.KEY_ogonek = gdk.KEY_ogonek
+	gdki.KEY_lstroke = gdk.KEY_lstroke
+	gdki.KEY_lcaron = gdk.KEY_lcaron
+	gdki.KEY_sacute = gdk.KEY_sacute
+	gdki.KEY_caron = gdk.KEY_caron
+	gdki.KEY_scaron = gdk.KEY_scaron
+	gdki.KEY_scedilla = gdk.KEY_scedilla
+	gdki.KEY_tcaron = gdk.KEY_tcaron
+	gdki.KEY_zacute = gdk.KEY_zacute
+	gdki.KEY_doubleacute = gdk.KEY_doubleacute
+	gdki.KEY_zcaron = gdk.KEY_zcaron
+	gdki.KEY_zabovedot = gdk.KEY_zabovedot
+	gdki.KEY_Racute = gdk.KEY_Racute
+	gdki.KEY_Abreve = gdk.KEY_Abreve
+	gdki.KEY_Lacute = gdk.KEY_Lacute
+	gdki.KEY_Cacute = gdk.KEY_Cacute
+	gdki.KEY_Ccaron = gdk.KEY_Ccaron
+	gdki.KEY_Eogonek = gdk.KEY_Eogonek
+	gdki.KEY_Ecaron = gdk.KEY_Ecaron
+	gdki.KEY_Dcaron = gdk.KEY_Dcaron
+	gdki.KEY_Dstroke = gdk.KEY_Dstroke
+	gdki.KEY_Nacute = gdk.KEY_Nacute
+	gdki.KEY_Ncaron = gdk.KEY_Ncaron
+	gdki.KEY_Odoubleacute = gdk.KEY_Odoubleacute
+	gdki.KEY_Rcaron = gdk.KEY_Rcaron
+	gdki.KEY_Uring = gdk.KEY_Uring
+	gdki.KEY_Udoubleacute = gdk.KEY_Udoubleacute
+	gdki.KEY_Tcedilla = gdk.KEY_Tcedilla
+	gdki.KEY_racute = gdk.KEY_racute
+	gdki.KEY_abreve = gdk.KEY_abreve
+	gdki.KEY_lacute = gdk.KEY_lacute
+	gdki.KEY_cacute = gdk.KEY_cacute
+	gdki.KEY_ccaron = gdk.KEY_ccaron
+	gdki.KEY_eogonek = gdk.KEY_eogonek
+	gdki.KEY_ecaron = gdk.KEY_ecaron
+	gdki.KEY_dcaron = gdk.KEY_dcaron
+	gdki.KEY_dstroke = gdk.KEY_dstroke
+	gdki.KEY_nacute = gdk.KEY_nacute
+	gdki.KEY_ncaron = gdk.KEY_ncaron
+	gdki.KEY_odoubleacute = gdk.KEY_odoubleacute
+	gdki.KEY_rcaron = gdk.KEY_rcaron
+	gdki.KEY_uring = gdk.KEY_uring
+	gdki.KEY_udoubleacute = gdk.KEY_udoubleacute
+	gdki.KEY_tcedilla = gdk.KEY_tcedilla
+	gdki.KEY_abovedot = gdk.KEY_abovedot
+	gdki.KEY_Hstroke = gdk.KEY_Hstroke
+	gdki.KEY_Hcircumflex = gdk.KEY_Hcircumflex
+	gdki.KEY_Iabovedot = gdk.KEY_Iabovedot
+	gdki.KEY_Gbreve = gdk.KEY_Gbreve
+	gdki.KEY_Jcircumflex = gdk.KEY_Jcircumflex
+	gdki.KEY_hstroke = gdk.KEY_hstroke
+	gdki.KEY_hcircumflex = gdk.KEY_hcircumflex
+	gdki.KEY_idotless = gdk.KEY_idotless
+	gdki.KEY_gbreve = gdk.KEY_gbreve
+	gdki.KEY_jcircumflex = gdk.KEY_jcircumflex
+	gdki.KEY_Cabovedot = gdk.KEY_Cabovedot
+	gdki.KEY_Ccircumflex = gdk.KEY_Ccircumflex
+	gdki.KEY_Gabovedot = gdk.KEY_Gabovedot
+	gdki.KEY_Gcircumflex = gdk.KEY_Gcircumflex
+	gdki.KEY_Ubreve = gdk.KEY_Ubreve
+	gdki.KEY_Scircumflex = gdk.KEY_Scircumflex
+	gdki.KEY_cabovedot = gdk.KEY_cabovedot
+	gdki.KEY_ccircumflex = gdk.KEY_ccircumflex
+	gdki.KEY_gabovedot = gdk.KEY_gabovedot
+	gdki.KEY_gcircumflex = gdk.KEY_gcircumflex
+	gdki.KEY_ubreve = gdk.KEY_ubreve
+	gdki.KEY_scircumflex = gdk.KEY_scircumflex
+	gdki.KEY_kra = gdk.KEY_kra
+	gdki.KEY_kappa = gdk.KEY_kappa
+	gdki.KEY_Rcedilla = gdk.KEY_Rcedilla
+	gdki.KEY_Itilde = gdk.KEY_Itilde
+	gdki.KEY_Lcedilla = gdk.KEY_Lcedilla
+	gdki.KEY_Emacron = gdk.KEY_Emacron
+	gdki.KEY_Gcedilla = gdk.KEY_Gcedilla
+	gdki.KEY_Tslash = gdk.KEY_Tslash
+	gdki.KEY_rcedilla = gdk.KEY_rcedilla
+	gdki.KEY_itilde = gdk.KEY_itilde
+	gdki.KEY_lcedilla = gdk.KEY_lcedilla
+	gdki.KEY_emacron = gdk.KEY_emacron
+	gdki.KEY_gcedilla = gdk.KEY_gcedilla
+	gdki.KEY_tslash = gdk.KEY_tslash
+	gdki.KEY_ENG = gdk.KEY_ENG
+	gdki.KEY_eng = gdk.KEY_eng
+	gdki.KEY_Amacron = gdk.KEY_Amacron
+	gdki.KEY_Iogonek = gdk.KEY_Iogonek
+	gdki.KEY_Eabovedot = gdk.KEY_Eabovedot
+	gdki.KEY_Imacron = gdk.KEY_Imacron
+	gdki.KEY_Ncedilla = gdk.KEY_Ncedilla
+	gdki.KEY_Omacron = gdk.KEY_Omacron
+	gdki.KEY_Kcedilla = gdk.KEY_Kcedilla
+	gdki.KEY_Uogonek = gdk.KEY_Uogonek
+	gdki.KEY_Utilde = gdk.KEY_Utilde
+	gdki.KEY_Umacron = gdk.KEY_Umacron
+	gdki.KEY_amacron = gdk.KEY_amacron
+	gdki.KEY_iogonek = gdk.KEY_iogonek
+	gdki.KEY_eabovedot = gdk.KEY_eabovedot
+	gdki.KEY_imacron = gdk.KEY_imacron
+	gdki.KEY_ncedilla = gdk.KEY_ncedilla
+	gdki.KEY_omacron = gdk.KEY_omacron
+	gdki.KEY_kcedilla = gdk.KEY_kcedilla
+	gdki.KEY_uogonek = gdk.KEY_uogonek
+	gdki.KEY_utilde = gdk.KEY_utilde
+	gdki.KEY_umacron = gdk.KEY_umacron
+	gdki.KEY_Wcircumflex = gdk.KEY_Wcircumflex
+	gdki.KEY_wcircumflex = gdk.KEY_wcircumflex
+	gdki.KEY_Ycircumflex = gdk.KEY_Ycircumflex
+	gdki.KEY_ycircumflex = gdk.KEY_ycircumflex
+	gdki.KEY_Babovedot = gdk.KEY_Babovedot
+	gdki.KEY_babovedot = gdk.KEY_babovedot
+	gdki.KEY_Dabovedot = gdk.KEY_Dabovedot
+	gdki.KEY_dabovedot = gdk.KEY_dabovedot
+	gdki.KEY_Fabovedot = gdk.KEY_Fabovedot
+	gdki.KEY_fabovedot = gdk.KEY_fabovedot
+	gdki.KEY_Mabovedot = gdk.KEY_Mabovedot
+	gdki.KEY_mabovedot = gdk.KEY_mabovedot
+	gdki.KEY_Pabovedot = gdk.KEY_Pabovedot
+	gdki.KEY_pabovedot = gdk.KEY_pabovedot
+	gdki.KEY_Sabovedot = gdk.KEY_Sabovedot
+	gdki.KEY_sabovedot = gdk.KEY_sabovedot
+	gdki.KEY_Tabovedot = gdk.KEY_Tabovedot
+	gdki.KEY_tabovedot = gdk.KEY_tabovedot
+	gdki.KEY_Wgrave = gdk.KEY_Wgrave
+	gdki.KEY_wgrave = gdk.KEY_wgrave
+	gdki.KEY_Wacute = gdk.KEY_Wacute
+	gdki.KEY_wacute = gdk.KEY_wacute
+	gdki.KEY_Wdiaeresis = gdk.KEY_Wdiaeresis
+	gdki.KEY_wdiaeresis = gdk.KEY_wdiaeresis
+	gdki.KEY_Ygrave = gdk.KEY_Ygrave
+	gdki.KEY_ygrave = gdk.KEY_ygrave
+	gdki.KEY_OE = gdk.KEY_OE
+	gdki.KEY_oe = gdk.KEY_oe
+	gdki.KEY_Ydiaeresis = gdk.KEY_Ydiaeresis
+	gdki.KEY_overline = gdk.KEY_overline
+}
@@ -0,0 +1,5 @@
+package gdki
+
+type Event interface{}
+
+func AssertEvent(_ Event) {}
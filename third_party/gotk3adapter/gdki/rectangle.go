@@ -0,0 +1,7 @@
+package gdki
+
+type Rectangle interface {
+	GetY() int
+}
+
+func AssertRectangle(_ Rectangle) {}
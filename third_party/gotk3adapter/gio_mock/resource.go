@@ -0,0 +1,3 @@
+package gio_mock
+
+type MockResource struct{}
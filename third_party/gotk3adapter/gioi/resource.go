@@ -0,0 +1,6 @@
+package gioi
+
+type Resource interface {
+}
+
+func AssertResource(_ Resource) {}
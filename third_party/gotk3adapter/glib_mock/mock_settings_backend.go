@@ -0,0 +1,5 @@
+package glib_mock
+
+type MockSettingsBackend struct {
+	MockObject
+}
@@ -0,0 +1,7 @@
+package glib_mock
+
+type MockSignal struct{}
+
+func (*MockSignal) String() string {
+	return ""
+}
@@ -0,0 +1,7 @@
+package glibi
+
+type SettingsBackend interface {
+	Object
+}
+
+func AssertSettingsBackend(_ SettingsBackend) {}
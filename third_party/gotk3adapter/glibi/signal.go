@@ -0,0 +1,7 @@
+package glibi
+
+type Signal interface {
+	String() string
+} // end of Signal
+
+func AssertSignal(_ Signal) {}
@@ -0,0 +1,5 @@
+package glibi
+
+type VariantType interface {
+	String() string
+}
@@ -0,0 +1,8 @@
+module github.com/coyim/gotk3adapter
+
+go 1.15
+
+require (
+	github.com/coyim/gotk3extra v0.0.2
+	github.com/gotk3/gotk3 v0.6.2
+)
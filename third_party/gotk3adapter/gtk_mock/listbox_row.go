@@ -0,0 +1,9 @@
+package gtk_mock
+
+type MockListBoxRow struct {
+	MockBin
+}
+
+func (*MockListBoxRow) GetIndex() int {
+	return 0
+}
@@ -0,0 +1,12 @@
+package gtk_mock
+
+type MockRevealer struct {
+	MockBin
+}
+
+func (*MockRevealer) SetRevealChild(revealChild bool) {
+}
+
+func (*MockRevealer) GetRevealChild() bool {
+	return false
+}
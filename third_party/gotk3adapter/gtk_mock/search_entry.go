@@ -0,0 +1,5 @@
+package gtk_mock
+
+type MockSearchEntry struct {
+	MockEntry
+}
@@ -0,0 +1,4 @@
+package gtk_mock
+
+type MockTextMark struct {
+}
@@ -0,0 +1,7 @@
+package gtk_mock
+
+import "github.com/coyim/gotk3adapter/glib_mock"
+
+type MockTreeViewColumn struct {
+	glib_mock.MockObject
+}
@@ -0,0 +1,14 @@
+package gtka
+
+// #cgo pkg-config: gtk+-3.0
+// #include <gtk/gtk.h>
+import "C"
+
+import "github.com/coyim/gotk3adapter/gtki"
+
+// WidgetSetDefaultDirection wraps gtk_widget_set_default_direction(),
+// which gotk3 doesn't bind. It changes the direction of the widgets
+// that don't have one of their own, including the ones already created
+func (*RealGtk) WidgetSetDefaultDirection(dir gtki.TextDirection) {
+	C.gtk_widget_set_default_direction(C.GtkTextDirection(dir))
+}
@@ -0,0 +1,7 @@
+package gtki
+
+type Bin interface {
+	Container
+
+	GetChild() Widget
+}
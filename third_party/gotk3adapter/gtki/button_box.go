@@ -0,0 +1,7 @@
+package gtki
+
+type ButtonBox interface {
+	Box
+}
+
+func AssertButtonBox(_ ButtonBox) {}
@@ -0,0 +1,7 @@
+package gtki
+
+type CellRendererText interface {
+	CellRenderer
+}
+
+func AssertCellRendererText(_ CellRendererText) {}
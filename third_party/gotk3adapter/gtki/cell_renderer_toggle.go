@@ -0,0 +1,7 @@
+package gtki
+
+type CellRendererToggle interface {
+	CellRenderer
+}
+
+func AssertCellRendererToggle(_ CellRendererToggle) {}
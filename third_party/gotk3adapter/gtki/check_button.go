@@ -0,0 +1,7 @@
+package gtki
+
+type CheckButton interface {
+	ToggleButton
+}
+
+func AssertCheckButton(_ CheckButton) {}
@@ -0,0 +1,10 @@
+package gtki
+
+type CheckMenuItem interface {
+	MenuItem
+
+	GetActive() bool
+	SetActive(bool)
+}
+
+func AssertCheckMenuItem(_ CheckMenuItem) {}
@@ -0,0 +1,10 @@
+package gtki
+
+type Container interface {
+	Widget
+
+	Add(Widget)
+	Remove(Widget)
+	SetBorderWidth(uint)
+	GetChildren() []Widget
+}
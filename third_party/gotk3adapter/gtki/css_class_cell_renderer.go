@@ -0,0 +1,9 @@
+package gtki
+
+type CSSClassCellRenderer interface {
+	CellRenderer
+
+	SetReal(CellRenderer)
+}
+
+func AssertCSSClassCellRenderer(_ CSSClassCellRenderer) {}
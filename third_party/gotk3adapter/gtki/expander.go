@@ -0,0 +1,7 @@
+package gtki
+
+type Expander interface {
+	Bin
+}
+
+func AssertExpander(_ Expander) {}
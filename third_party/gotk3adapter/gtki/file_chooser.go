@@ -0,0 +1,9 @@
+package gtki
+
+type FileChooser interface {
+	GetFilename() string
+	SetCurrentName(string)
+	SetDoOverwriteConfirmation(bool)
+}
+
+func AssertFileChooser(_ FileChooser) {}
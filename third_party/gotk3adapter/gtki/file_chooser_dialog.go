@@ -0,0 +1,8 @@
+package gtki
+
+type FileChooserDialog interface {
+	Dialog
+	FileChooser
+}
+
+func AssertFileChooserDialog(_ FileChooserDialog) {}
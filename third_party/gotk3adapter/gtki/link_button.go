@@ -0,0 +1,10 @@
+package gtki
+
+type LinkButton interface {
+	Button
+
+	GetUri() string
+	SetUri(string)
+}
+
+func AssertLinkButton(_ LinkButton) {}
@@ -0,0 +1,11 @@
+package gtki
+
+type ListBox interface {
+	Container
+
+	SelectRow(ListBoxRow)
+	GetRowAtIndex(int) ListBoxRow
+	GetSelectedRow() ListBoxRow
+}
+
+func AssertListBox(_ ListBox) {}
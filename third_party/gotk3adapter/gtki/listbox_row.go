@@ -0,0 +1,8 @@
+package gtki
+
+type ListBoxRow interface {
+	Bin
+	GetIndex() int
+}
+
+func AssertListBoxRow(_ ListBoxRow) {}
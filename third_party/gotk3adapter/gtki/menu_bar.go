@@ -0,0 +1,7 @@
+package gtki
+
+type MenuBar interface {
+	MenuShell
+}
+
+func AssertMenuBar(_ MenuBar) {}
@@ -0,0 +1,9 @@
+package gtki
+
+type MenuButton interface {
+	Bin
+
+	SetPopover(Popover)
+}
+
+func AssertMenuButton(_ MenuButton) {}
@@ -0,0 +1,9 @@
+package gtki
+
+type MenuShell interface {
+	Container
+
+	Append(MenuItem)
+}
+
+func AssertMenuShell(_ MenuShell) {}
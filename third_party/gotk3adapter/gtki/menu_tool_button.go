@@ -0,0 +1,7 @@
+package gtki
+
+type MenuToolButton interface {
+	ToolButton
+}
+
+func AssertMenuToolButton(_ MenuToolButton) {}
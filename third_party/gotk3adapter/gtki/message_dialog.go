@@ -0,0 +1,7 @@
+package gtki
+
+type MessageDialog interface {
+	Dialog
+}
+
+func AssertMessageDialog(_ MessageDialog) {}
@@ -0,0 +1,7 @@
+package gtki
+
+type ModelButton interface {
+	Button
+}
+
+func AssertModelButton(_ ModelButton) {}
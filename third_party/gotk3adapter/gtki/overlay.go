@@ -0,0 +1,7 @@
+package gtki
+
+type Overlay interface {
+	Bin
+
+	AddOverlay(Widget)
+}
@@ -0,0 +1,5 @@
+package gtki
+
+type Popover interface {
+	Bin
+}
@@ -0,0 +1,9 @@
+package gtki
+
+type ScrolledWindow interface {
+	Bin
+
+	GetVAdjustment() Adjustment
+}
+
+func AssertScrolledWindow(_ ScrolledWindow) {}
@@ -0,0 +1,7 @@
+package gtki
+
+type SearchEntry interface {
+	Entry
+}
+
+func AssertSearchEntry(_ SearchEntry) {}
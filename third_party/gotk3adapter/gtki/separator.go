@@ -0,0 +1,5 @@
+package gtki
+
+type Separator interface {
+	Widget
+}
@@ -0,0 +1,7 @@
+package gtki
+
+type SeparatorMenuItem interface {
+	MenuItem
+}
+
+func AssertSeparatorMenuItem(_ SeparatorMenuItem) {}
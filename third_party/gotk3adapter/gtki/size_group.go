@@ -0,0 +1,7 @@
+package gtki
+
+type SizeGroup interface {
+	SetMode(SizeGroupMode)
+}
+
+func AssertSizeGroup(_ SizeGroup) {}
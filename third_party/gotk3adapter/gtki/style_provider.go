@@ -0,0 +1,5 @@
+package gtki
+
+type StyleProvider interface{}
+
+func AssertStyleProvider(_ StyleProvider) {}
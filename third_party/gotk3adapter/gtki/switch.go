@@ -0,0 +1,10 @@
+package gtki
+
+type Switch interface {
+	Widget
+
+	GetActive() bool
+	SetActive(bool)
+}
+
+func AssertSwitch(_ Switch) {}
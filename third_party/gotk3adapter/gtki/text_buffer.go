@@ -0,0 +1,27 @@
+package gtki
+
+import (
+	"github.com/coyim/gotk3adapter/glibi"
+)
+
+type TextBuffer interface {
+	glibi.Object
+
+	ApplyTagByName(string, TextIter, TextIter)
+	CreateMark(string, TextIter, bool) TextMark
+	Delete(TextIter, TextIter)
+	GetBounds() (TextIter, TextIter)
+	GetCharCount() int
+	GetEndIter() TextIter
+	GetIterAtMark(TextMark) TextIter
+	GetIterAtOffset(int) TextIter
+	GetLineCount() int
+	GetStartIter() TextIter
+	GetText(TextIter, TextIter, bool) string
+	Insert(TextIter, string)
+	InsertAtCursor(string)
+	InsertWithTagByName(TextIter, string, string)
+	SetText(string)
+}
+
+func AssertTextBuffer(_ TextBuffer) {}
@@ -0,0 +1,5 @@
+package gtki
+
+type TextMark interface{}
+
+func AssertTextMark(_ TextMark) {}
@@ -0,0 +1,10 @@
+package gtki
+
+type ToggleButton interface {
+	Button
+
+	GetActive() bool
+	SetActive(bool)
+}
+
+func AssertToggleButton(_ ToggleButton) {}
@@ -0,0 +1,7 @@
+package gtki
+
+type ToolButton interface {
+	ToolItem
+}
+
+func AssertToolButton(_ ToolButton) {}
@@ -0,0 +1,7 @@
+package gtki
+
+type ToolItem interface {
+	Bin
+}
+
+func AssertToolItem(_ ToolItem) {}
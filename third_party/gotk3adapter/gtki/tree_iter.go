@@ -0,0 +1,5 @@
+package gtki
+
+type TreeIter interface{}
+
+func AssertTreeIter(_ TreeIter) {}
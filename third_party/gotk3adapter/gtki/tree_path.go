@@ -0,0 +1,8 @@
+package gtki
+
+type TreePath interface {
+	GetDepth() int
+	String() string
+}
+
+func AssertTreePath(_ TreePath) {}
@@ -0,0 +1,9 @@
+package gtki
+
+import "github.com/coyim/gotk3adapter/glibi"
+
+type TreeViewColumn interface {
+	glibi.Object
+}
+
+func AssertTreeViewColumn(_ TreeViewColumn) {}
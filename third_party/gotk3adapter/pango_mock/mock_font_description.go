@@ -0,0 +1,7 @@
+package pango_mock
+
+type MockFontDescription struct{}
+
+func (*MockFontDescription) GetSize() int {
+	return 0
+}
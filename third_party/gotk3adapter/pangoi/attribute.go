@@ -0,0 +1,8 @@
+package pangoi
+
+type Attribute interface {
+	SetStartIndex(int)
+	SetEndIndex(int)
+}
+
+func AssertAttribute(_ Attribute) {}
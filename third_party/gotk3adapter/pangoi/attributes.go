@@ -0,0 +1,8 @@
+package pangoi
+
+type AttrList interface {
+	Insert(Attribute)
+	GetAttributes() []Attribute
+}
+
+func AssertAttrList(_ AttrList) {}
@@ -0,0 +1,35 @@
+package pangoi
+
+var (
+	PANGO_SCALE int
+)
+
+var (
+	UNDERLINE_NONE   int
+	UNDERLINE_SINGLE int
+	UNDERLINE_DOUBLE int
+	UNDERLINE_LOW    int
+	UNDERLINE_ERROR  int
+
+	STYLE_NORMAL  int
+	STYLE_OBLIQUE int
+	STYLE_ITALIC  int
+
+	JUSTIFY_LEFT   int
+	JUSTIFY_RIGHT  int
+	JUSTIFY_CENTER int
+	JUSTIFY_FILL   int
+
+	WEIGHT_THIN       int
+	WEIGHT_ULTRALIGHT int
+	WEIGHT_LIGHT      int
+	WEIGHT_SEMILIGHT  int
+	WEIGHT_BOOK       int
+	WEIGHT_NORMAL     int
+	WEIGHT_MEDIUM     int
+	WEIGHT_SEMIBOLD   int
+	WEIGHT_BOLD       int
+	WEIGHT_ULTRABOLD  int
+	WEIGHT_HEAVY      int
+	WEIGHT_ULTRAHEAVY int
+)
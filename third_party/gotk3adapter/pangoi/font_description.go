@@ -0,0 +1,7 @@
+package pangoi
+
+type FontDescription interface {
+	GetSize() int
+}
+
+func AssertFontDescription(_ FontDescription) {}
@@ -0,0 +1,8 @@
+package pangoi
+
+type Pango interface {
+	AsFontDescription(interface{}) FontDescription
+	AttrListNew() AttrList
+}
+
+func AssertPango(_ Pango) {}
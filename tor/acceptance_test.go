@@ -1,12 +1,14 @@
 package tor
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"runtime"
+	"time"
 
 	"github.com/digitalautonomy/wahay/config"
 	"github.com/wybiral/torgo"
@@ -91,7 +93,7 @@ func (s *TorAcceptanceSuite) Test_thatSystemTorWillBeUsed_whenSystemTorIsOKWithN
 
 	c.Assert(mocktorgof.newControllerArg, Equals, "127.0.0.1:9051")
 
-	c.Assert(tc.authNoneCalled, Equals, 2)
+	c.Assert(tc.authNoneCalled, Equals, 1)
 	c.Assert(tc.authPassCalled, Equals, 0)
 	c.Assert(tc.authCookieCalled, Equals, 0)
 
@@ -140,7 +142,7 @@ func (s *TorAcceptanceSuite) Test_thatSystemTorIsUsed_whenSystemTorIsOKWithCooki
 
 	c.Assert(tc.authNoneCalled, Equals, 1)
 	c.Assert(tc.authPassCalled, Equals, 0)
-	c.Assert(tc.authCookieCalled, Equals, 2)
+	c.Assert(tc.authCookieCalled, Equals, 1)
 
 	c.Assert(tc.getVersionCalled, Equals, 1)
 
@@ -192,7 +194,7 @@ func (s *TorAcceptanceSuite) Test_thatSystemTorWillBeUsed_whenSystemTorIsOKWithP
 	c.Assert(mocktorgof.newControllerArg, Equals, "127.0.0.1:9051")
 
 	c.Assert(tc.authNoneCalled, Equals, 1)
-	c.Assert(tc.authPassCalled, Equals, 2)
+	c.Assert(tc.authPassCalled, Equals, 1)
 	c.Assert(tc.authCookieCalled, Equals, 1)
 	c.Assert(tc.authPassArg, Equals, "super secret samosa")
 
@@ -405,7 +407,9 @@ func verifyAllAssertions(c *C, e error, tc *mockTorgoController, i *instance) {
 
 	c.Assert(tc.authNoneCalled, Equals, 1)
 	c.Assert(tc.authPassCalled, Equals, 0)
-	c.Assert(tc.authCookieCalled, Equals, 2)
+	// The others are for the connection that owns our Tor
+	// and the one that receives the log events
+	c.Assert(tc.authCookieCalled, Equals, 3)
 
 	c.Assert(tc.getVersionCalled, Equals, 1)
 
@@ -476,6 +480,7 @@ func mockAll() {
 	filesystemf = mockfilesystemf
 	torgof = mocktorgof
 	httpf = mockhttpf
+	waitBeforeRetry = func(context.Context, time.Duration) error { return nil }
 }
 
 type mockOsImplementation struct {
@@ -539,6 +544,11 @@ func (m *mockOsImplementation) GetRandomPort() int {
 	return 0
 }
 
+func (*mockOsImplementation) Getpid() int {
+	testPrint("Getpid()\n")
+	return 4242
+}
+
 type mockFilepathImplementation struct {
 	joinReturn1 string
 }
@@ -593,7 +603,7 @@ func (m *mockExecImplementation) WaitCommand(cmd *exec.Cmd) error {
 
 type mockFilesystemImplementation struct {
 	onTempDir   func(string) string
-	onEnsureDir func(string, os.FileMode)
+	onEnsureDir func(string, os.FileMode) error
 	onWriteFile func(string, []byte, os.FileMode) error
 }
 
@@ -615,11 +625,12 @@ func (m *mockFilesystemImplementation) TempDir(suffix string) string {
 	return ""
 }
 
-func (m *mockFilesystemImplementation) EnsureDir(name string, mode os.FileMode) {
+func (m *mockFilesystemImplementation) EnsureDir(name string, mode os.FileMode) error {
 	testPrint("EnsureDir(%v, %v)\n", name, mode)
 	if m.onEnsureDir != nil {
-		m.onEnsureDir(name, mode)
+		return m.onEnsureDir(name, mode)
 	}
+	return nil
 }
 
 func (m *mockFilesystemImplementation) WriteFile(name string, content []byte, mode os.FileMode) error {
@@ -634,11 +645,15 @@ type mockTorgoController struct {
 	authNoneReturn, authPassReturn, authCookieReturn error
 	authNoneCalled, authPassCalled, authCookieCalled int
 
+	authMethods []string
+
 	authPassArg string
 
 	getVersionReturn1 string
 	getVersionReturn2 error
 	getVersionCalled  int
+
+	requestReturn string
 }
 
 func (m *mockTorgoController) AuthenticatePassword(v string) error {
@@ -654,6 +669,16 @@ func (m *mockTorgoController) AuthenticateCookie() error {
 	return m.authCookieReturn
 }
 
+func (m *mockTorgoController) AuthenticateSafeCookie() error {
+	testPrint("torgoController.AuthenticateSafeCookie()\n")
+	m.authCookieCalled++
+	return m.authCookieReturn
+}
+
+func (m *mockTorgoController) AuthMethods() []string {
+	return m.authMethods
+}
+
 func (m *mockTorgoController) AuthenticateNone() error {
 	testPrint("torgoController.AuthenticateNone()\n")
 	m.authNoneCalled++
@@ -676,6 +701,21 @@ func (m *mockTorgoController) DeleteOnion(v string) error {
 	return nil
 }
 
+func (m *mockTorgoController) Request(v string) (string, error) {
+	testPrint("torgoController.Request(%v)\n", v)
+	return m.requestReturn, nil
+}
+
+func (m *mockTorgoController) ReadEvent() (string, error) {
+	testPrint("torgoController.ReadEvent()\n")
+	return "", errors.New("no events")
+}
+
+func (m *mockTorgoController) Close() error {
+	testPrint("torgoController.Close()\n")
+	return nil
+}
+
 type mockTorgoImplementation struct {
 	newControllerArg     string
 	newControllerReturn1 torgoController
@@ -684,7 +724,7 @@ type mockTorgoImplementation struct {
 	onNewController func(a string) (torgoController, error)
 }
 
-func (m *mockTorgoImplementation) NewController(a string) (torgoController, error) {
+func (m *mockTorgoImplementation) NewController(_ context.Context, a string) (torgoController, error) {
 	testPrint("NewController(%v)\n", a)
 	if m.onNewController != nil {
 		return m.onNewController(a)
@@ -696,16 +736,37 @@ func (m *mockTorgoImplementation) NewController(a string) (torgoController, erro
 type mockHTTPImplementation struct {
 	checkConnectionArg1   string
 	checkConnectionArg2   int
+	checkConnectionArg3   []string
 	checkConnectionReturn bool
+	onCheckConnection     func(string) bool
+
+	socksHandshakeReturn bool
+	canConnectArg        string
+	canConnectReturn     bool
 }
 
-func (m *mockHTTPImplementation) CheckConnectionOverTor(host string, port int) bool {
-	testPrint("CheckConnectionOverTor(%v, %v)\n", host, port)
+func (m *mockHTTPImplementation) CheckConnectionOverTor(ctx context.Context, host string, port int, endpoint string) bool {
+	testPrint("CheckConnectionOverTor(%v, %v, %v)\n", host, port, endpoint)
 	m.checkConnectionArg1 = host
 	m.checkConnectionArg2 = port
+	m.checkConnectionArg3 = append(m.checkConnectionArg3, endpoint)
+	if m.onCheckConnection != nil {
+		return m.onCheckConnection(endpoint)
+	}
 	return m.checkConnectionReturn
 }
 
+func (m *mockHTTPImplementation) SOCKSHandshake(ctx context.Context, host string, port int) bool {
+	testPrint("SOCKSHandshake(%v, %v)\n", host, port)
+	return m.socksHandshakeReturn
+}
+
+func (m *mockHTTPImplementation) CanConnect(ctx context.Context, address string) bool {
+	testPrint("CanConnect(%v)\n", address)
+	m.canConnectArg = address
+	return m.canConnectReturn
+}
+
 func (m *mockHTTPImplementation) HTTPRequest(host string, port int, u string) (string, error) {
 	testPrint("HTTPRequest(%v, %v, %v)\n", host, port, u)
 	return "", nil
@@ -1,12 +1,29 @@
 package tor
 
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 type authenticationMethod func(tc torgoController) error
 
 func authenticateNone(tc torgoController) error {
 	return tc.AuthenticateNone()
 }
 
+// authenticateCookie uses SAFECOOKIE when Tor supports it, since the plain
+// cookie authentication sends the cookie itself to whoever is listening
+// on the control port
 func authenticateCookie(tc torgoController) error {
+	if supportsAuthMethod(tc, authMethodSafeCookie) {
+		return tc.AuthenticateSafeCookie()
+	}
 	return tc.AuthenticateCookie()
 }
 
@@ -28,3 +45,120 @@ func authenticateAny(am ...authenticationMethod) func(torgoController) error {
 		return e
 	}
 }
+
+const authMethodSafeCookie = "SAFECOOKIE"
+
+// supportsAuthMethod returns true if Tor advertised the method in
+// its answer to PROTOCOLINFO
+func supportsAuthMethod(tc torgoController, method string) bool {
+	for _, m := range tc.AuthMethods() {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+var errInvalidProtocolInfo = errors.New("invalid PROTOCOLINFO answer")
+
+// parseProtocolInfo returns the authentication methods and the cookie file
+// from the answer to PROTOCOLINFO, that has a line like
+// AUTH METHODS=COOKIE,SAFECOOKIE COOKIEFILE="/run/tor/control.authcookie"
+func parseProtocolInfo(msg string) (methods []string, cookieFile string, err error) {
+	const authPrefix = "AUTH METHODS="
+	const cookiePrefix = "COOKIEFILE="
+
+	for _, line := range strings.Split(msg, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, authPrefix) {
+			continue
+		}
+
+		parts := strings.SplitN(line[len(authPrefix):], " ", 2)
+		methods = strings.Split(parts[0], ",")
+
+		if len(parts) == 2 && strings.HasPrefix(parts[1], cookiePrefix) {
+			cookieFile, err = strconv.Unquote(parts[1][len(cookiePrefix):])
+			if err != nil {
+				return nil, "", errInvalidProtocolInfo
+			}
+		}
+	}
+
+	return methods, cookieFile, nil
+}
+
+const (
+	safeCookieServerKey = "Tor safe cookie authentication server-to-controller hash"
+	safeCookieClientKey = "Tor safe cookie authentication controller-to-server hash"
+	safeCookieNonceSize = 32
+)
+
+var (
+	errInvalidAuthChallenge = errors.New("invalid AUTHCHALLENGE answer")
+	errInvalidServerHash    = errors.New("the control port doesn't know the authentication cookie")
+)
+
+func safeCookieHash(key string, cookie, clientNonce, serverNonce []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(cookie)
+	mac.Write(clientNonce)
+	mac.Write(serverNonce)
+	return mac.Sum(nil)
+}
+
+func parseAuthChallenge(msg string) (serverHash, serverNonce []byte, err error) {
+	for _, field := range strings.Fields(msg) {
+		parts := strings.SplitN(field, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		switch parts[0] {
+		case "SERVERHASH":
+			serverHash, err = hex.DecodeString(parts[1])
+		case "SERVERNONCE":
+			serverNonce, err = hex.DecodeString(parts[1])
+		}
+
+		if err != nil {
+			return nil, nil, errInvalidAuthChallenge
+		}
+	}
+
+	if serverHash == nil || serverNonce == nil {
+		return nil, nil, errInvalidAuthChallenge
+	}
+
+	return serverHash, serverNonce, nil
+}
+
+// authenticateWithSafeCookie runs the SAFECOOKIE challenge with the given
+// cookie. Tor proves it knows the cookie before we send anything derived
+// from it, so a fake control port can't steal it
+func authenticateWithSafeCookie(tc torgoController, cookie []byte) error {
+	clientNonce := make([]byte, safeCookieNonceSize)
+	_, err := rand.Read(clientNonce)
+	if err != nil {
+		return err
+	}
+
+	msg, err := tc.Request(fmt.Sprintf("AUTHCHALLENGE SAFECOOKIE %s", hex.EncodeToString(clientNonce)))
+	if err != nil {
+		return err
+	}
+
+	serverHash, serverNonce, err := parseAuthChallenge(msg)
+	if err != nil {
+		return err
+	}
+
+	expected := safeCookieHash(safeCookieServerKey, cookie, clientNonce, serverNonce)
+	if !hmac.Equal(serverHash, expected) {
+		return errInvalidServerHash
+	}
+
+	clientHash := safeCookieHash(safeCookieClientKey, cookie, clientNonce, serverNonce)
+	_, err = tc.Request(fmt.Sprintf("AUTHENTICATE %s", hex.EncodeToString(clientHash)))
+	return err
+}
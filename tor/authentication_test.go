@@ -0,0 +1,116 @@
+package tor
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_authenticateCookie_prefersSafeCookieWhenAdvertised(c *C) {
+	mock := &controllerMock{authMethods: []string{"COOKIE", "SAFECOOKIE"}}
+
+	c.Assert(authenticateCookie(mock), IsNil)
+	c.Assert(mock.authenticateSafeCookieCalled, Equals, true)
+	c.Assert(mock.authenticateCookieCalled, Equals, false)
+}
+
+func (s *WahayTorSuite) Test_authenticateCookie_usesThePlainCookieOtherwise(c *C) {
+	mock := &controllerMock{authMethods: []string{"COOKIE"}}
+
+	c.Assert(authenticateCookie(mock), IsNil)
+	c.Assert(mock.authenticateSafeCookieCalled, Equals, false)
+	c.Assert(mock.authenticateCookieCalled, Equals, true)
+}
+
+// safeCookieTor answers the SAFECOOKIE challenge like Tor does
+type safeCookieTor struct {
+	*controllerMock
+	cookie      []byte
+	serverNonce []byte
+	serverHash  []byte
+}
+
+func (t *safeCookieTor) Request(req string) (string, error) {
+	t.requestArgs = append(t.requestArgs, req)
+
+	if strings.HasPrefix(req, "AUTHCHALLENGE SAFECOOKIE ") {
+		clientNonce, _ := hex.DecodeString(strings.TrimPrefix(req, "AUTHCHALLENGE SAFECOOKIE "))
+		serverHash := t.serverHash
+		if serverHash == nil {
+			serverHash = safeCookieHash(safeCookieServerKey, t.cookie, clientNonce, t.serverNonce)
+		}
+		return fmt.Sprintf("AUTHCHALLENGE SERVERHASH=%X SERVERNONCE=%X", serverHash, t.serverNonce), nil
+	}
+
+	return "", nil
+}
+
+func (s *WahayTorSuite) Test_authenticateWithSafeCookie_sendsTheClientHash(c *C) {
+	tor := &safeCookieTor{
+		controllerMock: &controllerMock{},
+		cookie:         []byte("a very secret cookie"),
+		serverNonce:    []byte("the nonce of the server"),
+	}
+
+	err := authenticateWithSafeCookie(tor, tor.cookie)
+
+	c.Assert(err, IsNil)
+	c.Assert(tor.requestArgs, HasLen, 2)
+
+	clientNonce, _ := hex.DecodeString(strings.TrimPrefix(tor.requestArgs[0], "AUTHCHALLENGE SAFECOOKIE "))
+	c.Assert(clientNonce, HasLen, safeCookieNonceSize)
+
+	clientHash := safeCookieHash(safeCookieClientKey, tor.cookie, clientNonce, tor.serverNonce)
+	c.Assert(tor.requestArgs[1], Equals, "AUTHENTICATE "+hex.EncodeToString(clientHash))
+}
+
+func (s *WahayTorSuite) Test_authenticateWithSafeCookie_failsWhenTorDoesntKnowTheCookie(c *C) {
+	tor := &safeCookieTor{
+		controllerMock: &controllerMock{},
+		cookie:         []byte("a very secret cookie"),
+		serverNonce:    []byte("the nonce of the server"),
+		serverHash:     []byte("a guess"),
+	}
+
+	err := authenticateWithSafeCookie(tor, tor.cookie)
+
+	c.Assert(err, Equals, errInvalidServerHash)
+	c.Assert(tor.requestArgs, HasLen, 1)
+}
+
+func (s *WahayTorSuite) Test_parseAuthChallenge_failsWithAnInvalidAnswer(c *C) {
+	_, _, err := parseAuthChallenge("AUTHCHALLENGE SERVERHASH=ZZ SERVERNONCE=00")
+	c.Assert(err, Equals, errInvalidAuthChallenge)
+
+	_, _, err = parseAuthChallenge("AUTHCHALLENGE SERVERHASH=00")
+	c.Assert(err, Equals, errInvalidAuthChallenge)
+}
+
+func (s *WahayTorSuite) Test_parseProtocolInfo_readsTheMethodsAndTheCookieFile(c *C) {
+	msg := "PROTOCOLINFO 1\n" +
+		"AUTH METHODS=COOKIE,SAFECOOKIE COOKIEFILE=\"/run/tor/control.authcookie\"\n" +
+		"VERSION Tor=\"0.4.8.9\"\n" +
+		"OK"
+
+	methods, cookieFile, err := parseProtocolInfo(msg)
+
+	c.Assert(err, IsNil)
+	c.Assert(methods, DeepEquals, []string{"COOKIE", "SAFECOOKIE"})
+	c.Assert(cookieFile, Equals, "/run/tor/control.authcookie")
+}
+
+func (s *WahayTorSuite) Test_parseProtocolInfo_worksWithoutCookieFile(c *C) {
+	methods, cookieFile, err := parseProtocolInfo("PROTOCOLINFO 1\nAUTH METHODS=NULL\nOK")
+
+	c.Assert(err, IsNil)
+	c.Assert(methods, DeepEquals, []string{"NULL"})
+	c.Assert(cookieFile, Equals, "")
+}
+
+func (s *WahayTorSuite) Test_parseProtocolInfo_failsWithAnInvalidCookieFile(c *C) {
+	_, _, err := parseProtocolInfo("AUTH METHODS=COOKIE COOKIEFILE=\"unfinished")
+
+	c.Assert(err, Equals, errInvalidProtocolInfo)
+}
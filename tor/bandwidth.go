@@ -0,0 +1,62 @@
+package tor
+
+import "sync"
+
+// BandwidthStats contains the traffic of a Tor instance
+type BandwidthStats struct {
+	// Read and Written are the bytes of the last second
+	Read    int64
+	Written int64
+	// TotalRead and TotalWritten are the bytes since Wahay started
+	// using this Tor, including the times it had to be restarted
+	TotalRead    int64
+	TotalWritten int64
+}
+
+// Since returns the traffic after the earlier stats were taken,
+// for example to know the data used during a meeting
+func (s BandwidthStats) Since(earlier BandwidthStats) BandwidthStats {
+	return BandwidthStats{
+		Read:         s.Read,
+		Written:      s.Written,
+		TotalRead:    s.TotalRead - earlier.TotalRead,
+		TotalWritten: s.TotalWritten - earlier.TotalWritten,
+	}
+}
+
+type bandwidthCounter struct {
+	sync.Mutex
+	stats BandwidthStats
+}
+
+func (b *bandwidthCounter) addEvent(e Event) {
+	bw, ok := ParseBandwidthEvent(e)
+	if !ok {
+		return
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	b.stats.Read = bw.Read
+	b.stats.Written = bw.Written
+	b.stats.TotalRead += bw.Read
+	b.stats.TotalWritten += bw.Written
+}
+
+func (b *bandwidthCounter) get() BandwidthStats {
+	b.Lock()
+	defer b.Unlock()
+
+	return b.stats
+}
+
+// BandwidthStats returns the traffic of this Tor. Tor reports it every
+// second, so it can be up to a second late. The events of a system Tor
+// are only watched from the first time this is called
+func (i *instance) BandwidthStats() BandwidthStats {
+	if !i.watchingEvents() {
+		i.watchEvents()
+	}
+	return i.bandwidth.get()
+}
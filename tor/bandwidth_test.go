@@ -0,0 +1,54 @@
+package tor
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_bandwidthCounter_addsUpTheTrafficOfEverySecond(c *C) {
+	b := &bandwidthCounter{}
+
+	b.addEvent(Event{Type: EventBandwidth, Args: []string{"100", "20"}})
+	b.addEvent(Event{Type: EventBandwidth, Args: []string{"300", "40"}})
+	b.addEvent(Event{Type: "NOTICE", Args: []string{"Bootstrapped", "100%"}})
+
+	c.Assert(b.get(), DeepEquals, BandwidthStats{
+		Read:         300,
+		Written:      40,
+		TotalRead:    400,
+		TotalWritten: 60,
+	})
+}
+
+func (s *WahayTorSuite) Test_BandwidthStats_Since_returnsTheTrafficAfterTheEarlierStats(c *C) {
+	earlier := BandwidthStats{Read: 1, Written: 2, TotalRead: 100, TotalWritten: 50}
+	now := BandwidthStats{Read: 10, Written: 5, TotalRead: 1100, TotalWritten: 550}
+
+	c.Assert(now.Since(earlier), DeepEquals, BandwidthStats{
+		Read:         10,
+		Written:      5,
+		TotalRead:    1000,
+		TotalWritten: 500,
+	})
+}
+
+func (s *WahayTorSuite) Test_instance_watchEvents_sendsEveryEventWhereItBelongs(c *C) {
+	mock := &controllerMock{events: make(chan string, 2)}
+	cntrl := &controller{tc: mock.createTestGotor}
+	i := &instance{controller: cntrl, torLog: newTorLog()}
+
+	i.watchEvents()
+	c.Assert(mock.requestArgs, DeepEquals, []string{"SETEVENTS BW NOTICE WARN ERR"})
+
+	mock.events <- "NOTICE Bootstrapped 100%"
+	mock.events <- "BW 10 20"
+	for i.bandwidth.get().TotalRead == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	i.stopWatchingEvents()
+
+	c.Assert(i.TorLog(), HasLen, 1)
+	c.Assert(i.bandwidth.get().TotalWritten, Equals, int64(20))
+	c.Assert(mock.closeCalled, Equals, true)
+}
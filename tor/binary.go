@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os/exec"
 	"path/filepath"
 	"regexp"
@@ -257,7 +258,10 @@ func listPossibleTorBinary(path string) []string {
 	return result
 }
 
-func (b *binary) start(configFile string) (*runningTor, error) {
+// start runs Tor with the given configuration and resource limits.
+// Everything Tor writes to its standard output and error goes to out,
+// if it's not nil
+func (b *binary) start(configFile string, out io.Writer, limits ResourceLimits) (*runningTor, error) {
 	ctx, cancelFunc := context.WithCancel(context.Background())
 	// This is safe since we control both the path and the configFile argument - there is
 	// no user input to these
@@ -270,11 +274,22 @@ func (b *binary) start(configFile string) (*runningTor, error) {
 		cmd.Env = append(osf.Environ(), b.env...)
 	}
 
+	if out != nil {
+		cmd.Stdout = out
+		cmd.Stderr = out
+	}
+
+	limits.wrap(cmd)
+
 	if err := execf.StartCommand(cmd); err != nil {
 		cancelFunc()
 		return nil, err
 	}
 
+	if cmd.Process != nil {
+		limits.apply(cmd.Process.Pid)
+	}
+
 	state := &runningTor{
 		cmd:               cmd,
 		ctx:               ctx,
@@ -0,0 +1,93 @@
+package tor
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// Bridges are the relays Tor uses to reach the network when the
+// public relays are blocked
+type Bridges struct {
+	// Lines are bridge lines like "obfs4 192.0.2.1:443 FINGERPRINT cert=... iat-mode=0"
+	Lines []string
+	// TransportPlugin is the program that implements the obfs4
+	// transport, or an empty string if none could be found
+	TransportPlugin string
+}
+
+// transportPlugins are the programs that implement obfs4, in order of
+// preference. lyrebird is the new name of obfs4proxy
+var transportPlugins = []string{"lyrebird", "obfs4proxy"}
+
+const obfs4Transport = "obfs4"
+
+// BridgesFrom returns the bridges configured by the user
+func BridgesFrom(conf *config.ApplicationConfig) Bridges {
+	b := Bridges{Lines: conf.GetBridges()}
+	if len(b.Lines) == 0 {
+		return b
+	}
+
+	for _, name := range transportPlugins {
+		if path, err := execf.LookPath(name); err == nil {
+			b.TransportPlugin = path
+			break
+		}
+	}
+
+	return b
+}
+
+// bridgeTransport returns the pluggable transport of a bridge line, or an
+// empty string for a bridge that doesn't need one
+func bridgeTransport(line string) string {
+	fields := strings.Fields(line)
+	if len(fields) == 0 || strings.ContainsAny(fields[0], ".:[") {
+		return ""
+	}
+	return fields[0]
+}
+
+// torrc returns the bridges as torrc lines. Bridges that need a transport
+// we can't run are skipped with a warning
+func (b Bridges) torrc() string {
+	bridges := ""
+	usesObfs4 := false
+
+	for _, line := range b.Lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.ContainsAny(line, "\r\n") {
+			continue
+		}
+
+		switch bridgeTransport(line) {
+		case "":
+		case obfs4Transport:
+			if b.TransportPlugin == "" {
+				log.Warnf("Bridges: ignoring an obfs4 bridge, since neither lyrebird nor obfs4proxy are installed")
+				continue
+			}
+			usesObfs4 = true
+		default:
+			log.Warnf("Bridges: ignoring a bridge with an unsupported transport")
+			continue
+		}
+
+		bridges += fmt.Sprintf("Bridge %s\n", line)
+	}
+
+	if bridges == "" {
+		return ""
+	}
+
+	content := "UseBridges 1\n"
+	if usesObfs4 {
+		content += fmt.Sprintf("ClientTransportPlugin %s exec %s\n", obfs4Transport, b.TransportPlugin)
+	}
+
+	return content + bridges
+}
@@ -0,0 +1,63 @@
+package tor
+
+import (
+	"errors"
+	"io/ioutil"
+
+	log "github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+func (s *WahayTorSuite) Test_Bridges_torrc_usesTheTransportPlugin(c *C) {
+	b := Bridges{
+		Lines: []string{
+			"obfs4 192.0.2.1:443 AAAA cert=x iat-mode=0",
+			"192.0.2.2:9001 BBBB",
+			"",
+		},
+		TransportPlugin: "/usr/bin/lyrebird",
+	}
+
+	c.Assert(b.torrc(), Equals, "UseBridges 1\n"+
+		"ClientTransportPlugin obfs4 exec /usr/bin/lyrebird\n"+
+		"Bridge obfs4 192.0.2.1:443 AAAA cert=x iat-mode=0\n"+
+		"Bridge 192.0.2.2:9001 BBBB\n")
+}
+
+func (s *WahayTorSuite) Test_Bridges_torrc_skipsTheBridgesItCantUse(c *C) {
+	log.SetOutput(ioutil.Discard)
+
+	b := Bridges{Lines: []string{
+		"obfs4 192.0.2.1:443 AAAA cert=x iat-mode=0",
+		"snowflake 192.0.2.3:80 CCCC",
+		"192.0.2.2:9001 BBBB\nExitRelay 1",
+	}}
+
+	c.Assert(b.torrc(), Equals, "")
+	c.Assert(Bridges{}.torrc(), Equals, "")
+}
+
+func (s *WahayTorSuite) Test_BridgesFrom_looksForTheTransportPlugin(c *C) {
+	mockAll()
+	defer setDefaultFacades()
+
+	conf := &config.ApplicationConfig{}
+	c.Assert(BridgesFrom(conf).TransportPlugin, Equals, "")
+
+	conf.SetBridges([]string{"obfs4 192.0.2.1:443 AAAA cert=x iat-mode=0"})
+	mockexecf.lookPathReturn1 = "/usr/bin/obfs4proxy"
+	c.Assert(BridgesFrom(conf).TransportPlugin, Equals, "/usr/bin/obfs4proxy")
+
+	mockexecf.lookPathReturn2 = errors.New("not found")
+	c.Assert(BridgesFrom(conf).TransportPlugin, Equals, "")
+}
+
+func (s *WahayTorSuite) Test_instance_getConfigFileContents_includesTheBridges(c *C) {
+	i := &instance{bridges: Bridges{Lines: []string{"192.0.2.2:9001 BBBB"}}}
+
+	content := string(i.getConfigFileContents())
+
+	c.Assert(content, Matches, "(?s).*## Bridges\nUseBridges 1\nBridge 192.0.2.2:9001 BBBB\n.*")
+}
@@ -0,0 +1,82 @@
+package tor
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// EventCircuit is sent by Tor when the status of one of its circuits changes
+const EventCircuit = "CIRC"
+
+// ErrNoNewCircuits is returned when Tor accepted the request for new
+// circuits, but didn't build any of them in time. Tor will still use
+// new circuits for the connections opened from then on
+var ErrNoNewCircuits = errors.New("no new Tor circuits were built in time")
+
+const circuitRotationTimeout = 30 * time.Second
+
+// NewCircuits asks Tor to stop using its current circuits for new
+// connections. Tor ignores the request if it's repeated too quickly
+func (cntrl *controller) NewCircuits() error {
+	cntrl.Lock()
+	defer cntrl.Unlock()
+
+	tc, err := cntrl.authenticatedController()
+	if err != nil {
+		cntrl.dropConnection()
+		return err
+	}
+
+	_, err = tc.Request("SIGNAL NEWNYM")
+	if err != nil {
+		cntrl.dropConnection()
+		return err
+	}
+
+	return nil
+}
+
+// RotateCircuits makes Tor use fresh circuits, for example when the
+// current path is slow or goes through a bad exit. It waits until Tor
+// has built a new circuit. Connections that are already open keep
+// their circuits until they are closed
+func (i *instance) RotateCircuits() error {
+	return rotateCircuits(i.GetController(), circuitRotationTimeout)
+}
+
+func rotateCircuits(cntrl Control, timeout time.Duration) error {
+	var signalled atomic.Bool
+	built := make(chan bool, 1)
+
+	stop, err := cntrl.WatchEvents([]string{EventCircuit}, func(e Event) {
+		if signalled.Load() && isCircuitBuilt(e) {
+			select {
+			case built <- true:
+			default:
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	err = cntrl.NewCircuits()
+	if err != nil {
+		return err
+	}
+	signalled.Store(true)
+
+	select {
+	case <-built:
+		return nil
+	case <-time.After(timeout):
+		return ErrNoNewCircuits
+	}
+}
+
+// isCircuitBuilt returns true for events like CIRC 12 BUILT ...
+func isCircuitBuilt(e Event) bool {
+	return e.Type == EventCircuit && len(e.Args) >= 2 && e.Args[1] == "BUILT"
+}
@@ -0,0 +1,79 @@
+package tor
+
+import (
+	"errors"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type rotationControlMock struct {
+	Control
+
+	watchArg     []string
+	onEvent      func(Event)
+	stopCalled   bool
+	newnym       chan bool
+	newnymReturn error
+	watchReturn  error
+}
+
+func (m *rotationControlMock) WatchEvents(types []string, f func(Event)) (func(), error) {
+	m.watchArg = types
+	m.onEvent = f
+	return func() { m.stopCalled = true }, m.watchReturn
+}
+
+func (m *rotationControlMock) NewCircuits() error {
+	m.onEvent(Event{Type: EventCircuit, Args: []string{"1", "BUILT"}})
+	close(m.newnym)
+	return m.newnymReturn
+}
+
+func (s *WahayTorSuite) Test_controller_NewCircuits_sendsTheNewnymSignal(c *C) {
+	mock := &controllerMock{}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	c.Assert(cntrl.NewCircuits(), IsNil)
+	c.Assert(mock.requestArgs, DeepEquals, []string{"SIGNAL NEWNYM"})
+}
+
+func (s *WahayTorSuite) Test_rotateCircuits_waitsForACircuitBuiltAfterTheSignal(c *C) {
+	m := &rotationControlMock{newnym: make(chan bool)}
+
+	done := make(chan error)
+	go func() {
+		done <- rotateCircuits(m, time.Minute)
+	}()
+
+	<-m.newnym
+	for {
+		select {
+		case err := <-done:
+			c.Assert(err, IsNil)
+			c.Assert(m.watchArg, DeepEquals, []string{EventCircuit})
+			c.Assert(m.stopCalled, Equals, true)
+			return
+		case <-time.After(time.Millisecond):
+			m.onEvent(Event{Type: EventCircuit, Args: []string{"2", "EXTENDED"}})
+			m.onEvent(Event{Type: EventCircuit, Args: []string{"2", "BUILT"}})
+		}
+	}
+}
+
+func (s *WahayTorSuite) Test_rotateCircuits_ignoresCircuitsBuiltBeforeTheSignal(c *C) {
+	m := &rotationControlMock{newnym: make(chan bool)}
+
+	err := rotateCircuits(m, 10*time.Millisecond)
+
+	c.Assert(err, Equals, ErrNoNewCircuits)
+}
+
+func (s *WahayTorSuite) Test_rotateCircuits_returnsTheErrorOfTheSignal(c *C) {
+	m := &rotationControlMock{newnym: make(chan bool), newnymReturn: errors.New("552 Unrecognized signal")}
+
+	err := rotateCircuits(m, time.Minute)
+
+	c.Assert(err, ErrorMatches, "552 Unrecognized signal")
+	c.Assert(m.stopCalled, Equals, true)
+}
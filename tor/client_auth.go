@@ -0,0 +1,97 @@
+package tor
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+// ClientAuthKey is a x25519 key pair used for the client authorization
+// of v3 onion services. Both keys are encoded in unpadded base32,
+// which is the format Tor expects in the control port and in the
+// ClientOnionAuthDir files
+type ClientAuthKey struct {
+	PublicKey  string
+	PrivateKey string
+}
+
+var clientAuthEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+var randReader = rand.Read
+
+// NewClientAuthKey generates a new random x25519 key pair that can
+// be used to authorize one client against an onion service
+func NewClientAuthKey() (*ClientAuthKey, error) {
+	priv := make([]byte, curve25519.ScalarSize)
+	if _, err := randReader(priv); err != nil {
+		return nil, err
+	}
+
+	pub, err := curve25519.X25519(priv, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientAuthKey{
+		PublicKey:  clientAuthEncoding.EncodeToString(pub),
+		PrivateKey: clientAuthEncoding.EncodeToString(priv),
+	}, nil
+}
+
+var errInvalidClientAuthKey = errors.New("invalid client authorization key")
+
+// IsValidClientAuthPrivateKey returns true if the given string is
+// a correctly encoded x25519 private key
+func IsValidClientAuthPrivateKey(k string) bool {
+	b, err := clientAuthEncoding.DecodeString(strings.ToUpper(k))
+	return err == nil && len(b) == curve25519.ScalarSize
+}
+
+func onionAddressWithoutSuffix(serviceID string) string {
+	return strings.TrimSuffix(serviceID, ".onion")
+}
+
+func clientAuthFileName(serviceID string) string {
+	return fmt.Sprintf("%s.auth_private", onionAddressWithoutSuffix(serviceID))
+}
+
+func clientAuthFileContent(serviceID, privateKey string) []byte {
+	return []byte(fmt.Sprintf("%s:descriptor:x25519:%s\n", onionAddressWithoutSuffix(serviceID), strings.ToUpper(privateKey)))
+}
+
+const clientAuthDirName = "onion_auth"
+
+// AddClientAuthorization configures the Tor instance so it can connect to the
+// given onion service that requires client authorization. When we run our own
+// Tor, the key is written to the ClientOnionAuthDir in our data directory.
+// For a system Tor we don't own the data directory, so the key is only
+// registered for the lifetime of the Tor process through the control port.
+func (i *instance) AddClientAuthorization(serviceID, privateKey string) error {
+	if !IsValidClientAuthPrivateKey(privateKey) {
+		return errInvalidClientAuthKey
+	}
+
+	c := i.GetController()
+
+	if i.isLocal || i.dataDirectory == "" {
+		return c.AddOnionClientAuthorization(serviceID, strings.ToUpper(privateKey))
+	}
+
+	dir := filepath.Join(i.dataDirectory, clientAuthDirName)
+	if err := filesystemf.EnsureDir(dir, 0700); err != nil {
+		return err
+	}
+
+	err := filesystemf.WriteFile(filepath.Join(dir, clientAuthFileName(serviceID)), clientAuthFileContent(serviceID, privateKey), 0600)
+	if err != nil {
+		return err
+	}
+
+	// Setting the configuration value again forces Tor to re-read the directory
+	return c.SetConfiguration("ClientOnionAuthDir", dir)
+}
@@ -0,0 +1,143 @@
+package tor
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/wybiral/torgo"
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_NewClientAuthKey_generatesAValidKeyPair(c *C) {
+	k, err := NewClientAuthKey()
+
+	c.Assert(err, IsNil)
+	c.Assert(k.PublicKey, HasLen, 52)
+	c.Assert(k.PrivateKey, HasLen, 52)
+	c.Assert(IsValidClientAuthPrivateKey(k.PrivateKey), Equals, true)
+}
+
+func (s *WahayTorSuite) Test_NewClientAuthKey_returnsErrorWhenRandomnessFails(c *C) {
+	orgRandReader := randReader
+	defer func() {
+		randReader = orgRandReader
+	}()
+
+	randReader = func([]byte) (int, error) {
+		return 0, errors.New("no randomness")
+	}
+
+	k, err := NewClientAuthKey()
+
+	c.Assert(k, IsNil)
+	c.Assert(err, ErrorMatches, "no randomness")
+}
+
+func (s *WahayTorSuite) Test_IsValidClientAuthPrivateKey_rejectsInvalidKeys(c *C) {
+	c.Assert(IsValidClientAuthPrivateKey(""), Equals, false)
+	c.Assert(IsValidClientAuthPrivateKey("not a key"), Equals, false)
+	c.Assert(IsValidClientAuthPrivateKey("MFRGGZDFMZTWQ2LK"), Equals, false)
+}
+
+func (s *WahayTorSuite) Test_clientAuthFileContent_usesTheTorFormat(c *C) {
+	content := clientAuthFileContent("abcdef.onion", "qwerty")
+
+	c.Assert(string(content), Equals, "abcdef:descriptor:x25519:QWERTY\n")
+	c.Assert(clientAuthFileName("abcdef.onion"), Equals, "abcdef.auth_private")
+}
+
+func (s *WahayTorSuite) Test_addOnionRequest_includesTheClientAuthorizationKeys(c *C) {
+	o := newOnionOptions([]OnionOption{WithClientAuthorization("KEYONE", "KEYTWO")})
+
+	onion := &torgo.Onion{
+		Ports:          map[int]string{443: "127.0.0.1:8443", 80: "127.0.0.1:8080"},
+		PrivateKeyType: "NEW",
+		PrivateKey:     "ED25519-V3",
+	}
+
+	req := addOnionRequest(onion, o)
+
+	c.Assert(req, Equals, "ADD_ONION NEW:ED25519-V3 Flags=V3Auth Port=80,127.0.0.1:8080 Port=443,127.0.0.1:8443 ClientAuthV3=KEYONE ClientAuthV3=KEYTWO")
+}
+
+func (s *WahayTorSuite) Test_controller_CreateNewOnionServiceWithMultiplePorts_usesClientAuthorization(c *C) {
+	mock := &controllerMock{requestReturn1: "ServiceID=abcdef"}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	serviceID, err := cntrl.CreateNewOnionServiceWithMultiplePorts(
+		[]OnionPort{{DestinationHost: "127.0.0.1", DestinationPort: 8080, ServicePort: 80}},
+		WithClientAuthorization("KEYONE"))
+
+	c.Assert(err, IsNil)
+	c.Assert(serviceID, Equals, "abcdef.onion")
+	c.Assert(mock.addOnionCalled, Equals, false)
+	c.Assert(mock.requestArgs, HasLen, 1)
+	c.Assert(mock.requestArgs[0], Matches, "ADD_ONION NEW:ED25519-V3 Flags=V3Auth Port=80,127.0.0.1:8080 ClientAuthV3=KEYONE")
+}
+
+func (s *WahayTorSuite) Test_controller_AddOnionClientAuthorization_sendsTheKeyToTor(c *C) {
+	mock := &controllerMock{}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	err := cntrl.AddOnionClientAuthorization("abcdef.onion", "QWERTY")
+
+	c.Assert(err, IsNil)
+	c.Assert(mock.requestArgs, DeepEquals, []string{"ONION_CLIENT_AUTH_ADD abcdef x25519:QWERTY"})
+}
+
+func (s *WahayTorSuite) Test_instance_AddClientAuthorization_writesTheKeyInTheDataDirectory(c *C) {
+	k, _ := NewClientAuthKey()
+	dir := c.MkDir()
+	mock := &controllerMock{}
+	i := &instance{
+		dataDirectory: dir,
+		controller:    &controller{tc: mock.createTestGotor},
+	}
+
+	err := i.AddClientAuthorization("abcdef.onion", k.PrivateKey)
+	c.Assert(err, IsNil)
+
+	content, err := os.ReadFile(filepath.Join(dir, clientAuthDirName, "abcdef.auth_private"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "abcdef:descriptor:x25519:"+k.PrivateKey+"\n")
+	c.Assert(mock.requestArgs, HasLen, 1)
+	c.Assert(mock.requestArgs[0], Equals, "SETCONF ClientOnionAuthDir=\""+filepath.Join(dir, clientAuthDirName)+"\"")
+}
+
+func (s *WahayTorSuite) Test_instance_AddClientAuthorization_failsWhenTheDirectoryCantBeCreated(c *C) {
+	k, _ := NewClientAuthKey()
+	file := filepath.Join(c.MkDir(), "file")
+	c.Assert(os.WriteFile(file, nil, 0600), IsNil)
+	mock := &controllerMock{}
+	i := &instance{
+		dataDirectory: file,
+		controller:    &controller{tc: mock.createTestGotor},
+	}
+
+	err := i.AddClientAuthorization("abcdef.onion", k.PrivateKey)
+
+	c.Assert(err, NotNil)
+	c.Assert(mock.requestArgs, HasLen, 0)
+}
+
+func (s *WahayTorSuite) Test_instance_AddClientAuthorization_rejectsAnInvalidKey(c *C) {
+	i := &instance{}
+
+	err := i.AddClientAuthorization("abcdef.onion", "invalid")
+
+	c.Assert(err, Equals, errInvalidClientAuthKey)
+}
+
+func (s *WahayTorSuite) Test_controller_CreateNewOnionServiceWithMultiplePorts_forwardsToAUnixSocket(c *C) {
+	mock := &controllerMock{requestReturn1: "ServiceID=abcdef"}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	_, err := cntrl.CreateNewOnionServiceWithMultiplePorts(
+		[]OnionPort{{DestinationSocket: "/run/wahay/meeting.sock", ServicePort: 80}},
+		WithClientAuthorization("KEYONE"))
+
+	c.Assert(err, IsNil)
+	c.Assert(mock.requestArgs, HasLen, 1)
+	c.Assert(mock.requestArgs[0], Equals, "ADD_ONION NEW:ED25519-V3 Flags=V3Auth Port=80,unix:/run/wahay/meeting.sock ClientAuthV3=KEYONE")
+}
@@ -1,17 +1,20 @@
 package tor
 
 import (
+	"context"
 	"errors"
 	"net"
 	"strconv"
+	"time"
 
 	"github.com/digitalautonomy/wahay/config"
 	log "github.com/sirupsen/logrus"
 )
 
-// basicConnectivity is used to check whether Tor can connect in different ways
+// basicConnectivity is used to check whether Tor can connect in different
+// ways. The checks give up as soon as ctx is done
 type basicConnectivity interface {
-	check() (authType string, errTotal error, errPartial error)
+	check(ctx context.Context) *ConnectivityReport
 }
 
 type connectivity struct {
@@ -19,104 +22,173 @@ type connectivity struct {
 	routePort   int
 	controlPort int
 	password    string
-	authType    string
+	retry       RetryPolicy
+	torCheck    TorCheck
+	proxy       UpstreamProxy
+	// passwordOnly restricts the authentication to the password
+	passwordOnly bool
 }
 
-func newCustomChecker(host string, routePort, controlPort int) basicConnectivity {
-	return newChecker(host, routePort, controlPort, "")
+// newCustomChecker checks our own Tor, which connects through the proxy
+// configured by the user, if any
+func newCustomChecker(host string, routePort, controlPort int, torCheck TorCheck, proxy UpstreamProxy) basicConnectivity {
+	c := newChecker(host, routePort, controlPort, "", NoRetry, torCheck).(*connectivity)
+	c.proxy = proxy
+	return c
 }
 
-func newDefaultChecker(defaultControlPort int) basicConnectivity {
-	return newChecker(defaultControlHost, defaultSocksPort, defaultControlPort, *config.TorControlPassword)
+func newDefaultChecker(loc systemTorLocation, retry RetryPolicy, torCheck TorCheck) basicConnectivity {
+	return newChecker(defaultControlHost, loc.socksPort, loc.controlPort, *config.TorControlPassword, retry, torCheck)
 }
 
 // newChecker can check connectivity on custom ports, and optionally
 // avoid checking for binary compatibility
-func newChecker(host string, routePort, controlPort int, password string) basicConnectivity {
+func newChecker(host string, routePort, controlPort int, password string, retry RetryPolicy, torCheck TorCheck) basicConnectivity {
 	return &connectivity{
 		host:        host,
 		routePort:   routePort,
 		controlPort: controlPort,
 		password:    password,
+		retry:       retry,
+		torCheck:    torCheck,
 	}
 }
 
-func (c *connectivity) checkTorControlPortExists() bool {
-	_, err := torgof.NewController(net.JoinHostPort(c.host, strconv.Itoa(c.controlPort)))
-	return err == nil
+// controlPortResult is what checkControlPort found out
+type controlPortResult struct {
+	found     bool
+	authType  string
+	version   string
+	bootstrap int
+	err       error
 }
 
-func withNewTorgoController(where string, a authenticationMethod) authenticationMethod {
-	return func(torgoController) error {
-		tc, err := torgof.NewController(where)
-		if err != nil {
-			return err
-		}
-		return a(tc)
+// checkControlPort connects to the control port once, finds a way to
+// authenticate and checks the version of Tor using that same connection
+func (c *connectivity) checkControlPort(ctx context.Context) controlPortResult {
+	r := controlPortResult{bootstrap: -1}
+	where := net.JoinHostPort(c.host, strconv.Itoa(c.controlPort))
+
+	tc, err := torgof.NewController(ctx, where)
+	if err != nil {
+		log.Debugf(" - no control port exists")
+		r.err = ErrPartialTorNoControlPort
+		return r
 	}
-}
+	r.found = true
 
-func (c *connectivity) settingAuthType(tp string, a authenticationMethod) authenticationMethod {
-	return func(tc torgoController) error {
-		res := a(tc)
-		if res == nil {
-			c.authType = tp
-		}
-		return res
+	tc, r.authType, err = c.authenticate(ctx, where, tc)
+	if err != nil {
+		log.Debugf(" - no valid authentication for control port")
+		r.err = ErrPartialTorNoValidAuth
+		return r
 	}
-}
+	defer tc.Close()
 
-func (c *connectivity) checkTorControlAuth() bool {
-	where := net.JoinHostPort(c.host, strconv.Itoa(c.controlPort))
+	r.bootstrap = bootstrapProgress(tc)
 
-	authCallback := authenticateAny(
-		withNewTorgoController(where, c.settingAuthType("none", authenticateNone)),
-		withNewTorgoController(where, c.settingAuthType("cookie", authenticateCookie)),
-		withNewTorgoController(where, c.settingAuthType("password", authenticatePassword(c.password))))
+	var ok bool
+	r.version, ok = c.checkControlPortVersion(tc)
+	if !ok {
+		log.Debugf(" - no valid version of tor on control port")
+		r.err = ErrPartialTorTooOld
+	}
 
-	return authCallback(nil) == nil
+	return r
 }
 
-func (c *connectivity) tryAuthenticate(tc torgoController) error {
-	switch c.authType {
-	case "none":
-		return authenticateNone(tc)
-	case "password":
-		return authenticatePassword(c.password)(tc)
-	case "cookie":
-		return authenticateCookie(tc)
-	default:
-		return errors.New("no valid authentication type")
+func bootstrapProgress(tc torgoController) int {
+	msg, err := tc.Request("GETINFO " + bootstrapPhaseKey)
+	if err != nil {
+		return -1
 	}
+	return parseBootstrapProgress(msg)
 }
 
-func (c *connectivity) checkControlPortVersion() bool {
-	where := net.JoinHostPort(c.host, strconv.Itoa(c.controlPort))
+type namedAuthenticationMethod struct {
+	name string
+	auth authenticationMethod
+	// advertisedAs are the names Tor uses for this method in PROTOCOLINFO
+	advertisedAs []string
+}
 
-	tc, err := torgof.NewController(where)
-	if err != nil {
-		log.Debugf("checkControlPortVersion() - can't connect to control port: %v", err)
-		return false
+// authenticationMethodsFor returns the methods Tor advertised when we
+// connected, in order of preference. Trying the others would only fail
+// and leave traces in the log of hardened Tor configurations. If Tor
+// didn't tell us anything useful, every method is tried
+func (c *connectivity) authenticationMethodsFor(tc torgoController) []namedAuthenticationMethod {
+	methods := []namedAuthenticationMethod{
+		{"none", authenticateNone, []string{"NULL"}},
+		{"cookie", authenticateCookie, []string{"COOKIE", authMethodSafeCookie}},
+		{"password", authenticatePassword(c.password), []string{"HASHEDPASSWORD"}},
 	}
-	err = c.tryAuthenticate(tc)
-	if err != nil {
-		log.Debugf("checkControlPortVersion() - can't authenticate: %v", err)
-		return false
+
+	if c.passwordOnly {
+		return methods[2:]
+	}
+
+	advertised := []namedAuthenticationMethod{}
+	for _, m := range methods {
+		for _, name := range m.advertisedAs {
+			if supportsAuthMethod(tc, name) {
+				advertised = append(advertised, m)
+				break
+			}
+		}
 	}
 
+	if len(advertised) == 0 {
+		return methods
+	}
+
+	return advertised
+}
+
+// authenticate tries the authentication methods until one works, and
+// returns the authenticated controller and the name of the method. Tor
+// closes the connection after a failed attempt, so every new attempt
+// needs a new connection
+func (c *connectivity) authenticate(ctx context.Context, where string, tc torgoController) (torgoController, string, error) {
+	methods := c.authenticationMethodsFor(tc)
+
+	var err error
+	for i, m := range methods {
+		if i > 0 {
+			_ = tc.Close()
+			if ctx.Err() != nil {
+				return nil, "", ctx.Err()
+			}
+
+			tc, err = torgof.NewController(ctx, where)
+			if err != nil {
+				return nil, "", err
+			}
+		}
+
+		err = m.auth(tc)
+		if err == nil {
+			return tc, m.name, nil
+		}
+	}
+
+	_ = tc.Close()
+	return nil, "", err
+}
+
+func (c *connectivity) checkControlPortVersion(tc torgoController) (version string, ok bool) {
 	v, err := tc.GetVersion()
 	if err != nil {
 		log.Debugf("checkControlPortVersion() - can't get version: %v", err)
-		return false
+		return "", false
 	}
 
 	diff, err := compareVersions(v, minSupportedVersion)
 	if err != nil {
 		log.Debugf("checkControlPortVersion() - can't compare versions: %v", err)
-		return false
+		return v, false
 	}
 
-	return diff >= 0
+	return v, diff >= 0
 }
 
 type checkTorResult struct {
@@ -124,10 +196,6 @@ type checkTorResult struct {
 	IP    string
 }
 
-func (c *connectivity) checkConnectionOverTor() bool {
-	return httpf.CheckConnectionOverTor(c.host, c.routePort)
-}
-
 var (
 	// ErrPartialTorNoControlPort is an error to be trown when a valid Tor
 	// control port cannot be found
@@ -146,31 +214,95 @@ var (
 	ErrFatalTorNoConnectionAllowed = errors.New("no connection over Tor allowed")
 )
 
-func (c *connectivity) check() (authType string, errTotal error, errPartial error) {
-	if !c.checkTorControlPortExists() {
-		log.Debugf(" - no control port exists")
-		return "", nil, ErrPartialTorNoControlPort
-	}
+// check runs the checks following the retry policy of the
+// checker, until they succeed, fail permanently or ctx is done
+func (c *connectivity) check(ctx context.Context) *ConnectivityReport {
+	return c.checkWithRetry(ctx)
+}
 
-	if !c.checkTorControlAuth() {
-		log.Debugf(" - no valid authentication for control port")
-		return "", nil, ErrPartialTorNoValidAuth
+// connectivityCheckTimeout is the maximum time a single pass
+// of the connectivity checks can take
+const connectivityCheckTimeout = 30 * time.Second
+
+// checkOnce runs the checks of the control port and the connection over
+// Tor at the same time, since they don't depend on each other
+func (c *connectivity) checkOnce(ctx context.Context) *ConnectivityReport {
+	ctx, cancel := context.WithTimeout(ctx, connectivityCheckTimeout)
+	defer cancel()
+
+	r := newConnectivityReport()
+
+	// The checks would keep running in the background after we give up
+	if ctx.Err() != nil {
+		r.Partial = ErrPartialTorNoControlPort
+		return r
 	}
 
-	if !c.checkControlPortVersion() {
-		log.Debugf(" - no valid version of tor on control port")
-		return "", nil, ErrPartialTorTooOld
+	control := make(chan controlPortResult, 1)
+	go func() {
+		control <- c.checkControlPort(ctx)
+	}()
+
+	socks := make(chan bool, 1)
+	connected := make(chan bool, 1)
+	go func() {
+		socks <- httpf.SOCKSHandshake(ctx, c.host, c.routePort)
+		connected <- c.checkConnectionOverTor(ctx)
+	}()
+
+	select {
+	case cr := <-control:
+		r.ControlPortFound = cr.found
+		r.AuthMethod = cr.authType
+		r.TorVersion = cr.version
+		r.BootstrapProgress = cr.bootstrap
+		r.Partial = cr.err
+	case <-ctx.Done():
+		log.Debugf(" - the control port checks didn't finish in time")
+		r.Partial = ErrPartialTorNoControlPort
 	}
 
-	// While this returns ErrFatalTorNoConnectionAllowed as a total error
+	// While this returns ErrFatalTorNoConnectionAllowed as a fatal error
 	// the System Tor checking will ignore this and not try to stop the
-	// process. Thus the distinction between total and partial is only really
+	// process. Thus the distinction between fatal and partial is only really
 	// relevant for custom instances.
 
-	if !c.checkConnectionOverTor() {
+	select {
+	case r.SOCKSReachable = <-socks:
+		select {
+		case r.ConnectedOverTor = <-connected:
+		case <-ctx.Done():
+			log.Debugf(" - the connection over tor didn't finish in time")
+		}
+	case <-ctx.Done():
+		log.Debugf(" - the SOCKS port didn't answer in time")
+	}
+
+	if r.Partial != nil {
+		r.AuthMethod = ""
+		return r
+	}
+
+	if !r.ConnectedOverTor && r.SOCKSReachable {
+		r.ConnectedOverTor = c.looksConnectedOffline(ctx)
+	}
+
+	if !r.ConnectedOverTor {
 		log.Debugf(" - no connection over tor to the internet possible")
-		return "", ErrFatalTorNoConnectionAllowed, nil
+		r.Fatal = c.noConnectionError(ctx)
+		r.AuthMethod = ""
 	}
 
-	return c.authType, nil, nil
+	return r
+}
+
+// noConnectionError tells apart the case where Tor can't connect because
+// the proxy it has to go through doesn't answer, since waiting for Tor
+// won't help and the user has to fix the proxy settings
+func (c *connectivity) noConnectionError(ctx context.Context) error {
+	if address := c.proxy.address(); address != "" && !httpf.CanConnect(ctx, address) {
+		log.Debugf(" - the configured proxy can't be reached")
+		return ErrTorProxyUnreachable
+	}
+	return ErrFatalTorNoConnectionAllowed
 }
@@ -0,0 +1,119 @@
+package tor
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ConnectivityReport contains everything the connectivity checks found out
+// about a Tor, so the reason why it can't be used can be shown to the user
+type ConnectivityReport struct {
+	// ControlPortFound is true when something answered on the control port
+	ControlPortFound bool
+	// AuthMethod is the authentication method that worked
+	// on the control port, or empty if none did
+	AuthMethod string
+	// TorVersion is the version Tor reported, if we could authenticate
+	TorVersion string
+	// BootstrapProgress is the percentage of the bootstrap of Tor,
+	// or -1 when it's not known
+	BootstrapProgress int
+	// SOCKSReachable is true when the SOCKS port accepts connections
+	SOCKSReachable bool
+	// ConnectedOverTor is true when a connection over Tor could be confirmed
+	ConnectedOverTor bool
+
+	// Fatal is set when this Tor can't be used, and waiting won't help
+	// unless it's ErrFatalTorNoConnectionAllowed
+	Fatal error
+	// Partial is set when some part of Tor doesn't work yet
+	Partial error
+}
+
+func newConnectivityReport() *ConnectivityReport {
+	return &ConnectivityReport{BootstrapProgress: -1}
+}
+
+// OK returns true when Tor can be used
+func (r *ConnectivityReport) OK() bool {
+	return r.Fatal == nil && r.Partial == nil
+}
+
+// Err returns the reason why Tor can't be used, or nil
+func (r *ConnectivityReport) Err() error {
+	if r.Fatal != nil {
+		return r.Fatal
+	}
+	return r.Partial
+}
+
+func yesNo(v bool) string {
+	if v {
+		return "yes"
+	}
+	return "no"
+}
+
+func (r *ConnectivityReport) String() string {
+	parts := []string{
+		fmt.Sprintf("control port: %s", yesNo(r.ControlPortFound)),
+	}
+
+	if r.AuthMethod != "" {
+		parts = append(parts, fmt.Sprintf("authentication: %s", r.AuthMethod))
+	}
+
+	if r.TorVersion != "" {
+		parts = append(parts, fmt.Sprintf("version: %s", r.TorVersion))
+	}
+
+	if r.BootstrapProgress >= 0 {
+		parts = append(parts, fmt.Sprintf("bootstrap: %d%%", r.BootstrapProgress))
+	}
+
+	parts = append(parts,
+		fmt.Sprintf("SOCKS port: %s", yesNo(r.SOCKSReachable)),
+		fmt.Sprintf("connected over Tor: %s", yesNo(r.ConnectedOverTor)))
+
+	if err := r.Err(); err != nil {
+		parts = append(parts, fmt.Sprintf("failure: %v", err))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// ConnectivityReport returns the result of the last connectivity check
+// of this Tor, or nil if it hasn't been checked
+func (i *instance) ConnectivityReport() *ConnectivityReport {
+	i.Lock()
+	defer i.Unlock()
+	return i.report
+}
+
+func (i *instance) setConnectivityReport(r *ConnectivityReport) {
+	i.Lock()
+	defer i.Unlock()
+	i.report = r
+}
+
+const bootstrapPhaseKey = "status/bootstrap-phase"
+
+var bootstrapProgressPattern = regexp.MustCompile(`\bPROGRESS=(\d+)\b`)
+
+// parseBootstrapProgress reads the percentage from an answer like
+// status/bootstrap-phase=NOTICE BOOTSTRAP PROGRESS=100 TAG=done SUMMARY="Done"
+func parseBootstrapProgress(msg string) int {
+	m := bootstrapProgressPattern.FindStringSubmatch(msg)
+	if m == nil {
+		return -1
+	}
+
+	p, err := strconv.Atoi(m[1])
+	if err != nil {
+		return -1
+	}
+
+	return p
+}
@@ -0,0 +1,59 @@
+package tor
+
+import (
+	"context"
+	"errors"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_parseBootstrapProgress_readsTheProgress(c *C) {
+	c.Assert(parseBootstrapProgress(`250-status/bootstrap-phase=NOTICE BOOTSTRAP PROGRESS=85 TAG=ap_conn SUMMARY="Connecting"`), Equals, 85)
+	c.Assert(parseBootstrapProgress("250 OK"), Equals, -1)
+}
+
+func (s *WahayTorSuite) Test_ConnectivityReport_String_describesTheChecks(c *C) {
+	r := newConnectivityReport()
+	r.ControlPortFound = true
+	r.TorVersion = "0.4.8.9"
+	r.BootstrapProgress = 100
+	r.SOCKSReachable = true
+	r.Fatal = errors.New("no connection over Tor allowed")
+
+	c.Assert(r.OK(), Equals, false)
+	c.Assert(r.String(), Equals, "control port: yes, version: 0.4.8.9, bootstrap: 100%, "+
+		"SOCKS port: yes, connected over Tor: no, failure: no connection over Tor allowed")
+}
+
+func (s *WahayTorSuite) Test_connectivity_check_reportsWhatItFound(c *C) {
+	tc, _ := mockSystemTorAdvertising("COOKIE")
+	defer setDefaultFacades()
+
+	tc.requestReturn = "status/bootstrap-phase=NOTICE BOOTSTRAP PROGRESS=100 TAG=done SUMMARY=\"Done\""
+	mockhttpf.socksHandshakeReturn = true
+
+	r := newChecker("127.0.0.1", 9050, 9051, "", NoRetry, TorCheck{}).check(context.Background())
+
+	c.Assert(r.OK(), Equals, true)
+	c.Assert(r.ControlPortFound, Equals, true)
+	c.Assert(r.AuthMethod, Equals, "cookie")
+	c.Assert(r.TorVersion, Equals, "4.0.4")
+	c.Assert(r.BootstrapProgress, Equals, 100)
+	c.Assert(r.SOCKSReachable, Equals, true)
+	c.Assert(r.ConnectedOverTor, Equals, true)
+}
+
+func (s *WahayTorSuite) Test_connectivity_check_reportsWhenThereIsNoControlPort(c *C) {
+	mockAll()
+	defer setDefaultFacades()
+
+	mocktorgof.onNewController = func(string) (torgoController, error) {
+		return nil, errors.New("connection refused")
+	}
+
+	r := newChecker("127.0.0.1", 9050, 9051, "", NoRetry, TorCheck{}).check(context.Background())
+
+	c.Assert(r.ControlPortFound, Equals, false)
+	c.Assert(r.BootstrapProgress, Equals, -1)
+	c.Assert(r.Partial, Equals, ErrPartialTorNoControlPort)
+}
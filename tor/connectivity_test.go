@@ -0,0 +1,95 @@
+package tor
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+
+	log "github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+func mockSystemTorAdvertising(methods ...string) (*mockTorgoController, *int) {
+	mockAll()
+	log.SetOutput(ioutil.Discard)
+
+	tc := &mockTorgoController{}
+	tc.authMethods = methods
+	tc.authNoneReturn = errors.New("no authentication not allowed")
+	tc.authPassReturn = errors.New("invalid password")
+	tc.getVersionReturn1 = "4.0.4"
+
+	mockhttpf.checkConnectionReturn = true
+
+	connections := 0
+	mocktorgof.onNewController = func(string) (torgoController, error) {
+		connections++
+		return tc, nil
+	}
+
+	return tc, &connections
+}
+
+func (s *WahayTorSuite) Test_connectivity_check_onlyTriesTheAdvertisedAuthenticationMethod(c *C) {
+	tc, connections := mockSystemTorAdvertising("COOKIE", "SAFECOOKIE")
+	defer setDefaultFacades()
+
+	checker := newChecker("127.0.0.1", 9050, 9051, "", NoRetry, TorCheck{})
+
+	r := checker.check(context.Background())
+
+	c.Assert(r.Fatal, IsNil)
+	c.Assert(r.Partial, IsNil)
+	c.Assert(r.AuthMethod, Equals, "cookie")
+	c.Assert(tc.authNoneCalled, Equals, 0)
+	c.Assert(tc.authCookieCalled, Equals, 1)
+	c.Assert(tc.authPassCalled, Equals, 0)
+	c.Assert(*connections, Equals, 1)
+}
+
+func (s *WahayTorSuite) Test_connectivity_check_triesTheNextAdvertisedMethodOnFailure(c *C) {
+	tc, connections := mockSystemTorAdvertising("COOKIE", "HASHEDPASSWORD")
+	defer setDefaultFacades()
+	tc.authCookieReturn = errors.New("can't read the cookie")
+	tc.authPassReturn = nil
+
+	checker := newChecker("127.0.0.1", 9050, 9051, "secret", NoRetry, TorCheck{})
+
+	r := checker.check(context.Background())
+
+	c.Assert(r.Partial, IsNil)
+	c.Assert(r.AuthMethod, Equals, "password")
+	c.Assert(tc.authNoneCalled, Equals, 0)
+	c.Assert(tc.authPassArg, Equals, "secret")
+	c.Assert(*connections, Equals, 2)
+}
+
+func (s *WahayTorSuite) Test_connectivity_check_triesEveryMethodWhenNothingIsAdvertised(c *C) {
+	tc, _ := mockSystemTorAdvertising()
+	defer setDefaultFacades()
+
+	r := newChecker("127.0.0.1", 9050, 9051, "", NoRetry, TorCheck{}).check(context.Background())
+
+	c.Assert(r.Partial, IsNil)
+	c.Assert(r.AuthMethod, Equals, "cookie")
+	c.Assert(tc.authNoneCalled, Equals, 1)
+	c.Assert(tc.authCookieCalled, Equals, 1)
+}
+
+func (s *WahayTorSuite) Test_NewInstanceContext_doesntLookForTorWhenTheContextIsDone(c *C) {
+	mockSystemTorAdvertising("NULL")
+	defer setDefaultFacades()
+	mocktorgof.onNewController = func(string) (torgoController, error) {
+		return nil, errors.New("no connection possible")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	i, err := NewInstanceContext(ctx, &config.ApplicationConfig{}, nil)
+
+	c.Assert(i, IsNil)
+	c.Assert(err, Equals, context.Canceled)
+}
@@ -1,11 +1,14 @@
 package tor
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/digitalautonomy/wahay/config"
 	log "github.com/sirupsen/logrus"
@@ -19,21 +22,46 @@ import (
 type Control interface {
 	SetPassword(string)
 	UseCookieAuth()
-	CreateNewOnionServiceWithMultiplePorts(ports []OnionPort) (serviceID string, err error)
+	CreateNewOnionServiceWithMultiplePorts(ports []OnionPort, opts ...OnionOption) (serviceID string, err error)
 	CreateNewOnionService(destinationHost string, destinationPort int, port int) (serviceID string, err error)
 	DeleteOnionService(serviceID string) error
 	DeleteOnionServices()
+	AddOnionClientAuthorization(serviceID, privateKey string) error
+	SetConfiguration(key, value string) error
+	WatchEvents(types []string, f func(Event)) (stop func(), err error)
+	GetNetworkLiveness() (bool, error)
+	GetNetworkStatus() (NetworkStatus, error)
+	NewCircuits() error
+	RestoreOnionServices() error
 }
 
+// controller is used from the health monitor, the network status
+// watcher and the GUI at the same time. Its lock protects the control
+// connection, which is shared, and the published onion services
 type controller struct {
+	sync.Mutex
+
 	torHost  string
 	torPort  int
 	authType *authenticationMethod
 	password string
 	c        torgoController
 	tc       func(string) (torgoController, error)
+
+	// published contains the onion services created through this
+	// controller, so they can be published again with the
+	// same address if Tor has to be restarted
+	published map[string]*publishedOnion
+}
+
+type publishedOnion struct {
+	ports      map[int]string
+	options    *onionOptions
+	privateKey string
 }
 
+const onionKeyType = "ED25519-V3"
+
 // TODO[OB] - I'm not a huge fan of this being global
 // Is there any reason why this is not on the controller?
 
@@ -42,7 +70,9 @@ var onions = []string{}
 // createController takes the Tor information given
 // and returns a controlling interface
 func createController(torHost string, torPort int) Control {
-	f := torgof.NewController
+	f := func(a string) (torgoController, error) {
+		return torgof.NewController(context.Background(), a)
+	}
 
 	var a authenticationMethod = authenticateNone
 
@@ -56,6 +86,9 @@ func createController(torHost string, torPort int) Control {
 }
 
 func (cntrl *controller) SetPassword(p string) {
+	cntrl.Lock()
+	defer cntrl.Unlock()
+
 	cntrl.password = p
 	if len(p) > 0 {
 		var a authenticationMethod = authenticatePassword(p)
@@ -64,6 +97,9 @@ func (cntrl *controller) SetPassword(p string) {
 }
 
 func (cntrl *controller) UseCookieAuth() {
+	cntrl.Lock()
+	defer cntrl.Unlock()
+
 	var a authenticationMethod = authenticateCookie
 	cntrl.authType = &a
 }
@@ -74,32 +110,122 @@ type OnionPort struct {
 	ServicePort     int
 	DestinationPort int
 	DestinationHost string
+	// DestinationSocket is the path of a unix socket the connections
+	// are forwarded to instead of the destination host and port
+	DestinationSocket string
 }
 
-// TODO[OB] - It seems this would be nicer if there was just one interface
-// method, and then it could take variable number of arguments
+func (p OnionPort) destination() string {
+	if p.DestinationSocket != "" {
+		return "unix:" + p.DestinationSocket
+	}
+	return net.JoinHostPort(p.DestinationHost, strconv.Itoa(p.DestinationPort))
+}
 
-func (cntrl *controller) CreateNewOnionServiceWithMultiplePorts(ports []OnionPort) (serviceID string, err error) {
-	log.Debugf("CreateNewOnionServiceWithMultiplePorts(%v)", ports)
+func (p OnionPort) isValid() bool {
+	if !config.CheckPort(p.ServicePort) {
+		return false
+	}
+	return p.DestinationSocket != "" || config.CheckPort(p.DestinationPort)
+}
+
+// OnionOption modifies the way a new onion service is created
+type OnionOption func(*onionOptions)
+
+type onionOptions struct {
+	clientAuthKeys []string
+	nonAnonymous   bool
+	privateKey     string
+}
+
+// WithClientAuthorization makes the onion service only reachable for clients
+// holding the private part of one of the given x25519 public keys
+func WithClientAuthorization(publicKeys ...string) OnionOption {
+	return func(o *onionOptions) {
+		o.clientAuthKeys = append(o.clientAuthKeys, publicKeys...)
+	}
+}
+
+// WithNonAnonymous marks the onion service as non anonymous, which is
+// required for the onion services of a Tor running in single hop mode
+func WithNonAnonymous() OnionOption {
+	return func(o *onionOptions) {
+		o.nonAnonymous = true
+	}
+}
+
+// WithPrivateKey publishes the onion service with an existing ed25519
+// key, like the ones created by MineOnionKey, instead of a new one
+func WithPrivateKey(key *OnionKey) OnionOption {
+	return func(o *onionOptions) {
+		o.privateKey = key.PrivateKey
+	}
+}
+
+func newOnionOptions(opts []OnionOption) *onionOptions {
+	o := &onionOptions{}
+	for _, f := range opts {
+		f(o)
+	}
+	return o
+}
+
+func (o *onionOptions) flags() []string {
+	flags := []string{}
+	if len(o.clientAuthKeys) > 0 {
+		flags = append(flags, "V3Auth")
+	}
+	if o.nonAnonymous {
+		flags = append(flags, "NonAnonymous")
+	}
+	return flags
+}
+
+// needsCustomRequest returns true when the options can't be expressed
+// through the torgo onion representation
+func (o *onionOptions) needsCustomRequest() bool {
+	return len(o.flags()) > 0
+}
+
+// authenticatedController returns the control connection, opening and
+// authenticating it when needed. It must be called with the lock held
+func (cntrl *controller) authenticatedController() (torgoController, error) {
 	tc, err := cntrl.getTorController()
 	if err != nil {
-		return
+		return nil, err
 	}
 
-	log.Debug("CreateNewOnionServiceWithMultiplePorts() - authenticating")
 	if cntrl.authType != nil {
 		err = (*cntrl.authType)(tc)
 		if err != nil {
-			return
+			return nil, err
 		}
 	}
 
+	return tc, nil
+}
+
+// TODO[OB] - It seems this would be nicer if there was just one interface
+// method, and then it could take variable number of arguments
+
+func (cntrl *controller) CreateNewOnionServiceWithMultiplePorts(ports []OnionPort, opts ...OnionOption) (serviceID string, err error) {
+	log.Debugf("CreateNewOnionServiceWithMultiplePorts(%v)", ports)
+
+	cntrl.Lock()
+	defer cntrl.Unlock()
+
+	log.Debug("CreateNewOnionServiceWithMultiplePorts() - authenticating")
+	tc, err := cntrl.authenticatedController()
+	if err != nil {
+		return
+	}
+
 	invalidPorts := []string{}
 	finalPorts := make(map[int]string)
 	for _, p := range ports {
-		host := net.JoinHostPort(p.DestinationHost, strconv.Itoa(p.DestinationPort))
+		host := p.destination()
 
-		if !config.CheckPort(p.ServicePort) || !config.CheckPort(p.DestinationPort) {
+		if !p.isValid() {
 			invalidPorts = append(invalidPorts, host)
 			continue
 		}
@@ -116,10 +242,16 @@ func (cntrl *controller) CreateNewOnionServiceWithMultiplePorts(ports []OnionPor
 	onion := &torgo.Onion{
 		Ports:          finalPorts,
 		PrivateKeyType: "NEW",
-		PrivateKey:     "ED25519-V3",
+		PrivateKey:     onionKeyType,
+	}
+
+	options := newOnionOptions(opts)
+	if options.privateKey != "" {
+		onion.PrivateKeyType = onionKeyType
+		onion.PrivateKey = options.privateKey
 	}
 
-	err = tc.AddOnion(onion)
+	err = addOnion(tc, onion, options)
 	if err != nil {
 		return "", err
 	}
@@ -127,9 +259,35 @@ func (cntrl *controller) CreateNewOnionServiceWithMultiplePorts(ports []OnionPor
 	serviceID = fmt.Sprintf("%s.onion", onion.ServiceID)
 	onions = append(onions, serviceID)
 
+	cntrl.remember(serviceID, onion, options)
+
 	return serviceID, nil
 }
 
+func addOnion(tc torgoController, onion *torgo.Onion, options *onionOptions) error {
+	if options.needsCustomRequest() {
+		return addOnionWithOptions(tc, onion, options)
+	}
+	return tc.AddOnion(onion)
+}
+
+func (cntrl *controller) remember(serviceID string, onion *torgo.Onion, options *onionOptions) {
+	if onion.PrivateKey == "" || onion.PrivateKeyType != onionKeyType {
+		log.Debugf("remember(): no private key returned for %s, it can't be restored", serviceID)
+		return
+	}
+
+	if cntrl.published == nil {
+		cntrl.published = make(map[string]*publishedOnion)
+	}
+
+	cntrl.published[serviceID] = &publishedOnion{
+		ports:      onion.Ports,
+		options:    options,
+		privateKey: onion.PrivateKey,
+	}
+}
+
 func (cntrl *controller) CreateNewOnionService(destinationHost string, destinationPort int,
 	servicePort int) (serviceID string, err error) {
 	p := OnionPort{
@@ -140,9 +298,102 @@ func (cntrl *controller) CreateNewOnionService(destinationHost string, destinati
 	return cntrl.CreateNewOnionServiceWithMultiplePorts([]OnionPort{p})
 }
 
+func addOnionRequest(onion *torgo.Onion, o *onionOptions) string {
+	req := []string{fmt.Sprintf("ADD_ONION %s:%s", onion.PrivateKeyType, onion.PrivateKey)}
+
+	if flags := o.flags(); len(flags) > 0 {
+		req = append(req, fmt.Sprintf("Flags=%s", strings.Join(flags, ",")))
+	}
+
+	servicePorts := make([]int, 0, len(onion.Ports))
+	for p := range onion.Ports {
+		servicePorts = append(servicePorts, p)
+	}
+	sort.Ints(servicePorts)
+
+	for _, p := range servicePorts {
+		req = append(req, fmt.Sprintf("Port=%d,%s", p, onion.Ports[p]))
+	}
+
+	for _, k := range o.clientAuthKeys {
+		req = append(req, fmt.Sprintf("ClientAuthV3=%s", k))
+	}
+
+	return strings.Join(req, " ")
+}
+
+func addOnionWithOptions(tc torgoController, onion *torgo.Onion, o *onionOptions) error {
+	msg, err := tc.Request(addOnionRequest(onion, o))
+	if err != nil {
+		return err
+	}
+
+	for _, line := range strings.Split(msg, "\n") {
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		switch parts[0] {
+		case "ServiceID":
+			onion.ServiceID = parts[1]
+		case "PrivateKey":
+			key := strings.SplitN(parts[1], ":", 2)
+			if len(key) == 2 {
+				onion.PrivateKeyType = key[0]
+				onion.PrivateKey = key[1]
+			}
+		}
+	}
+
+	if onion.ServiceID == "" {
+		return errors.New("the onion service was not created")
+	}
+
+	return nil
+}
+
+func (cntrl *controller) AddOnionClientAuthorization(serviceID, privateKey string) error {
+	cntrl.Lock()
+	defer cntrl.Unlock()
+
+	tc, err := cntrl.authenticatedController()
+	if err != nil {
+		return err
+	}
+
+	_, err = tc.Request(fmt.Sprintf("ONION_CLIENT_AUTH_ADD %s x25519:%s", onionAddressWithoutSuffix(serviceID), privateKey))
+	return err
+}
+
+func (cntrl *controller) SetConfiguration(key, value string) error {
+	cntrl.Lock()
+	defer cntrl.Unlock()
+
+	tc, err := cntrl.authenticatedController()
+	if err != nil {
+		return err
+	}
+
+	_, err = tc.Request(fmt.Sprintf("SETCONF %s=%s", key, strconv.Quote(value)))
+	return err
+}
+
 func (cntrl *controller) DeleteOnionService(serviceID string) error {
+	cntrl.Lock()
+	defer cntrl.Unlock()
+
+	return cntrl.deleteOnionService(serviceID)
+}
+
+func (cntrl *controller) deleteOnionService(serviceID string) error {
+	tc, err := cntrl.authenticatedController()
+	if err != nil {
+		return err
+	}
+
 	s := strings.TrimSuffix(serviceID, ".onion")
-	err := cntrl.c.DeleteOnion(s)
+	err = tc.DeleteOnion(s)
 	if err != nil {
 		return err
 	}
@@ -159,12 +410,17 @@ func (cntrl *controller) DeleteOnionService(serviceID string) error {
 		}
 	}
 
+	delete(cntrl.published, serviceID)
+
 	return nil
 }
 
 func (cntrl *controller) DeleteOnionServices() {
+	cntrl.Lock()
+	defer cntrl.Unlock()
+
 	for _, o := range onions {
-		_ = cntrl.DeleteOnionService(o)
+		_ = cntrl.deleteOnionService(o)
 	}
 }
 
@@ -24,6 +24,10 @@ type controllerMock struct {
 	authenticateCookieCalled bool
 	authenticateCookieReturn error
 
+	authenticateSafeCookieCalled bool
+	authenticateSafeCookieReturn error
+	authMethods                  []string
+
 	authenticateNoneCalled bool
 	authenticateNoneReturn error
 
@@ -31,6 +35,7 @@ type controllerMock struct {
 	addOnionCalled         bool
 	addOnionReturnError    error
 	addOnionAddServiceInfo string
+	addOnionAddPrivateKey  string
 
 	deleteOnionArg         *string
 	deleteOnionCalled      bool
@@ -38,6 +43,13 @@ type controllerMock struct {
 
 	getVersionReturn1 string
 	getVersionReturn2 error
+
+	requestArgs    []string
+	requestReturn1 string
+	requestReturn2 error
+
+	events      chan string
+	closeCalled bool
 }
 
 func (m *controllerMock) AuthenticateNone() error {
@@ -50,6 +62,15 @@ func (m *controllerMock) AuthenticateCookie() error {
 	return m.authenticateCookieReturn
 }
 
+func (m *controllerMock) AuthenticateSafeCookie() error {
+	m.authenticateSafeCookieCalled = true
+	return m.authenticateSafeCookieReturn
+}
+
+func (m *controllerMock) AuthMethods() []string {
+	return m.authMethods
+}
+
 func (m *controllerMock) AuthenticatePassword(v1 string) error {
 	m.authenticatePasswordArg1 = v1
 	m.authenticatePasswordCalled = true
@@ -62,6 +83,10 @@ func (m *controllerMock) AddOnion(v1 *torgo.Onion) error {
 	if m.addOnionAddServiceInfo != "" {
 		v1.ServiceID = m.addOnionAddServiceInfo
 	}
+	if m.addOnionAddPrivateKey != "" {
+		v1.PrivateKeyType = onionKeyType
+		v1.PrivateKey = m.addOnionAddPrivateKey
+	}
 	return m.addOnionReturnError
 }
 
@@ -77,6 +102,27 @@ func (m *controllerMock) DeleteOnion(serviceID string) error {
 	return m.deleteOnionReturnError
 }
 
+func (m *controllerMock) Request(req string) (string, error) {
+	m.requestArgs = append(m.requestArgs, req)
+	return m.requestReturn1, m.requestReturn2
+}
+
+func (m *controllerMock) ReadEvent() (string, error) {
+	ev, ok := <-m.events
+	if !ok {
+		return "", errors.New("connection closed")
+	}
+	return ev, nil
+}
+
+func (m *controllerMock) Close() error {
+	if !m.closeCalled && m.events != nil {
+		close(m.events)
+	}
+	m.closeCalled = true
+	return nil
+}
+
 func (m *controllerMock) createTestGotor(addr string) (torgoController, error) {
 	return m, nil
 }
@@ -271,3 +317,28 @@ func (s *WahayTorSuite) Test_controller_DeleteOnionService_returnsErrorIfFails(c
 	// error if delete fail
 	c.Assert(e, ErrorMatches, "service deletion error")
 }
+
+func (s *WahayTorSuite) Test_controller_DeleteOnionService_returnsErrorAfterTheConnectionWasDropped(c *C) {
+	mock := &controllerMock{}
+	connect := mock.createTestGotor
+
+	cntrl := &controller{
+		torHost: "127.1.2.3",
+		torPort: 9052,
+		tc: func(addr string) (torgoController, error) {
+			return connect(addr)
+		},
+	}
+
+	serviceID, _ := cntrl.CreateNewOnionService("127.1.2.3", 9052, 7877)
+
+	cntrl.dropConnection()
+	connect = func(string) (torgoController, error) {
+		return nil, errors.New("connection refused")
+	}
+
+	e := cntrl.DeleteOnionService(serviceID)
+
+	c.Assert(e, ErrorMatches, "connection refused")
+	c.Assert(mock.deleteOnionCalled, Equals, false)
+}
@@ -0,0 +1,157 @@
+package tor
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// TorDiscovery describes the ports probed looking for a Tor that
+// doesn't listen on the usual ones
+type TorDiscovery struct {
+	Enabled      bool
+	ControlPorts []int
+	SocksPorts   []int
+	// Discovered is where a Tor was found the last time, if anywhere
+	Discovered *systemTorLocation
+}
+
+const (
+	defaultDiscoveryControlPorts = "9051-9061, 9151"
+	defaultDiscoverySocksPorts   = "9050-9060, 9150"
+
+	// maxDiscoveryPorts bounds the number of ports of each kind
+	// that are probed, so a typo can't make Wahay scan every port
+	maxDiscoveryPorts = 200
+)
+
+var (
+	errInvalidPortList = errors.New("invalid list of ports")
+	errNoTorDiscovered = errors.New("no Tor found on the candidate ports")
+)
+
+// TorDiscoveryFrom returns the discovery configured by the user
+func TorDiscoveryFrom(conf *config.ApplicationConfig) TorDiscovery {
+	d := TorDiscovery{
+		Enabled:      conf.IsTorDiscoveryEnabled(),
+		ControlPorts: portsOrDefault(conf.GetTorDiscoveryControlPorts(), defaultDiscoveryControlPorts),
+		SocksPorts:   portsOrDefault(conf.GetTorDiscoverySocksPorts(), defaultDiscoverySocksPorts),
+	}
+
+	if control, socks := conf.GetDiscoveredTorPorts(); control != 0 && socks != 0 {
+		d.Discovered = &systemTorLocation{"discovered Tor", control, socks}
+	}
+
+	return d
+}
+
+func portsOrDefault(v, def string) []int {
+	if strings.TrimSpace(v) != "" {
+		ports, err := parsePortList(v)
+		if err == nil {
+			return ports
+		}
+		log.Warnf("Ignoring the ports to discover Tor %q: %v", v, err)
+	}
+
+	ports, _ := parsePortList(def)
+	return ports
+}
+
+// parsePortList reads a list like "9051, 9100-9110"
+func parsePortList(v string) ([]int, error) {
+	ports := []int{}
+
+	for _, item := range strings.Split(v, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		bounds := strings.SplitN(item, "-", 2)
+		from, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return nil, errInvalidPortList
+		}
+
+		to := from
+		if len(bounds) == 2 {
+			to, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil {
+				return nil, errInvalidPortList
+			}
+		}
+
+		if !config.CheckPort(from) || !config.CheckPort(to) || to < from {
+			return nil, errInvalidPortList
+		}
+
+		for p := from; p <= to; p++ {
+			ports = append(ports, p)
+		}
+
+		if len(ports) > maxDiscoveryPorts {
+			return nil, errInvalidPortList
+		}
+	}
+
+	return ports, nil
+}
+
+// locations returns the places where the system Tor is looked for,
+// starting with the one discovered the last time
+func (d TorDiscovery) locations() []systemTorLocation {
+	if d.Discovered == nil {
+		return systemTorLocations
+	}
+
+	result := []systemTorLocation{*d.Discovered}
+	for _, loc := range systemTorLocations {
+		if loc.controlPort != d.Discovered.controlPort || loc.socksPort != d.Discovered.socksPort {
+			result = append(result, loc)
+		}
+	}
+
+	return result
+}
+
+// discoverSystemTor probes the candidate ports and checks every
+// combination of control and SOCKS ports that answer, until one of them
+// is a usable Tor. The checks don't retry, since that could take very long
+func discoverSystemTor(ctx context.Context, d TorDiscovery, torCheck TorCheck) (Instance, systemTorLocation, error) {
+	socksPorts := []int{}
+	for _, p := range d.SocksPorts {
+		if httpf.SOCKSHandshake(ctx, defaultControlHost, p) {
+			socksPorts = append(socksPorts, p)
+		}
+	}
+
+	for _, control := range d.ControlPorts {
+		if ctx.Err() != nil {
+			return nil, systemTorLocation{}, ctx.Err()
+		}
+
+		if !httpf.CanConnect(ctx, net.JoinHostPort(defaultControlHost, strconv.Itoa(control))) {
+			continue
+		}
+
+		for _, socks := range socksPorts {
+			loc := systemTorLocation{"discovered Tor", control, socks}
+
+			i, err := systemInstanceAt(ctx, []systemTorLocation{loc}, NoRetry, torCheck)
+			if err == nil {
+				log.Infof("Discovered a usable Tor with the control port %d and the SOCKS port %d", control, socks)
+				return i, loc, nil
+			}
+		}
+	}
+
+	log.Debugf("discoverSystemTor(): %v", errNoTorDiscovered)
+	return nil, systemTorLocation{}, errNoTorDiscovered
+}
@@ -0,0 +1,77 @@
+package tor
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+
+	log "github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+func (s *WahayTorSuite) Test_parsePortList_readsPortsAndRanges(c *C) {
+	ports, err := parsePortList(" 9051, 9100-9102,, 9151 ")
+
+	c.Assert(err, IsNil)
+	c.Assert(ports, DeepEquals, []int{9051, 9100, 9101, 9102, 9151})
+}
+
+func (s *WahayTorSuite) Test_parsePortList_rejectsInvalidLists(c *C) {
+	for _, v := range []string{"abc", "9051-", "9060-9050", "0", "70000", "1-65535"} {
+		_, err := parsePortList(v)
+		c.Assert(err, Equals, errInvalidPortList, Commentf("list %q", v))
+	}
+}
+
+func (s *WahayTorSuite) Test_TorDiscoveryFrom_triesTheDiscoveredPortsFirst(c *C) {
+	conf := &config.ApplicationConfig{}
+	conf.SetTorDiscoveryControlPorts("not ports")
+	conf.SetDiscoveredTorPorts(9200, 9201)
+
+	d := TorDiscoveryFrom(conf)
+
+	c.Assert(d.ControlPorts[0], Equals, 9051)
+	c.Assert(d.locations()[0], Equals, systemTorLocation{"discovered Tor", 9200, 9201})
+	c.Assert(d.locations(), HasLen, len(systemTorLocations)+1)
+	c.Assert(TorDiscoveryFrom(&config.ApplicationConfig{}).locations(), DeepEquals, systemTorLocations)
+}
+
+func (s *WahayTorSuite) Test_discoverSystemTor_findsTheControlPortThatWorks(c *C) {
+	mockAll()
+	defer setDefaultFacades()
+	log.SetOutput(ioutil.Discard)
+
+	tc := &mockTorgoController{}
+	tc.authMethods = []string{"NULL"}
+	tc.getVersionReturn1 = "4.0.4"
+	mocktorgof.onNewController = func(a string) (torgoController, error) {
+		if a == "127.0.0.1:9202" {
+			return tc, nil
+		}
+		return nil, errors.New("no connection possible")
+	}
+	mockhttpf.canConnectReturn = true
+	mockhttpf.socksHandshakeReturn = true
+	mockhttpf.checkConnectionReturn = true
+
+	d := TorDiscovery{Enabled: true, ControlPorts: []int{9200, 9202}, SocksPorts: []int{9300}}
+	ix, loc, err := discoverSystemTor(context.Background(), d, TorCheck{})
+
+	c.Assert(err, IsNil)
+	c.Assert(ix, Not(IsNil))
+	c.Assert(loc.controlPort, Equals, 9202)
+	c.Assert(loc.socksPort, Equals, 9300)
+}
+
+func (s *WahayTorSuite) Test_discoverSystemTor_failsWhenNothingAnswers(c *C) {
+	mockAll()
+	defer setDefaultFacades()
+	log.SetOutput(ioutil.Discard)
+
+	d := TorDiscovery{Enabled: true, ControlPorts: []int{9200}, SocksPorts: []int{9300}}
+	_, _, err := discoverSystemTor(context.Background(), d, TorCheck{})
+
+	c.Assert(err, Equals, errNoTorDiscovered)
+}
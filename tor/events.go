@@ -0,0 +1,179 @@
+package tor
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Event is an asynchronous event sent by Tor through the control port
+type Event struct {
+	Type string
+	Args []string
+}
+
+func parseEvent(msg string) Event {
+	// Only the first line is interesting for the events we subscribe to
+	line := strings.SplitN(msg, "\n", 2)[0]
+	fields := strings.Fields(line)
+
+	if len(fields) == 0 {
+		return Event{}
+	}
+
+	return Event{
+		Type: fields[0],
+		Args: fields[1:],
+	}
+}
+
+// WatchEvents subscribes to the given types of events using a dedicated
+// connection to the control port, since the events would otherwise get
+// mixed with the responses to our regular commands. The given function is
+// called from a separate goroutine for every event until the returned
+// function is called
+func (cntrl *controller) WatchEvents(types []string, f func(Event)) (stop func(), err error) {
+	tc, err := cntrl.tc(net.JoinHostPort(cntrl.torHost, strconv.Itoa(cntrl.torPort)))
+	if err != nil {
+		return nil, err
+	}
+
+	cntrl.Lock()
+	authType := cntrl.authType
+	cntrl.Unlock()
+
+	if authType != nil {
+		err = (*authType)(tc)
+		if err != nil {
+			_ = tc.Close()
+			return nil, err
+		}
+	}
+
+	_, err = tc.Request(fmt.Sprintf("SETEVENTS %s", strings.Join(types, " ")))
+	if err != nil {
+		_ = tc.Close()
+		return nil, err
+	}
+
+	done := make(chan bool)
+
+	go func() {
+		for {
+			msg, err := tc.ReadEvent()
+			if err != nil {
+				select {
+				case <-done:
+				default:
+					log.Debugf("WatchEvents(): %s", err)
+				}
+				return
+			}
+			f(parseEvent(msg))
+		}
+	}()
+
+	return func() {
+		close(done)
+		_ = tc.Close()
+	}, nil
+}
+
+// watchEvents keeps the bandwidth statistics of this Tor and, for our
+// own Tor, its log. A single connection is used for all the events, and
+// it replaces the previous one, which is useless after a restart
+func (i *instance) watchEvents() {
+	i.stopWatchingEvents()
+
+	types := []string{EventBandwidth}
+	if !i.isLocal && i.torLog != nil {
+		types = append(types, torLogEvents...)
+	}
+
+	stop, err := i.GetController().WatchEvents(types, func(e Event) {
+		if e.Type == EventBandwidth {
+			i.bandwidth.addEvent(e)
+		} else if i.torLog != nil {
+			i.torLog.addEvent(e)
+		}
+	})
+	if err != nil {
+		log.Debugf("watchEvents(): %v", err)
+		return
+	}
+
+	i.Lock()
+	defer i.Unlock()
+	i.stopEvents = stop
+}
+
+func (i *instance) watchingEvents() bool {
+	i.Lock()
+	defer i.Unlock()
+	return i.stopEvents != nil
+}
+
+func (i *instance) stopWatchingEvents() {
+	i.Lock()
+	stop := i.stopEvents
+	i.stopEvents = nil
+	i.Unlock()
+
+	if stop != nil {
+		stop()
+	}
+}
+
+// BandwidthEvent contains the number of bytes Tor has read
+// and written during the last second
+type BandwidthEvent struct {
+	Read    int64
+	Written int64
+}
+
+const (
+	// EventBandwidth is sent by Tor every second with the traffic of that second
+	EventBandwidth = "BW"
+	// EventClientStatus is sent by Tor when the client status changes,
+	// for example when circuits can or can't be established anymore
+	EventClientStatus = "STATUS_CLIENT"
+)
+
+// ParseBandwidthEvent returns the bandwidth information of a BW event
+func ParseBandwidthEvent(e Event) (BandwidthEvent, bool) {
+	if e.Type != EventBandwidth || len(e.Args) < 2 {
+		return BandwidthEvent{}, false
+	}
+
+	read, err := strconv.ParseInt(e.Args[0], 10, 64)
+	if err != nil {
+		return BandwidthEvent{}, false
+	}
+
+	written, err := strconv.ParseInt(e.Args[1], 10, 64)
+	if err != nil {
+		return BandwidthEvent{}, false
+	}
+
+	return BandwidthEvent{Read: read, Written: written}, true
+}
+
+// ParseCircuitStatusEvent returns whether Tor can establish circuits,
+// for the client status events that carry that information
+func ParseCircuitStatusEvent(e Event) (established bool, ok bool) {
+	if e.Type != EventClientStatus || len(e.Args) < 2 {
+		return false, false
+	}
+
+	switch e.Args[1] {
+	case "CIRCUIT_ESTABLISHED":
+		return true, true
+	case "CIRCUIT_NOT_ESTABLISHED":
+		return false, true
+	}
+
+	return false, false
+}
@@ -0,0 +1,71 @@
+package tor
+
+import (
+	"errors"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_parseEvent_extractsTheTypeAndArguments(c *C) {
+	e := parseEvent("BW 1024 2048\nsomething else")
+
+	c.Assert(e.Type, Equals, "BW")
+	c.Assert(e.Args, DeepEquals, []string{"1024", "2048"})
+	c.Assert(parseEvent(""), DeepEquals, Event{})
+}
+
+func (s *WahayTorSuite) Test_ParseBandwidthEvent_returnsTheBytesReadAndWritten(c *C) {
+	bw, ok := ParseBandwidthEvent(Event{Type: "BW", Args: []string{"1024", "2048"}})
+
+	c.Assert(ok, Equals, true)
+	c.Assert(bw, Equals, BandwidthEvent{Read: 1024, Written: 2048})
+
+	_, ok = ParseBandwidthEvent(Event{Type: "BW", Args: []string{"x", "2048"}})
+	c.Assert(ok, Equals, false)
+
+	_, ok = ParseBandwidthEvent(Event{Type: "STATUS_CLIENT"})
+	c.Assert(ok, Equals, false)
+}
+
+func (s *WahayTorSuite) Test_ParseCircuitStatusEvent_detectsCircuitChanges(c *C) {
+	established, ok := ParseCircuitStatusEvent(Event{Type: "STATUS_CLIENT", Args: []string{"NOTICE", "CIRCUIT_ESTABLISHED"}})
+	c.Assert(ok, Equals, true)
+	c.Assert(established, Equals, true)
+
+	established, ok = ParseCircuitStatusEvent(Event{Type: "STATUS_CLIENT", Args: []string{"NOTICE", "CIRCUIT_NOT_ESTABLISHED", "REASON=CLOCK_JUMPED"}})
+	c.Assert(ok, Equals, true)
+	c.Assert(established, Equals, false)
+
+	_, ok = ParseCircuitStatusEvent(Event{Type: "STATUS_CLIENT", Args: []string{"NOTICE", "ENOUGH_DIR_INFO"}})
+	c.Assert(ok, Equals, false)
+}
+
+func (s *WahayTorSuite) Test_controller_WatchEvents_subscribesAndDeliversEvents(c *C) {
+	mock := &controllerMock{events: make(chan string, 1)}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	received := make(chan Event)
+	stop, err := cntrl.WatchEvents([]string{"BW", "STATUS_CLIENT"}, func(e Event) {
+		received <- e
+	})
+	c.Assert(err, IsNil)
+
+	mock.events <- "BW 10 20"
+	e := <-received
+	stop()
+
+	c.Assert(mock.requestArgs, DeepEquals, []string{"SETEVENTS BW STATUS_CLIENT"})
+	c.Assert(e, DeepEquals, Event{Type: "BW", Args: []string{"10", "20"}})
+	c.Assert(mock.closeCalled, Equals, true)
+}
+
+func (s *WahayTorSuite) Test_controller_WatchEvents_closesTheConnectionWhenSubscriptionFails(c *C) {
+	mock := &controllerMock{requestReturn2: errors.New("552 Unrecognized event")}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	stop, err := cntrl.WatchEvents([]string{"FOO"}, func(Event) {})
+
+	c.Assert(stop, IsNil)
+	c.Assert(err, ErrorMatches, "552 Unrecognized event")
+	c.Assert(mock.closeCalled, Equals, true)
+}
@@ -1,16 +1,20 @@
 package tor
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
+	"net/textproto"
 	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/digitalautonomy/wahay/config"
 	localExec "github.com/digitalautonomy/wahay/exec"
@@ -36,6 +40,7 @@ type osFacade interface {
 	Stderr() *os.File
 	IsPortAvailable(port int) bool
 	GetRandomPort() int
+	Getpid() int
 }
 
 type filepathFacade interface {
@@ -54,16 +59,18 @@ type filesystemFacade interface {
 	FileExists(string) bool
 	IsADirectory(string) bool
 	TempDir(suffix string) string
-	EnsureDir(string, os.FileMode)
+	EnsureDir(string, os.FileMode) error
 	WriteFile(string, []byte, os.FileMode) error
 }
 
 type torgoFacade interface {
-	NewController(string) (torgoController, error)
+	NewController(ctx context.Context, addr string) (torgoController, error)
 }
 
 type httpFacade interface {
-	CheckConnectionOverTor(host string, port int) bool
+	CheckConnectionOverTor(ctx context.Context, host string, port int, endpoint string) bool
+	SOCKSHandshake(ctx context.Context, host string, port int) bool
+	CanConnect(ctx context.Context, address string) bool
 	HTTPRequest(host string, port int, url string) (string, error)
 }
 
@@ -81,6 +88,7 @@ func setDefaultFacades() {
 	filesystemf = &realFilesystemImplementation{}
 	torgof = &realTorgoImplementation{}
 	httpf = &realHTTPImplementation{}
+	waitBeforeRetry = sleepWithContext
 }
 
 func init() {
@@ -129,6 +137,10 @@ func (*realOsImplementation) GetRandomPort() int {
 	return config.GetRandomPort()
 }
 
+func (*realOsImplementation) Getpid() int {
+	return os.Getpid()
+}
+
 type realFilepathImplementation struct{}
 
 func (*realFilepathImplementation) Glob(p string) ([]string, error) {
@@ -185,8 +197,8 @@ func (*realFilesystemImplementation) TempDir(suffix string) string {
 	return config.CreateTempDir(suffix)
 }
 
-func (*realFilesystemImplementation) EnsureDir(name string, mode os.FileMode) {
-	config.EnsureDir(name, mode)
+func (*realFilesystemImplementation) EnsureDir(name string, mode os.FileMode) error {
+	return os.MkdirAll(name, mode)
 }
 
 func (*realFilesystemImplementation) WriteFile(name string, content []byte, mode os.FileMode) error {
@@ -195,13 +207,96 @@ func (*realFilesystemImplementation) WriteFile(name string, content []byte, mode
 
 type realTorgoImplementation struct{}
 
-func (*realTorgoImplementation) NewController(a string) (torgoController, error) {
-	return torgo.NewController(a)
+// controlPortDialTimeout is the maximum time spent connecting to a control
+// port, so a firewall that drops the packets can't block us forever
+const controlPortDialTimeout = 10 * time.Second
+
+// NewController connects to the control port and asks Tor about the
+// authentication methods. The context only covers this first exchange,
+// the connection itself can be used for as long as needed
+func (*realTorgoImplementation) NewController(ctx context.Context, a string) (torgoController, error) {
+	ctx, cancel := context.WithTimeout(ctx, controlPortDialTimeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", a)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+
+	c := &realTorgoController{&torgo.Controller{Text: textproto.NewConn(conn)}}
+
+	msg, err := c.Request("PROTOCOLINFO 1")
+	if err == nil {
+		c.Controller.AuthMethods, c.CookieFile, err = parseProtocolInfo(msg)
+	}
+
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	_ = conn.SetDeadline(time.Time{})
+
+	return c, nil
+}
+
+// realTorgoController adds to the torgo controller the possibility of
+// sending control port commands that torgo doesn't implement itself
+type realTorgoController struct {
+	*torgo.Controller
+}
+
+func (c *realTorgoController) Request(request string) (string, error) {
+	id, err := c.Text.Cmd("%s", request)
+	if err != nil {
+		return "", err
+	}
+
+	c.Text.StartResponse(id)
+	defer c.Text.EndResponse(id)
+
+	_, msg, err := c.Text.ReadResponse(250)
+	return msg, err
+}
+
+// AuthMethods returns the authentication methods that Tor
+// advertised in its answer to PROTOCOLINFO
+func (c *realTorgoController) AuthMethods() []string {
+	return c.Controller.AuthMethods
+}
+
+// AuthenticateSafeCookie authenticates with the cookie file advertised
+// by Tor, or the one of Tor Browser, using the SAFECOOKIE challenge
+func (c *realTorgoController) AuthenticateSafeCookie() error {
+	cookie, err := readSafeCookie(c.CookieFile)
+	if err != nil {
+		return err
+	}
+	return authenticateWithSafeCookie(c, cookie)
+}
+
+// ReadEvent blocks until Tor sends an asynchronous event on this
+// connection, which only happens after subscribing with SETEVENTS
+func (c *realTorgoController) ReadEvent() (string, error) {
+	_, msg, err := c.Text.ReadResponse(650)
+	return msg, err
+}
+
+func (c *realTorgoController) Close() error {
+	return c.Text.Close()
 }
 
 type realHTTPImplementation struct{}
 
-func (*realHTTPImplementation) CheckConnectionOverTor(host string, port int) bool {
+// torCheckRequestTimeout is the maximum time we wait for every
+// endpoint that can confirm the connection over Tor
+const torCheckRequestTimeout = 15 * time.Second
+
+func (*realHTTPImplementation) CheckConnectionOverTor(ctx context.Context, host string, port int, endpoint string) bool {
 	proxyURL, err := url.Parse("socks5://" + net.JoinHostPort(host, strconv.Itoa(port)))
 	if err != nil {
 		return false
@@ -213,15 +308,29 @@ func (*realHTTPImplementation) CheckConnectionOverTor(host string, port int) boo
 	}
 
 	t := &http.Transport{Dial: dialer.Dial}
-	client := &http.Client{Transport: t}
+	if d, ok := dialer.(proxy.ContextDialer); ok {
+		t.DialContext = d.DialContext
+	}
+	client := &http.Client{Transport: t, Timeout: torCheckRequestTimeout}
 
-	resp, err := client.Get("https://check.torproject.org/api/ip")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return false
+	}
+
+	resp, err := client.Do(req)
 	if err != nil {
 		return false
 	}
 
 	defer resp.Body.Close()
 
+	// Onion services can't be reached without Tor,
+	// so getting any answer is enough
+	if isOnionEndpoint(endpoint) {
+		return true
+	}
+
 	var v checkTorResult
 	err = json.NewDecoder(resp.Body).Decode(&v)
 	if err != nil {
@@ -231,6 +340,50 @@ func (*realHTTPImplementation) CheckConnectionOverTor(host string, port int) boo
 	return v.IsTor
 }
 
+// SOCKSHandshake returns true if there is a SOCKS5 proxy in the given
+// address that accepts connections without authentication
+func (*realHTTPImplementation) SOCKSHandshake(ctx context.Context, host string, port int) bool {
+	ctx, cancel := context.WithTimeout(ctx, torCheckRequestTimeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+	if err != nil {
+		return false
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	// Version 5, one authentication method: no authentication
+	_, err = conn.Write([]byte{5, 1, 0})
+	if err != nil {
+		return false
+	}
+
+	answer := make([]byte, 2)
+	_, err = io.ReadFull(conn, answer)
+	if err != nil {
+		return false
+	}
+
+	return answer[0] == 5 && answer[1] == 0
+}
+
+// CanConnect returns true if a TCP connection to the address can be opened
+func (*realHTTPImplementation) CanConnect(ctx context.Context, address string) bool {
+	ctx, cancel := context.WithTimeout(ctx, torCheckRequestTimeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+	return true
+}
+
 func (*realHTTPImplementation) HTTPRequest(host string, port int, u string) (string, error) {
 	proxyURL, err := url.Parse("socks5://" + net.JoinHostPort(host, strconv.Itoa(port)))
 	if err != nil {
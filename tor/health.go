@@ -0,0 +1,257 @@
+package tor
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/wybiral/torgo"
+)
+
+// HealthEvent is sent by the health monitor when it notices
+// a change in the state of Tor
+type HealthEvent int
+
+const (
+	// HealthTorDied means that the control port of Tor stopped answering
+	HealthTorDied HealthEvent = iota
+	// HealthNetworkDown means that Tor is running but it can't reach the network
+	HealthNetworkDown
+	// HealthRecovered means that Tor works again, and the onion services
+	// that existed before the failure are published again
+	HealthRecovered
+	// HealthRecoveryFailed means that Tor couldn't be brought back. The
+	// monitor keeps trying, but nothing will work until it succeeds
+	HealthRecoveryFailed
+)
+
+func (e HealthEvent) String() string {
+	switch e {
+	case HealthTorDied:
+		return "Tor died"
+	case HealthNetworkDown:
+		return "Tor network down"
+	case HealthRecovered:
+		return "Tor recovered"
+	case HealthRecoveryFailed:
+		return "Tor recovery failed"
+	}
+	return "unknown"
+}
+
+const networkLivenessKey = "network-liveness"
+
+var errInvalidNetworkLiveness = errors.New("invalid network liveness answer")
+
+// GetNetworkLiveness asks Tor whether it thinks the network is reachable.
+// An error means the control port doesn't answer anymore
+func (cntrl *controller) GetNetworkLiveness() (bool, error) {
+	cntrl.Lock()
+	defer cntrl.Unlock()
+
+	return cntrl.getNetworkLiveness()
+}
+
+func (cntrl *controller) getNetworkLiveness() (bool, error) {
+	tc, err := cntrl.authenticatedController()
+	if err != nil {
+		cntrl.dropConnection()
+		return false, err
+	}
+
+	msg, err := tc.Request("GETINFO " + networkLivenessKey)
+	if err != nil {
+		cntrl.dropConnection()
+		return false, err
+	}
+
+	for _, line := range strings.Split(msg, "\n") {
+		parts := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(parts) == 2 && parts[0] == networkLivenessKey {
+			return parts[1] == "up", nil
+		}
+	}
+
+	return false, errInvalidNetworkLiveness
+}
+
+// RestoreOnionServices publishes again, with the same addresses, the onion
+// services created through this controller. It's meant to be used after
+// Tor has been restarted, so it always opens a new control connection
+func (cntrl *controller) RestoreOnionServices() error {
+	cntrl.Lock()
+	defer cntrl.Unlock()
+
+	cntrl.dropConnection()
+
+	tc, err := cntrl.authenticatedController()
+	if err != nil {
+		return err
+	}
+
+	for serviceID, p := range cntrl.published {
+		onion := &torgo.Onion{
+			Ports:          p.ports,
+			PrivateKeyType: onionKeyType,
+			PrivateKey:     p.privateKey,
+		}
+
+		err = addOnion(tc, onion, p.options)
+		if err != nil {
+			log.Errorf("RestoreOnionServices(): can't restore %s: %v", serviceID, err)
+			return err
+		}
+
+		log.Infof("RestoreOnionServices(): restored %s", serviceID)
+	}
+
+	return nil
+}
+
+// dropConnection closes the control connection, so the next command
+// opens a new one. It must be called with the lock held
+func (cntrl *controller) dropConnection() {
+	if cntrl.c != nil {
+		_ = cntrl.c.Close()
+		cntrl.c = nil
+	}
+}
+
+type healthMonitor struct {
+	control func() Control
+	restart func() error
+	notify  func(HealthEvent)
+
+	torDown        bool
+	networkDown    bool
+	recoveryFailed bool
+}
+
+// check verifies the state of Tor once. Every event is sent only when
+// the state changes, so a long outage doesn't flood the listener
+func (m *healthMonitor) check() {
+	live, err := m.control().GetNetworkLiveness()
+	if err != nil {
+		m.recover(err)
+		return
+	}
+
+	if m.torDown {
+		m.torDown = false
+		m.recoveryFailed = false
+		m.notify(HealthRecovered)
+	}
+
+	switch {
+	case !live && !m.networkDown:
+		m.networkDown = true
+		m.notify(HealthNetworkDown)
+	case live && m.networkDown:
+		m.networkDown = false
+		m.notify(HealthRecovered)
+	}
+}
+
+func (m *healthMonitor) recover(cause error) {
+	if !m.torDown {
+		log.Errorf("healthMonitor: Tor doesn't answer: %v", cause)
+		m.torDown = true
+		m.notify(HealthTorDied)
+	}
+
+	err := m.restart()
+	if err == nil {
+		err = m.control().RestoreOnionServices()
+	}
+
+	if err != nil {
+		log.Debugf("healthMonitor: recovery failed: %v", err)
+		if !m.recoveryFailed {
+			m.recoveryFailed = true
+			m.notify(HealthRecoveryFailed)
+		}
+		return
+	}
+
+	m.torDown = false
+	m.recoveryFailed = false
+	m.networkDown = false
+	m.notify(HealthRecovered)
+}
+
+// WatchHealth checks Tor every interval, restarting it and publishing
+// the onion services again when it dies. The given function is called
+// from a separate goroutine with every change noticed, until the
+// returned function is called or the instance is destroyed
+func (i *instance) WatchHealth(interval time.Duration, f func(HealthEvent)) (stop func()) {
+	m := &healthMonitor{
+		control: i.GetController,
+		restart: i.restart,
+		notify:  f,
+	}
+
+	done := make(chan bool)
+	var once sync.Once
+
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-t.C:
+				m.check()
+			}
+		}
+	}()
+
+	stop = func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+
+	i.Lock()
+	defer i.Unlock()
+	i.stopMonitors = append(i.stopMonitors, stop)
+
+	return stop
+}
+
+// restart starts our Tor again. The system Tor is not under our control,
+// so in that case we can only wait for somebody else to restart it
+func (i *instance) restart() error {
+	err := i.startAgain()
+	if err != nil {
+		return err
+	}
+
+	i.watchEvents()
+
+	return nil
+}
+
+func (i *instance) startAgain() error {
+	i.Lock()
+	defer i.Unlock()
+
+	if i.isLocal {
+		return nil
+	}
+
+	if i.runningTor != nil {
+		i.runningTor.closeTorService()
+		i.runningTor = nil
+	}
+
+	err := i.Start()
+	if err != nil {
+		return err
+	}
+
+	return i.waitForControlPort(torStartupTimeout)
+}
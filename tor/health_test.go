@@ -0,0 +1,162 @@
+package tor
+
+import (
+	"errors"
+	"sync"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_controller_GetNetworkLiveness_parsesTheAnswerOfTor(c *C) {
+	mock := &controllerMock{requestReturn1: "network-liveness=up\nOK"}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	live, err := cntrl.GetNetworkLiveness()
+	c.Assert(err, IsNil)
+	c.Assert(live, Equals, true)
+	c.Assert(mock.requestArgs, DeepEquals, []string{"GETINFO network-liveness"})
+
+	mock.requestReturn1 = "network-liveness=down\nOK"
+	live, err = cntrl.GetNetworkLiveness()
+	c.Assert(err, IsNil)
+	c.Assert(live, Equals, false)
+}
+
+func (s *WahayTorSuite) Test_controller_GetNetworkLiveness_dropsTheConnectionWhenTorDoesntAnswer(c *C) {
+	mock := &controllerMock{requestReturn2: errors.New("connection reset")}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	_, err := cntrl.GetNetworkLiveness()
+	c.Assert(err, ErrorMatches, "connection reset")
+	c.Assert(mock.closeCalled, Equals, true)
+	c.Assert(cntrl.c, IsNil)
+}
+
+func (s *WahayTorSuite) Test_controller_RestoreOnionServices_publishesTheServicesWithTheSameKey(c *C) {
+	mock := &controllerMock{
+		addOnionAddServiceInfo: "someid",
+		addOnionAddPrivateKey:  "secretkey",
+	}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	serviceID, err := cntrl.CreateNewOnionService("127.0.0.1", 4567, 123)
+	c.Assert(err, IsNil)
+	c.Assert(serviceID, Equals, "someid.onion")
+
+	mock.addOnionArg1 = nil
+	err = cntrl.RestoreOnionServices()
+	c.Assert(err, IsNil)
+
+	c.Assert(mock.addOnionArg1, NotNil)
+	c.Assert(mock.addOnionArg1.PrivateKeyType, Equals, "ED25519-V3")
+	c.Assert(mock.addOnionArg1.PrivateKey, Equals, "secretkey")
+	c.Assert(mock.addOnionArg1.Ports, DeepEquals, map[int]string{123: "127.0.0.1:4567"})
+
+	_ = cntrl.DeleteOnionService(serviceID)
+	c.Assert(cntrl.published, HasLen, 0)
+}
+
+func (s *WahayTorSuite) Test_controller_RestoreOnionServices_keepsTheClientAuthorization(c *C) {
+	mock := &controllerMock{requestReturn1: "ServiceID=someid\nPrivateKey=ED25519-V3:secretkey\nOK"}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	serviceID, err := cntrl.CreateNewOnionServiceWithMultiplePorts([]OnionPort{
+		{ServicePort: 123, DestinationPort: 4567, DestinationHost: "127.0.0.1"},
+	}, WithClientAuthorization("pubkey"))
+	c.Assert(err, IsNil)
+	defer func() {
+		_ = cntrl.DeleteOnionService(serviceID)
+	}()
+
+	err = cntrl.RestoreOnionServices()
+	c.Assert(err, IsNil)
+
+	c.Assert(mock.requestArgs, HasLen, 2)
+	c.Assert(mock.requestArgs[1], Equals,
+		"ADD_ONION ED25519-V3:secretkey Flags=V3Auth Port=123,127.0.0.1:4567 ClientAuthV3=pubkey")
+}
+
+func (s *WahayTorSuite) Test_controller_canBeUsedFromSeveralGoroutines(c *C) {
+	mock := &controllerMock{
+		addOnionAddServiceInfo: "someid",
+		addOnionAddPrivateKey:  "secretkey",
+		requestReturn1:         "network-liveness=up\nOK",
+	}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	const times = 10
+	var wg sync.WaitGroup
+	for i := 0; i < times; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			_, _ = cntrl.CreateNewOnionService("127.0.0.1", 4567, 123)
+		}()
+		go func() {
+			defer wg.Done()
+			_ = cntrl.RestoreOnionServices()
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = cntrl.GetNetworkLiveness()
+		}()
+	}
+	wg.Wait()
+
+	for i := 0; i < times; i++ {
+		_ = cntrl.DeleteOnionService("someid.onion")
+	}
+	c.Assert(cntrl.published, HasLen, 0)
+}
+
+func (s *WahayTorSuite) Test_healthMonitor_restartsTorWhenItDies(c *C) {
+	mock := &controllerMock{requestReturn2: errors.New("connection refused")}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	restarts := 0
+	events := []HealthEvent{}
+	m := &healthMonitor{
+		control: func() Control { return cntrl },
+		restart: func() error {
+			restarts++
+			return nil
+		},
+		notify: func(e HealthEvent) {
+			events = append(events, e)
+		},
+	}
+
+	m.check()
+
+	c.Assert(restarts, Equals, 1)
+	c.Assert(events, DeepEquals, []HealthEvent{HealthTorDied, HealthRecovered})
+}
+
+func (s *WahayTorSuite) Test_healthMonitor_reportsEveryChangeOnlyOnce(c *C) {
+	mock := &controllerMock{requestReturn2: errors.New("connection refused")}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	events := []HealthEvent{}
+	m := &healthMonitor{
+		control: func() Control { return cntrl },
+		restart: func() error { return errors.New("can't start") },
+		notify: func(e HealthEvent) {
+			events = append(events, e)
+		},
+	}
+
+	m.check()
+	m.check()
+	c.Assert(events, DeepEquals, []HealthEvent{HealthTorDied, HealthRecoveryFailed})
+
+	mock.requestReturn1 = "network-liveness=down\nOK"
+	mock.requestReturn2 = nil
+	m.check()
+	m.check()
+	c.Assert(events[2:], DeepEquals, []HealthEvent{HealthRecovered, HealthNetworkDown})
+
+	mock.requestReturn1 = "network-liveness=up\nOK"
+	m.check()
+	m.check()
+	c.Assert(events[4:], DeepEquals, []HealthEvent{HealthRecovered})
+}
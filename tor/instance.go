@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"os/exec"
 	"path/filepath"
 	"strconv"
@@ -33,7 +35,16 @@ type Instance interface {
 	GetController() Control
 	HTTPrequest(url string) (string, error)
 	NewService(string, []string, ModifyCommand) (Service, error)
-	NewOnionServiceWithMultiplePorts([]OnionPort) (Onion, error)
+	NewOnionServiceWithMultiplePorts([]OnionPort, ...OnionOption) (Onion, error)
+	AddClientAuthorization(serviceID, privateKey string) error
+	WatchHealth(interval time.Duration, f func(HealthEvent)) (stop func())
+	TorLog() []string
+	ConnectivityReport() *ConnectivityReport
+	RotateCircuits() error
+	NetworkStatus() (NetworkStatus, error)
+	BandwidthStats() BandwidthStats
+	SOCKSAddress() string
+	ControlAddress() string
 }
 
 type instance struct {
@@ -48,10 +59,22 @@ type instance struct {
 	useCookie       bool
 	isLocal         bool
 	enableLogs      bool
+	singleHop       bool
+	extraOptions    map[string]string
+	nodePolicy      NodePolicy
+	proxy           UpstreamProxy
+	bridges         Bridges
+	limits          ResourceLimits
+	torLog          *torLog
+	bandwidth       bandwidthCounter
+	owner           torgoController
+	report          *ConnectivityReport
 	controller      Control
 	runningTor      *runningTor
 	binary          *binary
 	onInitCallbacks []func(Instance)
+	stopMonitors    []func()
+	stopEvents      func()
 }
 
 func (i *instance) setBinary(b *binary) {
@@ -111,11 +134,16 @@ func (s *onion) Delete() error {
 }
 
 // NewOnionServiceWithMultiplePorts creates a new Onion service for the current Tor controller
-func (i *instance) NewOnionServiceWithMultiplePorts(ports []OnionPort) (Onion, error) {
+func (i *instance) NewOnionServiceWithMultiplePorts(ports []OnionPort, opts ...OnionOption) (Onion, error) {
 	log.Debugf("NewOnionServiceWithMultiplePorts(%v)", ports)
 	controller := i.GetController()
 
-	serviceID, err := controller.CreateNewOnionServiceWithMultiplePorts(ports)
+	if i.singleHop {
+		// Tor refuses to create anonymous onion services in single hop mode
+		opts = append(opts, WithNonAnonymous())
+	}
+
+	serviceID, err := controller.CreateNewOnionServiceWithMultiplePorts(ports, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -146,15 +174,41 @@ var (
 // NewInstance initializes and returns the Instance for working with Tor.
 // This function should be called only once during the system initialization
 func NewInstance(conf *config.ApplicationConfig, onInit func(Instance)) (Instance, error) {
+	return NewInstanceContext(context.Background(), conf, onInit)
+}
+
+// NewInstanceContext works like NewInstance, but gives up as soon as ctx
+// is done, for example because the user closed Wahay while Tor was starting
+func NewInstanceContext(ctx context.Context, conf *config.ApplicationConfig, onInit func(Instance)) (Instance, error) {
+	// A remote Tor is chosen explicitly by the user, so we
+	// never use any other Tor instead of it
+	if remote := RemoteTorFrom(conf); remote.configured() {
+		return remoteInstance(ctx, remote, RetryPolicyFrom(conf), TorCheckFrom(conf))
+	}
+
 	// Checking if the system Tor can be used.
 	// This should work for system like Tails, where Tor is
 	// already available in the system.
-	i, err := systemInstance()
+	discovery := TorDiscoveryFrom(conf)
+	i, err := systemInstanceAt(ctx, discovery.locations(), RetryPolicyFrom(conf), TorCheckFrom(conf))
 	if err == nil {
 		log.Infof("Using System Tor")
 		return i, nil
 	}
 
+	if discovery.Enabled && ctx.Err() == nil {
+		var loc systemTorLocation
+		i, loc, err = discoverSystemTor(ctx, discovery, TorCheckFrom(conf))
+		if err == nil {
+			conf.SetDiscoveredTorPorts(loc.controlPort, loc.socksPort)
+			return i, nil
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
 	b, err := findTorBinary(conf)
 	if b == nil || err != nil {
 		if err != nil {
@@ -165,7 +219,7 @@ func NewInstance(conf *config.ApplicationConfig, onInit func(Instance)) (Instanc
 
 	log.Infof("Using Tor binary found in: %s", b.path)
 
-	i, err = getOurInstance(b, conf, onInit)
+	i, err = getOurInstance(ctx, b, conf, onInit)
 	if err != nil {
 		log.Debugf("tor.NewInstance() error: %s", err)
 		return nil, err
@@ -176,27 +230,31 @@ func NewInstance(conf *config.ApplicationConfig, onInit func(Instance)) (Instanc
 
 const torStartupTimeout = 2 * time.Minute
 
-func systemInstance() (Instance, error) {
+func systemInstance(ctx context.Context, retry RetryPolicy, torCheck TorCheck) (Instance, error) {
+	return systemInstanceAt(ctx, systemTorLocations, retry, torCheck)
+}
+
+func systemInstanceAt(ctx context.Context, locations []systemTorLocation, retry RetryPolicy, torCheck TorCheck) (Instance, error) {
 	var (
-		authType           string
-		defaultControlPort int
-		total              error
-		partial            error
+		report   *ConnectivityReport
+		location systemTorLocation
 	)
 
-	for i, port := range defaultControlPorts {
-		checker := newDefaultChecker(port)
+	for i, loc := range locations {
+		checker := newDefaultChecker(loc, retry, torCheck)
 
-		log.Debugf("checking system instance...")
-		authType, total, partial = checker.check()
+		log.Debugf("checking system instance on port %d...", loc.controlPort)
+		report = checker.check(ctx)
+		log.Debugf("%s on port %d: %s", loc.name, loc.controlPort, report)
 
-		if total == nil && partial == nil {
-			defaultControlPort = port
+		if report.OK() {
+			log.Infof("Found a usable %s on port %d", loc.name, loc.controlPort)
+			location = loc
 			break
 		}
 
-		if i == len(defaultControlPorts)-1 {
-			log.Debugf("system instance not possible to use, because: %v - %v", total, partial)
+		if i == len(locations)-1 {
+			log.Debugf("system instance not possible to use, because: %v", report.Err())
 			return nil, errors.New("error: we can't use system Tor instance")
 		}
 	}
@@ -204,23 +262,24 @@ func systemInstance() (Instance, error) {
 	i := &instance{
 		started:     true,
 		controlHost: defaultControlHost,
-		controlPort: defaultControlPort,
-		socksPort:   defaultSocksPort,
+		controlPort: location.controlPort,
+		socksPort:   location.socksPort,
 		useCookie:   false,
 		isLocal:     true,
+		report:      report,
 	}
 
-	if authType == "cookie" {
+	if report.AuthMethod == "cookie" {
 		i.useCookie = true
-	} else if authType == "password" {
+	} else if report.AuthMethod == "password" {
 		i.password = *config.TorControlPassword
 	}
 
 	return i, nil
 }
 
-func getOurInstance(b *binary, conf *config.ApplicationConfig, onInit func(Instance)) (*instance, error) {
-	i, _ := newInstance(conf.IsLogsEnabled())
+func getOurInstance(ctx context.Context, b *binary, conf *config.ApplicationConfig, onInit func(Instance)) (*instance, error) {
+	i, _ := newInstance(conf)
 
 	if onInit != nil {
 		i.onInit(onInit)
@@ -234,33 +293,48 @@ func getOurInstance(b *binary, conf *config.ApplicationConfig, onInit func(Insta
 		return nil, err
 	}
 
-	checker := newCustomChecker(i.controlHost, i.socksPort, i.controlPort)
+	checker := newCustomChecker(i.controlHost, i.socksPort, i.controlPort, TorCheckFrom(conf), i.proxy)
 
 	timeout := time.Now().Add(torStartupTimeout)
 	for {
-		time.Sleep(3 * time.Second)
+		if err := sleepWithContext(ctx, 3*time.Second); err != nil {
+			i.Destroy()
+			return nil, err
+		}
+
+		report := checker.check(ctx)
+		i.setConnectivityReport(report)
 
-		_, errTotal, errPartial := checker.check()
-		if errTotal != nil {
-			return nil, errTotal
+		if report.Fatal != nil {
+			log.Debugf("Our Tor can't be used: %s", report)
+			return nil, report.Fatal
 		}
 
 		if time.Now().After(timeout) {
 			return nil, ErrTorConnectionTimeout
 		}
 
-		if errPartial == nil {
+		if report.Partial == nil {
+			if err := i.takeOwnership(); err != nil {
+				log.Warnf("Our Tor will only exit when it notices that Wahay is gone: %v", err)
+			}
+			i.watchEvents()
 			return i, nil
 		}
 
 		log.WithFields(log.Fields{
 			"time": time.Now(),
-		}).Error(fmt.Sprintf("The following error occurred while checking Tor connectivity: %s", errPartial.Error()))
+		}).Error(fmt.Sprintf("The following error occurred while checking Tor connectivity: %s", report))
 	}
 }
 
-func newInstance(enableLogs bool) (*instance, error) {
-	i := createOurInstance(enableLogs)
+func newInstance(conf *config.ApplicationConfig) (*instance, error) {
+	i := createOurInstance(conf.IsLogsEnabled())
+	i.extraOptions = conf.GetTorExtraOptions()
+	i.nodePolicy = NodePolicyFrom(conf)
+	i.proxy = UpstreamProxyFrom(conf)
+	i.bridges = BridgesFrom(conf)
+	i.limits = ResourceLimitsFrom(conf)
 
 	err := i.createConfigFile()
 
@@ -285,7 +359,7 @@ func (i *instance) exec(mumbleBinary string, args []string, pre ModifyCommand) (
 		pre(cmd)
 	}
 
-	if *config.Debug {
+	if *config.Debug && cmd.Stdout == nil {
 		cmd.Stdout = osf.Stdout()
 		cmd.Stderr = osf.Stderr()
 	}
@@ -310,7 +384,12 @@ func (i *instance) Start() error {
 		return ErrTorInstanceCantStart
 	}
 
-	state, err := i.binary.start(i.configFile)
+	var out io.Writer
+	if i.torLog != nil {
+		out = i.torLog
+	}
+
+	state, err := i.binary.start(i.configFile, out, i.limits)
 	if err != nil {
 		return err
 	}
@@ -323,6 +402,16 @@ func (i *instance) Start() error {
 	return nil
 }
 
+// SOCKSAddress returns the address of the SOCKS port of this Tor
+func (i *instance) SOCKSAddress() string {
+	return net.JoinHostPort(i.controlHost, strconv.Itoa(i.socksPort))
+}
+
+// ControlAddress returns where Tor listens for control connections
+func (i *instance) ControlAddress() string {
+	return net.JoinHostPort(i.controlHost, strconv.Itoa(i.controlPort))
+}
+
 // GetController returns a controller for the instance `i`
 func (i *instance) GetController() Control {
 	log.Debugf("instance(%#v).GetController()", i)
@@ -342,6 +431,12 @@ func (i *instance) GetController() Control {
 
 // Destroy close our instance running
 func (i *instance) Destroy() {
+	for _, stop := range i.stopMonitors {
+		stop()
+	}
+	i.stopMonitors = nil
+	i.stopWatchingEvents()
+
 	if i.controller != nil {
 		i.controller.DeleteOnionServices()
 		i.controller = nil
@@ -352,6 +447,8 @@ func (i *instance) Destroy() {
 		i.runningTor = nil
 	}
 
+	i.releaseOwnership()
+
 	if i.configFile != "" {
 		log.Debugf("Removing custom Tor temp dir: %s", filepath.Dir(i.configFile))
 		err := osf.RemoveAll(filepath.Dir(i.configFile))
@@ -380,6 +477,7 @@ func createOurInstance(enableLogs bool) *instance {
 		password:      "", // our instance don't use authentication with password
 		useCookie:     true,
 		isLocal:       false,
+		torLog:        newTorLog(),
 		controller:    nil,
 	}
 
@@ -401,7 +499,9 @@ func findAvailableTorPorts() (controlPort, routePort int) {
 }
 
 func (i *instance) createConfigFile() error {
-	filesystemf.EnsureDir(i.dataDirectory, 0700)
+	if err := filesystemf.EnsureDir(i.dataDirectory, 0700); err != nil {
+		return err
+	}
 	log.Printf("Saving the config file to: %s\n", i.configFile)
 	return i.writeToFile()
 }
@@ -412,8 +512,15 @@ func (i *instance) getConfigFileContents() []byte {
 		cookieFile = 0
 	}
 
+	socksPort := i.socksPort
+	if i.singleHop {
+		// A non anonymous Tor can't be used as a client,
+		// so we have to disable the SOCKS port
+		socksPort = 0
+	}
+
 	replacements := map[string]string{
-		"PORT":        strconv.Itoa(i.socksPort),
+		"PORT":        strconv.Itoa(socksPort),
 		"CONTROLPORT": strconv.Itoa(i.controlPort),
 		"DATADIR":     i.dataDirectory,
 		"COOKIE":      strconv.Itoa(cookieFile),
@@ -421,6 +528,32 @@ func (i *instance) getConfigFileContents() []byte {
 
 	content := getTorrc()
 
+	if i.singleHop {
+		content = fmt.Sprintf("%s\n%s", content, singleHopConfig)
+	}
+
+	content = fmt.Sprintf("%s\n%s", content, i.ownerConfig())
+
+	if proxy := i.proxy.torrc(); proxy != "" {
+		content = fmt.Sprintf("%s\n## Upstream proxy\n%s", content, proxy)
+	}
+
+	if limits := i.limits.torrc(); limits != "" {
+		content = fmt.Sprintf("%s\n## Resource limits\n%s", content, limits)
+	}
+
+	if bridges := i.bridges.torrc(); bridges != "" {
+		content = fmt.Sprintf("%s\n## Bridges\n%s", content, bridges)
+	}
+
+	if policy := i.nodePolicy.torrc(); policy != "" {
+		content = fmt.Sprintf("%s\n## Relays selected by country\n%s", content, policy)
+	}
+
+	if extra := torrcOptions(i.extraOptions); extra != "" {
+		content = fmt.Sprintf("%s\n## Options from the Wahay configuration\n%s", content, extra)
+	}
+
 	if i.enableLogs {
 		noticeLog := filepath.Join(filepath.Dir(i.configFile), "notice.log")
 		logFile := filepath.Join(filepath.Dir(i.configFile), "debug.log")
@@ -0,0 +1,70 @@
+package tor
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+var (
+	// ErrLatencyProbeTimeout is returned when the onion
+	// service doesn't answer a latency probe in time
+	ErrLatencyProbeTimeout = errors.New("the onion service didn't answer in time")
+
+	// ErrLatencyProbeUnreachable is returned when Tor
+	// can't reach the onion service of a latency probe
+	ErrLatencyProbeUnreachable = errors.New("the onion service can't be reached")
+)
+
+// latencyProbePort is a port the onion services of the meetings never
+// publish. Onion services close the streams to those ports as soon as
+// they arrive, so the probes never reach Mumble or the connection gate
+const latencyProbePort = "1"
+
+// ProbeLatency measures the round trip through the Tor listening on
+// socksAddress to the onion service with the given address. It travels
+// over a circuit to the same onion service as the meeting, so it's
+// close to what the voice of the participants goes through
+func ProbeLatency(ctx context.Context, socksAddress, onion string) (time.Duration, error) {
+	dialer, err := proxy.SOCKS5("tcp", socksAddress, nil, &net.Dialer{})
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	conn, err := dialer.(proxy.ContextDialer).DialContext(ctx, "tcp", net.JoinHostPort(onion, latencyProbePort))
+	elapsed := time.Since(start)
+
+	if err == nil {
+		_ = conn.Close()
+		return elapsed, nil
+	}
+
+	if ctx.Err() != nil {
+		return 0, ErrLatencyProbeTimeout
+	}
+
+	if answeredByOnionService(err) {
+		return elapsed, nil
+	}
+
+	return 0, ErrLatencyProbeUnreachable
+}
+
+// answeredByOnionService tells whether the error of a probe is the
+// onion service closing the stream, and not Tor failing to reach it
+func answeredByOnionService(err error) bool {
+	var op *net.OpError
+	if !errors.As(err, &op) || op.Err == nil {
+		return false
+	}
+
+	switch op.Err.Error() {
+	case "unknown error general SOCKS server failure", "unknown error connection refused":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package tor
+
+import (
+	"context"
+	"io"
+	"net"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+// fakeSOCKSServer answers every connection request with the given reply
+func fakeSOCKSServer(c *C, reply byte) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+
+	go func() {
+		defer l.Close()
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		greeting := make([]byte, 3)
+		if _, err := io.ReadFull(conn, greeting); err != nil {
+			return
+		}
+		_, _ = conn.Write([]byte{5, 0})
+
+		request := make([]byte, 5)
+		if _, err := io.ReadFull(conn, request); err != nil {
+			return
+		}
+		rest := make([]byte, int(request[4])+2)
+		if _, err := io.ReadFull(conn, rest); err != nil {
+			return
+		}
+		_, _ = conn.Write([]byte{5, reply, 0, 1, 0, 0, 0, 0, 0, 0})
+	}()
+
+	return l.Addr().String()
+}
+
+const probedOnion = "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz234567.onion"
+
+func (s *WahayTorSuite) Test_ProbeLatency_measuresTheAnswerOfTheOnionService(c *C) {
+	address := fakeSOCKSServer(c, 0x01)
+
+	d, err := ProbeLatency(context.Background(), address, probedOnion)
+
+	c.Assert(err, IsNil)
+	c.Assert(d > 0, Equals, true)
+}
+
+func (s *WahayTorSuite) Test_ProbeLatency_failsWhenTorCantReachTheOnionService(c *C) {
+	address := fakeSOCKSServer(c, 0x04)
+
+	_, err := ProbeLatency(context.Background(), address, probedOnion)
+
+	c.Assert(err, Equals, ErrLatencyProbeUnreachable)
+}
+
+func (s *WahayTorSuite) Test_ProbeLatency_failsWhenTorIsNotListening(c *C) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	address := l.Addr().String()
+	l.Close()
+
+	_, err = ProbeLatency(context.Background(), address, probedOnion)
+
+	c.Assert(err, Equals, ErrLatencyProbeUnreachable)
+}
+
+func (s *WahayTorSuite) Test_ProbeLatency_timesOutWhenTheOnionServiceDoesntAnswer(c *C) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	defer l.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = ProbeLatency(ctx, l.Addr().String(), probedOnion)
+
+	c.Assert(err, Equals, ErrLatencyProbeTimeout)
+}
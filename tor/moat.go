@@ -0,0 +1,174 @@
+package tor
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// The Tor Project hides Moat behind a CDN, so that it can be reached where
+// bridges.torproject.org is blocked. The connection goes to the front
+// domain and the real host only appears inside the encrypted request.
+// These are the values Tor Browser uses
+const (
+	defaultMoatURL   = "https://www.phpmyadmin.net/moat"
+	defaultMoatHost  = "1723079976.rsc.cdn77.org"
+	moatContentType  = "application/vnd.api+json"
+	moatVersion      = "0.1.0"
+	moatTimeout      = 60 * time.Second
+	moatMaxResponse  = 1 << 20
+	moatWrongCaptcha = 419
+)
+
+var (
+	// ErrMoatWrongSolution is returned when the solution of the
+	// captcha is not correct. A new captcha has to be requested
+	ErrMoatWrongSolution = errors.New("the solution of the captcha is not correct")
+
+	// ErrMoatUnavailable is returned when the bridges can't be
+	// requested from the Tor Project
+	ErrMoatUnavailable = errors.New("the bridges can't be requested right now")
+)
+
+// MoatChallenge is the captcha that has to be solved to get bridges
+type MoatChallenge struct {
+	Transport string
+	// Image is the captcha, usually a JPEG image
+	Image     []byte
+	challenge string
+}
+
+// MoatClient requests bridges from the Tor Project. It doesn't go through
+// Tor, since it's used when Tor can't reach the network
+type MoatClient struct {
+	URL    string
+	Host   string
+	client *http.Client
+}
+
+// NewMoatClient returns a client of the Moat service of the Tor Project
+func NewMoatClient() *MoatClient {
+	return &MoatClient{
+		URL:    defaultMoatURL,
+		Host:   defaultMoatHost,
+		client: &http.Client{Timeout: moatTimeout},
+	}
+}
+
+type moatData struct {
+	ID        string   `json:"id,omitempty"`
+	Type      string   `json:"type"`
+	Version   string   `json:"version"`
+	Supported []string `json:"supported,omitempty"`
+	Transport string   `json:"transport,omitempty"`
+	Image     string   `json:"image,omitempty"`
+	Challenge string   `json:"challenge,omitempty"`
+	Solution  string   `json:"solution,omitempty"`
+	QRCode    string   `json:"qrcode,omitempty"`
+	Bridges   []string `json:"bridges,omitempty"`
+}
+
+type moatError struct {
+	Code   int    `json:"code"`
+	Detail string `json:"detail"`
+}
+
+type moatMessage struct {
+	Data   []moatData  `json:"data,omitempty"`
+	Errors []moatError `json:"errors,omitempty"`
+}
+
+func (m *MoatClient) request(ctx context.Context, endpoint string, data moatData) (moatData, error) {
+	body, err := json.Marshal(moatMessage{Data: []moatData{data}})
+	if err != nil {
+		return moatData{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.URL+"/"+endpoint, bytes.NewReader(body))
+	if err != nil {
+		return moatData{}, err
+	}
+	req.Host = m.Host
+	req.Header.Set("Content-Type", moatContentType)
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return moatData{}, fmt.Errorf("%w: %v", ErrMoatUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	content, err := ioutil.ReadAll(http.MaxBytesReader(nil, resp.Body, moatMaxResponse))
+	if err != nil {
+		return moatData{}, fmt.Errorf("%w: %v", ErrMoatUnavailable, err)
+	}
+
+	var msg moatMessage
+	if err := json.Unmarshal(content, &msg); err != nil {
+		return moatData{}, fmt.Errorf("%w: unexpected answer with status %d", ErrMoatUnavailable, resp.StatusCode)
+	}
+
+	if len(msg.Errors) > 0 {
+		if msg.Errors[0].Code == moatWrongCaptcha {
+			return moatData{}, ErrMoatWrongSolution
+		}
+		return moatData{}, fmt.Errorf("%w: %s", ErrMoatUnavailable, msg.Errors[0].Detail)
+	}
+
+	if len(msg.Data) == 0 {
+		return moatData{}, fmt.Errorf("%w: empty answer", ErrMoatUnavailable)
+	}
+
+	return msg.Data[0], nil
+}
+
+// FetchChallenge requests a captcha to get obfs4 bridges
+func (m *MoatClient) FetchChallenge(ctx context.Context) (*MoatChallenge, error) {
+	d, err := m.request(ctx, "fetch", moatData{
+		Type:      "client-transports",
+		Version:   moatVersion,
+		Supported: []string{obfs4Transport},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := base64.StdEncoding.DecodeString(d.Image)
+	if err != nil || d.Challenge == "" {
+		return nil, fmt.Errorf("%w: invalid captcha", ErrMoatUnavailable)
+	}
+
+	return &MoatChallenge{
+		Transport: d.Transport,
+		Image:     image,
+		challenge: d.Challenge,
+	}, nil
+}
+
+// CheckSolution sends the solution of the captcha and returns
+// the bridge lines given in exchange
+func (m *MoatClient) CheckSolution(ctx context.Context, c *MoatChallenge, solution string) ([]string, error) {
+	d, err := m.request(ctx, "check", moatData{
+		ID:        "2",
+		Type:      "moat-solution",
+		Version:   moatVersion,
+		Transport: c.Transport,
+		Challenge: c.challenge,
+		Solution:  solution,
+		QRCode:    "false",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(d.Bridges) == 0 {
+		return nil, fmt.Errorf("%w: no bridges were given", ErrMoatUnavailable)
+	}
+
+	return d.Bridges, nil
+}
@@ -0,0 +1,72 @@
+package tor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "gopkg.in/check.v1"
+)
+
+func newMoatTestServer(c *C, answer func(endpoint string, d moatData) string) (*MoatClient, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Host, Equals, "moat.example.org")
+		c.Check(r.Header.Get("Content-Type"), Equals, moatContentType)
+
+		var msg moatMessage
+		c.Check(json.NewDecoder(r.Body).Decode(&msg), IsNil)
+		c.Assert(msg.Data, HasLen, 1)
+
+		_, _ = w.Write([]byte(answer(r.URL.Path, msg.Data[0])))
+	}))
+
+	return &MoatClient{URL: server.URL + "/moat", Host: "moat.example.org", client: server.Client()}, server
+}
+
+func (s *WahayTorSuite) Test_MoatClient_getsBridgesForTheSolutionOfTheCaptcha(c *C) {
+	m, server := newMoatTestServer(c, func(endpoint string, d moatData) string {
+		switch endpoint {
+		case "/moat/fetch":
+			c.Check(d.Supported, DeepEquals, []string{"obfs4"})
+			return `{"data":[{"id":"1","type":"moat-challenge","version":"0.1.0","transport":"obfs4","image":"aW1hZ2U=","challenge":"abc"}]}`
+		case "/moat/check":
+			c.Check(d.Challenge, Equals, "abc")
+			c.Check(d.Solution, Equals, "xyz")
+			return `{"data":[{"id":"3","type":"moat-bridges","version":"0.1.0","bridges":["obfs4 192.0.2.1:443 AAAA cert=x iat-mode=0"]}]}`
+		}
+		return ""
+	})
+	defer server.Close()
+
+	challenge, err := m.FetchChallenge(context.Background())
+	c.Assert(err, IsNil)
+	c.Assert(string(challenge.Image), Equals, "image")
+
+	bridges, err := m.CheckSolution(context.Background(), challenge, "xyz")
+	c.Assert(err, IsNil)
+	c.Assert(bridges, DeepEquals, []string{"obfs4 192.0.2.1:443 AAAA cert=x iat-mode=0"})
+}
+
+func (s *WahayTorSuite) Test_MoatClient_CheckSolution_recognizesAWrongSolution(c *C) {
+	m, server := newMoatTestServer(c, func(string, moatData) string {
+		return `{"errors":[{"code":419,"detail":"The CAPTCHA solution was incorrect."}]}`
+	})
+	defer server.Close()
+
+	_, err := m.CheckSolution(context.Background(), &MoatChallenge{Transport: "obfs4", challenge: "abc"}, "wrong")
+
+	c.Assert(err, Equals, ErrMoatWrongSolution)
+}
+
+func (s *WahayTorSuite) Test_MoatClient_FetchChallenge_failsWithUnexpectedAnswers(c *C) {
+	m, server := newMoatTestServer(c, func(string, moatData) string {
+		return "<html>blocked</html>"
+	})
+	defer server.Close()
+
+	_, err := m.FetchChallenge(context.Background())
+
+	c.Assert(errors.Is(err, ErrMoatUnavailable), Equals, true)
+}
@@ -0,0 +1,96 @@
+package tor
+
+import (
+	"strings"
+	"time"
+)
+
+// NetworkStatus is what Tor knows about the state of the Tor network
+type NetworkStatus struct {
+	// Live is false when Tor thinks the network can't be reached
+	Live bool
+	// ValidAfter, FreshUntil and ValidUntil come from the consensus Tor is
+	// using. They are zero when Tor has no consensus
+	ValidAfter time.Time
+	FreshUntil time.Time
+	ValidUntil time.Time
+}
+
+// Stale returns true when Tor can't be expected to work with its current
+// view of the network. This happens often after resuming a laptop, until
+// Tor downloads a new consensus, and when the clock of the computer is wrong
+func (s NetworkStatus) Stale(now time.Time) bool {
+	if !s.Live || s.ValidUntil.IsZero() {
+		return true
+	}
+	return now.Before(s.ValidAfter) || now.After(s.ValidUntil)
+}
+
+const (
+	consensusValidAfterKey = "consensus/valid-after"
+	consensusFreshUntilKey = "consensus/fresh-until"
+	consensusValidUntilKey = "consensus/valid-until"
+
+	consensusTimeLayout = "2006-01-02 15:04:05"
+)
+
+// GetNetworkStatus asks Tor about the liveness of the network and the
+// validity of its consensus. An error means the control port doesn't
+// answer anymore
+func (cntrl *controller) GetNetworkStatus() (NetworkStatus, error) {
+	s := NetworkStatus{}
+
+	cntrl.Lock()
+	defer cntrl.Unlock()
+
+	live, err := cntrl.getNetworkLiveness()
+	if err != nil {
+		return s, err
+	}
+	s.Live = live
+
+	tc, err := cntrl.authenticatedController()
+	if err != nil {
+		cntrl.dropConnection()
+		return s, err
+	}
+
+	// Tor refuses to answer when it has no consensus yet, so it's
+	// not an error for us
+	msg, err := tc.Request("GETINFO " + strings.Join([]string{
+		consensusValidAfterKey,
+		consensusFreshUntilKey,
+		consensusValidUntilKey,
+	}, " "))
+	if err != nil {
+		return s, nil
+	}
+
+	for _, line := range strings.Split(msg, "\n") {
+		parts := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		t, err := time.Parse(consensusTimeLayout, parts[1])
+		if err != nil {
+			continue
+		}
+
+		switch parts[0] {
+		case consensusValidAfterKey:
+			s.ValidAfter = t
+		case consensusFreshUntilKey:
+			s.FreshUntil = t
+		case consensusValidUntilKey:
+			s.ValidUntil = t
+		}
+	}
+
+	return s, nil
+}
+
+// NetworkStatus returns what our Tor knows about the state of the network
+func (i *instance) NetworkStatus() (NetworkStatus, error) {
+	return i.GetController().GetNetworkStatus()
+}
@@ -0,0 +1,55 @@
+package tor
+
+import (
+	"errors"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_controller_GetNetworkStatus_parsesTheConsensusTimes(c *C) {
+	mock := &controllerMock{requestReturn1: "network-liveness=up\n" +
+		"consensus/valid-after=2026-10-17 12:00:00\n" +
+		"consensus/fresh-until=2026-10-17 13:00:00\n" +
+		"consensus/valid-until=2026-10-17 15:00:00\nOK"}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	status, err := cntrl.GetNetworkStatus()
+
+	c.Assert(err, IsNil)
+	c.Assert(status.Live, Equals, true)
+	c.Assert(status.ValidAfter, Equals, time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+	c.Assert(status.FreshUntil, Equals, time.Date(2026, 10, 17, 13, 0, 0, 0, time.UTC))
+	c.Assert(status.ValidUntil, Equals, time.Date(2026, 10, 17, 15, 0, 0, 0, time.UTC))
+	c.Assert(mock.requestArgs, DeepEquals, []string{
+		"GETINFO network-liveness",
+		"GETINFO consensus/valid-after consensus/fresh-until consensus/valid-until",
+	})
+}
+
+func (s *WahayTorSuite) Test_controller_GetNetworkStatus_failsWhenTorDoesntAnswer(c *C) {
+	mock := &controllerMock{requestReturn2: errors.New("connection reset")}
+	cntrl := &controller{tc: mock.createTestGotor}
+
+	_, err := cntrl.GetNetworkStatus()
+
+	c.Assert(err, ErrorMatches, "connection reset")
+}
+
+func (s *WahayTorSuite) Test_NetworkStatus_Stale(c *C) {
+	now := time.Date(2026, 10, 17, 14, 0, 0, 0, time.UTC)
+	status := NetworkStatus{
+		Live:       true,
+		ValidAfter: now.Add(-2 * time.Hour),
+		FreshUntil: now.Add(-time.Hour),
+		ValidUntil: now.Add(time.Hour),
+	}
+
+	c.Assert(status.Stale(now), Equals, false)
+	c.Assert(status.Stale(now.Add(2*time.Hour)), Equals, true)
+	c.Assert(status.Stale(now.Add(-3*time.Hour)), Equals, true)
+	c.Assert(NetworkStatus{Live: true}.Stale(now), Equals, true)
+
+	status.Live = false
+	c.Assert(status.Stale(now), Equals, true)
+}
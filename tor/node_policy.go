@@ -0,0 +1,64 @@
+package tor
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// NodePolicy restricts the relays Tor uses depending on the country they
+// are in, for users that need to avoid relays in specific jurisdictions
+type NodePolicy struct {
+	// EntryCountries are the countries of the relays Tor can use
+	// as the first hop of its circuits
+	EntryCountries []string
+	// ExcludedExitCountries are the countries of the relays Tor
+	// must never use as the last hop of its circuits
+	ExcludedExitCountries []string
+}
+
+// NodePolicyFrom returns the node policy configured by the user
+func NodePolicyFrom(conf *config.ApplicationConfig) NodePolicy {
+	return NodePolicy{
+		EntryCountries:        conf.GetEntryNodeCountries(),
+		ExcludedExitCountries: conf.GetExcludedExitNodeCountries(),
+	}
+}
+
+// Tor uses the two letter ISO 3166 codes, and "??" for the
+// relays it can't find the country of
+var validCountryCode = regexp.MustCompile(`^([a-z]{2}|\?\?)$`)
+
+// countrySet returns the countries in the format Tor expects, for
+// example {de},{se}. Invalid country codes are skipped with a warning
+func countrySet(countries []string) string {
+	result := []string{}
+	for _, c := range countries {
+		code := strings.ToLower(strings.TrimSpace(c))
+		if !validCountryCode.MatchString(code) {
+			log.Warnf("countrySet(): ignoring invalid country code: %q", c)
+			continue
+		}
+		result = append(result, fmt.Sprintf("{%s}", code))
+	}
+	return strings.Join(result, ",")
+}
+
+// torrc returns the policy as torrc lines
+func (p NodePolicy) torrc() string {
+	content := ""
+
+	if entry := countrySet(p.EntryCountries); entry != "" {
+		content += fmt.Sprintf("EntryNodes %s\n", entry)
+	}
+
+	if exits := countrySet(p.ExcludedExitCountries); exits != "" {
+		content += fmt.Sprintf("ExcludeExitNodes %s\n", exits)
+	}
+
+	return content
+}
@@ -0,0 +1,58 @@
+package tor
+
+import (
+	"io/ioutil"
+
+	log "github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+func (s *WahayTorSuite) Test_NodePolicy_torrc_generatesTheCountrySets(c *C) {
+	p := NodePolicy{
+		EntryCountries:        []string{"DE", " se "},
+		ExcludedExitCountries: []string{"ru", "??"},
+	}
+
+	c.Assert(p.torrc(), Equals, "EntryNodes {de},{se}\nExcludeExitNodes {ru},{??}\n")
+}
+
+func (s *WahayTorSuite) Test_NodePolicy_torrc_returnsNothingWithoutRestrictions(c *C) {
+	c.Assert(NodePolicy{}.torrc(), Equals, "")
+}
+
+func (s *WahayTorSuite) Test_NodePolicy_torrc_skipsInvalidCountryCodes(c *C) {
+	log.SetOutput(ioutil.Discard)
+
+	p := NodePolicy{
+		EntryCountries:        []string{"germany", "de},{ru"},
+		ExcludedExitCountries: []string{"ru\nControlPort 1", "by"},
+	}
+
+	c.Assert(p.torrc(), Equals, "ExcludeExitNodes {by}\n")
+}
+
+func (s *WahayTorSuite) Test_NodePolicyFrom_readsTheConfiguration(c *C) {
+	conf := &config.ApplicationConfig{}
+	conf.SetEntryNodeCountries([]string{"de"})
+	conf.SetExcludedExitNodeCountries([]string{"ru"})
+
+	p := NodePolicyFrom(conf)
+
+	c.Assert(p.EntryCountries, DeepEquals, []string{"de"})
+	c.Assert(p.ExcludedExitCountries, DeepEquals, []string{"ru"})
+}
+
+func (s *WahayTorSuite) Test_instance_getConfigFileContents_includesTheNodePolicy(c *C) {
+	i := &instance{
+		socksPort:     9050,
+		controlPort:   9051,
+		dataDirectory: "/tmp/data",
+		nodePolicy:    NodePolicy{ExcludedExitCountries: []string{"ru"}},
+	}
+
+	content := string(i.getConfigFileContents())
+
+	c.Assert(content, Matches, "(?s).*\n## Relays selected by country\nExcludeExitNodes \\{ru\\}\n$")
+}
@@ -0,0 +1,90 @@
+package tor
+
+import (
+	"crypto/ed25519"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"filippo.io/edwards25519"
+)
+
+var (
+	// ErrInvalidOnionKey is returned when signing with a key that
+	// isn't an expanded ed25519 key
+	ErrInvalidOnionKey = errors.New("the onion key is not valid")
+
+	// ErrInvalidOnionAddress is returned when an address is not a v3
+	// onion address, or its checksum doesn't match
+	ErrInvalidOnionAddress = errors.New("the onion address is not valid")
+)
+
+const expandedOnionKeyLength = 64
+
+// Sign signs the message with the key of the onion service. Tor only keeps
+// the expanded key, not the seed ed25519.Sign needs, so the signature is
+// computed from the expanded key. It can be verified with the onion address
+func (k *OnionKey) Sign(message []byte) ([]byte, error) {
+	expanded, err := base64.StdEncoding.DecodeString(k.PrivateKey)
+	if err != nil || len(expanded) != expandedOnionKeyLength {
+		return nil, ErrInvalidOnionKey
+	}
+
+	s, err := edwards25519.NewScalar().SetBytesWithClamping(expanded[:32])
+	if err != nil {
+		return nil, ErrInvalidOnionKey
+	}
+	pub := new(edwards25519.Point).ScalarBaseMult(s).Bytes()
+
+	h := sha512.New()
+	h.Write(expanded[32:])
+	h.Write(message)
+	r, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	R := new(edwards25519.Point).ScalarBaseMult(r).Bytes()
+
+	h.Reset()
+	h.Write(R)
+	h.Write(pub)
+	h.Write(message)
+	c, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	S := edwards25519.NewScalar().MultiplyAdd(c, s, r)
+
+	return append(R, S.Bytes()...), nil
+}
+
+// OnionPublicKey returns the public key of a v3 onion address,
+// with or without the .onion suffix
+func OnionPublicKey(serviceID string) (ed25519.PublicKey, error) {
+	id := strings.TrimSuffix(strings.ToLower(serviceID), ".onion")
+
+	content, err := onionEncoding.DecodeString(strings.ToUpper(id))
+	if err != nil || len(content) != ed25519.PublicKeySize+3 {
+		return nil, ErrInvalidOnionAddress
+	}
+
+	// The checksum and the version are checked by encoding the key again
+	pub := ed25519.PublicKey(content[:ed25519.PublicKeySize])
+	if onionAddress(pub) != id+".onion" {
+		return nil, ErrInvalidOnionAddress
+	}
+
+	return pub, nil
+}
+
+// VerifyOnionSignature returns true if the signature of the message
+// was made with the key of the onion service at the address
+func VerifyOnionSignature(serviceID string, message, signature []byte) bool {
+	pub, err := OnionPublicKey(serviceID)
+	if err != nil {
+		return false
+	}
+	return ed25519.Verify(pub, message, signature)
+}
@@ -0,0 +1,56 @@
+package tor
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_OnionKey_Sign_matchesTheSignatureOfTheSeed(c *C) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	c.Assert(err, IsNil)
+
+	sig, err := newOnionKey(priv).Sign([]byte("hello"))
+
+	c.Assert(err, IsNil)
+	c.Assert(sig, DeepEquals, ed25519.Sign(priv, []byte("hello")))
+}
+
+func (s *WahayTorSuite) Test_OnionKey_Sign_rejectsInvalidKeys(c *C) {
+	_, err := (&OnionKey{PrivateKey: "not a key"}).Sign([]byte("hello"))
+
+	c.Assert(err, Equals, ErrInvalidOnionKey)
+}
+
+func (s *WahayTorSuite) Test_VerifyOnionSignature_checksTheSignatureWithTheAddress(c *C) {
+	key, err := GenerateOnionKey()
+	c.Assert(err, IsNil)
+	other, err := GenerateOnionKey()
+	c.Assert(err, IsNil)
+
+	sig, err := key.Sign([]byte("hello"))
+	c.Assert(err, IsNil)
+
+	c.Assert(VerifyOnionSignature(key.ServiceID, []byte("hello"), sig), Equals, true)
+	c.Assert(VerifyOnionSignature(key.ServiceID, []byte("bye"), sig), Equals, false)
+	c.Assert(VerifyOnionSignature(other.ServiceID, []byte("hello"), sig), Equals, false)
+}
+
+func (s *WahayTorSuite) Test_OnionPublicKey_rejectsAddressesWithAWrongChecksum(c *C) {
+	key, err := GenerateOnionKey()
+	c.Assert(err, IsNil)
+
+	pub, err := OnionPublicKey(key.ServiceID)
+	c.Assert(err, IsNil)
+	c.Assert(onionAddress(pub), Equals, key.ServiceID)
+
+	broken := []byte(key.ServiceID)
+	broken[53] = 'a' + (broken[53]-'a'+1)%26
+
+	_, err = OnionPublicKey(string(broken))
+	c.Assert(err, Equals, ErrInvalidOnionAddress)
+
+	_, err = OnionPublicKey("abcdef.onion")
+	c.Assert(err, Equals, ErrInvalidOnionAddress)
+}
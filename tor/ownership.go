@@ -0,0 +1,57 @@
+package tor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ownerConfig makes our Tor exit when the Wahay process is gone, so
+// no Tor is left running with our onion services if Wahay crashes
+const ownerConfig = `## Exit when Wahay is not running anymore
+__OwningControllerProcess %d
+`
+
+func (i *instance) ownerConfig() string {
+	return fmt.Sprintf(ownerConfig, osf.Getpid())
+}
+
+// takeOwnership opens a control connection that stays open until the
+// instance is destroyed, and tells Tor to exit as soon as it's closed.
+// Tor only checks the owning process every few seconds, but the
+// connection is closed the moment Wahay dies
+func (i *instance) takeOwnership() error {
+	tc, err := torgof.NewController(context.Background(), net.JoinHostPort(i.controlHost, strconv.Itoa(i.controlPort)))
+	if err != nil {
+		return err
+	}
+
+	err = authenticateCookie(tc)
+	if err == nil {
+		_, err = tc.Request("TAKEOWNERSHIP")
+	}
+
+	if err != nil {
+		_ = tc.Close()
+		return err
+	}
+
+	i.releaseOwnership()
+	i.owner = tc
+
+	log.Debugf("takeOwnership(): our Tor will exit together with Wahay")
+
+	return nil
+}
+
+// releaseOwnership closes the owning connection. If our Tor is
+// still running, this makes it exit
+func (i *instance) releaseOwnership() {
+	if i.owner != nil {
+		_ = i.owner.Close()
+		i.owner = nil
+	}
+}
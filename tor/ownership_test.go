@@ -0,0 +1,55 @@
+package tor
+
+import (
+	"errors"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_instance_getConfigFileContents_makesWahayTheOwner(c *C) {
+	mockAll()
+	defer setDefaultFacades()
+
+	i := &instance{socksPort: 9050, controlPort: 9051, dataDirectory: "/tmp/data"}
+
+	content := string(i.getConfigFileContents())
+
+	c.Assert(content, Matches, "(?s).*\n__OwningControllerProcess 4242\n.*")
+}
+
+func (s *WahayTorSuite) Test_instance_takeOwnership_keepsTheConnectionUntilItsReleased(c *C) {
+	mockAll()
+	defer setDefaultFacades()
+
+	mock := &controllerMock{}
+	mocktorgof.onNewController = func(string) (torgoController, error) {
+		return mock, nil
+	}
+
+	i := &instance{controlHost: "127.0.0.1", controlPort: 9051}
+
+	c.Assert(i.takeOwnership(), IsNil)
+	c.Assert(mock.authenticateCookieCalled, Equals, true)
+	c.Assert(mock.requestArgs, DeepEquals, []string{"TAKEOWNERSHIP"})
+	c.Assert(mock.closeCalled, Equals, false)
+
+	i.releaseOwnership()
+	c.Assert(mock.closeCalled, Equals, true)
+	c.Assert(i.owner, IsNil)
+}
+
+func (s *WahayTorSuite) Test_instance_takeOwnership_closesTheConnectionWhenTorRefuses(c *C) {
+	mockAll()
+	defer setDefaultFacades()
+
+	mock := &controllerMock{requestReturn2: errors.New("510 Unrecognized command")}
+	mocktorgof.onNewController = func(string) (torgoController, error) {
+		return mock, nil
+	}
+
+	i := &instance{controlHost: "127.0.0.1", controlPort: 9051}
+
+	c.Assert(i.takeOwnership(), ErrorMatches, "510 Unrecognized command")
+	c.Assert(mock.closeCalled, Equals, true)
+	c.Assert(i.owner, IsNil)
+}
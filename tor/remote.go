@@ -0,0 +1,121 @@
+package tor
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// RemoteTor is a Tor running on another computer, for users who keep Tor
+// on a separate hardened machine. Nothing protects the control connection
+// on the way, so it can only be used once the user has accepted that
+type RemoteTor struct {
+	Host        string
+	ControlPort int
+	SocksPort   int
+	Password    string
+	// NonLocalControlAccepted is true when the user understands that the
+	// control traffic, including the password, travels over the network
+	NonLocalControlAccepted bool
+}
+
+var (
+	// ErrRemoteTorNotAccepted is returned when a remote Tor is configured,
+	// but the user hasn't accepted that the control traffic is not local
+	ErrRemoteTorNotAccepted = errors.New("the control connection to a remote Tor has not been accepted")
+
+	// ErrRemoteTorNeedsPassword is returned when a remote Tor is
+	// configured without a password for its control port
+	ErrRemoteTorNeedsPassword = errors.New("a remote Tor can only be controlled with a password")
+)
+
+// RemoteTorFrom returns the remote Tor configured by the user. Its
+// Host is empty when Wahay has to use a Tor on this computer
+func RemoteTorFrom(conf *config.ApplicationConfig) RemoteTor {
+	r := RemoteTor{
+		Host:                    conf.GetRemoteTorHost(),
+		ControlPort:             conf.GetRemoteTorControlPort(),
+		SocksPort:               conf.GetRemoteTorSocksPort(),
+		Password:                conf.GetRemoteTorPassword(),
+		NonLocalControlAccepted: conf.IsRemoteTorControlAccepted(),
+	}
+
+	if r.ControlPort == 0 {
+		r.ControlPort = config.DefaultControlPort
+	}
+
+	if r.SocksPort == 0 {
+		r.SocksPort = config.DefaultRoutePort
+	}
+
+	return r
+}
+
+func (r RemoteTor) configured() bool {
+	return r.Host != ""
+}
+
+func (r RemoteTor) isLocal() bool {
+	if strings.EqualFold(r.Host, "localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(r.Host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (r RemoteTor) validate() error {
+	if r.Password == "" {
+		return ErrRemoteTorNeedsPassword
+	}
+
+	if !r.isLocal() && !r.NonLocalControlAccepted {
+		return ErrRemoteTorNotAccepted
+	}
+
+	return nil
+}
+
+// newRemoteChecker only authenticates with the password, since the
+// cookie of a remote Tor can't be read and no authentication at all
+// would let anybody in the network control it
+func newRemoteChecker(r RemoteTor, retry RetryPolicy, torCheck TorCheck) basicConnectivity {
+	c := newChecker(r.Host, r.SocksPort, r.ControlPort, r.Password, retry, torCheck).(*connectivity)
+	c.passwordOnly = true
+	return c
+}
+
+func remoteInstance(ctx context.Context, r RemoteTor, retry RetryPolicy, torCheck TorCheck) (Instance, error) {
+	err := r.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	where := net.JoinHostPort(r.Host, strconv.Itoa(r.ControlPort))
+
+	log.Debugf("checking remote instance on %s...", where)
+	report := newRemoteChecker(r, retry, torCheck).check(ctx)
+	log.Debugf("remote Tor on %s: %s", where, report)
+
+	if !report.OK() {
+		return nil, report.Err()
+	}
+
+	log.Infof("Using the remote Tor on %s", where)
+
+	return &instance{
+		started:     true,
+		controlHost: r.Host,
+		controlPort: r.ControlPort,
+		socksPort:   r.SocksPort,
+		password:    r.Password,
+		isLocal:     true,
+		report:      report,
+	}, nil
+}
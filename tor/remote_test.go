@@ -0,0 +1,72 @@
+package tor
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+
+	log "github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+func (s *WahayTorSuite) Test_RemoteTorFrom_usesTheDefaultPorts(c *C) {
+	conf := &config.ApplicationConfig{}
+	conf.SetRemoteTorHost("10.0.0.2")
+
+	r := RemoteTorFrom(conf)
+
+	c.Assert(r.configured(), Equals, true)
+	c.Assert(r.ControlPort, Equals, config.DefaultControlPort)
+	c.Assert(r.SocksPort, Equals, config.DefaultRoutePort)
+	c.Assert(RemoteTorFrom(&config.ApplicationConfig{}).configured(), Equals, false)
+}
+
+func (s *WahayTorSuite) Test_RemoteTor_validate_needsTheUserToAcceptNonLocalControl(c *C) {
+	r := RemoteTor{Host: "10.0.0.2", Password: "secret"}
+	c.Assert(r.validate(), Equals, ErrRemoteTorNotAccepted)
+
+	r.NonLocalControlAccepted = true
+	c.Assert(r.validate(), IsNil)
+
+	c.Assert(RemoteTor{Host: "localhost", Password: "secret"}.validate(), IsNil)
+	c.Assert(RemoteTor{Host: "::1", Password: "secret"}.validate(), IsNil)
+	c.Assert(RemoteTor{Host: "localhost"}.validate(), Equals, ErrRemoteTorNeedsPassword)
+}
+
+func (s *WahayTorSuite) Test_remoteInstance_onlyAuthenticatesWithThePassword(c *C) {
+	mockAll()
+	defer setDefaultFacades()
+	log.SetOutput(ioutil.Discard)
+
+	tc := &mockTorgoController{}
+	tc.authMethods = []string{"NULL", "HASHEDPASSWORD"}
+	tc.getVersionReturn1 = "4.0.4"
+	mocktorgof.onNewController = func(a string) (torgoController, error) {
+		if a == "10.0.0.2:9061" {
+			return tc, nil
+		}
+		return nil, errors.New("no connection possible")
+	}
+	mockhttpf.checkConnectionReturn = true
+
+	ix, err := remoteInstance(context.Background(), RemoteTor{
+		Host:                    "10.0.0.2",
+		ControlPort:             9061,
+		SocksPort:               9060,
+		Password:                "secret",
+		NonLocalControlAccepted: true,
+	}, NoRetry, TorCheck{})
+
+	c.Assert(err, IsNil)
+	c.Assert(tc.authNoneCalled, Equals, 0)
+	c.Assert(tc.authPassArg, Equals, "secret")
+	c.Assert(mockhttpf.checkConnectionArg1, Equals, "10.0.0.2")
+	c.Assert(mockhttpf.checkConnectionArg2, Equals, 9060)
+
+	i := ix.(*instance)
+	c.Assert(i.isLocal, Equals, true)
+	c.Assert(i.password, Equals, "secret")
+	c.Assert(i.SOCKSAddress(), Equals, "10.0.0.2:9060")
+}
@@ -0,0 +1,77 @@
+package tor
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// ResourceLimits keep the Tor started by Wahay from taking the CPU and
+// memory that the audio of the meetings needs on low-end computers
+type ResourceLimits struct {
+	// Niceness lowers the scheduling priority of Tor, from 0, which
+	// leaves it unchanged, to 19, the lowest priority
+	Niceness int
+	// MaxMemoryMB bounds the memory Tor can use, or 0 for no limit
+	MaxMemoryMB int
+	// CPUQuota is the percentage of one CPU Tor can use, or 0 for no limit
+	CPUQuota int
+}
+
+const (
+	maxNiceness = 19
+
+	// minTorMemoryMB is the smallest memory limit Tor accepts for its
+	// queues. Lower limits would make Tor fail to start
+	minTorMemoryMB = 256
+)
+
+// ResourceLimitsFrom returns the limits configured by the user
+func ResourceLimitsFrom(conf *config.ApplicationConfig) ResourceLimits {
+	return ResourceLimits{
+		Niceness:    conf.GetTorNiceness(),
+		MaxMemoryMB: conf.GetTorMaxMemory(),
+		CPUQuota:    conf.GetTorCPUQuota(),
+	}
+}
+
+func (l ResourceLimits) niceness() int {
+	switch {
+	case l.Niceness < 0:
+		return 0
+	case l.Niceness > maxNiceness:
+		return maxNiceness
+	}
+	return l.Niceness
+}
+
+func (l ResourceLimits) maxMemory() int {
+	if l.MaxMemoryMB > 0 && l.MaxMemoryMB < minTorMemoryMB {
+		log.Warnf("ResourceLimits: Tor needs at least %d MB, using that as the memory limit", minTorMemoryMB)
+		return minTorMemoryMB
+	}
+	return l.MaxMemoryMB
+}
+
+// torrc returns the limit Tor enforces by itself. Tor drops circuits
+// before its queues go over it, which keeps it working with less memory
+func (l ResourceLimits) torrc() string {
+	if m := l.maxMemory(); m > 0 {
+		return fmt.Sprintf("MaxMemInQueues %d MB\n", m)
+	}
+	return ""
+}
+
+// cgroupProperties returns the hard limits of the cgroup Tor runs in
+func (l ResourceLimits) cgroupProperties() []string {
+	props := []string{}
+	if l.CPUQuota > 0 {
+		props = append(props, fmt.Sprintf("CPUQuota=%d%%", l.CPUQuota))
+	}
+	if m := l.maxMemory(); m > 0 {
+		props = append(props, fmt.Sprintf("MemoryMax=%dM", m))
+	}
+	return props
+}
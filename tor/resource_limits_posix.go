@@ -0,0 +1,47 @@
+//go:build !windows
+
+package tor
+
+import (
+	"os/exec"
+	"syscall"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// wrap makes Tor run in its own cgroup through systemd-run when a CPU or
+// memory limit is set. systemd-run executes Tor in the same process, so
+// the process we start is still Tor. Without systemd only the limits Tor
+// enforces by itself apply
+func (l ResourceLimits) wrap(cmd *exec.Cmd) {
+	props := l.cgroupProperties()
+	if len(props) == 0 {
+		return
+	}
+
+	systemdRun, err := execf.LookPath("systemd-run")
+	if err != nil {
+		log.Warnf("ResourceLimits: systemd-run can't be found, Tor will run without CPU or memory limits")
+		return
+	}
+
+	args := []string{systemdRun, "--user", "--scope", "--quiet", "--collect"}
+	for _, p := range props {
+		args = append(args, "-p", p)
+	}
+
+	cmd.Args = append(append(args, "--"), cmd.Args...)
+	cmd.Path = systemdRun
+}
+
+// apply lowers the scheduling priority of the Tor process
+func (l ResourceLimits) apply(pid int) {
+	n := l.niceness()
+	if n == 0 {
+		return
+	}
+
+	if err := syscall.Setpriority(syscall.PRIO_PROCESS, pid, n); err != nil {
+		log.Warnf("ResourceLimits: the priority of Tor can't be lowered: %v", err)
+	}
+}
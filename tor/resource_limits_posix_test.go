@@ -0,0 +1,38 @@
+//go:build !windows
+
+package tor
+
+import (
+	"errors"
+	"io/ioutil"
+	"os/exec"
+
+	log "github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_ResourceLimits_wrap_runsTorInItsOwnCgroup(c *C) {
+	mockAll()
+	defer setDefaultFacades()
+	mockexecf.lookPathReturn1 = "/usr/bin/systemd-run"
+
+	cmd := exec.Command("/usr/bin/tor", "-f", "torrc")
+	ResourceLimits{CPUQuota: 50}.wrap(cmd)
+
+	c.Assert(cmd.Path, Equals, "/usr/bin/systemd-run")
+	c.Assert(cmd.Args, DeepEquals, []string{"/usr/bin/systemd-run", "--user", "--scope", "--quiet", "--collect",
+		"-p", "CPUQuota=50%", "--", "/usr/bin/tor", "-f", "torrc"})
+}
+
+func (s *WahayTorSuite) Test_ResourceLimits_wrap_leavesTorAloneWithoutSystemd(c *C) {
+	mockAll()
+	defer setDefaultFacades()
+	log.SetOutput(ioutil.Discard)
+	mockexecf.lookPathReturn2 = errors.New("not found")
+
+	cmd := exec.Command("/usr/bin/tor", "-f", "torrc")
+	ResourceLimits{CPUQuota: 50}.wrap(cmd)
+
+	c.Assert(cmd.Path, Equals, "/usr/bin/tor")
+	c.Assert(cmd.Args, DeepEquals, []string{"/usr/bin/tor", "-f", "torrc"})
+}
@@ -0,0 +1,42 @@
+package tor
+
+import (
+	"io/ioutil"
+
+	log "github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+func (s *WahayTorSuite) Test_ResourceLimitsFrom_readsTheConfiguration(c *C) {
+	conf := &config.ApplicationConfig{}
+	conf.SetTorNiceness(10)
+	conf.SetTorMaxMemory(512)
+	conf.SetTorCPUQuota(50)
+
+	c.Assert(ResourceLimitsFrom(conf), Equals, ResourceLimits{Niceness: 10, MaxMemoryMB: 512, CPUQuota: 50})
+}
+
+func (s *WahayTorSuite) Test_ResourceLimits_keepsTheValuesTorAccepts(c *C) {
+	log.SetOutput(ioutil.Discard)
+
+	c.Assert(ResourceLimits{Niceness: -5}.niceness(), Equals, 0)
+	c.Assert(ResourceLimits{Niceness: 40}.niceness(), Equals, maxNiceness)
+	c.Assert(ResourceLimits{MaxMemoryMB: 100}.torrc(), Equals, "MaxMemInQueues 256 MB\n")
+	c.Assert(ResourceLimits{}.torrc(), Equals, "")
+}
+
+func (s *WahayTorSuite) Test_ResourceLimits_cgroupProperties_includesTheHardLimits(c *C) {
+	c.Assert(ResourceLimits{CPUQuota: 50, MaxMemoryMB: 512}.cgroupProperties(), DeepEquals,
+		[]string{"CPUQuota=50%", "MemoryMax=512M"})
+	c.Assert(ResourceLimits{Niceness: 10}.cgroupProperties(), HasLen, 0)
+}
+
+func (s *WahayTorSuite) Test_instance_getConfigFileContents_includesTheResourceLimits(c *C) {
+	i := &instance{limits: ResourceLimits{MaxMemoryMB: 512}}
+
+	content := string(i.getConfigFileContents())
+
+	c.Assert(content, Matches, "(?s).*## Resource limits\nMaxMemInQueues 512 MB\n.*")
+}
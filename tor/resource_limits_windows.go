@@ -0,0 +1,39 @@
+package tor
+
+import (
+	"os/exec"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sys/windows"
+)
+
+// wrap does nothing, since there are no cgroups on Windows. Only the
+// limits Tor enforces by itself apply
+func (l ResourceLimits) wrap(cmd *exec.Cmd) {}
+
+// apply lowers the priority class of the Tor process. Windows has a few
+// classes instead of niceness, so the highest values use the lowest class
+func (l ResourceLimits) apply(pid int) {
+	n := l.niceness()
+	if n == 0 {
+		return
+	}
+
+	class := uint32(windows.BELOW_NORMAL_PRIORITY_CLASS)
+	if n >= 15 {
+		class = windows.IDLE_PRIORITY_CLASS
+	}
+
+	h, err := windows.OpenProcess(windows.PROCESS_SET_INFORMATION, false, uint32(pid))
+	if err != nil {
+		log.Warnf("ResourceLimits: the priority of Tor can't be lowered: %v", err)
+		return
+	}
+	defer func() {
+		_ = windows.CloseHandle(h)
+	}()
+
+	if err := windows.SetPriorityClass(h, class); err != nil {
+		log.Warnf("ResourceLimits: the priority of Tor can't be lowered: %v", err)
+	}
+}
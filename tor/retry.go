@@ -0,0 +1,102 @@
+package tor
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// RetryPolicy controls how the connectivity checks are repeated before
+// giving up, so a Tor that is still bootstrapping gets some time to
+// connect before we decide it can't be used
+type RetryPolicy struct {
+	// Attempts is the maximum number of times the checks run. Zero or one
+	// means the checks run only once
+	Attempts int
+	// InitialBackoff is the time to wait after the first failed attempt.
+	// It's doubled after every failed attempt, up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Deadline is the maximum time spent retrying. Zero means no limit
+	Deadline time.Duration
+}
+
+const (
+	defaultRetryInitialBackoff = time.Second
+	defaultRetryMaxBackoff     = 8 * time.Second
+)
+
+// NoRetry is a policy that runs the checks only once
+var NoRetry = RetryPolicy{Attempts: 1}
+
+// RetryPolicyFrom returns the retry policy configured by the user
+func RetryPolicyFrom(conf *config.ApplicationConfig) RetryPolicy {
+	return RetryPolicy{
+		Attempts:       conf.GetTorCheckAttempts(),
+		InitialBackoff: defaultRetryInitialBackoff,
+		MaxBackoff:     defaultRetryMaxBackoff,
+		Deadline:       conf.GetTorCheckTimeout(),
+	}
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	return d
+}
+
+// isTransient returns true for the results of the connectivity checks
+// that can change by just waiting. When there is no control port at all
+// or it's too old, waiting won't help, so we fail fast in those cases
+func isTransient(r *ConnectivityReport) bool {
+	return r.Fatal == ErrFatalTorNoConnectionAllowed
+}
+
+var waitBeforeRetry = sleepWithContext
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func (c *connectivity) checkWithRetry(ctx context.Context) *ConnectivityReport {
+	if c.retry.Deadline > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.retry.Deadline)
+		defer cancel()
+	}
+
+	for attempt := 1; ; attempt++ {
+		r := c.checkOnce(ctx)
+		reportStartupProgress(ctx, r)
+
+		if !isTransient(r) || attempt >= c.retry.Attempts {
+			return r
+		}
+
+		wait := c.retry.backoff(attempt)
+		log.WithFields(log.Fields{
+			"attempt": attempt,
+			"wait":    wait,
+		}).Debugf("checkWithRetry(): retrying the Tor checks after: %s", r)
+
+		if waitBeforeRetry(ctx, wait) != nil {
+			return r
+		}
+	}
+}
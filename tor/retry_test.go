@@ -0,0 +1,118 @@
+package tor
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_RetryPolicy_backoff_doublesUpToTheMaximum(c *C) {
+	p := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	c.Assert(p.backoff(1), Equals, time.Second)
+	c.Assert(p.backoff(2), Equals, 2*time.Second)
+	c.Assert(p.backoff(3), Equals, 4*time.Second)
+	c.Assert(p.backoff(4), Equals, 5*time.Second)
+}
+
+func mockSystemTorWithoutConnection() {
+	mockAll()
+	log.SetOutput(ioutil.Discard)
+
+	tc := &mockTorgoController{}
+	tc.authNoneReturn = nil
+	tc.getVersionReturn1 = "4.0.4"
+	mocktorgof.newControllerReturn1 = tc
+	mockhttpf.checkConnectionReturn = false
+}
+
+func (s *WahayTorSuite) Test_connectivity_check_retriesWhileTorCantConnectYet(c *C) {
+	mockSystemTorWithoutConnection()
+	defer setDefaultFacades()
+
+	waits := []time.Duration{}
+	waitBeforeRetry = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		if len(waits) == 2 {
+			mockhttpf.checkConnectionReturn = true
+		}
+		return nil
+	}
+
+	checker := newChecker("127.0.0.1", 9050, 9051, "", RetryPolicy{
+		Attempts:       5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+	}, TorCheck{})
+
+	r := checker.check(context.Background())
+
+	c.Assert(r.Fatal, IsNil)
+	c.Assert(r.Partial, IsNil)
+	c.Assert(r.AuthMethod, Equals, "none")
+	c.Assert(waits, DeepEquals, []time.Duration{time.Second, 2 * time.Second})
+}
+
+func (s *WahayTorSuite) Test_connectivity_check_givesUpAfterTheConfiguredAttempts(c *C) {
+	mockSystemTorWithoutConnection()
+	defer setDefaultFacades()
+
+	retries := 0
+	waitBeforeRetry = func(context.Context, time.Duration) error {
+		retries++
+		return nil
+	}
+
+	checker := newChecker("127.0.0.1", 9050, 9051, "", RetryPolicy{Attempts: 3}, TorCheck{})
+
+	r := checker.check(context.Background())
+
+	c.Assert(r.Fatal, Equals, ErrFatalTorNoConnectionAllowed)
+	c.Assert(retries, Equals, 2)
+}
+
+func (s *WahayTorSuite) Test_connectivity_check_doesntRetryWhenThereIsNoControlPort(c *C) {
+	mockAll()
+	defer setDefaultFacades()
+	log.SetOutput(ioutil.Discard)
+	mocktorgof.newControllerReturn2 = errors.New("no connection possible")
+
+	retries := 0
+	waitBeforeRetry = func(context.Context, time.Duration) error {
+		retries++
+		return nil
+	}
+
+	checker := newChecker("127.0.0.1", 9050, 9051, "", RetryPolicy{Attempts: 3}, TorCheck{})
+
+	r := checker.check(context.Background())
+
+	c.Assert(r.Partial, Equals, ErrPartialTorNoControlPort)
+	c.Assert(retries, Equals, 0)
+}
+
+func (s *WahayTorSuite) Test_connectivity_checkContext_stopsWhenTheContextIsDone(c *C) {
+	mockSystemTorWithoutConnection()
+	defer setDefaultFacades()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	waitBeforeRetry = func(ctx context.Context, d time.Duration) error {
+		cancel()
+		return sleepWithContext(ctx, d)
+	}
+
+	checker := newChecker("127.0.0.1", 9050, 9051, "", RetryPolicy{
+		Attempts:       3,
+		InitialBackoff: time.Hour,
+	}, TorCheck{})
+
+	r := checker.check(ctx)
+
+	c.Assert(r.Fatal, Equals, ErrFatalTorNoConnectionAllowed)
+}
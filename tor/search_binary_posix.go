@@ -2,7 +2,19 @@
 
 package tor
 
-import log "github.com/sirupsen/logrus"
+import (
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// torInstallDirs are where Homebrew and MacPorts install Tor. They are not
+// in the PATH of the applications started from the Finder by launchd
+var torInstallDirs = []string{
+	"/opt/homebrew/bin",
+	"/usr/local/bin",
+	"/opt/local/bin",
+}
 
 func isThereConfiguredTorBinary(path string) (b *binary, err error) {
 	if len(path) == 0 {
@@ -31,6 +43,10 @@ func isThereConfiguredTorBinary(path string) (b *binary, err error) {
 func findTorBinaryInSystem() (b *binary, fatalErr error) {
 	path, err := execf.LookPath("tor")
 	if err != nil {
+		path = torInInstallDirs()
+	}
+
+	if path == "" {
 		return nil, nil
 	}
 
@@ -44,3 +60,14 @@ func findTorBinaryInSystem() (b *binary, fatalErr error) {
 
 	return b, nil
 }
+
+func torInInstallDirs() string {
+	for _, dir := range torInstallDirs {
+		path := filepath.Join(dir, "tor")
+		if filesystemf.FileExists(path) {
+			return path
+		}
+	}
+
+	return ""
+}
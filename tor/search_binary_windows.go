@@ -17,7 +17,7 @@ func findTorExecutable(dir string) (string, error) {
 	for _, rootDir := range possibleTorPaths {
 		err := filepath.WalkDir(rootDir, func(path string, d os.DirEntry, err error) error {
 			if err != nil {
-				fmt.Printf("Error accessing path %s: %v\n", path, err)
+				log.Debugf("findTorExecutable(): can't access %s: %v", path, err)
 				return nil
 			}
 
@@ -94,6 +94,11 @@ func findTorBinaryInSystem() (b *binary, fatalErr error) {
 		programFilesX86Dir,
 	}
 
+	// The Tor Expert Bundle is usually unpacked for the current user
+	if localAppData := osf.Getenv("LOCALAPPDATA"); localAppData != "" {
+		dirs = append(dirs, filepathf.Join(localAppData, "Programs"))
+	}
+
 	for _, d := range dirs {
 
 		log.Debugf("findTorBinaryInSystem(%s)", d)
@@ -1,10 +1,15 @@
 package tor
 
+import "sync/atomic"
+
 // Service is a representation of a service running through Tor
 type Service interface {
 	Close()
 	IsClosed() bool
 	OnClose(func())
+	// Crashed returns true when the command finished with an
+	// error on its own, instead of being closed with Close
+	Crashed() bool
 }
 
 type service struct {
@@ -15,6 +20,7 @@ type service struct {
 	finished          bool
 	finishedWithError error
 	finishChannel     chan bool
+	closedByUs        atomic.Bool
 }
 
 // NewService creates a new Tor command service
@@ -38,9 +44,14 @@ func (i *instance) NewService(cmd string, args []string, modifier ModifyCommand)
 }
 
 func (s *service) Close() {
+	s.closedByUs.Store(true)
 	s.rc.CancelFunc()
 }
 
+func (s *service) Crashed() bool {
+	return s.finished && s.finishedWithError != nil && !s.closedByUs.Load()
+}
+
 func (s *service) IsClosed() bool {
 	return s.finished
 }
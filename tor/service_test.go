@@ -0,0 +1,31 @@
+package tor
+
+import (
+	"context"
+	"errors"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_service_Crashed_isTrueWhenTheCommandFailedOnItsOwn(c *C) {
+	srv := &service{finished: true, finishedWithError: errors.New("signal: segmentation fault")}
+
+	c.Assert(srv.Crashed(), Equals, true)
+}
+
+func (s *WahayTorSuite) Test_service_Crashed_isFalseWhenTheCommandExitedNormally(c *C) {
+	srv := &service{finished: true}
+
+	c.Assert(srv.Crashed(), Equals, false)
+}
+
+func (s *WahayTorSuite) Test_service_Crashed_isFalseWhenTheServiceWasClosed(c *C) {
+	_, cancel := context.WithCancel(context.Background())
+	srv := &service{rc: &RunningCommand{CancelFunc: cancel}}
+
+	srv.Close()
+	srv.finished = true
+	srv.finishedWithError = errors.New("signal: killed")
+
+	c.Assert(srv.Crashed(), Equals, false)
+}
@@ -0,0 +1,79 @@
+package tor
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+const singleHopConfig = `## Publish onion services with single hop circuits. This makes them
+## faster, but it reveals the location of this computer to the participants
+HiddenServiceSingleHopMode 1
+HiddenServiceNonAnonymousMode 1
+`
+
+// NewSingleHopInstance starts a separate Tor instance that publishes onion
+// services without hiding the location of this computer, trading the
+// anonymity of the host for a lower latency. This instance can only be used
+// to create onion services, it can't connect to anything through Tor
+func NewSingleHopInstance(conf *config.ApplicationConfig) (Instance, error) {
+	b, err := findTorBinary(conf)
+	if b == nil || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, ErrTorBinaryNotFound
+	}
+
+	i := createOurInstance(conf.IsLogsEnabled())
+	i.singleHop = true
+	i.extraOptions = conf.GetTorExtraOptions()
+	i.nodePolicy = NodePolicyFrom(conf)
+	i.proxy = UpstreamProxyFrom(conf)
+
+	err = i.createConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	i.setBinary(b)
+
+	err = i.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.waitForControlPort(torStartupTimeout)
+	if err != nil {
+		i.Destroy()
+		return nil, err
+	}
+
+	i.watchEvents()
+
+	log.Infof("Started single hop Tor instance using the binary: %s", b.path)
+
+	return i, nil
+}
+
+// waitForControlPort waits until we can take ownership of our Tor
+// through its control port
+func (i *instance) waitForControlPort(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		err := i.takeOwnership()
+		if err == nil {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			log.Debugf("waitForControlPort(): %v", err)
+			return ErrTorConnectionTimeout
+		}
+
+		time.Sleep(time.Second)
+	}
+}
@@ -0,0 +1,47 @@
+package tor
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_instance_getConfigFileContents_disablesTheSocksPortInSingleHopMode(c *C) {
+	i := &instance{
+		socksPort:     9050,
+		controlPort:   9051,
+		dataDirectory: "/tmp/data",
+		useCookie:     true,
+		singleHop:     true,
+	}
+
+	content := string(i.getConfigFileContents())
+
+	c.Assert(content, Matches, "(?s).*SOCKSPort 0\n.*")
+	c.Assert(content, Matches, "(?s).*HiddenServiceSingleHopMode 1\nHiddenServiceNonAnonymousMode 1\n.*")
+}
+
+func (s *WahayTorSuite) Test_instance_getConfigFileContents_keepsTheSocksPortByDefault(c *C) {
+	i := &instance{
+		socksPort:     9050,
+		controlPort:   9051,
+		dataDirectory: "/tmp/data",
+	}
+
+	content := string(i.getConfigFileContents())
+
+	c.Assert(content, Matches, "(?s).*SOCKSPort 9050\n.*")
+	c.Assert(content, Not(Matches), "(?s).*HiddenServiceSingleHopMode.*")
+}
+
+func (s *WahayTorSuite) Test_instance_NewOnionServiceWithMultiplePorts_createsNonAnonymousServicesInSingleHopMode(c *C) {
+	mock := &controllerMock{requestReturn1: "ServiceID=abcdef"}
+	i := &instance{
+		singleHop:  true,
+		controller: &controller{tc: mock.createTestGotor},
+	}
+
+	o, err := i.NewOnionServiceWithMultiplePorts([]OnionPort{{DestinationHost: "127.0.0.1", DestinationPort: 8080, ServicePort: 80}})
+
+	c.Assert(err, IsNil)
+	c.Assert(o.ID(), Equals, "abcdef.onion")
+	c.Assert(mock.requestArgs, DeepEquals, []string{"ADD_ONION NEW:ED25519-V3 Flags=NonAnonymous Port=80,127.0.0.1:8080"})
+}
@@ -0,0 +1,48 @@
+package tor
+
+import "context"
+
+// StartupStage is how far a Tor got in becoming usable by Wahay
+type StartupStage int
+
+const (
+	// StageLookingForTor is before anything answered on a control port
+	StageLookingForTor StartupStage = iota
+	// StageControlPortFound is when something answered on the control port
+	StageControlPortFound
+	// StageAuthenticated is when Wahay could authenticate on the control port
+	StageAuthenticated
+	// StageCircuitsBuilt is when a connection over Tor could be confirmed
+	StageCircuitsBuilt
+	// StageOnionPublished is only reached by the meetings,
+	// once their onion service has been added to Tor
+	StageOnionPublished
+)
+
+// Stage returns the last stage the connectivity checks reached
+func (r *ConnectivityReport) Stage() StartupStage {
+	switch {
+	case r.ConnectedOverTor && r.Partial == nil:
+		return StageCircuitsBuilt
+	case r.AuthMethod != "" || r.TorVersion != "":
+		return StageAuthenticated
+	case r.ControlPortFound:
+		return StageControlPortFound
+	}
+	return StageLookingForTor
+}
+
+type startupProgressKey struct{}
+
+// WithStartupProgress returns a context that makes NewInstanceContext
+// call f with the report of every connectivity check it runs, while it
+// looks for a Tor to use and waits for it to connect
+func WithStartupProgress(ctx context.Context, f func(*ConnectivityReport)) context.Context {
+	return context.WithValue(ctx, startupProgressKey{}, f)
+}
+
+func reportStartupProgress(ctx context.Context, r *ConnectivityReport) {
+	if f, ok := ctx.Value(startupProgressKey{}).(func(*ConnectivityReport)); ok {
+		f(r)
+	}
+}
@@ -0,0 +1,46 @@
+package tor
+
+import (
+	"context"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_ConnectivityReport_Stage_isTheLastCheckThatWorked(c *C) {
+	r := newConnectivityReport()
+	c.Assert(r.Stage(), Equals, StageLookingForTor)
+
+	r.ControlPortFound = true
+	r.Partial = ErrPartialTorNoValidAuth
+	c.Assert(r.Stage(), Equals, StageControlPortFound)
+
+	r.Partial = nil
+	r.TorVersion = "0.4.8.9"
+	r.Fatal = ErrFatalTorNoConnectionAllowed
+	c.Assert(r.Stage(), Equals, StageAuthenticated)
+
+	r.Fatal = nil
+	r.AuthMethod = "cookie"
+	r.ConnectedOverTor = true
+	c.Assert(r.Stage(), Equals, StageCircuitsBuilt)
+}
+
+func (s *WahayTorSuite) Test_connectivity_check_reportsEveryAttemptToTheStartupProgress(c *C) {
+	mockSystemTorWithoutConnection()
+	defer setDefaultFacades()
+
+	waitBeforeRetry = func(context.Context, time.Duration) error {
+		mockhttpf.checkConnectionReturn = true
+		return nil
+	}
+
+	stages := []StartupStage{}
+	ctx := WithStartupProgress(context.Background(), func(r *ConnectivityReport) {
+		stages = append(stages, r.Stage())
+	})
+
+	newChecker("127.0.0.1", 9050, 9051, "", RetryPolicy{Attempts: 3}, TorCheck{}).check(ctx)
+
+	c.Assert(stages, DeepEquals, []StartupStage{StageAuthenticated, StageCircuitsBuilt})
+}
@@ -0,0 +1,82 @@
+package tor
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+
+	"github.com/digitalautonomy/wahay/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrTorNotRunning is returned when no Tor answers on the
+// control ports Wahay would use
+var ErrTorNotRunning = errors.New("Tor is not running")
+
+// QueryNetworkStatus asks a Tor that is already running about the network,
+// without starting or changing anything. It tries the control address
+// given first, usually the one of the Tor a running Wahay uses, and then
+// the remote or system Tor of the configuration. It returns the control
+// address of the Tor that answered
+func QueryNetworkStatus(ctx context.Context, conf *config.ApplicationConfig, controlAddress string) (NetworkStatus, string, error) {
+	for _, c := range statusCheckers(conf, controlAddress) {
+		where := net.JoinHostPort(c.host, strconv.Itoa(c.controlPort))
+
+		s, err := c.networkStatus(ctx, where)
+		if err == nil {
+			return s, where, nil
+		}
+		log.Debugf("QueryNetworkStatus(): no Tor answers on %s: %s", where, err)
+	}
+
+	return NetworkStatus{}, "", ErrTorNotRunning
+}
+
+// statusCheckers returns the control ports to ask, in order. A remote Tor
+// is chosen explicitly by the user, so no other Tor is asked instead of it
+func statusCheckers(conf *config.ApplicationConfig, controlAddress string) []*connectivity {
+	result := []*connectivity{}
+
+	if host, port, err := net.SplitHostPort(controlAddress); err == nil {
+		if p, err := strconv.Atoi(port); err == nil {
+			result = append(result, newChecker(host, 0, p, *config.TorControlPassword, NoRetry, TorCheck{}).(*connectivity))
+		}
+	}
+
+	if remote := RemoteTorFrom(conf); remote.configured() {
+		if remote.validate() == nil {
+			result = append(result, newRemoteChecker(remote, NoRetry, TorCheck{}).(*connectivity))
+		}
+		return result
+	}
+
+	for _, loc := range TorDiscoveryFrom(conf).locations() {
+		result = append(result, newDefaultChecker(loc, NoRetry, TorCheck{}).(*connectivity))
+	}
+
+	return result
+}
+
+// networkStatus connects to the control port only for as long as
+// it takes to ask Tor about the network
+func (c *connectivity) networkStatus(ctx context.Context, where string) (NetworkStatus, error) {
+	tc, err := torgof.NewController(ctx, where)
+	if err != nil {
+		return NetworkStatus{}, err
+	}
+
+	tc, _, err = c.authenticate(ctx, where, tc)
+	if err != nil {
+		return NetworkStatus{}, err
+	}
+
+	cntrl := &controller{torHost: c.host, torPort: c.controlPort, c: tc}
+	defer func() {
+		cntrl.Lock()
+		defer cntrl.Unlock()
+		cntrl.dropConnection()
+	}()
+
+	return cntrl.GetNetworkStatus()
+}
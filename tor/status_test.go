@@ -0,0 +1,31 @@
+package tor
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+func (s *WahayTorSuite) Test_statusCheckers_asksTheGivenTorBeforeTheSystemTor(c *C) {
+	checkers := statusCheckers(&config.ApplicationConfig{}, "127.0.0.1:4321")
+
+	c.Assert(checkers, HasLen, 1+len(systemTorLocations))
+	c.Assert(checkers[0].host, Equals, "127.0.0.1")
+	c.Assert(checkers[0].controlPort, Equals, 4321)
+	c.Assert(checkers[1].controlPort, Equals, systemTorLocations[0].controlPort)
+}
+
+func (s *WahayTorSuite) Test_statusCheckers_onlyAsksTheRemoteTorTheUserAccepted(c *C) {
+	conf := &config.ApplicationConfig{}
+	conf.SetRemoteTorHost("10.0.0.2")
+	conf.SetRemoteTorPassword("secret")
+
+	c.Assert(statusCheckers(conf, ""), HasLen, 0)
+
+	conf.AcceptRemoteTorControl(true)
+	checkers := statusCheckers(conf, "")
+
+	c.Assert(checkers, HasLen, 1)
+	c.Assert(checkers[0].host, Equals, "10.0.0.2")
+	c.Assert(checkers[0].passwordOnly, Equals, true)
+}
@@ -0,0 +1,74 @@
+package tor
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// systemTorLocation is a place where a Tor not started by Wahay can be
+// listening. Tor Browser runs its own Tor on different ports than the
+// Tor of the system, and users that have it open don't need another one
+type systemTorLocation struct {
+	name        string
+	controlPort int
+	socksPort   int
+}
+
+const (
+	torBrowserControlPort = 9151
+	torBrowserSocksPort   = 9150
+)
+
+var systemTorLocations = []systemTorLocation{
+	{"system Tor", defaultControlPorts[0], defaultSocksPort},
+	{"system Tor", defaultControlPorts[1], defaultSocksPort},
+	{"Tor Browser", torBrowserControlPort, torBrowserSocksPort},
+}
+
+// torBrowserDataDirs are the places where the usual ways of installing
+// Tor Browser keep the data of its Tor, relative to the home directory
+var torBrowserDataDirs = []string{
+	"tor-browser/Browser/TorBrowser/Data/Tor",
+	".local/share/torbrowser/tbb/x86_64/tor-browser/Browser/TorBrowser/Data/Tor",
+	".var/app/org.torproject.torbrowser-launcher/data/torbrowser/tbb/x86_64/tor-browser/Browser/TorBrowser/Data/Tor",
+	".var/app/com.github.micahflee.torbrowser-launcher/data/torbrowser/tbb/x86_64/tor-browser/Browser/TorBrowser/Data/Tor",
+}
+
+const torCookieFileName = "control_auth_cookie"
+
+var errNoCookieFile = errors.New("the authentication cookie can't be read")
+
+// torBrowserCookieFiles returns the places where the authentication
+// cookie of Tor Browser can be
+func torBrowserCookieFiles() []string {
+	result := []string{}
+	for _, d := range torBrowserDataDirs {
+		result = append(result, config.WithHome(filepath.Join(d, torCookieFileName)))
+	}
+	return result
+}
+
+// readSafeCookie reads the authentication cookie from the file advertised
+// by Tor. When Tor runs in a sandbox, like Tor Browser installed with
+// Flatpak, that path doesn't exist for us, so the places where Tor Browser
+// usually keeps it are tried as well. This is only safe with SAFECOOKIE,
+// where a wrong cookie is never sent to Tor
+func readSafeCookie(advertised string) ([]byte, error) {
+	candidates := append([]string{advertised}, torBrowserCookieFiles()...)
+
+	for _, f := range candidates {
+		if f == "" {
+			continue
+		}
+
+		cookie, err := ioutil.ReadFile(filepath.Clean(f))
+		if err == nil {
+			return cookie, nil
+		}
+	}
+
+	return nil, errNoCookieFile
+}
@@ -0,0 +1,74 @@
+package tor
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_systemInstance_usesTorBrowserWhenThereIsNoSystemTor(c *C) {
+	mockAll()
+	defer setDefaultFacades()
+	log.SetOutput(ioutil.Discard)
+
+	tc := &mockTorgoController{}
+	tc.authNoneReturn = errors.New("no authentication not allowed")
+	tc.getVersionReturn1 = "4.0.4"
+	mocktorgof.onNewController = func(a string) (torgoController, error) {
+		if a == "127.0.0.1:9151" {
+			return tc, nil
+		}
+		return nil, errors.New("no connection possible")
+	}
+	mockhttpf.checkConnectionReturn = true
+
+	ix, err := systemInstance(context.Background(), NoRetry, TorCheck{})
+
+	c.Assert(err, IsNil)
+	i := ix.(*instance)
+	c.Assert(i.controlPort, Equals, torBrowserControlPort)
+	c.Assert(i.socksPort, Equals, torBrowserSocksPort)
+	c.Assert(i.useCookie, Equals, true)
+	c.Assert(i.isLocal, Equals, true)
+	c.Assert(mockhttpf.checkConnectionArg2, Equals, torBrowserSocksPort)
+}
+
+func (s *WahayTorSuite) Test_readSafeCookie_readsTheAdvertisedFile(c *C) {
+	dir := c.MkDir()
+	f := filepath.Join(dir, torCookieFileName)
+	c.Assert(ioutil.WriteFile(f, []byte("the cookie"), 0600), IsNil)
+
+	cookie, err := readSafeCookie(f)
+
+	c.Assert(err, IsNil)
+	c.Assert(string(cookie), Equals, "the cookie")
+}
+
+func (s *WahayTorSuite) Test_readSafeCookie_findsTheCookieOfTorBrowser(c *C) {
+	home := c.MkDir()
+	defer os.Setenv("HOME", os.Getenv("HOME"))
+	os.Setenv("HOME", home)
+
+	d := filepath.Join(home, torBrowserDataDirs[2])
+	c.Assert(os.MkdirAll(d, 0700), IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(d, torCookieFileName), []byte("sandboxed"), 0600), IsNil)
+
+	cookie, err := readSafeCookie("/run/user/1000/flatpak/tor/control_auth_cookie")
+
+	c.Assert(err, IsNil)
+	c.Assert(string(cookie), Equals, "sandboxed")
+}
+
+func (s *WahayTorSuite) Test_readSafeCookie_failsWithoutCookie(c *C) {
+	defer os.Setenv("HOME", os.Getenv("HOME"))
+	os.Setenv("HOME", c.MkDir())
+
+	_, err := readSafeCookie("")
+
+	c.Assert(err, Equals, errNoCookieFile)
+}
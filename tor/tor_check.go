@@ -0,0 +1,135 @@
+package tor
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+const defaultTorCheckEndpoint = "https://check.torproject.org/api/ip"
+
+// defaultTorCheckFallbacks are onion services used when the main check
+// can't be reached. They can only be reached over Tor, so any answer
+// from them means that the connection goes through Tor
+var defaultTorCheckFallbacks = []string{
+	// The website of the Tor Project
+	"http://2gzyxa5ihm7nsggfxnu52rck2vv4rvmdlkiu3zzui5du4xyclen53wid.onion/",
+}
+
+// TorCheck controls how we find out whether connections really go
+// through Tor
+type TorCheck struct {
+	// Endpoints are tried in order until one of them confirms the
+	// connection. An empty list means the default check is used
+	Endpoints []string
+	// Offline allows accepting Tor when no endpoint answers, as long as
+	// Tor says it has built a circuit and its SOCKS port works
+	Offline bool
+}
+
+// TorCheckFrom returns the way to check Tor configured by the user
+func TorCheckFrom(conf *config.ApplicationConfig) TorCheck {
+	endpoint := conf.GetTorCheckEndpoint()
+	if endpoint == "" {
+		endpoint = defaultTorCheckEndpoint
+	}
+
+	fallbacks := conf.GetTorCheckFallbackEndpoints()
+	if fallbacks == nil {
+		fallbacks = defaultTorCheckFallbacks
+	}
+
+	return TorCheck{
+		Endpoints: append([]string{endpoint}, fallbacks...),
+		Offline:   conf.IsOfflineTorCheckEnabled(),
+	}
+}
+
+func (t TorCheck) endpoints() []string {
+	if len(t.Endpoints) == 0 {
+		return []string{defaultTorCheckEndpoint}
+	}
+	return t.Endpoints
+}
+
+// isOnionEndpoint returns true when the endpoint is an onion service,
+// which can only be reached through Tor
+func isOnionEndpoint(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+	return strings.HasSuffix(strings.ToLower(u.Hostname()), ".onion")
+}
+
+func (c *connectivity) checkConnectionOverTor(ctx context.Context) bool {
+	for _, endpoint := range c.torCheck.endpoints() {
+		if ctx.Err() != nil {
+			return false
+		}
+		if httpf.CheckConnectionOverTor(ctx, c.host, c.routePort, endpoint) {
+			return true
+		}
+		log.Debugf(" - the connection over tor couldn't be confirmed by %s", endpoint)
+	}
+
+	return false
+}
+
+// looksConnectedOffline guesses whether Tor works when none of the
+// checks could be reached, maybe because they are blocked or down.
+// Tor must have built a circuit, and the caller must have verified
+// that its SOCKS port answers. It needs a working control port, so
+// it runs after checking it
+func (c *connectivity) looksConnectedOffline(ctx context.Context) bool {
+	if !c.torCheck.Offline {
+		return false
+	}
+
+	if !c.circuitEstablished(ctx) {
+		log.Debugf(" - tor hasn't established any circuit")
+		return false
+	}
+
+	log.Warnf("The connection over Tor couldn't be confirmed, but Tor has established a circuit")
+
+	return true
+}
+
+const circuitEstablishedKey = "status/circuit-established"
+
+func (c *connectivity) circuitEstablished(ctx context.Context) bool {
+	where := net.JoinHostPort(c.host, strconv.Itoa(c.controlPort))
+
+	tc, err := torgof.NewController(ctx, where)
+	if err != nil {
+		return false
+	}
+
+	tc, _, err = c.authenticate(ctx, where, tc)
+	if err != nil {
+		return false
+	}
+	defer tc.Close()
+
+	msg, err := tc.Request("GETINFO " + circuitEstablishedKey)
+	if err != nil {
+		log.Debugf("circuitEstablished(): %v", err)
+		return false
+	}
+
+	for _, line := range strings.Split(msg, "\n") {
+		parts := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(parts) == 2 && parts[0] == circuitEstablishedKey {
+			return parts[1] == "1"
+		}
+	}
+
+	return false
+}
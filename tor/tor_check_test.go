@@ -0,0 +1,152 @@
+package tor
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+func (s *WahayTorSuite) Test_TorCheckFrom_usesTheDefaultsWhenNothingIsConfigured(c *C) {
+	t := TorCheckFrom(&config.ApplicationConfig{})
+
+	c.Assert(t.Endpoints, DeepEquals, append([]string{defaultTorCheckEndpoint}, defaultTorCheckFallbacks...))
+	c.Assert(t.Offline, Equals, true)
+}
+
+func (s *WahayTorSuite) Test_TorCheckFrom_readsTheConfiguration(c *C) {
+	conf := &config.ApplicationConfig{}
+	conf.SetTorCheckEndpoint("https://check.example.org/")
+	conf.SetTorCheckFallbackEndpoints([]string{})
+	conf.EnableOfflineTorCheck(false)
+
+	t := TorCheckFrom(conf)
+
+	c.Assert(t.Endpoints, DeepEquals, []string{"https://check.example.org/"})
+	c.Assert(t.Offline, Equals, false)
+}
+
+func (s *WahayTorSuite) Test_isOnionEndpoint(c *C) {
+	c.Assert(isOnionEndpoint("http://2gzyxa5ihm7nsggfxnu52rck2vv4rvmdlkiu3zzui5du4xyclen53wid.onion/"), Equals, true)
+	c.Assert(isOnionEndpoint("https://check.torproject.org/api/ip"), Equals, false)
+	c.Assert(isOnionEndpoint("://"), Equals, false)
+}
+
+func mockSystemTorWithCircuit(established string) *mockTorgoController {
+	mockAll()
+	log.SetOutput(ioutil.Discard)
+
+	tc := &mockTorgoController{}
+	tc.getVersionReturn1 = "4.0.4"
+	tc.requestReturn = "status/circuit-established=" + established
+	mocktorgof.newControllerReturn1 = tc
+	mockhttpf.checkConnectionReturn = false
+
+	return tc
+}
+
+func (s *WahayTorSuite) Test_connectivity_checkConnectionOverTor_triesTheFallbacksInOrder(c *C) {
+	mockSystemTorWithCircuit("0")
+	defer setDefaultFacades()
+
+	mockhttpf.onCheckConnection = func(endpoint string) bool {
+		return endpoint == "http://second.onion/"
+	}
+
+	checker := newChecker("127.0.0.1", 9050, 9051, "", NoRetry, TorCheck{
+		Endpoints: []string{"https://first.example.org/", "http://second.onion/", "http://third.onion/"},
+	})
+
+	r := checker.check(context.Background())
+
+	c.Assert(r.Fatal, IsNil)
+	c.Assert(r.Partial, IsNil)
+	c.Assert(mockhttpf.checkConnectionArg3, DeepEquals, []string{"https://first.example.org/", "http://second.onion/"})
+}
+
+func (s *WahayTorSuite) Test_connectivity_checkConnectionOverTor_acceptsAnEstablishedCircuitWhenOffline(c *C) {
+	mockSystemTorWithCircuit("1")
+	defer setDefaultFacades()
+
+	mockhttpf.socksHandshakeReturn = true
+
+	checker := newChecker("127.0.0.1", 9050, 9051, "", NoRetry, TorCheck{Offline: true})
+
+	r := checker.check(context.Background())
+
+	c.Assert(r.Fatal, IsNil)
+	c.Assert(r.Partial, IsNil)
+}
+
+func (s *WahayTorSuite) Test_connectivity_checkConnectionOverTor_failsWithoutCircuit(c *C) {
+	mockSystemTorWithCircuit("0")
+	defer setDefaultFacades()
+
+	mockhttpf.socksHandshakeReturn = true
+
+	checker := newChecker("127.0.0.1", 9050, 9051, "", NoRetry, TorCheck{Offline: true})
+
+	r := checker.check(context.Background())
+
+	c.Assert(r.Fatal, Equals, ErrFatalTorNoConnectionAllowed)
+}
+
+func (s *WahayTorSuite) Test_connectivity_checkConnectionOverTor_failsWithoutSOCKSPort(c *C) {
+	mockSystemTorWithCircuit("1")
+	defer setDefaultFacades()
+
+	checker := newChecker("127.0.0.1", 9050, 9051, "", NoRetry, TorCheck{Offline: true})
+
+	r := checker.check(context.Background())
+
+	c.Assert(r.Fatal, Equals, ErrFatalTorNoConnectionAllowed)
+}
+
+func (s *WahayTorSuite) Test_connectivity_checkConnectionOverTor_onlyUsesTheHeuristicWhenEnabled(c *C) {
+	mockSystemTorWithCircuit("1")
+	defer setDefaultFacades()
+
+	mockhttpf.socksHandshakeReturn = true
+
+	checker := newChecker("127.0.0.1", 9050, 9051, "", NoRetry, TorCheck{})
+
+	r := checker.check(context.Background())
+
+	c.Assert(r.Fatal, Equals, ErrFatalTorNoConnectionAllowed)
+}
+
+func (s *WahayTorSuite) Test_connectivity_checkConnectionOverTor_givesUpWhenTheContextIsCancelled(c *C) {
+	mockSystemTorWithCircuit("0")
+	defer setDefaultFacades()
+
+	mockhttpf.checkConnectionReturn = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	checker := &connectivity{host: "127.0.0.1", routePort: 9050}
+
+	c.Assert(checker.checkConnectionOverTor(ctx), Equals, false)
+	c.Assert(mockhttpf.checkConnectionArg3, HasLen, 0)
+}
+
+func (s *WahayTorSuite) Test_realHTTPImplementation_doesntConnectWhenTheContextIsCancelled(c *C) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	defer l.Close()
+
+	port := l.Addr().(*net.TCPAddr).Port
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	h := &realHTTPImplementation{}
+	c.Assert(h.CanConnect(ctx, l.Addr().String()), Equals, false)
+	c.Assert(h.SOCKSHandshake(ctx, "127.0.0.1", port), Equals, false)
+	c.Assert(h.CheckConnectionOverTor(ctx, "127.0.0.1", port, "http://"+net.JoinHostPort("127.0.0.1", strconv.Itoa(port))+"/"), Equals, false)
+}
@@ -0,0 +1,103 @@
+package tor
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const torLogMaxLines = 500
+
+// torLogEvents are the control port events with the log messages
+// of Tor that we keep, the debug and info ones are too noisy
+var torLogEvents = []string{"NOTICE", "WARN", "ERR"}
+
+var (
+	onionAddressPattern = regexp.MustCompile(`(?i)\b[a-z2-7]{16,56}\.onion\b`)
+	// Addresses starting with zero are not used, but versions of Tor are
+	ipv4Pattern = regexp.MustCompile(`\b[1-9]\d{0,2}(?:\.\d{1,3}){3}\b`)
+	// Times have only two colons, so they are not taken as addresses
+	ipv6Pattern     = regexp.MustCompile(`(?i)(?:[0-9a-f]{1,4}:){3,7}[0-9a-f]{1,4}|[0-9a-f]{0,4}(?::[0-9a-f]{1,4})*::(?:[0-9a-f]{1,4}:)*[0-9a-f]{0,4}`)
+	homePathPattern = regexp.MustCompile(`(/home/|/Users/|\\Users\\)[^/\\\s"']+`)
+)
+
+// ScrubLogLine removes from a line of a log the information
+// that could identify the user or the people they talk with
+func ScrubLogLine(line string) string {
+	line = onionAddressPattern.ReplaceAllString(line, "[onion]")
+	line = ipv4Pattern.ReplaceAllString(line, "[address]")
+	line = ipv6Pattern.ReplaceAllString(line, "[address]")
+	line = homePathPattern.ReplaceAllString(line, "${1}[user]")
+	return line
+}
+
+// torLog keeps the latest lines logged by our Tor, already scrubbed.
+// It can be used as the output of the Tor process
+type torLog struct {
+	sync.Mutex
+	lines   []string
+	partial []byte
+}
+
+func newTorLog() *torLog {
+	return &torLog{}
+}
+
+func (l *torLog) Write(p []byte) (int, error) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.partial = append(l.partial, p...)
+	for {
+		ix := bytes.IndexByte(l.partial, '\n')
+		if ix < 0 {
+			break
+		}
+		l.add(string(l.partial[:ix]))
+		l.partial = l.partial[ix+1:]
+	}
+
+	return len(p), nil
+}
+
+func (l *torLog) addEvent(e Event) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.add(fmt.Sprintf("%s [%s] %s",
+		time.Now().Format("Jan 02 15:04:05.000"),
+		strings.ToLower(e.Type),
+		strings.Join(e.Args, " ")))
+}
+
+func (l *torLog) add(line string) {
+	line = strings.TrimRight(line, "\r")
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+
+	l.lines = append(l.lines, ScrubLogLine(line))
+	if extra := len(l.lines) - torLogMaxLines; extra > 0 {
+		l.lines = append([]string{}, l.lines[extra:]...)
+	}
+}
+
+func (l *torLog) get() []string {
+	l.Lock()
+	defer l.Unlock()
+
+	return append([]string{}, l.lines...)
+}
+
+// TorLog returns the latest lines logged by our Tor, both its own output
+// and the log events from the control port. Addresses and user names are
+// removed from them. The system Tor is not ours, so it has no log here
+func (i *instance) TorLog() []string {
+	if i.torLog == nil {
+		return nil
+	}
+	return i.torLog.get()
+}
@@ -0,0 +1,62 @@
+package tor
+
+import (
+	"fmt"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_ScrubLogLine_removesAddressesAndUserNames(c *C) {
+	line := "Opening Socks listener on 127.0.0.1:9050 for abcdefghijklmnopqrstuvwxyz234567abcdefghijklmnopqrstuvwx.onion " +
+		"via [2001:db8::1]:443 and fe80:0:0:0:200:f8ff:fe21:67cf, reading /home/alice/.config/wahay/torrc"
+
+	c.Assert(ScrubLogLine(line), Equals, "Opening Socks listener on [address]:9050 for [onion] "+
+		"via [[address]]:443 and [address], reading /home/[user]/.config/wahay/torrc")
+}
+
+func (s *WahayTorSuite) Test_ScrubLogLine_keepsTimesAndVersions(c *C) {
+	line := "Oct 17 15:04:05.000 [notice] Tor 0.4.8.9 running on Linux."
+
+	c.Assert(ScrubLogLine(line), Equals, line)
+}
+
+func (s *WahayTorSuite) Test_torLog_Write_splitsTheOutputInLines(c *C) {
+	l := newTorLog()
+
+	n, err := l.Write([]byte("first line\nsecond "))
+	c.Assert(err, IsNil)
+	c.Assert(n, Equals, 18)
+	c.Assert(l.get(), DeepEquals, []string{"first line"})
+
+	_, _ = l.Write([]byte("line from 10.0.0.1\r\n\n"))
+	c.Assert(l.get(), DeepEquals, []string{"first line", "second line from [address]"})
+}
+
+func (s *WahayTorSuite) Test_torLog_keepsOnlyTheLatestLines(c *C) {
+	l := newTorLog()
+
+	for ix := 0; ix < torLogMaxLines+10; ix++ {
+		_, _ = l.Write([]byte(fmt.Sprintf("line %d\n", ix)))
+	}
+
+	lines := l.get()
+	c.Assert(lines, HasLen, torLogMaxLines)
+	c.Assert(lines[0], Equals, "line 10")
+	c.Assert(lines[torLogMaxLines-1], Equals, fmt.Sprintf("line %d", torLogMaxLines+9))
+}
+
+func (s *WahayTorSuite) Test_torLog_addEvent_addsTheScrubbedMessage(c *C) {
+	l := newTorLog()
+
+	l.addEvent(Event{Type: "WARN", Args: []string{"Problem", "bootstrapping", "with", "1.2.3.4"}})
+
+	lines := l.get()
+	c.Assert(lines, HasLen, 1)
+	c.Assert(lines[0], Matches, `.* \[warn\] Problem bootstrapping with \[address\]`)
+}
+
+func (s *WahayTorSuite) Test_instance_TorLog_returnsNothingForTheSystemTor(c *C) {
+	i := &instance{isLocal: true}
+
+	c.Assert(i.TorLog(), IsNil)
+}
@@ -5,8 +5,13 @@ import "github.com/wybiral/torgo"
 type torgoController interface {
 	AuthenticatePassword(string) error
 	AuthenticateCookie() error
+	AuthenticateSafeCookie() error
+	AuthMethods() []string
 	AuthenticateNone() error
 	AddOnion(*torgo.Onion) error
 	GetVersion() (string, error)
 	DeleteOnion(string) error
+	Request(string) (string, error)
+	ReadEvent() (string, error)
+	Close() error
 }
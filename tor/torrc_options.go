@@ -0,0 +1,82 @@
+package tor
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// deniedTorOptions are the options that can't be set through the extra
+// options of the configuration. Wahay depends on the values it generates for
+// some of them, and the rest could make Tor run code, read or write files
+// somewhere else, or stop protecting the location of the user
+var deniedTorOptions = map[string]bool{
+	"socksport":                     true,
+	"controlport":                   true,
+	"controlsocket":                 true,
+	"datadirectory":                 true,
+	"cookieauthentication":          true,
+	"cookieauthfile":                true,
+	"hashedcontrolpassword":         true,
+	"__owningcontrollerprocess":     true,
+	"clientonionauthdir":            true,
+	"hiddenservicedir":              true,
+	"hiddenserviceport":             true,
+	"hiddenservicesinglehopmode":    true,
+	"hiddenservicenonanonymousmode": true,
+	"clienttransportplugin":         true,
+	"servertransportplugin":         true,
+	"runasdaemon":                   true,
+	"user":                          true,
+	"%include":                      true,
+}
+
+var validTorOptionName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// isAllowedTorOption returns nil if the option can be appended to the
+// torrc without changing anything Wahay relies on
+func isAllowedTorOption(key, value string) error {
+	if !validTorOptionName.MatchString(key) {
+		return fmt.Errorf("invalid Tor option name: %q", key)
+	}
+
+	if deniedTorOptions[strings.ToLower(key)] {
+		return fmt.Errorf("the Tor option %s can't be changed", key)
+	}
+
+	if strings.ContainsAny(value, "\r\n") {
+		return fmt.Errorf("the value of the Tor option %s can't span multiple lines", key)
+	}
+
+	return nil
+}
+
+// torrcOptions returns the given options as torrc lines, sorted by name so
+// the generated file is always the same. Options that are not allowed are
+// skipped with a warning instead of failing, so a bad configuration never
+// stops Tor from starting
+func torrcOptions(options map[string]string) string {
+	keys := make([]string, 0, len(options))
+	for k := range options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	lines := []string{}
+	for _, k := range keys {
+		if err := isAllowedTorOption(k, options[k]); err != nil {
+			log.Warnf("torrcOptions(): ignoring option: %v", err)
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s %s", k, options[k]))
+	}
+
+	if len(lines) == 0 {
+		return ""
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
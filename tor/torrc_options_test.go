@@ -0,0 +1,60 @@
+package tor
+
+import (
+	"io/ioutil"
+
+	log "github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_torrcOptions_returnsTheOptionsSortedByName(c *C) {
+	result := torrcOptions(map[string]string{
+		"ExcludeNodes":      "{ru},{by}",
+		"ConnectionPadding": "1",
+	})
+
+	c.Assert(result, Equals, "ConnectionPadding 1\nExcludeNodes {ru},{by}\n")
+}
+
+func (s *WahayTorSuite) Test_torrcOptions_returnsNothingWithoutOptions(c *C) {
+	c.Assert(torrcOptions(nil), Equals, "")
+}
+
+func (s *WahayTorSuite) Test_torrcOptions_skipsTheDeniedOptions(c *C) {
+	log.SetOutput(ioutil.Discard)
+
+	result := torrcOptions(map[string]string{
+		"ControlPort":       "9999",
+		"socksport":         "0",
+		"DataDirectory":     "/tmp",
+		"ConnectionPadding": "1",
+	})
+
+	c.Assert(result, Equals, "ConnectionPadding 1\n")
+}
+
+func (s *WahayTorSuite) Test_torrcOptions_doesntAllowInjectingOtherOptions(c *C) {
+	log.SetOutput(ioutil.Discard)
+
+	result := torrcOptions(map[string]string{
+		"ConnectionPadding":   "1\nControlPort 9999",
+		"Log notice\nSocks":   "1",
+		"":                    "1",
+		"HiddenServiceDir /x": "1",
+	})
+
+	c.Assert(result, Equals, "")
+}
+
+func (s *WahayTorSuite) Test_instance_getConfigFileContents_appendsTheExtraOptions(c *C) {
+	i := &instance{
+		socksPort:     9050,
+		controlPort:   9051,
+		dataDirectory: "/tmp/data",
+		extraOptions:  map[string]string{"ConnectionPadding": "1"},
+	}
+
+	content := string(i.getConfigFileContents())
+
+	c.Assert(content, Matches, "(?s).*\n## Options from the Wahay configuration\nConnectionPadding 1\n$")
+}
@@ -0,0 +1,120 @@
+package tor
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+// UpstreamProxy is the proxy Tor has to go through to reach the network,
+// for networks where every outbound connection must use a proxy. The
+// addresses have the form host:port, optionally preceded by user:password@
+type UpstreamProxy struct {
+	HTTPS  string
+	Socks5 string
+}
+
+// ErrTorProxyUnreachable is returned when the configured proxy can't be
+// reached, so Tor won't be able to connect through it
+var ErrTorProxyUnreachable = errors.New("the configured proxy can't be reached")
+
+var errInvalidProxyAddress = errors.New("invalid proxy address")
+
+// UpstreamProxyFrom returns the proxy configured by the user
+func UpstreamProxyFrom(conf *config.ApplicationConfig) UpstreamProxy {
+	return UpstreamProxy{
+		HTTPS:  conf.GetHTTPSProxy(),
+		Socks5: conf.GetSocks5Proxy(),
+	}
+}
+
+// parseProxyAddress splits an address like user:password@host:port
+func parseProxyAddress(v string) (address, username, password string, err error) {
+	if strings.ContainsAny(v, " \t\r\n") {
+		return "", "", "", errInvalidProxyAddress
+	}
+
+	address = v
+	if ix := strings.LastIndex(v, "@"); ix >= 0 {
+		address = v[ix+1:]
+		parts := strings.SplitN(v[:ix], ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return "", "", "", errInvalidProxyAddress
+		}
+		username, password = parts[0], parts[1]
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil || host == "" || port == "" {
+		return "", "", "", errInvalidProxyAddress
+	}
+
+	return address, username, password, nil
+}
+
+// active returns the proxy Tor will use. Tor only accepts one, so the
+// SOCKS proxy wins when both are configured
+func (p UpstreamProxy) active() (kind, value string) {
+	if p.Socks5 != "" {
+		if p.HTTPS != "" {
+			log.Warnf("UpstreamProxy: only one proxy can be used, ignoring the HTTPS proxy")
+		}
+		return "socks5", p.Socks5
+	}
+
+	if p.HTTPS != "" {
+		return "https", p.HTTPS
+	}
+
+	return "", ""
+}
+
+// address returns the host and port of the proxy Tor will use, or an
+// empty string if there is none
+func (p UpstreamProxy) address() string {
+	_, value := p.active()
+	if value == "" {
+		return ""
+	}
+
+	address, _, _, err := parseProxyAddress(value)
+	if err != nil {
+		return ""
+	}
+
+	return address
+}
+
+// torrc returns the proxy as torrc lines. An invalid address is
+// skipped with a warning
+func (p UpstreamProxy) torrc() string {
+	kind, value := p.active()
+	if value == "" {
+		return ""
+	}
+
+	address, username, password, err := parseProxyAddress(value)
+	if err != nil {
+		log.Warnf("UpstreamProxy: ignoring invalid %s proxy address", kind)
+		return ""
+	}
+
+	if kind == "https" {
+		content := fmt.Sprintf("HTTPSProxy %s\n", address)
+		if username != "" {
+			content += fmt.Sprintf("HTTPSProxyAuthenticator %s:%s\n", username, password)
+		}
+		return content
+	}
+
+	content := fmt.Sprintf("Socks5Proxy %s\n", address)
+	if username != "" {
+		content += fmt.Sprintf("Socks5ProxyUsername %s\nSocks5ProxyPassword %s\n", username, password)
+	}
+	return content
+}
@@ -0,0 +1,88 @@
+package tor
+
+import (
+	"context"
+	"io/ioutil"
+
+	log "github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+
+	"github.com/digitalautonomy/wahay/config"
+)
+
+func (s *WahayTorSuite) Test_UpstreamProxy_torrc_generatesTheHTTPSProxy(c *C) {
+	p := UpstreamProxy{HTTPS: "alice:s3cret@proxy.example.org:3128"}
+
+	c.Assert(p.torrc(), Equals, "HTTPSProxy proxy.example.org:3128\nHTTPSProxyAuthenticator alice:s3cret\n")
+}
+
+func (s *WahayTorSuite) Test_UpstreamProxy_torrc_generatesTheSocks5Proxy(c *C) {
+	p := UpstreamProxy{Socks5: "bob:pass@10.0.0.1:1080"}
+
+	c.Assert(p.torrc(), Equals, "Socks5Proxy 10.0.0.1:1080\nSocks5ProxyUsername bob\nSocks5ProxyPassword pass\n")
+	c.Assert(UpstreamProxy{Socks5: "[::1]:1080"}.torrc(), Equals, "Socks5Proxy [::1]:1080\n")
+}
+
+func (s *WahayTorSuite) Test_UpstreamProxy_torrc_prefersTheSocks5Proxy(c *C) {
+	log.SetOutput(ioutil.Discard)
+
+	p := UpstreamProxy{HTTPS: "proxy:3128", Socks5: "proxy:1080"}
+
+	c.Assert(p.torrc(), Equals, "Socks5Proxy proxy:1080\n")
+	c.Assert(p.address(), Equals, "proxy:1080")
+}
+
+func (s *WahayTorSuite) Test_UpstreamProxy_torrc_skipsInvalidAddresses(c *C) {
+	log.SetOutput(ioutil.Discard)
+
+	c.Assert(UpstreamProxy{}.torrc(), Equals, "")
+	c.Assert(UpstreamProxy{HTTPS: "proxy"}.torrc(), Equals, "")
+	c.Assert(UpstreamProxy{HTTPS: "proxy:3128\nControlPort 1"}.torrc(), Equals, "")
+	c.Assert(UpstreamProxy{Socks5: "nopassword@proxy:1080"}.torrc(), Equals, "")
+	c.Assert(UpstreamProxy{Socks5: "proxy"}.address(), Equals, "")
+}
+
+func (s *WahayTorSuite) Test_UpstreamProxyFrom_readsTheConfiguration(c *C) {
+	conf := &config.ApplicationConfig{}
+	conf.SetHTTPSProxy("proxy:3128")
+	conf.SetSocks5Proxy("proxy:1080")
+
+	c.Assert(UpstreamProxyFrom(conf), DeepEquals, UpstreamProxy{HTTPS: "proxy:3128", Socks5: "proxy:1080"})
+}
+
+func (s *WahayTorSuite) Test_instance_getConfigFileContents_includesTheProxy(c *C) {
+	i := &instance{
+		socksPort:     9050,
+		controlPort:   9051,
+		dataDirectory: "/tmp/data",
+		proxy:         UpstreamProxy{HTTPS: "proxy:3128"},
+	}
+
+	content := string(i.getConfigFileContents())
+
+	c.Assert(content, Matches, "(?s).*\n## Upstream proxy\nHTTPSProxy proxy:3128\n$")
+}
+
+func (s *WahayTorSuite) Test_connectivity_check_reportsAnUnreachableProxy(c *C) {
+	mockSystemTorWithoutConnection()
+	defer setDefaultFacades()
+
+	checker := newCustomChecker("127.0.0.1", 9050, 9051, TorCheck{}, UpstreamProxy{HTTPS: "proxy:3128"})
+
+	r := checker.check(context.Background())
+
+	c.Assert(r.Fatal, Equals, ErrTorProxyUnreachable)
+	c.Assert(mockhttpf.canConnectArg, Equals, "proxy:3128")
+}
+
+func (s *WahayTorSuite) Test_connectivity_check_blamesTorWhenTheProxyAnswers(c *C) {
+	mockSystemTorWithoutConnection()
+	defer setDefaultFacades()
+	mockhttpf.canConnectReturn = true
+
+	checker := newCustomChecker("127.0.0.1", 9050, 9051, TorCheck{}, UpstreamProxy{HTTPS: "proxy:3128"})
+
+	r := checker.check(context.Background())
+
+	c.Assert(r.Fatal, Equals, ErrFatalTorNoConnectionAllowed)
+}
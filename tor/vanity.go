@@ -0,0 +1,200 @@
+package tor
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// OnionKey is the key of an onion service, created before publishing
+// the service so that its address can be chosen
+type OnionKey struct {
+	// ServiceID is the address of the onion service, with the .onion suffix
+	ServiceID string
+	// PrivateKey is the expanded ed25519 key, in the format Tor expects
+	PrivateKey string
+}
+
+const (
+	// maxVanityPrefixLength bounds the work of finding an address, since
+	// every character more multiplies it by 32. Five characters take
+	// some minutes on a normal computer
+	maxVanityPrefixLength = 5
+
+	// vanityWorkFactor is how many times the expected number of attempts
+	// are made before giving up. The chance of giving up with a prefix
+	// that could be found is less than one in a million
+	vanityWorkFactor = 16
+
+	onionAddressVersion = 0x03
+	onionChecksumPrefix = ".onion checksum"
+)
+
+var (
+	// ErrInvalidVanityPrefix is returned when the prefix can't be part of
+	// an onion address, or is too long to be found in reasonable time
+	ErrInvalidVanityPrefix = errors.New("the prefix can't be used for an onion address")
+
+	// ErrVanityPrefixNotFound is returned when no address with the prefix
+	// was found after the maximum number of attempts
+	ErrVanityPrefixNotFound = errors.New("no onion address with the prefix was found")
+)
+
+var onionEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func validVanityPrefix(prefix string) bool {
+	if prefix == "" || len(prefix) > maxVanityPrefixLength {
+		return false
+	}
+
+	for _, c := range prefix {
+		if !(c >= 'a' && c <= 'z') && !(c >= '2' && c <= '7') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// vanityAttempts returns the number of keys generated before giving up
+func vanityAttempts(prefix string) int64 {
+	return vanityWorkFactor << (5 * len(prefix))
+}
+
+// onionAddress returns the v3 onion address of a public key
+func onionAddress(pub ed25519.PublicKey) string {
+	h := sha3.New256()
+	h.Write([]byte(onionChecksumPrefix))
+	h.Write(pub)
+	h.Write([]byte{onionAddressVersion})
+	checksum := h.Sum(nil)
+
+	content := append(append([]byte{}, pub...), checksum[0], checksum[1], onionAddressVersion)
+
+	return strings.ToLower(onionEncoding.EncodeToString(content)) + ".onion"
+}
+
+// expandedOnionKey returns the private key in the format Tor uses for the
+// keys of onion services, which is the hashed and clamped seed
+func expandedOnionKey(priv ed25519.PrivateKey) string {
+	h := sha512.Sum512(priv.Seed())
+	h[0] &= 248
+	h[31] &= 127
+	h[31] |= 64
+
+	return base64.StdEncoding.EncodeToString(h[:])
+}
+
+// matchesVanityPrefix checks the prefix without computing the whole
+// address, since the first characters only depend on the public key
+func matchesVanityPrefix(pub ed25519.PublicKey, prefix string) bool {
+	n := (len(prefix)*5 + 7) / 8
+	return strings.HasPrefix(strings.ToLower(onionEncoding.EncodeToString(pub[:n])), prefix)
+}
+
+func newOnionKey(priv ed25519.PrivateKey) *OnionKey {
+	return &OnionKey{
+		ServiceID:  onionAddress(priv.Public().(ed25519.PublicKey)),
+		PrivateKey: expandedOnionKey(priv),
+	}
+}
+
+// GenerateOnionKey creates a new onion key with a random address
+func GenerateOnionKey() (*OnionKey, error) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return newOnionKey(priv), nil
+}
+
+// HasVanityPrefix returns true if the address of the key starts
+// with the prefix, ignoring case and surrounding spaces
+func (k *OnionKey) HasVanityPrefix(prefix string) bool {
+	return strings.HasPrefix(k.ServiceID, strings.ToLower(strings.TrimSpace(prefix)))
+}
+
+// MineOnionKey looks for an onion key whose address starts with the
+// prefix, using the given number of workers, or one per CPU if it's zero.
+// The work is bounded, and it stops as soon as ctx is done
+func MineOnionKey(ctx context.Context, prefix string, workers int) (*OnionKey, error) {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	if !validVanityPrefix(prefix) {
+		return nil, ErrInvalidVanityPrefix
+	}
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		attempts = vanityAttempts(prefix)
+		tried    int64
+		found    = make(chan ed25519.PrivateKey, 1)
+		failed   = make(chan error, 1)
+		wg       sync.WaitGroup
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for ctx.Err() == nil && atomic.AddInt64(&tried, 1) <= attempts {
+				pub, priv, err := ed25519.GenerateKey(rand.Reader)
+				if err != nil {
+					select {
+					case failed <- err:
+					default:
+					}
+					return
+				}
+
+				if matchesVanityPrefix(pub, prefix) {
+					select {
+					case found <- priv:
+					default:
+					}
+					return
+				}
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case priv := <-found:
+		return newOnionKey(priv), nil
+	case err := <-failed:
+		return nil, err
+	case <-done:
+		// The last worker could have found it just before finishing
+		select {
+		case priv := <-found:
+			return newOnionKey(priv), nil
+		default:
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, ErrVanityPrefixNotFound
+	}
+}
@@ -0,0 +1,87 @@
+package tor
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *WahayTorSuite) Test_onionAddress_encodesTheKeyWithChecksumAndVersion(c *C) {
+	pub := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)).Public().(ed25519.PublicKey)
+
+	address := onionAddress(pub)
+
+	c.Assert(address, HasLen, 62)
+	c.Assert(strings.HasSuffix(address, "d.onion"), Equals, true)
+
+	content, err := onionEncoding.DecodeString(strings.ToUpper(strings.TrimSuffix(address, ".onion")))
+	c.Assert(err, IsNil)
+	c.Assert([]byte(content[:32]), DeepEquals, []byte(pub))
+	c.Assert(content[34], Equals, byte(onionAddressVersion))
+}
+
+func (s *WahayTorSuite) Test_onionAddress_computesTheChecksumOfARealAddress(c *C) {
+	address := "2gzyxa5ihm7nsggfxnu52rck2vv4rvmdlkiu3zzui5du4xyclen53wid.onion"
+	content, _ := onionEncoding.DecodeString(strings.ToUpper(strings.TrimSuffix(address, ".onion")))
+
+	c.Assert(onionAddress(ed25519.PublicKey(content[:32])), Equals, address)
+}
+
+func (s *WahayTorSuite) Test_expandedOnionKey_clampsTheHashedSeed(c *C) {
+	key, err := base64.StdEncoding.DecodeString(expandedOnionKey(ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))))
+
+	c.Assert(err, IsNil)
+	c.Assert(key, HasLen, 64)
+	c.Assert(key[0]&7, Equals, byte(0))
+	c.Assert(key[31]&192, Equals, byte(64))
+}
+
+func (s *WahayTorSuite) Test_MineOnionKey_findsAnAddressWithThePrefix(c *C) {
+	key, err := MineOnionKey(context.Background(), " Wa ", 2)
+
+	c.Assert(err, IsNil)
+	c.Assert(key.ServiceID, Matches, "wa[a-z2-7]{54}\\.onion")
+	c.Assert(key.PrivateKey, Not(Equals), "")
+}
+
+func (s *WahayTorSuite) Test_MineOnionKey_rejectsPrefixesThatCantBeUsed(c *C) {
+	for _, prefix := range []string{"", "wahay1", "abc-", "toolong"} {
+		_, err := MineOnionKey(context.Background(), prefix, 1)
+		c.Assert(err, Equals, ErrInvalidVanityPrefix, Commentf("prefix %q", prefix))
+	}
+}
+
+func (s *WahayTorSuite) Test_MineOnionKey_stopsWhenTheContextIsDone(c *C) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := MineOnionKey(ctx, "wahay", 2)
+
+	c.Assert(err, Equals, context.Canceled)
+}
+
+func (s *WahayTorSuite) Test_instance_NewOnionServiceWithMultiplePorts_usesTheGivenKey(c *C) {
+	mock := &controllerMock{requestReturn1: "ServiceID=abcdef"}
+	i := &instance{
+		singleHop:  true,
+		controller: &controller{tc: mock.createTestGotor},
+	}
+
+	_, err := i.NewOnionServiceWithMultiplePorts([]OnionPort{{DestinationHost: "127.0.0.1", DestinationPort: 8080, ServicePort: 80}},
+		WithPrivateKey(&OnionKey{PrivateKey: "secretkey"}))
+
+	c.Assert(err, IsNil)
+	c.Assert(mock.requestArgs, DeepEquals, []string{"ADD_ONION ED25519-V3:secretkey Flags=NonAnonymous Port=80,127.0.0.1:8080"})
+}
+
+func (s *WahayTorSuite) Test_GenerateOnionKey_createsAKeyForItsAddress(c *C) {
+	key, err := GenerateOnionKey()
+
+	c.Assert(err, IsNil)
+	c.Assert(key.ServiceID, Matches, "[a-z2-7]{55}d\\.onion")
+	c.Assert(key.HasVanityPrefix(""), Equals, true)
+	c.Assert(key.HasVanityPrefix(strings.ToUpper(key.ServiceID[:3])), Equals, true)
+}